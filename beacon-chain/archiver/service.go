@@ -3,6 +3,7 @@ package archiver
 import (
 	"context"
 	"fmt"
+	"reflect"
 
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
@@ -14,10 +15,13 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/sirupsen/logrus"
 )
 
+var _ = shared.DependentService(&Service{})
+
 var log = logrus.WithField("prefix", "archiver")
 
 // Service defining archiver functionality for persisting checkpointed
@@ -70,6 +74,11 @@ func (s *Service) Status() error {
 	return nil
 }
 
+// Dependencies of the archiver, which archives the head state of the blockchain service.
+func (s *Service) Dependencies() []reflect.Type {
+	return shared.DependencyTypes(s.headFetcher)
+}
+
 // We archive committee information pertaining to the head state's epoch.
 func (s *Service) archiveCommitteeInfo(ctx context.Context, headState *state.BeaconState, epoch uint64) error {
 	proposerSeed, err := helpers.Seed(headState, epoch, params.BeaconConfig().DomainBeaconProposer)
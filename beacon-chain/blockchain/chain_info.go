@@ -9,6 +9,7 @@ import (
 	"github.com/prysmaticlabs/go-ssz"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/epoch/precompute"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	f "github.com/prysmaticlabs/prysm/beacon-chain/forkchoice"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/params"
@@ -24,6 +25,7 @@ type ChainInfoFetcher interface {
 // TimeFetcher retrieves the Eth2 data that's related to time.
 type TimeFetcher interface {
 	GenesisTime() time.Time
+	GenesisValidatorRoot() [32]byte
 	CurrentSlot() uint64
 }
 
@@ -57,6 +59,11 @@ type ParticipationFetcher interface {
 	Participation(epoch uint64) *precompute.Balance
 }
 
+// ForkChoiceFetcher retrieves the fork choice store of the beacon chain, to inspect its nodes and votes.
+type ForkChoiceFetcher interface {
+	ForkChoiceStore() f.Getter
+}
+
 // FinalizedCheckpt returns the latest finalized checkpoint from head state.
 func (s *Service) FinalizedCheckpt() *ethpb.Checkpoint {
 	if s.finalizedCheckpt == nil {
@@ -178,6 +185,12 @@ func (s *Service) GenesisTime() time.Time {
 	return s.genesisTime
 }
 
+// GenesisValidatorRoot returns the genesis validators root of the beacon chain, which is
+// the hash tree root of the validator registry in the genesis state.
+func (s *Service) GenesisValidatorRoot() [32]byte {
+	return s.genesisValidatorsRoot
+}
+
 // CurrentFork retrieves the latest fork information of the beacon chain.
 func (s *Service) CurrentFork() *pb.Fork {
 	if !s.hasHeadState() {
@@ -196,3 +209,8 @@ func (s *Service) Participation(epoch uint64) *precompute.Balance {
 
 	return s.epochParticipation[epoch]
 }
+
+// ForkChoiceStore returns the fork choice store of the chain service.
+func (s *Service) ForkChoiceStore() f.Getter {
+	return s.forkChoiceStore
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
@@ -14,7 +15,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/params"
-	"github.com/prysmaticlabs/prysm/shared/roughtime"
+	"github.com/prysmaticlabs/prysm/shared/slotutil"
 	"github.com/prysmaticlabs/prysm/shared/traceutil"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
@@ -22,7 +23,26 @@ import (
 
 // CurrentSlot returns the current slot based on time.
 func (s *Service) CurrentSlot() uint64 {
-	return uint64(roughtime.Now().Unix()-s.genesisTime.Unix()) / params.BeaconConfig().SecondsPerSlot
+	return uint64(slotutil.ClockOrDefault(s.clock).Now().Unix()-s.genesisTime.Unix()) / params.BeaconConfig().SecondsPerSlot
+}
+
+// isTimelyBlock returns true if the block of the given slot was received during the first interval
+// of its slot, in which case it is boosted in fork choice until the end of the slot.
+func (s *Service) isTimelyBlock(slot uint64, receivedTime time.Time) bool {
+	secondsPerSlot := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+	slotStart := s.genesisTime.Add(time.Duration(slot) * secondsPerSlot)
+	sinceSlotStart := receivedTime.Sub(slotStart)
+	return sinceSlotStart >= 0 && sinceSlotStart < secondsPerSlot/time.Duration(params.BeaconConfig().IntervalsPerSlot)
+}
+
+// justifiedActiveBalance returns the total active balance of the justified checkpoint state, which
+// weights the proposer boost of timely blocks.
+func (s *Service) justifiedActiveBalance(ctx context.Context) (uint64, error) {
+	justifiedState, err := s.getAttPreState(ctx, s.justifiedCheckpt)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not get justified checkpoint state")
+	}
+	return helpers.TotalActiveBalance(justifiedState)
 }
 
 // getBlockPreState returns the pre state of an incoming block. It uses the parent root of the block
@@ -39,7 +59,7 @@ func (s *Service) getBlockPreState(ctx context.Context, b *ethpb.BeaconBlock) (*
 	}
 
 	// Verify block slot time is not from the feature.
-	if err := helpers.VerifySlotTime(preState.GenesisTime(), b.Slot); err != nil {
+	if err := helpers.VerifySlotTimeAt(preState.GenesisTime(), b.Slot, slotutil.ClockOrDefault(s.clock).Now()); err != nil {
 		return nil, err
 	}
 
@@ -372,14 +392,15 @@ func (s *Service) filterBlockRoots(ctx context.Context, roots [][32]byte) ([][32
 // ancestor returns the block root of an ancestry block from the input block root.
 //
 // Spec pseudocode definition:
-//   def get_ancestor(store: Store, root: Hash, slot: Slot) -> Hash:
-//    block = store.blocks[root]
-//    if block.slot > slot:
-//      return get_ancestor(store, block.parent_root, slot)
-//    elif block.slot == slot:
-//      return root
-//    else:
-//      return Bytes32()  # root is older than queried slot: no results.
+//
+//	def get_ancestor(store: Store, root: Hash, slot: Slot) -> Hash:
+//	 block = store.blocks[root]
+//	 if block.slot > slot:
+//	   return get_ancestor(store, block.parent_root, slot)
+//	 elif block.slot == slot:
+//	   return root
+//	 else:
+//	   return Bytes32()  # root is older than queried slot: no results.
 func (s *Service) ancestor(ctx context.Context, root []byte, slot uint64) ([]byte, error) {
 	ctx, span := trace.StartSpan(ctx, "forkchoice.ancestor")
 	defer span.End()
@@ -420,11 +441,12 @@ func (s *Service) ancestor(ctx context.Context, root []byte, slot uint64) ([]byt
 // the store's justified is not in chain with finalized check point.
 //
 // Spec definition:
-//   if (
-//            state.current_justified_checkpoint.epoch > store.justified_checkpoint.epoch
-//            or get_ancestor(store, store.justified_checkpoint.root, finalized_slot) != store.finalized_checkpoint.root
-//        ):
-//            store.justified_checkpoint = state.current_justified_checkpoint
+//
+//	if (
+//	         state.current_justified_checkpoint.epoch > store.justified_checkpoint.epoch
+//	         or get_ancestor(store, store.justified_checkpoint.root, finalized_slot) != store.finalized_checkpoint.root
+//	     ):
+//	         store.justified_checkpoint = state.current_justified_checkpoint
 func (s *Service) finalizedImpliesNewJustified(ctx context.Context, state *stateTrie.BeaconState) error {
 	finalizedBlkSigned, err := s.beaconDB.Block(ctx, bytesutil.ToBytes32(s.finalizedCheckpt.Root))
 	if err != nil || finalizedBlkSigned == nil || finalizedBlkSigned.Block == nil {
@@ -699,3 +699,53 @@ func blockTree1(db db.Database, genesisRoot []byte) ([][]byte, error) {
 	}
 	return [][]byte{r0[:], r1[:], nil, r3[:], r4[:], r5[:], r6[:], r7[:], r8[:]}, nil
 }
+
+func TestIsTimelyBlock(t *testing.T) {
+	genesis := time.Unix(1000, 0)
+	service := &Service{genesisTime: genesis}
+	// Mainnet slots of 12 seconds have a first interval of 4 seconds.
+	slotStart := genesis.Add(10 * 12 * time.Second)
+	tests := []struct {
+		name         string
+		receivedTime time.Time
+		want         bool
+	}{
+		{name: "start of the slot", receivedTime: slotStart, want: true},
+		{name: "first interval", receivedTime: slotStart.Add(3 * time.Second), want: true},
+		{name: "second interval", receivedTime: slotStart.Add(4 * time.Second), want: false},
+		{name: "next slot", receivedTime: slotStart.Add(13 * time.Second), want: false},
+		{name: "before the slot", receivedTime: slotStart.Add(-time.Second), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := service.isTimelyBlock(10, tt.receivedTime); got != tt.want {
+				t.Errorf("Expected timely %v, received %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestJustifiedActiveBalance(t *testing.T) {
+	ctx := context.Background()
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+
+	service, err := NewService(ctx, &Config{BeaconDB: db})
+	if err != nil {
+		t.Fatal(err)
+	}
+	justifiedState, _ := testutil.DeterministicGenesisState(t, 64)
+	r := [32]byte{'j'}
+	if err := db.SaveState(ctx, justifiedState, r); err != nil {
+		t.Fatal(err)
+	}
+	service.justifiedCheckpt = &ethpb.Checkpoint{Root: r[:]}
+
+	balance, err := service.justifiedActiveBalance(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := 64 * params.BeaconConfig().MaxEffectiveBalance; balance != want {
+		t.Errorf("Wanted justified active balance %d, received %d", want, balance)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/hex"
+	"time"
 
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
@@ -15,6 +16,8 @@ import (
 	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateutil"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/slottiming"
+	"github.com/prysmaticlabs/prysm/shared/slotutil"
 	"github.com/prysmaticlabs/prysm/shared/traceutil"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
@@ -31,13 +34,14 @@ type BlockReceiver interface {
 
 // ReceiveBlock is a function that defines the operations that are preformed on
 // blocks that is received from rpc service. The operations consists of:
-//   1. Gossip block to other peers
-//   2. Validate block, apply state transition and update check points
-//   3. Apply fork choice to the processed block
-//   4. Save latest head info
+//  1. Gossip block to other peers
+//  2. Validate block, apply state transition and update check points
+//  3. Apply fork choice to the processed block
+//  4. Save latest head info
 func (s *Service) ReceiveBlock(ctx context.Context, block *ethpb.SignedBeaconBlock) error {
 	ctx, span := trace.StartSpan(ctx, "beacon-chain.blockchain.ReceiveBlock")
 	defer span.End()
+	receivedTime := slotutil.ClockOrDefault(s.clock).Now()
 
 	root, err := ssz.HashTreeRoot(block.Block)
 	if err != nil {
@@ -48,11 +52,12 @@ func (s *Service) ReceiveBlock(ctx context.Context, block *ethpb.SignedBeaconBlo
 	if err := s.p2p.Broadcast(ctx, block); err != nil {
 		return errors.Wrap(err, "could not broadcast block")
 	}
+	slottiming.Step(ctx, "blockBroadcast")
 	log.WithFields(logrus.Fields{
 		"blockRoot": hex.EncodeToString(root[:]),
 	}).Debug("Broadcasting block")
 
-	if err := s.ReceiveBlockNoPubsub(ctx, block); err != nil {
+	if err := s.receiveBlockNoPubsub(ctx, block, receivedTime); err != nil {
 		return err
 	}
 
@@ -61,10 +66,16 @@ func (s *Service) ReceiveBlock(ctx context.Context, block *ethpb.SignedBeaconBlo
 
 // ReceiveBlockNoPubsub is a function that defines the the operations (minus pubsub)
 // that are preformed on blocks that is received from regular sync service. The operations consists of:
-//   1. Validate block, apply state transition and update check points
-//   2. Apply fork choice to the processed block
-//   3. Save latest head info
+//  1. Validate block, apply state transition and update check points
+//  2. Apply fork choice to the processed block
+//  3. Save latest head info
 func (s *Service) ReceiveBlockNoPubsub(ctx context.Context, block *ethpb.SignedBeaconBlock) error {
+	return s.receiveBlockNoPubsub(ctx, block, slotutil.ClockOrDefault(s.clock).Now())
+}
+
+// This processes a block received at the given time, the time at which a block is received
+// determining whether it is boosted in fork choice.
+func (s *Service) receiveBlockNoPubsub(ctx context.Context, block *ethpb.SignedBeaconBlock, receivedTime time.Time) error {
 	ctx, span := trace.StartSpan(ctx, "beacon-chain.blockchain.ReceiveBlockNoPubsub")
 	defer span.End()
 	blockCopy := stateTrie.CopySignedBeaconBlock(block)
@@ -100,6 +111,13 @@ func (s *Service) ReceiveBlockNoPubsub(ctx context.Context, block *ethpb.SignedB
 			return errors.Wrap(err, "could not save head")
 		}
 	} else {
+		if featureconfig.Get().EnableProposerBoost && s.isTimelyBlock(blockCopy.Block.Slot, receivedTime) {
+			justifiedActiveBalance, err := s.justifiedActiveBalance(ctx)
+			if err != nil {
+				return errors.Wrap(err, "could not get justified active balance")
+			}
+			s.forkChoiceStore.BoostProposerRoot(ctx, root, justifiedActiveBalance)
+		}
 		if err := s.updateHead(ctx, postState.Balances()); err != nil {
 			return errors.Wrap(err, "could not save head")
 		}
@@ -126,8 +144,8 @@ func (s *Service) ReceiveBlockNoPubsub(ctx context.Context, block *ethpb.SignedB
 
 // ReceiveBlockNoPubsubForkchoice is a function that defines the all operations (minus pubsub and forkchoice)
 // that are preformed blocks that is received from initial sync service. The operations consists of:
-//   1. Validate block, apply state transition and update check points
-//   2. Save latest head info
+//  1. Validate block, apply state transition and update check points
+//  2. Save latest head info
 func (s *Service) ReceiveBlockNoPubsubForkchoice(ctx context.Context, block *ethpb.SignedBeaconBlock) error {
 	ctx, span := trace.StartSpan(ctx, "beacon-chain.blockchain.ReceiveBlockNoForkchoice")
 	defer span.End()
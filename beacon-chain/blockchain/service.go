@@ -6,6 +6,7 @@ package blockchain
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"runtime"
 	"sync"
 	"time"
@@ -33,13 +34,19 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
 	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateutil"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/slotutil"
 	"go.opencensus.io/trace"
 )
 
+var _ = shared.DependentService(&Service{})
+var _ = shared.ReadyService(&Service{})
+
 // Service represents a service that handles the internal
 // logic of managing the full PoS beacon chain.
 type Service struct {
@@ -58,6 +65,7 @@ type Service struct {
 	headLock               sync.RWMutex
 	stateNotifier          statefeed.Notifier
 	genesisRoot            [32]byte
+	genesisValidatorsRoot  [32]byte
 	epochParticipation     map[uint64]*precompute.Balance
 	epochParticipationLock sync.RWMutex
 	forkChoiceStore        f.ForkChoicer
@@ -77,6 +85,9 @@ type Service struct {
 	opsService             *attestations.Service
 	initSyncBlocks         map[[32]byte]*ethpb.SignedBeaconBlock
 	initSyncBlocksLock     sync.RWMutex
+	clock                  slotutil.Clock
+	ready                  bool
+	readyLock              sync.RWMutex
 }
 
 // Config options for the service.
@@ -94,6 +105,7 @@ type Config struct {
 	ForkChoiceStore   f.ForkChoicer
 	OpsService        *attestations.Service
 	StateGen          *stategen.State
+	Clock             slotutil.Clock
 }
 
 // NewService instantiates a new block service instance that will
@@ -120,6 +132,7 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 		opsService:         cfg.OpsService,
 		stateGen:           cfg.StateGen,
 		initSyncBlocks:     make(map[[32]byte]*ethpb.SignedBeaconBlock),
+		clock:              cfg.Clock,
 	}, nil
 }
 
@@ -192,7 +205,8 @@ func (s *Service) Start() {
 		s.stateNotifier.StateFeed().Send(&feed.Event{
 			Type: statefeed.Initialized,
 			Data: &statefeed.InitializedData{
-				StartTime: s.genesisTime,
+				StartTime:             s.genesisTime,
+				GenesisValidatorsRoot: s.genesisValidatorsRoot[:],
 			},
 		})
 	} else {
@@ -227,6 +241,10 @@ func (s *Service) Start() {
 	}
 
 	go s.processAttestation(attestationProcessorSubscribed)
+
+	s.readyLock.Lock()
+	s.ready = true
+	s.readyLock.Unlock()
 }
 
 // processChainStartTime initializes a series of deposits from the ChainStart deposits in the eth1
@@ -239,7 +257,8 @@ func (s *Service) processChainStartTime(ctx context.Context, genesisTime time.Ti
 	s.stateNotifier.StateFeed().Send(&feed.Event{
 		Type: statefeed.Initialized,
 		Data: &statefeed.InitializedData{
-			StartTime: genesisTime,
+			StartTime:             genesisTime,
+			GenesisValidatorsRoot: s.genesisValidatorsRoot[:],
 		},
 	})
 }
@@ -299,6 +318,23 @@ func (s *Service) Status() error {
 	return nil
 }
 
+// Ready returns an error until the service has initialized the chain info from the
+// database, or subscribed to the chain start if the database holds no chain.
+func (s *Service) Ready() error {
+	s.readyLock.RLock()
+	defer s.readyLock.RUnlock()
+	if !s.ready {
+		return errors.New("chain info not initialized")
+	}
+	return nil
+}
+
+// Dependencies of the blockchain service: the p2p service it broadcasts blocks with, the
+// proof of work chain it gets the chain start from, and the attestation pool service.
+func (s *Service) Dependencies() []reflect.Type {
+	return shared.DependencyTypes(s.p2p, s.chainStartFetcher, s.opsService)
+}
+
 // ClearCachedStates removes all stored caches states. This is done after the node
 // is synced.
 func (s *Service) ClearCachedStates() {
@@ -356,6 +392,11 @@ func (s *Service) saveGenesisData(ctx context.Context, genesisState *stateTrie.B
 	if err := s.saveGenesisValidators(ctx, genesisState); err != nil {
 		return errors.Wrap(err, "could not save genesis validators")
 	}
+	genesisValidatorsRoot, err := stateutil.ValidatorRegistryRoot(genesisState.Validators())
+	if err != nil {
+		return errors.Wrap(err, "could not compute genesis validators root")
+	}
+	s.genesisValidatorsRoot = genesisValidatorsRoot
 
 	genesisCheckpoint := &ethpb.Checkpoint{Root: genesisBlkRoot[:]}
 
@@ -395,6 +436,18 @@ func (s *Service) initializeChainInfo(ctx context.Context) error {
 	}
 	s.genesisRoot = genesisBlkRoot
 
+	genesisState, err := s.beaconDB.GenesisState(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get genesis state from db")
+	}
+	if genesisState == nil {
+		return errors.New("no genesis state in db")
+	}
+	s.genesisValidatorsRoot, err = stateutil.ValidatorRegistryRoot(genesisState.Validators())
+	if err != nil {
+		return errors.Wrap(err, "could not compute genesis validators root")
+	}
+
 	if flags.Get().UnsafeSync {
 		headBlock, err := s.beaconDB.HeadBlock(ctx)
 		if err != nil {
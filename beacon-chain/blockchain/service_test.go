@@ -27,6 +27,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
 	beaconstate "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateutil"
 	protodb "github.com/prysmaticlabs/prysm/proto/beacon/db"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/event"
@@ -249,8 +250,14 @@ func TestChainStartStop_Initialized(t *testing.T) {
 		t.Fatal(err)
 	}
 
+	if err := chainService.Ready(); err == nil {
+		t.Error("Expected the service not to be ready before it started")
+	}
 	// Test the start function.
 	chainService.Start()
+	if err := chainService.Ready(); err != nil {
+		t.Errorf("Expected the service to be ready once the chain info is initialized: %v", err)
+	}
 
 	if err := chainService.Stop(); err != nil {
 		t.Fatalf("unable to stop chain service: %v", err)
@@ -339,6 +346,10 @@ func TestChainService_InitializeChainInfo(t *testing.T) {
 	if err := db.SaveBlock(ctx, genesis); err != nil {
 		t.Fatal(err)
 	}
+	genesisState, _ := testutil.DeterministicGenesisState(t, 8)
+	if err := db.SaveState(ctx, genesisState, genesisRoot); err != nil {
+		t.Fatal(err)
+	}
 
 	finalizedSlot := params.BeaconConfig().SlotsPerEpoch*2 + 1
 	headBlock := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: finalizedSlot, ParentRoot: genesisRoot[:]}}
@@ -393,6 +404,13 @@ func TestChainService_InitializeChainInfo(t *testing.T) {
 	if c.genesisRoot != genesisRoot {
 		t.Error("genesis block root incorrect")
 	}
+	wantedRoot, err := stateutil.ValidatorRegistryRoot(genesisState.Validators())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.GenesisValidatorRoot() != wantedRoot {
+		t.Error("genesis validators root incorrect")
+	}
 }
 
 func TestChainService_SaveHeadNoDB(t *testing.T) {
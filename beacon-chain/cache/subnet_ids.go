@@ -0,0 +1,136 @@
+package cache
+
+import (
+	"sync"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/patrickmn/go-cache"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/sliceutil"
+)
+
+// subnetSlots is the number of slots for which attester and aggregator subnets are tracked,
+// validators announce their duties for the current and next epochs.
+const subnetSlots = 128
+
+type subnetIDs struct {
+	attester          *lru.Cache
+	attesterLock      sync.RWMutex
+	aggregator        *lru.Cache
+	aggregatorLock    sync.RWMutex
+	persistentSubnets *cache.Cache
+	subnetsLock       sync.RWMutex
+}
+
+// SubnetIDs for attester and aggregator duties, and the long-lived subnets of the validators
+// connected to the beacon node.
+var SubnetIDs = newSubnetIDs()
+
+func newSubnetIDs() *subnetIDs {
+	attesterCache, err := lru.New(subnetSlots)
+	if err != nil {
+		panic(err)
+	}
+	aggregatorCache, err := lru.New(subnetSlots)
+	if err != nil {
+		panic(err)
+	}
+	epochDuration := time.Duration(params.BeaconConfig().SlotsPerEpoch*params.BeaconConfig().SecondsPerSlot) * time.Second
+	subLength := epochDuration * time.Duration(params.BeaconConfig().EpochsPerRandomSubnetSubscription)
+	persistentCache := cache.New(subLength, epochDuration)
+	return &subnetIDs{attester: attesterCache, aggregator: aggregatorCache, persistentSubnets: persistentCache}
+}
+
+// AddAttesterSubnetID adds the subnet of an attester duty at the given slot.
+func (c *subnetIDs) AddAttesterSubnetID(slot uint64, subnetID uint64) {
+	c.attesterLock.Lock()
+	defer c.attesterLock.Unlock()
+
+	ids := []uint64{subnetID}
+	val, exists := c.attester.Get(slot)
+	if exists {
+		ids = sliceutil.UnionUint64(val.([]uint64), ids)
+	}
+	c.attester.Add(slot, ids)
+}
+
+// GetAttesterSubnetIDs returns the subnets of the attester duties at the given slot.
+func (c *subnetIDs) GetAttesterSubnetIDs(slot uint64) []uint64 {
+	c.attesterLock.RLock()
+	defer c.attesterLock.RUnlock()
+
+	val, exists := c.attester.Get(slot)
+	if !exists {
+		return nil
+	}
+	if v, ok := val.([]uint64); ok {
+		return v
+	}
+	return nil
+}
+
+// AddAggregatorSubnetID adds the subnet of an aggregator duty at the given slot.
+func (c *subnetIDs) AddAggregatorSubnetID(slot uint64, subnetID uint64) {
+	c.aggregatorLock.Lock()
+	defer c.aggregatorLock.Unlock()
+
+	ids := []uint64{subnetID}
+	val, exists := c.aggregator.Get(slot)
+	if exists {
+		ids = sliceutil.UnionUint64(val.([]uint64), ids)
+	}
+	c.aggregator.Add(slot, ids)
+}
+
+// GetAggregatorSubnetIDs returns the subnets of the aggregator duties at the given slot.
+func (c *subnetIDs) GetAggregatorSubnetIDs(slot uint64) []uint64 {
+	c.aggregatorLock.RLock()
+	defer c.aggregatorLock.RUnlock()
+
+	val, exists := c.aggregator.Get(slot)
+	if !exists {
+		return nil
+	}
+	if v, ok := val.([]uint64); ok {
+		return v
+	}
+	return nil
+}
+
+// GetPersistentSubnets returns the long-lived subnets of a validator, whether the validator
+// has any, and the time at which they expire.
+func (c *subnetIDs) GetPersistentSubnets(pubkey []byte) ([]uint64, bool, time.Time) {
+	c.subnetsLock.RLock()
+	defer c.subnetsLock.RUnlock()
+
+	val, expTime, ok := c.persistentSubnets.GetWithExpiration(string(pubkey))
+	if !ok {
+		return nil, false, time.Time{}
+	}
+	return val.([]uint64), ok, expTime
+}
+
+// GetAllSubnets returns the long-lived subnets of all the validators which have not expired.
+func (c *subnetIDs) GetAllSubnets() []uint64 {
+	c.subnetsLock.RLock()
+	defer c.subnetsLock.RUnlock()
+
+	var subnets []uint64
+	for _, item := range c.persistentSubnets.Items() {
+		if item.Expired() {
+			continue
+		}
+		subnets = append(subnets, item.Object.([]uint64)...)
+	}
+	return sliceutil.SetUint64(subnets)
+}
+
+// AddPersistentCommittee adds the long-lived subnets of a validator, which expire after the
+// given duration.
+func (c *subnetIDs) AddPersistentCommittee(pubkey []byte, subnetIDs []uint64, duration time.Duration) {
+	c.subnetsLock.Lock()
+	defer c.subnetsLock.Unlock()
+
+	c.persistentSubnets.Set(string(pubkey), subnetIDs, duration)
+}
@@ -0,0 +1,73 @@
+package cache
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+)
+
+func TestSubnetIDsCache_AttesterAndAggregator(t *testing.T) {
+	c := newSubnetIDs()
+	slot := uint64(100)
+
+	if ids := c.GetAttesterSubnetIDs(slot); len(ids) != 0 {
+		t.Errorf("Expected no attester subnets, got %v", ids)
+	}
+	c.AddAttesterSubnetID(slot, 2)
+	c.AddAttesterSubnetID(slot, 5)
+	c.AddAttesterSubnetID(slot, 2)
+	ids := c.GetAttesterSubnetIDs(slot)
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	if !reflect.DeepEqual(ids, []uint64{2, 5}) {
+		t.Errorf("Wanted attester subnets %v, got %v", []uint64{2, 5}, ids)
+	}
+
+	if ids := c.GetAggregatorSubnetIDs(slot); len(ids) != 0 {
+		t.Errorf("Expected no aggregator subnets, got %v", ids)
+	}
+	c.AddAggregatorSubnetID(slot, 7)
+	if ids := c.GetAggregatorSubnetIDs(slot); !reflect.DeepEqual(ids, []uint64{7}) {
+		t.Errorf("Wanted aggregator subnets %v, got %v", []uint64{7}, ids)
+	}
+	if ids := c.GetAggregatorSubnetIDs(slot + 1); len(ids) != 0 {
+		t.Errorf("Expected no aggregator subnets for the next slot, got %v", ids)
+	}
+}
+
+func TestSubnetIDsCache_PersistentSubnets(t *testing.T) {
+	c := newSubnetIDs()
+	pubkey1 := []byte{'A'}
+	pubkey2 := []byte{'B'}
+
+	if _, ok, _ := c.GetPersistentSubnets(pubkey1); ok {
+		t.Error("Expected no persistent subnets")
+	}
+	c.AddPersistentCommittee(pubkey1, []uint64{1, 3}, time.Minute)
+	c.AddPersistentCommittee(pubkey2, []uint64{3, 4}, time.Minute)
+
+	ids, ok, expTime := c.GetPersistentSubnets(pubkey1)
+	if !ok {
+		t.Fatal("Expected persistent subnets")
+	}
+	if !reflect.DeepEqual(ids, []uint64{1, 3}) {
+		t.Errorf("Wanted persistent subnets %v, got %v", []uint64{1, 3}, ids)
+	}
+	if !expTime.After(time.Now()) {
+		t.Errorf("Expected expiration in the future, got %v", expTime)
+	}
+
+	all := c.GetAllSubnets()
+	sort.Slice(all, func(i, j int) bool { return all[i] < all[j] })
+	if !reflect.DeepEqual(all, []uint64{1, 3, 4}) {
+		t.Errorf("Wanted all subnets %v, got %v", []uint64{1, 3, 4}, all)
+	}
+
+	c.AddPersistentCommittee(pubkey2, []uint64{5}, time.Millisecond)
+	time.Sleep(10 * time.Millisecond)
+	all = c.GetAllSubnets()
+	sort.Slice(all, func(i, j int) bool { return all[i] < all[j] })
+	if !reflect.DeepEqual(all, []uint64{1, 3}) {
+		t.Errorf("Expected expired subnets to be left out, wanted %v, got %v", []uint64{1, 3}, all)
+	}
+}
@@ -428,6 +428,25 @@ func ProcessProposerSlashings(
 	ctx context.Context,
 	beaconState *stateTrie.BeaconState,
 	body *ethpb.BeaconBlockBody,
+) (*stateTrie.BeaconState, error) {
+	return processProposerSlashings(beaconState, body, VerifyProposerSlashing)
+}
+
+// ProcessProposerSlashingsNoVerify processes the proposer slashings in a block body
+// without verifying the signatures of the slashed headers. This is used when the
+// signatures of the block have been verified separately.
+func ProcessProposerSlashingsNoVerify(
+	ctx context.Context,
+	beaconState *stateTrie.BeaconState,
+	body *ethpb.BeaconBlockBody,
+) (*stateTrie.BeaconState, error) {
+	return processProposerSlashings(beaconState, body, verifyProposerSlashingNoSig)
+}
+
+func processProposerSlashings(
+	beaconState *stateTrie.BeaconState,
+	body *ethpb.BeaconBlockBody,
+	verify func(*stateTrie.BeaconState, *ethpb.ProposerSlashing) error,
 ) (*stateTrie.BeaconState, error) {
 	var err error
 	for idx, slashing := range body.ProposerSlashings {
@@ -437,7 +456,7 @@ func ProcessProposerSlashings(
 		if int(slashing.ProposerIndex) >= beaconState.NumValidators() {
 			return nil, fmt.Errorf("invalid proposer index given in slashing %d", slashing.ProposerIndex)
 		}
-		if err = VerifyProposerSlashing(beaconState, slashing); err != nil {
+		if err = verify(beaconState, slashing); err != nil {
 			return nil, errors.Wrapf(err, "could not verify proposer slashing %d", idx)
 		}
 		beaconState, err = v.SlashValidator(
@@ -454,6 +473,32 @@ func ProcessProposerSlashings(
 func VerifyProposerSlashing(
 	beaconState *stateTrie.BeaconState,
 	slashing *ethpb.ProposerSlashing,
+) error {
+	if err := verifyProposerSlashingNoSig(beaconState, slashing); err != nil {
+		return err
+	}
+	proposer, err := beaconState.ValidatorAtIndex(slashing.ProposerIndex)
+	if err != nil {
+		return err
+	}
+	// Using headerEpoch1 here because both of the headers should have the same epoch.
+	domain, err := helpers.Domain(beaconState.Fork(), helpers.StartSlot(slashing.Header_1.Header.Slot), params.BeaconConfig().DomainBeaconProposer)
+	if err != nil {
+		return err
+	}
+	headers := []*ethpb.SignedBeaconBlockHeader{slashing.Header_1, slashing.Header_2}
+	for _, header := range headers {
+		if err := verifySigningRoot(header.Header, proposer.PublicKey, header.Signature, domain); err != nil {
+			return errors.Wrap(err, "could not verify beacon block header")
+		}
+	}
+	return nil
+}
+
+// verifyProposerSlashingNoSig runs every proposer slashing check except the header signatures.
+func verifyProposerSlashingNoSig(
+	beaconState *stateTrie.BeaconState,
+	slashing *ethpb.ProposerSlashing,
 ) error {
 	proposer, err := beaconState.ValidatorAtIndex(slashing.ProposerIndex)
 	if err != nil {
@@ -471,17 +516,6 @@ func VerifyProposerSlashing(
 	if !helpers.IsSlashableValidator(proposer, helpers.SlotToEpoch(beaconState.Slot())) {
 		return fmt.Errorf("validator with key %#x is not slashable", proposer.PublicKey)
 	}
-	// Using headerEpoch1 here because both of the headers should have the same epoch.
-	domain, err := helpers.Domain(beaconState.Fork(), helpers.StartSlot(slashing.Header_1.Header.Slot), params.BeaconConfig().DomainBeaconProposer)
-	if err != nil {
-		return err
-	}
-	headers := []*ethpb.SignedBeaconBlockHeader{slashing.Header_1, slashing.Header_2}
-	for _, header := range headers {
-		if err := verifySigningRoot(header.Header, proposer.PublicKey, header.Signature, domain); err != nil {
-			return errors.Wrap(err, "could not verify beacon block header")
-		}
-	}
 	return nil
 }
 
@@ -508,9 +542,29 @@ func ProcessAttesterSlashings(
 	ctx context.Context,
 	beaconState *stateTrie.BeaconState,
 	body *ethpb.BeaconBlockBody,
+) (*stateTrie.BeaconState, error) {
+	return processAttesterSlashings(ctx, beaconState, body, VerifyIndexedAttestation)
+}
+
+// ProcessAttesterSlashingsNoVerify processes the attester slashings in a block body
+// without verifying the signatures of the slashed attestations. This is used when the
+// signatures of the block have been verified separately.
+func ProcessAttesterSlashingsNoVerify(
+	ctx context.Context,
+	beaconState *stateTrie.BeaconState,
+	body *ethpb.BeaconBlockBody,
+) (*stateTrie.BeaconState, error) {
+	return processAttesterSlashings(ctx, beaconState, body, verifyIndexedAttestationNoSig)
+}
+
+func processAttesterSlashings(
+	ctx context.Context,
+	beaconState *stateTrie.BeaconState,
+	body *ethpb.BeaconBlockBody,
+	verifyIndexed indexedAttestationVerifier,
 ) (*stateTrie.BeaconState, error) {
 	for idx, slashing := range body.AttesterSlashings {
-		if err := VerifyAttesterSlashing(ctx, beaconState, slashing); err != nil {
+		if err := verifyAttesterSlashing(ctx, beaconState, slashing, verifyIndexed); err != nil {
 			return nil, errors.Wrapf(err, "could not verify attester slashing %d", idx)
 		}
 		slashableIndices := slashableAttesterIndices(slashing)
@@ -544,6 +598,17 @@ func ProcessAttesterSlashings(
 
 // VerifyAttesterSlashing validates the attestation data in both attestations in the slashing object.
 func VerifyAttesterSlashing(ctx context.Context, beaconState *stateTrie.BeaconState, slashing *ethpb.AttesterSlashing) error {
+	return verifyAttesterSlashing(ctx, beaconState, slashing, VerifyIndexedAttestation)
+}
+
+type indexedAttestationVerifier func(context.Context, *stateTrie.BeaconState, *ethpb.IndexedAttestation) error
+
+func verifyAttesterSlashing(
+	ctx context.Context,
+	beaconState *stateTrie.BeaconState,
+	slashing *ethpb.AttesterSlashing,
+	verifyIndexed indexedAttestationVerifier,
+) error {
 	if slashing == nil {
 		return errors.New("nil slashing")
 	}
@@ -560,10 +625,10 @@ func VerifyAttesterSlashing(ctx context.Context, beaconState *stateTrie.BeaconSt
 	if !IsSlashableAttestationData(data1, data2) {
 		return errors.New("attestations are not slashable")
 	}
-	if err := VerifyIndexedAttestation(ctx, beaconState, att1); err != nil {
+	if err := verifyIndexed(ctx, beaconState, att1); err != nil {
 		return errors.Wrap(err, "could not validate indexed attestation")
 	}
-	if err := VerifyIndexedAttestation(ctx, beaconState, att2); err != nil {
+	if err := verifyIndexed(ctx, beaconState, att2); err != nil {
 		return errors.Wrap(err, "could not validate indexed attestation")
 	}
 	return nil
@@ -709,6 +774,13 @@ func ProcessAttestationNoVerify(
 	if helpers.SlotToEpoch(data.Slot) != data.Target.Epoch {
 		return nil, fmt.Errorf("data slot is not in the same epoch as target %d != %d", helpers.SlotToEpoch(data.Slot), data.Target.Epoch)
 	}
+	activeValidatorCount, err := helpers.ActiveValidatorCount(beaconState, data.Target.Epoch)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get active validator count")
+	}
+	if committeeCount := helpers.SlotCommitteeCount(activeValidatorCount); data.CommitteeIndex >= committeeCount {
+		return nil, fmt.Errorf("committee index %d is not lower than committee count %d", data.CommitteeIndex, committeeCount)
+	}
 
 	s := att.Data.Slot
 	minInclusionCheck := s+params.BeaconConfig().MinAttestationInclusionDelay <= beaconState.Slot()
@@ -802,6 +874,42 @@ func ProcessAttestationNoVerify(
 func VerifyIndexedAttestation(ctx context.Context, beaconState *stateTrie.BeaconState, indexedAtt *ethpb.IndexedAttestation) error {
 	ctx, span := trace.StartSpan(ctx, "core.VerifyIndexedAttestation")
 	defer span.End()
+	if err := verifyIndexedAttestationNoSig(ctx, beaconState, indexedAtt); err != nil {
+		return err
+	}
+	indices := indexedAtt.AttestingIndices
+	domain, err := helpers.Domain(beaconState.Fork(), indexedAtt.Data.Target.Epoch, params.BeaconConfig().DomainBeaconAttester)
+	if err != nil {
+		return err
+	}
+	var pubkey *bls.PublicKey
+	if len(indices) > 0 {
+		pubkey, err = aggregatePubkeys(beaconState, indices)
+		if err != nil {
+			return err
+		}
+	}
+
+	messageHash, err := ssz.HashTreeRoot(indexedAtt.Data)
+	if err != nil {
+		return errors.Wrap(err, "could not tree hash att data")
+	}
+
+	sig, err := bls.SignatureFromBytes(indexedAtt.Signature)
+	if err != nil {
+		return errors.Wrap(err, "could not convert bytes to signature")
+	}
+
+	voted := len(indices) > 0
+	if voted && !sig.Verify(messageHash[:], pubkey, domain) {
+		return ErrSigFailedToVerify
+	}
+	return nil
+}
+
+// verifyIndexedAttestationNoSig checks the attesting indices of an indexed attestation
+// without verifying its aggregate signature.
+func verifyIndexedAttestationNoSig(ctx context.Context, beaconState *stateTrie.BeaconState, indexedAtt *ethpb.IndexedAttestation) error {
 	if indexedAtt == nil || indexedAtt.Data == nil || indexedAtt.Data.Target == nil {
 		return errors.New("nil or missing indexed attestation data")
 	}
@@ -826,43 +934,25 @@ func VerifyIndexedAttestation(ctx context.Context, beaconState *stateTrie.Beacon
 	if !reflect.DeepEqual(setIndices, indices) {
 		return errors.New("attesting indices is not uniquely sorted")
 	}
+	return nil
+}
 
-	domain, err := helpers.Domain(beaconState.Fork(), indexedAtt.Data.Target.Epoch, params.BeaconConfig().DomainBeaconAttester)
+// aggregatePubkeys aggregates the public keys of the validators at the given non-empty list of indices.
+func aggregatePubkeys(beaconState *stateTrie.BeaconState, indices []uint64) (*bls.PublicKey, error) {
+	pubkeyAtIdx := beaconState.PubkeyAtIndex(indices[0])
+	pubkey, err := bls.PublicKeyFromBytes(pubkeyAtIdx[:])
 	if err != nil {
-		return err
+		return nil, errors.Wrap(err, "could not deserialize validator public key")
 	}
-	var pubkey *bls.PublicKey
-	if len(indices) > 0 {
-		pubkeyAtIdx := beaconState.PubkeyAtIndex(indices[0])
-		pubkey, err = bls.PublicKeyFromBytes(pubkeyAtIdx[:])
+	for i := 1; i < len(indices); i++ {
+		pubkeyAtIdx = beaconState.PubkeyAtIndex(indices[i])
+		pk, err := bls.PublicKeyFromBytes(pubkeyAtIdx[:])
 		if err != nil {
-			return errors.Wrap(err, "could not deserialize validator public key")
-		}
-		for i := 1; i < len(indices); i++ {
-			pubkeyAtIdx = beaconState.PubkeyAtIndex(indices[i])
-			pk, err := bls.PublicKeyFromBytes(pubkeyAtIdx[:])
-			if err != nil {
-				return errors.Wrap(err, "could not deserialize validator public key")
-			}
-			pubkey.Aggregate(pk)
+			return nil, errors.Wrap(err, "could not deserialize validator public key")
 		}
+		pubkey.Aggregate(pk)
 	}
-
-	messageHash, err := ssz.HashTreeRoot(indexedAtt.Data)
-	if err != nil {
-		return errors.Wrap(err, "could not tree hash att data")
-	}
-
-	sig, err := bls.SignatureFromBytes(indexedAtt.Signature)
-	if err != nil {
-		return errors.Wrap(err, "could not convert bytes to signature")
-	}
-
-	voted := len(indices) > 0
-	if voted && !sig.Verify(messageHash[:], pubkey, domain) {
-		return ErrSigFailedToVerify
-	}
-	return nil
+	return pubkey, nil
 }
 
 // VerifyAttestation converts and attestation into an indexed attestation and verifies
@@ -1095,6 +1185,26 @@ func ProcessVoluntaryExits(
 	ctx context.Context,
 	beaconState *stateTrie.BeaconState,
 	body *ethpb.BeaconBlockBody,
+) (*stateTrie.BeaconState, error) {
+	return processVoluntaryExits(beaconState, body, VerifyExit)
+}
+
+// ProcessVoluntaryExitsNoVerifySignature processes all the voluntary exits in a block
+// body, running every exit check except the BLS signature verification. Unlike
+// ProcessVoluntaryExitsNoVerify, this is safe to use on blocks that have not been
+// validated before, as long as their signatures are verified separately.
+func ProcessVoluntaryExitsNoVerifySignature(
+	ctx context.Context,
+	beaconState *stateTrie.BeaconState,
+	body *ethpb.BeaconBlockBody,
+) (*stateTrie.BeaconState, error) {
+	return processVoluntaryExits(beaconState, body, verifyExitNoSig)
+}
+
+func processVoluntaryExits(
+	beaconState *stateTrie.BeaconState,
+	body *ethpb.BeaconBlockBody,
+	verify func(*ethpb.Validator, uint64, *pb.Fork, *ethpb.SignedVoluntaryExit) error,
 ) (*stateTrie.BeaconState, error) {
 	exits := body.VoluntaryExits
 	for idx, exit := range exits {
@@ -1112,7 +1222,7 @@ func ProcessVoluntaryExits(
 		if err != nil {
 			return nil, err
 		}
-		if err := VerifyExit(val, beaconState.Slot(), beaconState.Fork(), exit); err != nil {
+		if err := verify(val, beaconState.Slot(), beaconState.Fork(), exit); err != nil {
 			return nil, errors.Wrapf(err, "could not verify exit %d", idx)
 		}
 		beaconState, err = v.InitiateValidatorExit(beaconState, exit.Exit.ValidatorIndex)
@@ -1164,6 +1274,22 @@ func ProcessVoluntaryExitsNoVerify(
 //    domain = get_domain(state, DOMAIN_VOLUNTARY_EXIT, exit.epoch)
 //    assert bls_verify(validator.pubkey, signing_root(exit), exit.signature, domain)
 func VerifyExit(validator *ethpb.Validator, currentSlot uint64, fork *pb.Fork, signed *ethpb.SignedVoluntaryExit) error {
+	if err := verifyExitNoSig(validator, currentSlot, fork, signed); err != nil {
+		return err
+	}
+	exit := signed.Exit
+	domain, err := helpers.Domain(fork, exit.Epoch, params.BeaconConfig().DomainVoluntaryExit)
+	if err != nil {
+		return err
+	}
+	if err := verifySigningRoot(exit, validator.PublicKey, signed.Signature, domain); err != nil {
+		return ErrSigFailedToVerify
+	}
+	return nil
+}
+
+// verifyExitNoSig runs every voluntary exit check except the signature verification.
+func verifyExitNoSig(validator *ethpb.Validator, currentSlot uint64, fork *pb.Fork, signed *ethpb.SignedVoluntaryExit) error {
 	if signed == nil || signed.Exit == nil {
 		return errors.New("nil exit")
 	}
@@ -1190,13 +1316,6 @@ func VerifyExit(validator *ethpb.Validator, currentSlot uint64, fork *pb.Fork, s
 			validator.ActivationEpoch+params.BeaconConfig().PersistentCommitteePeriod,
 		)
 	}
-	domain, err := helpers.Domain(fork, exit.Epoch, params.BeaconConfig().DomainVoluntaryExit)
-	if err != nil {
-		return err
-	}
-	if err := verifySigningRoot(exit, validator.PublicKey, signed.Signature, domain); err != nil {
-		return ErrSigFailedToVerify
-	}
 	return nil
 }
 
@@ -0,0 +1,142 @@
+package blocks_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+// The targets below are driven by the coverage guided fuzzers in fuzz_test.go. Each target
+// decodes the fuzzed bytes as SSZ and applies the decoded operation to a copy of a valid
+// beacon state. Targets must never panic, while processing errors are expected for most inputs.
+
+// fuzzPreState returns the beacon state operations are applied to, along with a block
+// carrying one of each operation whose SSZ encodings seed the fuzzers.
+func fuzzPreState(tb testing.TB) (*stateTrie.BeaconState, *ethpb.SignedBeaconBlock) {
+	beaconState, privKeys := testutil.DeterministicGenesisState(tb, 64)
+	blk, err := testutil.GenerateFullBlock(beaconState, privKeys, &testutil.BlockGenConfig{
+		NumProposerSlashings: 1,
+		NumAttesterSlashings: 1,
+		NumAttestations:      1,
+		NumDeposits:          1,
+		NumVoluntaryExits:    1,
+	}, 1)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	if err := beaconState.SetSlot(blk.Block.Slot); err != nil {
+		tb.Fatal(err)
+	}
+	// The deposit proofs of the block are against the eth1 data voted for in the block.
+	if err := beaconState.SetEth1Data(blk.Block.Body.Eth1Data); err != nil {
+		tb.Fatal(err)
+	}
+	return beaconState, blk
+}
+
+// fuzzSeeds returns the SSZ encodings of the given objects.
+func fuzzSeeds(tb testing.TB, objs ...interface{}) [][]byte {
+	seeds := make([][]byte, len(objs))
+	for i, obj := range objs {
+		enc, err := ssz.Marshal(obj)
+		if err != nil {
+			tb.Fatal(err)
+		}
+		seeds[i] = enc
+	}
+	return seeds
+}
+
+func fuzzProcessAttestation(beaconState *stateTrie.BeaconState, data []byte) {
+	att := &ethpb.Attestation{}
+	if err := ssz.Unmarshal(data, att); err != nil {
+		return
+	}
+	_, _ = blocks.ProcessAttestation(context.Background(), beaconState.Copy(), att)
+}
+
+func fuzzProcessDeposit(beaconState *stateTrie.BeaconState, data []byte) {
+	deposit := &ethpb.Deposit{}
+	if err := ssz.Unmarshal(data, deposit); err != nil {
+		return
+	}
+	_, _ = blocks.ProcessDeposit(beaconState.Copy(), deposit)
+}
+
+func fuzzProcessVoluntaryExits(beaconState *stateTrie.BeaconState, data []byte) {
+	exit := &ethpb.SignedVoluntaryExit{}
+	if err := ssz.Unmarshal(data, exit); err != nil {
+		return
+	}
+	body := &ethpb.BeaconBlockBody{VoluntaryExits: []*ethpb.SignedVoluntaryExit{exit}}
+	_, _ = blocks.ProcessVoluntaryExits(context.Background(), beaconState.Copy(), body)
+}
+
+func fuzzProcessProposerSlashings(beaconState *stateTrie.BeaconState, data []byte) {
+	slashing := &ethpb.ProposerSlashing{}
+	if err := ssz.Unmarshal(data, slashing); err != nil {
+		return
+	}
+	body := &ethpb.BeaconBlockBody{ProposerSlashings: []*ethpb.ProposerSlashing{slashing}}
+	_, _ = blocks.ProcessProposerSlashings(context.Background(), beaconState.Copy(), body)
+}
+
+func TestFuzzTargets_Seeds(t *testing.T) {
+	beaconState, blk := fuzzPreState(t)
+	body := blk.Block.Body
+	for _, seed := range fuzzSeeds(t, body.Attestations[0]) {
+		fuzzProcessAttestation(beaconState, seed)
+	}
+	for _, seed := range fuzzSeeds(t, body.Deposits[0]) {
+		fuzzProcessDeposit(beaconState, seed)
+	}
+	for _, seed := range fuzzSeeds(t, body.VoluntaryExits[0]) {
+		fuzzProcessVoluntaryExits(beaconState, seed)
+	}
+	for _, seed := range fuzzSeeds(t, body.ProposerSlashings[0]) {
+		fuzzProcessProposerSlashings(beaconState, seed)
+	}
+}
+
+// Inputs which crashed a target, or which reach the same code paths, are kept here so that
+// every toolchain runs them.
+func TestFuzzTargets_Regressions(t *testing.T) {
+	beaconState, blk := fuzzPreState(t)
+	body := blk.Block.Body
+	committeeCount := helpers.SlotCommitteeCount(uint64(beaconState.NumValidators()))
+
+	// Committee indices out of range sliced past the shuffled validators on committee cache misses.
+	for _, index := range []uint64{committeeCount, 1 << 40} {
+		att := proto.Clone(body.Attestations[0]).(*ethpb.Attestation)
+		att.Data.CommitteeIndex = index
+		helpers.ClearCache()
+		for _, seed := range fuzzSeeds(t, att) {
+			fuzzProcessAttestation(beaconState, seed)
+		}
+	}
+
+	exit := proto.Clone(body.VoluntaryExits[0]).(*ethpb.SignedVoluntaryExit)
+	exit.Exit.ValidatorIndex = 1 << 40
+	for _, seed := range fuzzSeeds(t, exit) {
+		fuzzProcessVoluntaryExits(beaconState, seed)
+	}
+
+	slashing := proto.Clone(body.ProposerSlashings[0]).(*ethpb.ProposerSlashing)
+	slashing.ProposerIndex = 1 << 40
+	for _, seed := range fuzzSeeds(t, slashing) {
+		fuzzProcessProposerSlashings(beaconState, seed)
+	}
+
+	// Truncated encodings must fail to decode rather than yield partial operations.
+	for _, seed := range fuzzSeeds(t, body.Deposits[0]) {
+		fuzzProcessDeposit(beaconState, seed[:len(seed)-1])
+		fuzzProcessDeposit(beaconState, seed[:32])
+	}
+}
@@ -0,0 +1,72 @@
+//go:build go1.18
+// +build go1.18
+
+package blocks_test
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+)
+
+// Run a fuzzer with, for example:
+//
+//	go test ./beacon-chain/core/blocks -run=^$ -fuzz=FuzzProcessAttestation
+//
+// See fuzz_targets_test.go for the targets.
+
+func FuzzProcessAttestation(f *testing.F) {
+	beaconState, blk := fuzzPreState(f)
+	for _, seed := range fuzzSeeds(f, blk.Block.Body.Attestations[0]) {
+		f.Add(seed)
+	}
+	skipBLSVerify(f)
+	f.Fuzz(func(t *testing.T, data []byte) {
+		fuzzProcessAttestation(beaconState, data)
+	})
+}
+
+func FuzzProcessDeposit(f *testing.F) {
+	beaconState, blk := fuzzPreState(f)
+	for _, seed := range fuzzSeeds(f, blk.Block.Body.Deposits[0]) {
+		f.Add(seed)
+	}
+	skipBLSVerify(f)
+	f.Fuzz(func(t *testing.T, data []byte) {
+		fuzzProcessDeposit(beaconState, data)
+	})
+}
+
+func FuzzProcessVoluntaryExits(f *testing.F) {
+	beaconState, blk := fuzzPreState(f)
+	for _, seed := range fuzzSeeds(f, blk.Block.Body.VoluntaryExits[0]) {
+		f.Add(seed)
+	}
+	skipBLSVerify(f)
+	f.Fuzz(func(t *testing.T, data []byte) {
+		fuzzProcessVoluntaryExits(beaconState, data)
+	})
+}
+
+func FuzzProcessProposerSlashings(f *testing.F) {
+	beaconState, blk := fuzzPreState(f)
+	for _, seed := range fuzzSeeds(f, blk.Block.Body.ProposerSlashings[0]) {
+		f.Add(seed)
+	}
+	skipBLSVerify(f)
+	f.Fuzz(func(t *testing.T, data []byte) {
+		fuzzProcessProposerSlashings(beaconState, data)
+	})
+}
+
+// skipBLSVerify disables signature verification while the fuzzer runs, so that mutated
+// inputs reach the checks which come after the signature checks.
+func skipBLSVerify(f *testing.F) {
+	resetCfg := featureconfig.Get()
+	cfg := *resetCfg
+	cfg.SkipBLSVerify = true
+	featureconfig.Init(&cfg)
+	f.Cleanup(func() {
+		featureconfig.Init(resetCfg)
+	})
+}
@@ -0,0 +1,318 @@
+package blocks
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateutil"
+	"github.com/prysmaticlabs/prysm/shared/attestationutil"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// BlockSignatureSet collects every signature of a signed beacon block into a single
+// signature set which can be batch verified: the proposer signature, the randao reveal,
+// the proposer and attester slashings, the attestations and the voluntary exits.
+// Deposit signatures are not part of the set, since an invalid deposit signature does
+// not invalidate the block.
+//
+// The beacon state must have been advanced to the slot of the block, but the block must
+// not have been processed yet.
+func BlockSignatureSet(
+	ctx context.Context,
+	beaconState *stateTrie.BeaconState,
+	signed *ethpb.SignedBeaconBlock,
+) (*bls.SignatureSet, error) {
+	set, err := BlockSignatureSetNoVerifyAttSigs(beaconState, signed)
+	if err != nil {
+		return nil, err
+	}
+	body := signed.Block.Body
+	attestationsSet, err := AttestationsSignatureSet(ctx, beaconState, body.Attestations)
+	if err != nil {
+		return nil, err
+	}
+	exitsSet, err := VoluntaryExitsSignatureSet(beaconState, body.VoluntaryExits)
+	if err != nil {
+		return nil, err
+	}
+	return set.Join(attestationsSet).Join(exitsSet), nil
+}
+
+// BlockSignatureSetNoVerifyAttSigs collects the signatures of a signed beacon block which
+// are verified when processing a block without verifying attestation signatures: the
+// proposer signature, the randao reveal and the proposer and attester slashings.
+//
+// The beacon state must have been advanced to the slot of the block, but the block must
+// not have been processed yet.
+func BlockSignatureSetNoVerifyAttSigs(
+	beaconState *stateTrie.BeaconState,
+	signed *ethpb.SignedBeaconBlock,
+) (*bls.SignatureSet, error) {
+	if signed == nil || signed.Block == nil || signed.Block.Body == nil {
+		return nil, errors.New("nil block")
+	}
+	body := signed.Block.Body
+	set, err := ProposerSignatureSet(beaconState, signed)
+	if err != nil {
+		return nil, err
+	}
+	randaoSet, err := RandaoSignatureSet(beaconState, body)
+	if err != nil {
+		return nil, err
+	}
+	proposerSlashingsSet, err := ProposerSlashingsSignatureSet(beaconState, body.ProposerSlashings)
+	if err != nil {
+		return nil, err
+	}
+	attesterSlashingsSet, err := AttesterSlashingsSignatureSet(beaconState, body.AttesterSlashings)
+	if err != nil {
+		return nil, err
+	}
+	return set.Join(randaoSet).
+		Join(proposerSlashingsSet).
+		Join(attesterSlashingsSet), nil
+}
+
+// BlockBodySignatureSet collects the randao reveal and the signatures of the operations of a
+// block body into a single signature set which can be batch verified. Unlike
+// BlockSignatureSet it does not need a signed block, so a block body can be checked before
+// the proposer signs the block.
+//
+// The beacon state must have been advanced to the slot of the block.
+func BlockBodySignatureSet(
+	ctx context.Context,
+	beaconState *stateTrie.BeaconState,
+	body *ethpb.BeaconBlockBody,
+) (*bls.SignatureSet, error) {
+	if body == nil {
+		return nil, errors.New("nil block body")
+	}
+	set, err := RandaoSignatureSet(beaconState, body)
+	if err != nil {
+		return nil, err
+	}
+	proposerSlashingsSet, err := ProposerSlashingsSignatureSet(beaconState, body.ProposerSlashings)
+	if err != nil {
+		return nil, err
+	}
+	attesterSlashingsSet, err := AttesterSlashingsSignatureSet(beaconState, body.AttesterSlashings)
+	if err != nil {
+		return nil, err
+	}
+	attestationsSet, err := AttestationsSignatureSet(ctx, beaconState, body.Attestations)
+	if err != nil {
+		return nil, err
+	}
+	exitsSet, err := VoluntaryExitsSignatureSet(beaconState, body.VoluntaryExits)
+	if err != nil {
+		return nil, err
+	}
+	return set.Join(proposerSlashingsSet).
+		Join(attesterSlashingsSet).
+		Join(attestationsSet).
+		Join(exitsSet), nil
+}
+
+// ProposerSignatureSet retrieves the block proposer signature set.
+func ProposerSignatureSet(beaconState *stateTrie.BeaconState, signed *ethpb.SignedBeaconBlock) (*bls.SignatureSet, error) {
+	idx, err := helpers.BeaconProposerIndex(beaconState)
+	if err != nil {
+		return nil, err
+	}
+	proposerPub := beaconState.PubkeyAtIndex(idx)
+	currentEpoch := helpers.SlotToEpoch(beaconState.Slot())
+	domain, err := helpers.Domain(beaconState.Fork(), currentEpoch, params.BeaconConfig().DomainBeaconProposer)
+	if err != nil {
+		return nil, err
+	}
+	root, err := stateutil.BlockRoot(signed.Block)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get signing root")
+	}
+	set := bls.NewSet()
+	if err := addToSet(set, proposerPub[:], signed.Signature, root, domain, "block proposer signature"); err != nil {
+		return nil, err
+	}
+	return set, nil
+}
+
+// RandaoSignatureSet retrieves the signature set of the randao reveal in the block body.
+func RandaoSignatureSet(beaconState *stateTrie.BeaconState, body *ethpb.BeaconBlockBody) (*bls.SignatureSet, error) {
+	idx, err := helpers.BeaconProposerIndex(beaconState)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get beacon proposer index")
+	}
+	proposerPub := beaconState.PubkeyAtIndex(idx)
+	currentEpoch := helpers.SlotToEpoch(beaconState.Slot())
+	var msg [32]byte
+	binary.LittleEndian.PutUint64(msg[:], currentEpoch)
+	domain, err := helpers.Domain(beaconState.Fork(), currentEpoch, params.BeaconConfig().DomainRandao)
+	if err != nil {
+		return nil, err
+	}
+	set := bls.NewSet()
+	if err := addToSet(set, proposerPub[:], body.RandaoReveal, msg, domain, "block randao"); err != nil {
+		return nil, err
+	}
+	return set, nil
+}
+
+// ProposerSlashingsSignatureSet retrieves the signature sets of both headers of every
+// proposer slashing.
+func ProposerSlashingsSignatureSet(beaconState *stateTrie.BeaconState, slashings []*ethpb.ProposerSlashing) (*bls.SignatureSet, error) {
+	set := bls.NewSet()
+	for i, slashing := range slashings {
+		if slashing == nil || slashing.Header_1 == nil || slashing.Header_1.Header == nil ||
+			slashing.Header_2 == nil || slashing.Header_2.Header == nil {
+			return nil, errors.New("nil proposer slashing in block body")
+		}
+		if int(slashing.ProposerIndex) >= beaconState.NumValidators() {
+			return nil, fmt.Errorf("invalid proposer index given in slashing %d", slashing.ProposerIndex)
+		}
+		proposerPub := beaconState.PubkeyAtIndex(slashing.ProposerIndex)
+		// Using the first header slot here because both of the headers should have the same epoch.
+		domain, err := helpers.Domain(beaconState.Fork(), helpers.StartSlot(slashing.Header_1.Header.Slot), params.BeaconConfig().DomainBeaconProposer)
+		if err != nil {
+			return nil, err
+		}
+		for j, header := range []*ethpb.SignedBeaconBlockHeader{slashing.Header_1, slashing.Header_2} {
+			root, err := ssz.HashTreeRoot(header.Header)
+			if err != nil {
+				return nil, errors.Wrap(err, "could not get signing root")
+			}
+			desc := fmt.Sprintf("header %d of proposer slashing %d", j+1, i)
+			if err := addToSet(set, proposerPub[:], header.Signature, root, domain, desc); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return set, nil
+}
+
+// AttesterSlashingsSignatureSet retrieves the signature sets of both indexed attestations
+// of every attester slashing.
+func AttesterSlashingsSignatureSet(beaconState *stateTrie.BeaconState, slashings []*ethpb.AttesterSlashing) (*bls.SignatureSet, error) {
+	set := bls.NewSet()
+	for i, slashing := range slashings {
+		if slashing == nil || slashing.Attestation_1 == nil || slashing.Attestation_2 == nil {
+			return nil, errors.New("nil attester slashing in block body")
+		}
+		for j, att := range []*ethpb.IndexedAttestation{slashing.Attestation_1, slashing.Attestation_2} {
+			desc := fmt.Sprintf("attestation %d of attester slashing %d", j+1, i)
+			if err := addIndexedAttestationToSet(set, beaconState, att, desc); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return set, nil
+}
+
+// AttestationsSignatureSet retrieves the aggregate signature sets of the attestations in
+// the block body.
+func AttestationsSignatureSet(ctx context.Context, beaconState *stateTrie.BeaconState, atts []*ethpb.Attestation) (*bls.SignatureSet, error) {
+	set := bls.NewSet()
+	for i, att := range atts {
+		if att == nil || att.Data == nil {
+			return nil, fmt.Errorf("nil or missing attestation data: %v", att)
+		}
+		committee, err := helpers.BeaconCommitteeFromState(beaconState, att.Data.Slot, att.Data.CommitteeIndex)
+		if err != nil {
+			return nil, err
+		}
+		indexedAtt := attestationutil.ConvertToIndexed(ctx, att, committee)
+		if err := addIndexedAttestationToSet(set, beaconState, indexedAtt, fmt.Sprintf("attestation %d", i)); err != nil {
+			return nil, err
+		}
+	}
+	return set, nil
+}
+
+// VoluntaryExitsSignatureSet retrieves the signature sets of the voluntary exits in the
+// block body.
+func VoluntaryExitsSignatureSet(beaconState *stateTrie.BeaconState, exits []*ethpb.SignedVoluntaryExit) (*bls.SignatureSet, error) {
+	set := bls.NewSet()
+	for i, exit := range exits {
+		if exit == nil || exit.Exit == nil {
+			return nil, errors.New("nil voluntary exit in block body")
+		}
+		if int(exit.Exit.ValidatorIndex) >= beaconState.NumValidators() {
+			return nil, fmt.Errorf(
+				"validator index out of bound %d > %d",
+				exit.Exit.ValidatorIndex,
+				beaconState.NumValidators(),
+			)
+		}
+		pub := beaconState.PubkeyAtIndex(exit.Exit.ValidatorIndex)
+		domain, err := helpers.Domain(beaconState.Fork(), exit.Exit.Epoch, params.BeaconConfig().DomainVoluntaryExit)
+		if err != nil {
+			return nil, err
+		}
+		root, err := ssz.HashTreeRoot(exit.Exit)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get signing root")
+		}
+		if err := addToSet(set, pub[:], exit.Signature, root, domain, fmt.Sprintf("voluntary exit %d", i)); err != nil {
+			return nil, err
+		}
+	}
+	return set, nil
+}
+
+func addIndexedAttestationToSet(
+	set *bls.SignatureSet,
+	beaconState *stateTrie.BeaconState,
+	indexedAtt *ethpb.IndexedAttestation,
+	description string,
+) error {
+	if indexedAtt == nil || indexedAtt.Data == nil || indexedAtt.Data.Target == nil {
+		return errors.New("nil or missing indexed attestation data")
+	}
+	indices := indexedAtt.AttestingIndices
+	// An attestation without any attesters carries no signature to verify.
+	if len(indices) == 0 {
+		return nil
+	}
+	for _, idx := range indices {
+		if int(idx) >= beaconState.NumValidators() {
+			return fmt.Errorf("attesting index %d out of bound in %s", idx, description)
+		}
+	}
+	pubkey, err := aggregatePubkeys(beaconState, indices)
+	if err != nil {
+		return err
+	}
+	domain, err := helpers.Domain(beaconState.Fork(), indexedAtt.Data.Target.Epoch, params.BeaconConfig().DomainBeaconAttester)
+	if err != nil {
+		return err
+	}
+	root, err := ssz.HashTreeRoot(indexedAtt.Data)
+	if err != nil {
+		return errors.Wrap(err, "could not tree hash att data")
+	}
+	sig, err := bls.SignatureFromBytes(indexedAtt.Signature)
+	if err != nil {
+		return errors.Wrap(err, "could not convert bytes to signature")
+	}
+	set.Add(sig, pubkey, root, domain, description)
+	return nil
+}
+
+func addToSet(set *bls.SignatureSet, pub []byte, signature []byte, msg [32]byte, domain uint64, description string) error {
+	publicKey, err := bls.PublicKeyFromBytes(pub)
+	if err != nil {
+		return errors.Wrap(err, "could not convert bytes to public key")
+	}
+	sig, err := bls.SignatureFromBytes(signature)
+	if err != nil {
+		return errors.Wrapf(err, "could not convert bytes to signature of %s", description)
+	}
+	set.Add(sig, publicKey, msg, domain, description)
+	return nil
+}
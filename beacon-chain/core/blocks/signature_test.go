@@ -0,0 +1,142 @@
+package blocks_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+func TestBlockSignatureSet_ProposerAndRandao(t *testing.T) {
+	beaconState, privKeys := testutil.DeterministicGenesisState(t, 100)
+	randaoReveal, err := testutil.RandaoReveal(beaconState, helpers.CurrentEpoch(beaconState), privKeys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	block := &ethpb.SignedBeaconBlock{
+		Block: &ethpb.BeaconBlock{
+			Slot: beaconState.Slot(),
+			Body: &ethpb.BeaconBlockBody{
+				RandaoReveal: randaoReveal,
+			},
+		},
+	}
+	sig, err := testutil.BlockSignature(beaconState, block.Block, privKeys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	block.Signature = sig.Marshal()
+
+	set, err := blocks.BlockSignatureSet(context.Background(), beaconState, block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(set.Signatures) != 2 {
+		t.Fatalf("Expected 2 signatures in set, received %d", len(set.Signatures))
+	}
+	if err := set.Verify(); err != nil {
+		t.Errorf("Expected signature set to verify: %v", err)
+	}
+
+	// Signing the wrong randao message must be pinpointed by the set.
+	proposerIdx, err := helpers.BeaconProposerIndex(beaconState)
+	if err != nil {
+		t.Fatal(err)
+	}
+	block.Block.Body.RandaoReveal = privKeys[proposerIdx].Sign([]byte("bad"), 0).Marshal()
+	sig, err = testutil.BlockSignature(beaconState, block.Block, privKeys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	block.Signature = sig.Marshal()
+	set, err = blocks.BlockSignatureSet(context.Background(), beaconState, block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "could not verify block randao"
+	if err := set.Verify(); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected %s, received %v", want, err)
+	}
+}
+
+func TestVoluntaryExitsSignatureSet_IndexOutOfBound(t *testing.T) {
+	beaconState, _ := testutil.DeterministicGenesisState(t, 10)
+	exits := []*ethpb.SignedVoluntaryExit{
+		{
+			Exit: &ethpb.VoluntaryExit{ValidatorIndex: 10},
+		},
+	}
+	want := "validator index out of bound"
+	if _, err := blocks.VoluntaryExitsSignatureSet(beaconState, exits); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected %s, received %v", want, err)
+	}
+}
+
+func TestProcessVoluntaryExitsNoVerifySignature_ChecksExitConditions(t *testing.T) {
+	exits := []*ethpb.SignedVoluntaryExit{
+		{
+			Exit: &ethpb.VoluntaryExit{
+				ValidatorIndex: 0,
+				Epoch:          0,
+			},
+		},
+	}
+	registry := []*ethpb.Validator{
+		{
+			ExitEpoch: params.BeaconConfig().FarFutureEpoch,
+		},
+	}
+	state, _ := stateTrie.InitializeFromProto(&pb.BeaconState{
+		Validators: registry,
+		Slot:       10,
+	})
+	block := &ethpb.BeaconBlock{
+		Body: &ethpb.BeaconBlockBody{
+			VoluntaryExits: exits,
+		},
+	}
+
+	want := "validator has not been active long enough to exit"
+	if _, err := blocks.ProcessVoluntaryExitsNoVerifySignature(context.Background(), state, block.Body); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected %s, received %v", want, err)
+	}
+}
+
+func TestProcessProposerSlashingsNoVerify_SkipsSignatures(t *testing.T) {
+	beaconState, _ := testutil.DeterministicGenesisState(t, 100)
+	slashings := []*ethpb.ProposerSlashing{
+		{
+			ProposerIndex: 1,
+			Header_1: &ethpb.SignedBeaconBlockHeader{
+				Header:    &ethpb.BeaconBlockHeader{Slot: 0, StateRoot: []byte("A")},
+				Signature: make([]byte, 96),
+			},
+			Header_2: &ethpb.SignedBeaconBlockHeader{
+				Header:    &ethpb.BeaconBlockHeader{Slot: 0, StateRoot: []byte("B")},
+				Signature: make([]byte, 96),
+			},
+		},
+	}
+	block := &ethpb.BeaconBlock{
+		Body: &ethpb.BeaconBlockBody{
+			ProposerSlashings: slashings,
+		},
+	}
+	if _, err := blocks.ProcessProposerSlashings(context.Background(), beaconState.Copy(), block.Body); err == nil {
+		t.Error("Expected proposer slashing with invalid signatures to fail")
+	}
+	newState, err := blocks.ProcessProposerSlashingsNoVerify(context.Background(), beaconState, block.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, _ := newState.ValidatorAtIndex(1); !v.Slashed {
+		t.Error("Expected validator at index 1 to be slashed")
+	}
+}
@@ -129,6 +129,9 @@ func ComputeCommittee(
 	index uint64,
 	count uint64,
 ) ([]uint64, error) {
+	if index >= count {
+		return nil, fmt.Errorf("committee index %d is not lower than committee count %d", index, count)
+	}
 	validatorCount := uint64(len(indices))
 	start := sliceutil.SplitOffset(validatorCount, count, index)
 	end := sliceutil.SplitOffset(validatorCount, count, index+1)
@@ -85,6 +85,13 @@ func TestComputeCommittee_WithoutCache(t *testing.T) {
 	if !reflect.DeepEqual(committees[start:end], committee9) {
 		t.Error("committee has different shuffled indices")
 	}
+
+	// Test committee indices out of range are rejected
+	for _, index := range []uint64{committeeCount, 1 << 40} {
+		if _, err := ComputeCommittee(indices, seed, index, committeeCount); err == nil {
+			t.Errorf("Expected committee index %d to be rejected", index)
+		}
+	}
 }
 
 func TestAttestationParticipants_NoCommitteeCache(t *testing.T) {
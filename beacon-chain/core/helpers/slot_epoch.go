@@ -94,8 +94,14 @@ const timeShiftTolerance = 10 // ms
 
 // VerifySlotTime validates the input slot is not from the future.
 func VerifySlotTime(genesisTime uint64, slot uint64) error {
+	return VerifySlotTimeAt(genesisTime, slot, roughtime.Now())
+}
+
+// VerifySlotTimeAt validates the input slot is not from the future, with respect to the
+// given current time.
+func VerifySlotTimeAt(genesisTime uint64, slot uint64, now time.Time) error {
 	slotTime := genesisTime + slot*params.BeaconConfig().SecondsPerSlot
-	currentTime := uint64(roughtime.Now().Unix())
+	currentTime := uint64(now.Unix())
 	if slotTime > currentTime+timeShiftTolerance {
 		return fmt.Errorf("could not process slot from the future, slot time %d > current time %d", slotTime, currentTime)
 	}
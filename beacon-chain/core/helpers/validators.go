@@ -3,6 +3,7 @@ package helpers
 import (
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
 	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/bls"
@@ -258,6 +259,34 @@ func Domain(fork *pb.Fork, epoch uint64, domainType [bls.DomainByteLength]byte)
 	return bls.Domain(domainType, forkVersionArray), nil
 }
 
+// ComputeForkDigest returns the fork digest of the given fork version and genesis validators
+// root, used to tell apart the networks of different forks and chains.
+//
+// Spec pseudocode definition:
+//  def compute_fork_digest(current_version: Version, genesis_validators_root: Root) -> ForkDigest:
+//    """
+//    Return the 4-byte fork digest for the ``current_version`` and ``genesis_validators_root``.
+//    This is a digest primarily used for domain separation on the p2p layer.
+//    4-bytes suffices for practical separation of forks/chains.
+//    """
+//    return ForkDigest(compute_fork_data_root(current_version, genesis_validators_root)[:4])
+func ComputeForkDigest(version []byte, genesisValidatorsRoot []byte) ([4]byte, error) {
+	if len(version) != 4 {
+		return [4]byte{}, errors.New("fork version length is not 4 byte")
+	}
+	if len(genesisValidatorsRoot) != 32 {
+		return [4]byte{}, errors.New("genesis validators root length is not 32 byte")
+	}
+	dataRoot, err := ssz.HashTreeRoot(&pb.ForkData{
+		CurrentVersion:        version,
+		GenesisValidatorsRoot: genesisValidatorsRoot,
+	})
+	if err != nil {
+		return [4]byte{}, errors.Wrap(err, "could not compute fork data root")
+	}
+	return bytesutil.ToBytes4(dataRoot[:]), nil
+}
+
 // IsEligibleForActivationQueue checks if the validator is eligible to
 // be placed into the activation queue.
 //
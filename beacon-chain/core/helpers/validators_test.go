@@ -255,6 +255,37 @@ func TestDomain_OK(t *testing.T) {
 	}
 }
 
+func TestComputeForkDigest_OK(t *testing.T) {
+	root := bytesutil.PadTo([]byte{'A'}, 32)
+	digest, err := ComputeForkDigest([]byte{0, 0, 0, 0}, root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if digest == [4]byte{} {
+		t.Error("Expected non zero fork digest")
+	}
+	other, err := ComputeForkDigest([]byte{0, 0, 0, 1}, root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if digest == other {
+		t.Error("Expected different fork versions to have different digests")
+	}
+	other, err = ComputeForkDigest([]byte{0, 0, 0, 0}, bytesutil.PadTo([]byte{'B'}, 32))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if digest == other {
+		t.Error("Expected different genesis validators roots to have different digests")
+	}
+	if _, err := ComputeForkDigest([]byte{0, 0, 0}, root); err == nil {
+		t.Error("Expected error with invalid fork version length")
+	}
+	if _, err := ComputeForkDigest([]byte{0, 0, 0, 0}, nil); err == nil {
+		t.Error("Expected error with missing genesis validators root")
+	}
+}
+
 // Test basic functionality of ActiveValidatorIndices without caching. This test will need to be
 // rewritten when releasing some cache flag.
 func TestActiveValidatorIndices(t *testing.T) {
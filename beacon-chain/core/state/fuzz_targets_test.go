@@ -0,0 +1,76 @@
+package state_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	beaconstate "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+)
+
+// fuzzProcessBlock decodes the fuzzed bytes as an SSZ encoded signed block and applies it to
+// a copy of the pre-state. It is driven by FuzzProcessBlock in fuzz_test.go and must never
+// panic, whatever the block.
+func fuzzProcessBlock(beaconState *beaconstate.BeaconState, data []byte) {
+	blk := &ethpb.SignedBeaconBlock{}
+	if err := ssz.Unmarshal(data, blk); err != nil {
+		return
+	}
+	_, _ = state.ProcessBlock(context.Background(), beaconState.Copy(), blk)
+}
+
+func TestFuzzProcessBlock_Seed(t *testing.T) {
+	beaconState, blk, _ := createFullBlockWithOperations(t)
+	enc, err := ssz.Marshal(blk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fuzzProcessBlock(beaconState, enc)
+}
+
+// Inputs which crashed the target, or which reach the same code paths, are kept here so that
+// every toolchain runs them.
+func TestFuzzProcessBlock_Regressions(t *testing.T) {
+	resetCfg := featureconfig.Get()
+	cfg := *resetCfg
+	cfg.SkipBLSVerify = true
+	featureconfig.Init(&cfg)
+	defer featureconfig.Init(resetCfg)
+
+	beaconState, blk, _ := createFullBlockWithOperations(t)
+	tests := []struct {
+		name   string
+		mutate func(b *ethpb.BeaconBlock)
+	}{
+		{
+			// Sliced past the shuffled validators on committee cache misses.
+			name:   "attestation committee index out of range",
+			mutate: func(b *ethpb.BeaconBlock) { b.Body.Attestations[0].Data.CommitteeIndex = 1 << 40 },
+		},
+		{
+			name:   "voluntary exit of unknown validator",
+			mutate: func(b *ethpb.BeaconBlock) { b.Body.VoluntaryExits[0].Exit.ValidatorIndex = 1 << 40 },
+		},
+		{
+			name:   "proposer slashing of unknown validator",
+			mutate: func(b *ethpb.BeaconBlock) { b.Body.ProposerSlashings[0].ProposerIndex = 1 << 40 },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mutated := proto.Clone(blk).(*ethpb.SignedBeaconBlock)
+			tt.mutate(mutated.Block)
+			enc, err := ssz.Marshal(mutated)
+			if err != nil {
+				t.Fatal(err)
+			}
+			helpers.ClearCache()
+			fuzzProcessBlock(beaconState, enc)
+		})
+	}
+}
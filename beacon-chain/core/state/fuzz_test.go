@@ -0,0 +1,35 @@
+//go:build go1.18
+// +build go1.18
+
+package state_test
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+)
+
+// FuzzProcessBlock is seeded with a block carrying every kind of block operation, run it with:
+//
+//	go test ./beacon-chain/core/state -run=^$ -fuzz=FuzzProcessBlock
+func FuzzProcessBlock(f *testing.F) {
+	beaconState, blk, _ := createFullBlockWithOperations(f)
+	enc, err := ssz.Marshal(blk)
+	if err != nil {
+		f.Fatal(err)
+	}
+	f.Add(enc)
+
+	// Mutated blocks are never signed by the proposer, skip the signature checks so the
+	// fuzzer explores the processing of the block operations.
+	resetCfg := featureconfig.Get()
+	cfg := *resetCfg
+	cfg.SkipBLSVerify = true
+	featureconfig.Init(&cfg)
+	defer featureconfig.Init(resetCfg)
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		fuzzProcessBlock(beaconState, data)
+	})
+}
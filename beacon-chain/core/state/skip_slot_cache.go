@@ -1,7 +1,12 @@
 package state
 
 import (
+	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateutil"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
 )
 
 // SkipSlotCache exists for the unlikely scenario that is a large gap between the head state and
@@ -9,3 +14,14 @@ import (
 // difficult or impossible to compute the appropriate beacon state for assignments within a
 // reasonable amount of time.
 var SkipSlotCache = cache.NewSkipSlotCache()
+
+// skipSlotCacheKey returns the key of the skip slot cache entry for advancing the given state.
+// The key commits to the latest block header as well as the slot, so states on different
+// forks at the same slot are never mistaken for one another.
+func skipSlotCacheKey(state *stateTrie.BeaconState) ([32]byte, error) {
+	headerRoot, err := stateutil.BlockHeaderRoot(state.LatestBlockHeader())
+	if err != nil {
+		return [32]byte{}, errors.Wrap(err, "could not compute latest block header root")
+	}
+	return hashutil.Hash(append(bytesutil.Bytes8(state.Slot()), headerRoot[:]...)), nil
+}
@@ -1,9 +1,11 @@
 package state_test
 
 import (
+	"bytes"
 	"context"
 	"testing"
 
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/go-ssz"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	beaconstate "github.com/prysmaticlabs/prysm/beacon-chain/state"
@@ -40,3 +42,32 @@ func TestSkipSlotCache_OK(t *testing.T) {
 		t.Fatal("Skipped slots cache leads to different states")
 	}
 }
+
+func TestSkipSlotCache_ForkedStatesAtSameSlot(t *testing.T) {
+	state.SkipSlotCache.Enable()
+	defer state.SkipSlotCache.Disable()
+	bState, _ := testutil.DeterministicGenesisState(t, params.MinimalSpecConfig().MinGenesisActiveValidatorCount)
+	forkedState := bState.Copy()
+	header := bState.LatestBlockHeader()
+	if err := forkedState.SetLatestBlockHeader(&ethpb.BeaconBlockHeader{
+		Slot:       header.Slot,
+		ParentRoot: header.ParentRoot,
+		StateRoot:  header.StateRoot,
+		BodyRoot:   bytes.Repeat([]byte{'b'}, 32),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Advancing the first state populates the cache, which must not be used to advance the
+	// state of the other fork at the same slot.
+	if _, err := state.ProcessSlots(context.Background(), bState, 5); err != nil {
+		t.Fatal(err)
+	}
+	forkedState, err := state.ProcessSlots(context.Background(), forkedState, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(forkedState.LatestBlockHeader().BodyRoot, bytes.Repeat([]byte{'b'}, 32)) {
+		t.Error("Skipped slots cache returned the state of another fork")
+	}
+}
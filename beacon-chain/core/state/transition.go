@@ -18,6 +18,8 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state/interop"
 	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateutil"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/mathutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/traceutil"
@@ -64,9 +66,20 @@ func ExecuteStateTransition(
 	}
 
 	// Execute per block transition.
-	state, err = ProcessBlock(ctx, state, signed)
-	if err != nil {
-		return nil, errors.Wrapf(err, "could not process block in slot %d", signed.Block.Slot)
+	if featureconfig.Get().EnableBatchBlockVerify {
+		var set *bls.SignatureSet
+		set, state, err = ProcessBlockNoVerifyAnySig(ctx, state, signed)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not process block in slot %d", signed.Block.Slot)
+		}
+		if err := set.Verify(); err != nil {
+			return nil, errors.Wrapf(err, "could not verify signatures of block in slot %d", signed.Block.Slot)
+		}
+	} else {
+		state, err = ProcessBlock(ctx, state, signed)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not process block in slot %d", signed.Block.Slot)
+		}
 	}
 
 	interop.WriteBlockToDisk(signed, false)
@@ -122,9 +135,20 @@ func ExecuteStateTransitionNoVerifyAttSigs(
 	}
 
 	// Execute per block transition.
-	state, err = ProcessBlockNoVerifyAttSigs(ctx, state, signed)
-	if err != nil {
-		return nil, errors.Wrap(err, "could not process block")
+	if featureconfig.Get().EnableBatchBlockVerify {
+		var set *bls.SignatureSet
+		set, state, err = processBlockNoVerifyAttSigsBatch(ctx, state, signed)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not process block")
+		}
+		if err := set.Verify(); err != nil {
+			return nil, errors.Wrapf(err, "could not verify signatures of block in slot %d", signed.Block.Slot)
+		}
+	} else {
+		state, err = ProcessBlockNoVerifyAttSigs(ctx, state, signed)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not process block")
+		}
 	}
 
 	return state, nil
@@ -271,7 +295,10 @@ func ProcessSlots(ctx context.Context, state *stateTrie.BeaconState, slot uint64
 	}
 
 	highestSlot := state.Slot()
-	key := state.Slot()
+	key, err := skipSlotCacheKey(state)
+	if err != nil {
+		return nil, err
+	}
 
 	// Restart from cached value, if one exists.
 	cachedState, err := SkipSlotCache.Get(ctx, key)
@@ -420,6 +447,102 @@ func ProcessBlockNoVerifyAttSigs(
 	return state, nil
 }
 
+// ProcessBlockNoVerifyAnySig processes the block like ProcessBlock, but instead of verifying
+// each signature of the block as it goes, it collects all of them into a signature set which
+// the caller is expected to batch verify. The state is only valid if the returned set verifies.
+//
+// WARNING: This method does not verify any signatures of the block.
+func ProcessBlockNoVerifyAnySig(
+	ctx context.Context,
+	state *stateTrie.BeaconState,
+	signed *ethpb.SignedBeaconBlock,
+) (*bls.SignatureSet, *stateTrie.BeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "beacon-chain.ChainService.state.ProcessBlockNoVerifyAnySig")
+	defer span.End()
+
+	if signed == nil || signed.Block == nil || signed.Block.Body == nil {
+		return nil, nil, errors.New("nil block")
+	}
+	// The signature set is collected from the pre-block state, the public keys,
+	// committees and fork used to verify the signatures are not changed by
+	// processing the block.
+	set, err := b.BlockSignatureSet(ctx, state, signed)
+	if err != nil {
+		traceutil.AnnotateError(span, err)
+		return nil, nil, errors.Wrap(err, "could not retrieve block signature set")
+	}
+
+	state, err = b.ProcessBlockHeaderNoVerify(state, signed.Block)
+	if err != nil {
+		traceutil.AnnotateError(span, err)
+		return nil, nil, errors.Wrap(err, "could not process block header")
+	}
+
+	state, err = b.ProcessRandaoNoVerify(state, signed.Block.Body)
+	if err != nil {
+		traceutil.AnnotateError(span, err)
+		return nil, nil, errors.Wrap(err, "could not process randao")
+	}
+
+	state, err = b.ProcessEth1DataInBlock(state, signed.Block)
+	if err != nil {
+		traceutil.AnnotateError(span, err)
+		return nil, nil, errors.Wrap(err, "could not process eth1 data")
+	}
+
+	state, err = processOperationsNoVerifySignatures(ctx, state, signed.Block.Body)
+	if err != nil {
+		traceutil.AnnotateError(span, err)
+		return nil, nil, errors.Wrap(err, "could not process block operation")
+	}
+
+	return set, state, nil
+}
+
+// processBlockNoVerifyAttSigsBatch processes the block like ProcessBlockNoVerifyAttSigs, but
+// collects the signatures verified by ProcessBlockNoVerifyAttSigs into a signature set which
+// the caller is expected to batch verify, instead of verifying them one by one.
+func processBlockNoVerifyAttSigsBatch(
+	ctx context.Context,
+	state *stateTrie.BeaconState,
+	signed *ethpb.SignedBeaconBlock,
+) (*bls.SignatureSet, *stateTrie.BeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "beacon-chain.ChainService.state.processBlockNoVerifyAttSigsBatch")
+	defer span.End()
+
+	set, err := b.BlockSignatureSetNoVerifyAttSigs(state, signed)
+	if err != nil {
+		traceutil.AnnotateError(span, err)
+		return nil, nil, errors.Wrap(err, "could not retrieve block signature set")
+	}
+
+	state, err = b.ProcessBlockHeaderNoVerify(state, signed.Block)
+	if err != nil {
+		traceutil.AnnotateError(span, err)
+		return nil, nil, errors.Wrap(err, "could not process block header")
+	}
+
+	state, err = b.ProcessRandaoNoVerify(state, signed.Block.Body)
+	if err != nil {
+		traceutil.AnnotateError(span, err)
+		return nil, nil, errors.Wrap(err, "could not process randao")
+	}
+
+	state, err = b.ProcessEth1DataInBlock(state, signed.Block)
+	if err != nil {
+		traceutil.AnnotateError(span, err)
+		return nil, nil, errors.Wrap(err, "could not process eth1 data")
+	}
+
+	state, err = processOperationsNoVerifySignatures(ctx, state, signed.Block.Body)
+	if err != nil {
+		traceutil.AnnotateError(span, err)
+		return nil, nil, errors.Wrap(err, "could not process block operation")
+	}
+
+	return set, state, nil
+}
+
 // ProcessOperations processes the operations in the beacon block and updates beacon state
 // with the operations in block.
 //
@@ -537,6 +660,43 @@ func processOperationsNoVerify(
 	return state, nil
 }
 
+// processOperationsNoVerifySignatures processes the operations in the beacon block with every
+// check of ProcessOperations, except for the signature verifications.
+func processOperationsNoVerifySignatures(
+	ctx context.Context,
+	state *stateTrie.BeaconState,
+	body *ethpb.BeaconBlockBody) (*stateTrie.BeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "beacon-chain.ChainService.state.ProcessOperations")
+	defer span.End()
+
+	if err := verifyOperationLengths(state, body); err != nil {
+		return nil, errors.Wrap(err, "could not verify operation lengths")
+	}
+
+	state, err := b.ProcessProposerSlashingsNoVerify(ctx, state, body)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not process block proposer slashings")
+	}
+	state, err = b.ProcessAttesterSlashingsNoVerify(ctx, state, body)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not process block attester slashings")
+	}
+	state, err = b.ProcessAttestationsNoVerify(ctx, state, body)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not process block attestations")
+	}
+	state, err = b.ProcessDeposits(ctx, state, body)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not process block validator deposits")
+	}
+	state, err = b.ProcessVoluntaryExitsNoVerifySignature(ctx, state, body)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not process validator exits")
+	}
+
+	return state, nil
+}
+
 func verifyOperationLengths(state *stateTrie.BeaconState, body *ethpb.BeaconBlockBody) error {
 	if uint64(len(body.ProposerSlashings)) > params.BeaconConfig().MaxProposerSlashings {
 		return fmt.Errorf(
@@ -18,6 +18,7 @@ import (
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/attestationutil"
 	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
@@ -296,7 +297,7 @@ func TestProcessBlock_IncorrectProcessExits(t *testing.T) {
 	}
 }
 
-func TestProcessBlock_PassesProcessingConditions(t *testing.T) {
+func createFullBlockWithOperations(t testing.TB) (*beaconstate.BeaconState, *ethpb.SignedBeaconBlock, []*bls.SecretKey) {
 	beaconState, privKeys := testutil.DeterministicGenesisState(t, 32)
 	genesisBlock := blocks.NewGenesisBlock([]byte{})
 	bodyRoot, err := ssz.HashTreeRoot(genesisBlock.Block)
@@ -495,8 +496,15 @@ func TestProcessBlock_PassesProcessingConditions(t *testing.T) {
 		t.Fatal(err)
 	}
 	block.Signature = sig.Marshal()
+	return beaconState, block, privKeys
+}
+
+func TestProcessBlock_PassesProcessingConditions(t *testing.T) {
+	beaconState, block, _ := createFullBlockWithOperations(t)
+	proposerSlashings := block.Block.Body.ProposerSlashings
+	exit := block.Block.Body.VoluntaryExits[0]
 
-	beaconState, err = state.ProcessBlock(context.Background(), beaconState, block)
+	beaconState, err := state.ProcessBlock(context.Background(), beaconState, block)
 	if err != nil {
 		t.Fatalf("Expected block to pass processing conditions: %v", err)
 	}
@@ -517,6 +525,103 @@ func TestProcessBlock_PassesProcessingConditions(t *testing.T) {
 	}
 }
 
+func TestProcessBlockNoVerifyAnySig_MatchesProcessBlock(t *testing.T) {
+	beaconState, block, _ := createFullBlockWithOperations(t)
+
+	wanted, err := state.ProcessBlock(context.Background(), beaconState.Copy(), block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	set, received, err := state.ProcessBlockNoVerifyAnySig(context.Background(), beaconState, block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Proposer, randao, two slashed headers, two slashed attestations, one attestation and one exit.
+	if len(set.Signatures) != 8 {
+		t.Errorf("Expected 8 signatures in the block signature set, received %d", len(set.Signatures))
+	}
+	if err := set.Verify(); err != nil {
+		t.Errorf("Expected block signatures to verify: %v", err)
+	}
+	wantedRoot, err := wanted.HashTreeRoot(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	receivedRoot, err := received.HashTreeRoot(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if wantedRoot != receivedRoot {
+		t.Errorf("Expected post state roots to match, %#x != %#x", wantedRoot, receivedRoot)
+	}
+}
+
+func TestProcessBlockNoVerifyAnySig_ReportsInvalidSignature(t *testing.T) {
+	beaconState, block, privKeys := createFullBlockWithOperations(t)
+	exit := block.Block.Body.VoluntaryExits[0]
+	exit.Signature = block.Block.Body.ProposerSlashings[0].Header_1.Signature
+	sig, err := testutil.BlockSignature(beaconState, block.Block, privKeys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	block.Signature = sig.Marshal()
+
+	set, _, err := state.ProcessBlockNoVerifyAnySig(context.Background(), beaconState, block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "could not verify voluntary exit 0"
+	if err := set.Verify(); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected %s, received %v", want, err)
+	}
+}
+
+func TestExecuteStateTransitionNoVerifyAttSigs_BatchVerify(t *testing.T) {
+	beaconState, block, _ := createFullBlockWithOperations(t)
+
+	wanted, err := state.ExecuteStateTransitionNoVerifyAttSigs(context.Background(), beaconState.Copy(), block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resetCfg := featureconfig.Get()
+	featureconfig.Init(&featureconfig.Flags{EnableBatchBlockVerify: true})
+	defer featureconfig.Init(resetCfg)
+	received, err := state.ExecuteStateTransitionNoVerifyAttSigs(context.Background(), beaconState, block)
+	if err != nil {
+		t.Fatalf("Expected block to pass batch verification: %v", err)
+	}
+	wantedRoot, err := wanted.HashTreeRoot(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	receivedRoot, err := received.HashTreeRoot(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if wantedRoot != receivedRoot {
+		t.Errorf("Expected post state roots to match, %#x != %#x", wantedRoot, receivedRoot)
+	}
+}
+
+func TestExecuteStateTransitionNoVerifyAttSigs_BatchRejectsInvalidSignature(t *testing.T) {
+	beaconState, block, privKeys := createFullBlockWithOperations(t)
+	slashing := block.Block.Body.ProposerSlashings[0]
+	slashing.Header_2.Signature = slashing.Header_1.Signature
+	sig, err := testutil.BlockSignature(beaconState, block.Block, privKeys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	block.Signature = sig.Marshal()
+
+	resetCfg := featureconfig.Get()
+	featureconfig.Init(&featureconfig.Flags{EnableBatchBlockVerify: true})
+	defer featureconfig.Init(resetCfg)
+	want := "could not verify header 2 of proposer slashing 0"
+	if _, err := state.ExecuteStateTransitionNoVerifyAttSigs(context.Background(), beaconState, block); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected %s, received %v", want, err)
+	}
+}
+
 func TestProcessEpochPrecompute_CanProcess(t *testing.T) {
 	epoch := uint64(1)
 
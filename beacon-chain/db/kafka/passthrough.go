@@ -373,6 +373,26 @@ func (e Exporter) HighestSlotStatesBelow(ctx context.Context, slot uint64) ([]*s
 	return e.db.HighestSlotStatesBelow(ctx, slot)
 }
 
+// StateDiff -- passthrough
+func (e Exporter) StateDiff(ctx context.Context, blockRoot [32]byte) ([]byte, error) {
+	return e.db.StateDiff(ctx, blockRoot)
+}
+
+// HasStateDiff -- passthrough
+func (e Exporter) HasStateDiff(ctx context.Context, blockRoot [32]byte) bool {
+	return e.db.HasStateDiff(ctx, blockRoot)
+}
+
+// SaveStateDiff -- passthrough
+func (e Exporter) SaveStateDiff(ctx context.Context, blockRoot [32]byte, diff []byte) error {
+	return e.db.SaveStateDiff(ctx, blockRoot, diff)
+}
+
+// DeleteStateDiff -- passthrough
+func (e Exporter) DeleteStateDiff(ctx context.Context, blockRoot [32]byte) error {
+	return e.db.DeleteStateDiff(ctx, blockRoot)
+}
+
 // SaveLastArchivedIndex -- passthrough
 func (e Exporter) SaveLastArchivedIndex(ctx context.Context, index uint64) error {
 	return e.db.SaveLastArchivedIndex(ctx, index)
@@ -105,6 +105,7 @@ func NewKVStore(dirPath string, stateSummaryCache *cache.StateSummaryCache) (*St
 			stateSummaryBucket,
 			archivedIndexRootBucket,
 			slotsHasObjectBucket,
+			stateDiffBucket,
 			// Indices buckets.
 			attestationHeadBlockRootBucket,
 			attestationSourceRootIndicesBucket,
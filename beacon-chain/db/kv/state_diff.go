@@ -0,0 +1,66 @@
+package kv
+
+import (
+	"context"
+
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// SaveStateDiff saves an encoded state diff keyed by the block root of the state it reconstructs.
+// The diff is opaque to the DB, encoding and decoding is handled by the state management service.
+func (k *Store) SaveStateDiff(ctx context.Context, blockRoot [32]byte, diff []byte) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveStateDiff")
+	defer span.End()
+
+	return k.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(stateDiffBucket)
+		return bucket.Put(blockRoot[:], diff)
+	})
+}
+
+// StateDiff returns the encoded state diff of the input block root. It returns nil if
+// no diff was saved for the block root.
+func (k *Store) StateDiff(ctx context.Context, blockRoot [32]byte) ([]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.StateDiff")
+	defer span.End()
+
+	var diff []byte
+	err := k.db.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(stateDiffBucket)
+		enc := bucket.Get(blockRoot[:])
+		if enc == nil {
+			return nil
+		}
+		// Bolt owns the returned slice only for the life of the transaction.
+		diff = make([]byte, len(enc))
+		copy(diff, enc)
+		return nil
+	})
+	return diff, err
+}
+
+// HasStateDiff checks if a state diff of the input block root exists in DB.
+func (k *Store) HasStateDiff(ctx context.Context, blockRoot [32]byte) bool {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.HasStateDiff")
+	defer span.End()
+	var exists bool
+	// #nosec G104. Always returns nil.
+	k.db.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(stateDiffBucket)
+		exists = bucket.Get(blockRoot[:]) != nil
+		return nil
+	})
+	return exists
+}
+
+// DeleteStateDiff deletes the state diff of the input block root from DB.
+func (k *Store) DeleteStateDiff(ctx context.Context, blockRoot [32]byte) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.DeleteStateDiff")
+	defer span.End()
+
+	return k.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(stateDiffBucket)
+		return bucket.Delete(blockRoot[:])
+	})
+}
@@ -0,0 +1,47 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"testing"
+)
+
+func TestStateDiff_CanSaveRetrieveDelete(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+	r := [32]byte{'A'}
+	diff := []byte("diff")
+
+	if db.HasStateDiff(ctx, r) {
+		t.Fatal("Should not have a state diff")
+	}
+	received, err := db.StateDiff(ctx, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if received != nil {
+		t.Fatal("Should not have been saved")
+	}
+
+	if err := db.SaveStateDiff(ctx, r, diff); err != nil {
+		t.Fatal(err)
+	}
+	if !db.HasStateDiff(ctx, r) {
+		t.Fatal("Should have a state diff")
+	}
+	received, err = db.StateDiff(ctx, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(received, diff) {
+		t.Errorf("Wanted %v, received %v", diff, received)
+	}
+
+	if err := db.DeleteStateDiff(ctx, r); err != nil {
+		t.Fatal(err)
+	}
+	if db.HasStateDiff(ctx, r) {
+		t.Error("Should have deleted state diff")
+	}
+}
@@ -1,6 +1,8 @@
 package flags
 
 import (
+	"time"
+
 	"gopkg.in/urfave/cli.v2"
 )
 
@@ -17,6 +19,21 @@ var (
 		Usage: "A mainchain web3 provider string endpoint. Can either be an IPC file string or a WebSocket endpoint. Cannot be an HTTP endpoint.",
 		Value: "wss://goerli.prylabs.net/websocket",
 	}
+	// FallbackWeb3ProviderFlag defines a flag for mainchain RPC endpoints used when the web3provider is unhealthy.
+	FallbackWeb3ProviderFlag = &cli.StringSliceFlag{
+		Name:  "fallback-web3provider",
+		Usage: "A mainchain web3 provider string endpoint used, in order, when the web3provider is unhealthy. Each must be paired with a fallback-http-web3provider, and eth1-chain-id must be set. This flag may be used multiple times.",
+	}
+	// FallbackHTTPWeb3ProviderFlag provides the HTTP endpoints of the fallback mainchain RPC endpoints.
+	FallbackHTTPWeb3ProviderFlag = &cli.StringSliceFlag{
+		Name:  "fallback-http-web3provider",
+		Usage: "A mainchain web3 provider string http endpoint of the fallback-web3provider of the same position. This flag may be used multiple times.",
+	}
+	// Eth1ChainIDFlag defines the chain ID the mainchain RPC endpoints must be on.
+	Eth1ChainIDFlag = &cli.Uint64Flag{
+		Name:  "eth1-chain-id",
+		Usage: "Chain ID the mainchain web3 providers must be on. Required with fallback web3 providers, which could otherwise be on another chain.",
+	}
 	// DepositContractFlag defines a flag for the deposit contract address.
 	DepositContractFlag = &cli.StringFlag{
 		Name:  "deposit-contract",
@@ -105,9 +122,69 @@ var (
 		Usage: "The slot durations of when an archived state gets saved in the DB.",
 		Value: 128,
 	}
+	// HistoricalStateQueryEpochs defines how far back from the head historical beacon states
+	// can be queried over RPC.
+	HistoricalStateQueryEpochs = &cli.Uint64Flag{
+		Name:  "historical-state-query-epochs",
+		Usage: "The number of epochs before the head for which historical beacon states can be queried over RPC. 0 means no limit.",
+		Value: 0,
+	}
+	// EnableLightClientServer enables serving light client updates over p2p and gRPC.
+	EnableLightClientServer = &cli.BoolFlag{
+		Name:  "light-client-server",
+		Usage: "Serves finalized header updates with their finality proofs to light clients over p2p and gRPC.",
+	}
+	// LightClientSyncCommitteeFlag defines the file holding the public keys of the sync committee
+	// signing light client updates.
+	LightClientSyncCommitteeFlag = &cli.StringFlag{
+		Name:  "light-client-sync-committee",
+		Usage: "The file holding the hex encoded public keys of the light client sync committee, one per line.",
+	}
+	// LightClientSigningKeyFlag defines the file holding the secret key the node signs light client
+	// updates with.
+	LightClientSigningKeyFlag = &cli.StringFlag{
+		Name:  "light-client-signing-key",
+		Usage: "The file holding the hex encoded secret key of the member of the light client sync committee run by this node.",
+	}
 	// EnableDiscv5 enables running discv5.
 	EnableDiscv5 = &cli.BoolFlag{
 		Name:  "enable-discv5",
 		Usage: "Starts dv5 dht.",
 	}
+	// BlockBatchLimit specifies the number of blocks per second served to a single peer over p2p RPC.
+	BlockBatchLimit = &cli.IntFlag{
+		Name:  "block-batch-limit",
+		Usage: "The number of blocks per second a peer can request from this node over p2p RPC.",
+		Value: 32,
+	}
+	// BlockBatchLimitBurstFactor specifies the factor by which the block batch limit may be exceeded in bursts.
+	BlockBatchLimitBurstFactor = &cli.IntFlag{
+		Name:  "block-batch-limit-burst-factor",
+		Usage: "The factor by which a peer can exceed the block batch limit in bursts of requests.",
+		Value: 10,
+	}
+	// RPCRequestLimit specifies the number of non-block requests per second accepted from a single peer over p2p RPC.
+	RPCRequestLimit = &cli.IntFlag{
+		Name:  "p2p-rpc-request-limit",
+		Usage: "The number of status, goodbye and light client requests per second a peer can send to this node over p2p RPC.",
+		Value: 1,
+	}
+	// RPCGlobalLimitFactor specifies the factor between the rate limit across all peers and the rate limit of a single peer.
+	RPCGlobalLimitFactor = &cli.IntFlag{
+		Name:  "p2p-rpc-global-limit-factor",
+		Usage: "The factor between the rate limit of p2p RPC requests across all peers and the rate limit of a single peer.",
+		Value: 16,
+	}
+	// BlockBodyProviderFlag defines the gRPC endpoint of an external block body provider.
+	BlockBodyProviderFlag = &cli.StringFlag{
+		Name: "block-body-provider",
+		Usage: "The gRPC endpoint of an external process providing the bodies of the blocks proposed by the " +
+			"validators of the node. The node builds block bodies itself when the provided ones are invalid or late.",
+	}
+	// BlockBodyProviderTimeoutFlag defines the time an external block body provider has to provide a block body.
+	BlockBodyProviderTimeoutFlag = &cli.DurationFlag{
+		Name:  "block-body-provider-timeout",
+		Usage: "The time the external block body provider has to provide a block body before the node builds it itself.",
+		Value: time.Second,
+	}
 )
@@ -18,6 +18,12 @@ type GlobalFlags struct {
 	MinimumSyncPeers                  int
 	MaxPageSize                       int
 	DeploymentBlock                   int
+	HistoricalStateQueryEpochs        uint64
+	EnableLightClientServer           bool
+	BlockBatchLimit                   int
+	BlockBatchLimitBurstFactor        int
+	RPCRequestLimit                   int
+	RPCGlobalLimitFactor              int
 }
 
 var globalConfig *GlobalFlags
@@ -54,11 +60,19 @@ func ConfigureGlobalFlags(ctx *cli.Context) {
 	if ctx.Bool(UnsafeSync.Name) {
 		cfg.UnsafeSync = true
 	}
+	if ctx.Bool(EnableLightClientServer.Name) {
+		cfg.EnableLightClientServer = true
+	}
 	if ctx.Bool(EnableDiscv5.Name) {
 		cfg.EnableDiscv5 = true
 	}
 	cfg.MaxPageSize = ctx.Int(RPCMaxPageSize.Name)
 	cfg.DeploymentBlock = ctx.Int(ContractDeploymentBlock.Name)
+	cfg.HistoricalStateQueryEpochs = ctx.Uint64(HistoricalStateQueryEpochs.Name)
+	cfg.BlockBatchLimit = ctx.Int(BlockBatchLimit.Name)
+	cfg.BlockBatchLimitBurstFactor = ctx.Int(BlockBatchLimitBurstFactor.Name)
+	cfg.RPCRequestLimit = ctx.Int(RPCRequestLimit.Name)
+	cfg.RPCGlobalLimitFactor = ctx.Int(RPCGlobalLimitFactor.Name)
 	configureMinimumPeers(ctx, cfg)
 
 	Init(cfg)
@@ -12,6 +12,7 @@ type ForkChoicer interface {
 	BlockProcessor       // to track new block for fork choice.
 	AttestationProcessor // to track new attestation for fork choice.
 	Pruner               // to clean old data for fork choice.
+	ProposerBooster      // to boost the weight of timely blocks for fork choice.
 	Getter               // to retrieve fork choice information.
 }
 
@@ -35,9 +36,18 @@ type Pruner interface {
 	Prune(context.Context, [32]byte) error
 }
 
+// ProposerBooster boosts the weight of the timely block of the current slot.
+type ProposerBooster interface {
+	BoostProposerRoot(context.Context, [32]byte, uint64)
+	ResetBoostedProposerRoot(context.Context)
+}
+
 // Getter returns fork choice related information.
 type Getter interface {
 	Nodes() []*protoarray.Node
 	Node([32]byte) *protoarray.Node
 	HasNode([32]byte) bool
+	Votes() []protoarray.Vote
+	JustifiedEpoch() uint64
+	FinalizedEpoch() uint64
 }
@@ -42,6 +42,12 @@ var (
 			Help: "The number of times an attestation is processed for fork choice.",
 		},
 	)
+	proposerBoostCount = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "proto_array_proposer_boost_count",
+			Help: "The number of timely blocks boosted for fork choice.",
+		},
+	)
 	prunedCount = promauto.NewCounter(
 		prometheus.CounterOpts{
 			Name: "proto_array_pruned_count",
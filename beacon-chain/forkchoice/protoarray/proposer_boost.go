@@ -0,0 +1,75 @@
+package protoarray
+
+import (
+	"context"
+
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// BoostProposerRoot sets the block to boost in the next head computations, it should be called
+// with a block received in the first interval of its slot along with the total active balance
+// of the justified checkpoint state. The boost is weighted as the proposer score of the spec:
+//
+//	def get_proposer_score(store: Store) -> Gwei:
+//	  justified_checkpoint_state = store.checkpoint_states[store.justified_checkpoint]
+//	  committee_weight = get_total_active_balance(justified_checkpoint_state) // SLOTS_PER_EPOCH
+//	  return (committee_weight * PROPOSER_SCORE_BOOST) // 100
+func (f *ForkChoice) BoostProposerRoot(_ context.Context, blockRoot [32]byte, justifiedActiveBalance uint64) {
+	f.store.proposerBoostLock.Lock()
+	defer f.store.proposerBoostLock.Unlock()
+	f.store.proposerBoostRoot = blockRoot
+	f.store.proposerBoostScore = proposerScore(justifiedActiveBalance)
+	proposerBoostCount.Inc()
+}
+
+// ResetBoostedProposerRoot stops boosting the block of the previous slot, it should be called at
+// the start of every slot. The boost is removed from the node weights in the next head computation.
+func (f *ForkChoice) ResetBoostedProposerRoot(_ context.Context) {
+	f.store.proposerBoostLock.Lock()
+	defer f.store.proposerBoostLock.Unlock()
+	f.store.proposerBoostRoot = [32]byte{}
+	f.store.proposerBoostScore = 0
+}
+
+// This adds the proposer boost to the deltas of the node weights. The score of the previous boost
+// is removed from the node it was applied to, and the score of the current boost is added to the
+// boosted node, both being back propagated to their ancestors with the attestation deltas.
+func (s *Store) applyProposerBoostScore(delta []int) error {
+	s.proposerBoostLock.Lock()
+	defer s.proposerBoostLock.Unlock()
+
+	if s.previousProposerBoostRoot != params.BeaconConfig().ZeroHash {
+		// The previously boosted node may have been pruned along with its weight.
+		if index, ok := s.nodeIndices[s.previousProposerBoostRoot]; ok {
+			if index >= uint64(len(delta)) {
+				return errInvalidNodeDelta
+			}
+			delta[index] -= int(s.previousProposerBoostScore)
+		}
+	}
+	s.previousProposerBoostRoot = [32]byte{}
+	s.previousProposerBoostScore = 0
+
+	if s.proposerBoostRoot == params.BeaconConfig().ZeroHash {
+		return nil
+	}
+	index, ok := s.nodeIndices[s.proposerBoostRoot]
+	if !ok {
+		return nil
+	}
+	if index >= uint64(len(delta)) {
+		return errInvalidNodeDelta
+	}
+	score := s.proposerBoostScore
+	delta[index] += int(score)
+	s.previousProposerBoostRoot = s.proposerBoostRoot
+	s.previousProposerBoostScore = score
+	return nil
+}
+
+// This returns the proposer boost score, a share of the balance of a committee of a slot given the
+// total active balance of the justified checkpoint state.
+func proposerScore(justifiedActiveBalance uint64) uint64 {
+	committeeWeight := justifiedActiveBalance / params.BeaconConfig().SlotsPerEpoch
+	return committeeWeight * params.BeaconConfig().ProposerScoreBoost / 100
+}
@@ -0,0 +1,137 @@
+package protoarray
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func TestProposerBoost_LateBlockReorg(t *testing.T) {
+	ctx := context.Background()
+	// 320 validators of balance 1 make a committee weight of 10 and a proposer boost of 4. The boost
+	// only depends on the justified active balance, not on the balances of the head computation.
+	balances := make([]uint64, 320)
+	for i := range balances {
+		balances[i] = 1
+	}
+	f := setup(1, 1)
+	weight := func(root [32]byte) uint64 {
+		return f.store.nodes[f.store.nodeIndices[root]].Weight
+	}
+
+	// Insert block 1 with the votes of 3 validators and verify head is at 1:
+	//         0
+	//        /
+	//       1 <- head, 3 votes
+	if err := f.ProcessBlock(ctx, 1, indexToHash(1), params.BeaconConfig().ZeroHash, 1, 1); err != nil {
+		t.Fatal(err)
+	}
+	f.ProcessAttestation(ctx, []uint64{0, 1, 2}, indexToHash(1), 2)
+	r, err := f.Head(ctx, 1, params.BeaconConfig().ZeroHash, balances, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r != indexToHash(1) {
+		t.Error("Incorrect head with a single block")
+	}
+
+	// Insert timely block 2 of the next slot on top of 0 and verify the boost makes it head:
+	//            0
+	//           / \
+	//          1  2 <- boosted, new head
+	if err := f.ProcessBlock(ctx, 2, indexToHash(2), params.BeaconConfig().ZeroHash, 1, 1); err != nil {
+		t.Fatal(err)
+	}
+	f.BoostProposerRoot(ctx, indexToHash(2), 320)
+	r, err = f.Head(ctx, 1, params.BeaconConfig().ZeroHash, balances, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r != indexToHash(2) {
+		t.Error("Incorrect head with a boosted block")
+	}
+	if weight(indexToHash(2)) != 4 {
+		t.Errorf("Expected boosted weight 4, received %d", weight(indexToHash(2)))
+	}
+
+	// Reset the boost at the start of the next slot and verify head is back at 1:
+	//            0
+	//           / \
+	// head ->  1  2
+	f.ResetBoostedProposerRoot(ctx)
+	r, err = f.Head(ctx, 1, params.BeaconConfig().ZeroHash, balances, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r != indexToHash(1) {
+		t.Error("Incorrect head once the boost is removed")
+	}
+	if weight(indexToHash(2)) != 0 {
+		t.Errorf("Expected boost to be removed, received weight %d", weight(indexToHash(2)))
+	}
+
+	// Insert timely block 3 on top of 1 and verify the boost is applied once across head computations:
+	//            0
+	//           / \
+	//          1  2
+	//         /
+	//        3 <- boosted, new head
+	if err := f.ProcessBlock(ctx, 3, indexToHash(3), indexToHash(1), 1, 1); err != nil {
+		t.Fatal(err)
+	}
+	f.BoostProposerRoot(ctx, indexToHash(3), 320)
+	for i := 0; i < 2; i++ {
+		r, err = f.Head(ctx, 1, params.BeaconConfig().ZeroHash, balances, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if r != indexToHash(3) {
+			t.Error("Incorrect head with a boosted block")
+		}
+		if weight(indexToHash(3)) != 4 || weight(indexToHash(1)) != 7 {
+			t.Errorf("Expected weights 4 and 7, received %d and %d", weight(indexToHash(3)), weight(indexToHash(1)))
+		}
+	}
+
+	// Reset the boost and verify head stays at 3 with the votes of 1 only.
+	f.ResetBoostedProposerRoot(ctx)
+	r, err = f.Head(ctx, 1, params.BeaconConfig().ZeroHash, balances, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r != indexToHash(3) {
+		t.Error("Incorrect head once the boost is removed")
+	}
+	if weight(indexToHash(3)) != 0 || weight(indexToHash(1)) != 3 {
+		t.Errorf("Expected weights 0 and 3, received %d and %d", weight(indexToHash(3)), weight(indexToHash(1)))
+	}
+}
+
+func TestProposerBoost_UnknownRoot(t *testing.T) {
+	ctx := context.Background()
+	f := setup(1, 1)
+	if err := f.ProcessBlock(ctx, 1, indexToHash(1), params.BeaconConfig().ZeroHash, 1, 1); err != nil {
+		t.Fatal(err)
+	}
+	f.BoostProposerRoot(ctx, indexToHash(2), 64)
+	r, err := f.Head(ctx, 1, params.BeaconConfig().ZeroHash, []uint64{32, 32}, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r != indexToHash(1) {
+		t.Error("Incorrect head with an unknown boosted block")
+	}
+	if f.store.nodes[1].Weight != 0 {
+		t.Errorf("Expected no boost, received weight %d", f.store.nodes[1].Weight)
+	}
+}
+
+func TestProposerScore(t *testing.T) {
+	justifiedActiveBalance := 2 * params.BeaconConfig().SlotsPerEpoch * params.BeaconConfig().MaxEffectiveBalance
+	// A committee of 2 validators.
+	want := 2 * params.BeaconConfig().MaxEffectiveBalance * params.BeaconConfig().ProposerScoreBoost / 100
+	if got := proposerScore(justifiedActiveBalance); got != want {
+		t.Errorf("Expected proposer score %d, received %d", want, got)
+	}
+}
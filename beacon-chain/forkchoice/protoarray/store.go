@@ -41,16 +41,22 @@ func (f *ForkChoice) Head(ctx context.Context, justifiedEpoch uint64, justifiedR
 
 	newBalances := justifiedStateBalances
 
-	// Using the read lock is ok here, rest of the operations below is read only.
-	// The only time it writes to node indices is inserting and pruning blocks from the store.
-	f.store.nodeIndicesLock.RLock()
-	defer f.store.nodeIndicesLock.RUnlock()
+	// The weights and best descendants of the nodes are updated below, which readers of the
+	// nodes must not observe half way.
+	f.store.nodeIndicesLock.Lock()
+	defer f.store.nodeIndicesLock.Unlock()
+	f.votesLock.Lock()
+	defer f.votesLock.Unlock()
 	deltas, newVotes, err := computeDeltas(ctx, f.store.nodeIndices, f.votes, f.balances, newBalances)
 	if err != nil {
 		return [32]byte{}, errors.Wrap(err, "Could not compute deltas")
 	}
 	f.votes = newVotes
 
+	if err := f.store.applyProposerBoostScore(deltas); err != nil {
+		return [32]byte{}, errors.Wrap(err, "Could not apply proposer boost score")
+	}
+
 	if err := f.store.applyWeightChanges(ctx, justifiedEpoch, finalizedEpoch, deltas); err != nil {
 		return [32]byte{}, errors.Wrap(err, "Could not apply score changes")
 	}
@@ -65,6 +71,8 @@ func (f *ForkChoice) ProcessAttestation(ctx context.Context, validatorIndices []
 	ctx, span := trace.StartSpan(ctx, "protoArrayForkChoice.ProcessAttestation")
 	defer span.End()
 
+	f.votesLock.Lock()
+	defer f.votesLock.Unlock()
 	for _, index := range validatorIndices {
 		// Validator indices will grow the vote cache.
 		for index >= uint64(len(f.votes)) {
@@ -101,8 +109,13 @@ func (f *ForkChoice) Prune(ctx context.Context, finalizedRoot [32]byte) error {
 
 // Nodes returns the copied list of block nodes in the fork choice store.
 func (f *ForkChoice) Nodes() []*Node {
+	f.store.nodeIndicesLock.RLock()
+	defer f.store.nodeIndicesLock.RUnlock()
+
 	cpy := make([]*Node, len(f.store.nodes))
-	copy(cpy, f.store.nodes)
+	for i, n := range f.store.nodes {
+		cpy[i] = copyNode(n)
+	}
 	return cpy
 }
 
@@ -128,3 +141,27 @@ func (f *ForkChoice) HasNode(root [32]byte) bool {
 	_, ok := f.store.nodeIndices[root]
 	return ok
 }
+
+// Votes returns the copied list of the latest votes of the validators, indexed by validator index.
+func (f *ForkChoice) Votes() []Vote {
+	f.votesLock.RLock()
+	defer f.votesLock.RUnlock()
+
+	cpy := make([]Vote, len(f.votes))
+	copy(cpy, f.votes)
+	return cpy
+}
+
+// JustifiedEpoch returns the latest justified epoch in the fork choice store.
+func (f *ForkChoice) JustifiedEpoch() uint64 {
+	f.store.nodeIndicesLock.RLock()
+	defer f.store.nodeIndicesLock.RUnlock()
+	return f.store.justifiedEpoch
+}
+
+// FinalizedEpoch returns the latest finalized epoch in the fork choice store.
+func (f *ForkChoice) FinalizedEpoch() uint64 {
+	f.store.nodeIndicesLock.RLock()
+	defer f.store.nodeIndicesLock.RUnlock()
+	return f.store.finalizedEpoch
+}
@@ -4,9 +4,10 @@ import "sync"
 
 // ForkChoice defines the overall fork choice store which includes all block nodes, validator's latest votes and balances.
 type ForkChoice struct {
-	store    *Store
-	votes    []Vote   // tracks individual validator's last vote.
-	balances []uint64 // tracks individual validator's last justified balances.
+	store     *Store
+	votes     []Vote   // tracks individual validator's last vote.
+	balances  []uint64 // tracks individual validator's last justified balances.
+	votesLock sync.RWMutex
 }
 
 // Store defines the fork choice store which includes block nodes and the last view of checkpoint information.
@@ -18,6 +19,12 @@ type Store struct {
 	nodes           []*Node             // list of block nodes, each node is a representation of one block.
 	nodeIndices     map[[32]byte]uint64 // the root of block node and the nodes index in the list.
 	nodeIndicesLock sync.RWMutex
+
+	proposerBoostRoot          [32]byte // root of the timely block of the current slot to boost.
+	proposerBoostScore         uint64   // score of the boost of the timely block of the current slot.
+	previousProposerBoostRoot  [32]byte // root of the block boosted in the node weights.
+	previousProposerBoostScore uint64   // score of the boost applied to the node weights.
+	proposerBoostLock          sync.Mutex
 }
 
 // Node defines the individual block which includes its block parent, ancestor and how much weight accounted for it.
@@ -42,3 +49,38 @@ type Vote struct {
 
 // This defines an unknown node which is used for the array based stateful DAG.
 const nonExistentNode = ^uint64(0)
+
+// Root returns the root of the block converted to the node.
+func (n *Node) Root() [32]byte {
+	return n.root
+}
+
+// JustifiedEpoch returns the justified epoch of the node.
+func (n *Node) JustifiedEpoch() uint64 {
+	return n.justifiedEpoch
+}
+
+// FinalizedEpoch returns the finalized epoch of the node.
+func (n *Node) FinalizedEpoch() uint64 {
+	return n.finalizedEpoch
+}
+
+// BestChild returns the best child index of the node.
+func (n *Node) BestChild() uint64 {
+	return n.bestChild
+}
+
+// CurrentRoot returns the current voting root of the vote.
+func (v Vote) CurrentRoot() [32]byte {
+	return v.currentRoot
+}
+
+// NextRoot returns the next voting root of the vote.
+func (v Vote) NextRoot() [32]byte {
+	return v.nextRoot
+}
+
+// NextEpoch returns the epoch of the next voting period of the vote.
+func (v Vote) NextEpoch() uint64 {
+	return v.nextEpoch
+}
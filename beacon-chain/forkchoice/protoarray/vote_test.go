@@ -394,3 +394,38 @@ func TestVotes_CanFindHead(t *testing.T) {
 		t.Error("Incorrect head for with justified epoch at 2")
 	}
 }
+
+func TestVotes_SnapshotWhileProcessingAttestations(t *testing.T) {
+	f := setup(1, 1)
+	if err := f.ProcessBlock(context.Background(), 1, indexToHash(1), params.BeaconConfig().ZeroHash, 1, 1); err != nil {
+		t.Fatal(err)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := uint64(0); i < 1000; i++ {
+			f.ProcessAttestation(context.Background(), []uint64{i}, indexToHash(1), 2)
+			if _, err := f.Head(context.Background(), 1, params.BeaconConfig().ZeroHash, []uint64{1, 1}, 1); err != nil {
+				t.Error(err)
+				return
+			}
+		}
+	}()
+	for i := 0; i < 100; i++ {
+		for _, v := range f.Votes() {
+			if v.NextRoot() != indexToHash(1) {
+				t.Fatalf("Expected votes for block 1, received %#x", v.NextRoot())
+			}
+		}
+		for _, n := range f.Nodes() {
+			n.Weight = 0
+		}
+	}
+	<-done
+
+	// The nodes returned are copies, the weights of the store are left untouched.
+	if n := f.Node(indexToHash(1)); n.Weight == 0 {
+		t.Error("Expected the weight of block 1 to be kept")
+	}
+}
@@ -0,0 +1,244 @@
+package lightclient
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateutil"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+)
+
+var _ = shared.DependentService(&Service{})
+
+var log = logrus.WithField("prefix", "lightclient")
+
+// UpdateFetcher defines a common interface for methods in the light client service which
+// return light client updates.
+type UpdateFetcher interface {
+	LatestUpdate() *pb.LightClientUpdate
+	UpdateSince(ctx context.Context, finalizedSlot uint64) (*pb.LightClientUpdate, error)
+}
+
+// Service maintains the latest light client update of the node, which proves the finalized
+// header of the chain against the header of the head block.
+type Service struct {
+	ctx                  context.Context
+	cancel               context.CancelFunc
+	beaconDB             db.ReadOnlyDatabase
+	headFetcher          blockchain.HeadFetcher
+	stateNotifier        statefeed.Notifier
+	latestUpdate         *pb.LightClientUpdate
+	latestState          *state.BeaconState
+	latestFinalizedEpoch uint64
+	syncCommittee        *SyncCommittee
+	signingKey           *bls.SecretKey
+	lock                 sync.RWMutex
+}
+
+// Config options for the light client service.
+type Config struct {
+	BeaconDB      db.ReadOnlyDatabase
+	HeadFetcher   blockchain.HeadFetcher
+	StateNotifier statefeed.Notifier
+	// SyncCommittee and SigningKey sign the attested headers of the updates with the key of the
+	// member of the sync committee run by the node. Updates are not signed if they are nil.
+	SyncCommittee *SyncCommittee
+	SigningKey    *bls.SecretKey
+}
+
+// NewLightClientService initializes the service from configuration options.
+func NewLightClientService(ctx context.Context, cfg *Config) *Service {
+	ctx, cancel := context.WithCancel(ctx)
+	return &Service{
+		ctx:           ctx,
+		cancel:        cancel,
+		beaconDB:      cfg.BeaconDB,
+		headFetcher:   cfg.HeadFetcher,
+		stateNotifier: cfg.StateNotifier,
+		syncCommittee: cfg.SyncCommittee,
+		signingKey:    cfg.SigningKey,
+	}
+}
+
+// Start the light client service event loop.
+func (s *Service) Start() {
+	go s.run(s.ctx)
+}
+
+// Stop the light client service event loop.
+func (s *Service) Stop() error {
+	defer s.cancel()
+	return nil
+}
+
+// Status reports the healthy status of the light client service. Returning nil means service
+// is correctly running without error.
+func (s *Service) Status() error {
+	return nil
+}
+
+// Dependencies of the light client service, which builds updates from the head of the
+// blockchain service.
+func (s *Service) Dependencies() []reflect.Type {
+	return shared.DependencyTypes(s.headFetcher)
+}
+
+// LatestUpdate returns a copy of the latest light client update, or nil if the chain
+// has not finalized any epoch yet.
+func (s *Service) LatestUpdate() *pb.LightClientUpdate {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	if s.latestUpdate == nil {
+		return nil
+	}
+	return proto.Clone(s.latestUpdate).(*pb.LightClientUpdate)
+}
+
+// UpdateSince returns a copy of the latest light client update if its finalized header is more
+// recent than the given finalized slot, or nil otherwise. The update includes the ancestry branch
+// of the block root of the finalized slot, so that light clients can verify that the finalized
+// header descends from the header they finalized.
+func (s *Service) UpdateSince(ctx context.Context, finalizedSlot uint64) (*pb.LightClientUpdate, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	if s.latestUpdate == nil || s.latestUpdate.FinalizedHeader.Slot <= finalizedSlot {
+		return nil, nil
+	}
+	update := proto.Clone(s.latestUpdate).(*pb.LightClientUpdate)
+	// Light clients which fell behind by more than the block roots range of the state can not be
+	// served an ancestry branch, they have to restart from a more recent trusted header.
+	if update.AttestedHeader.Slot-finalizedSlot > params.BeaconConfig().SlotsPerHistoricalRoot {
+		return update, nil
+	}
+	proof, err := s.latestState.ElementProof(ctx, "block_roots", finalizedSlot%params.BeaconConfig().SlotsPerHistoricalRoot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute ancestry proof")
+	}
+	update.AncestryBranch = proof.Branch
+	return update, nil
+}
+
+// This builds a light client update proving the finalized checkpoint of the head state
+// against the head block header. A nil update is returned when the head block and state
+// are momentarily out of sync.
+func (s *Service) buildUpdate(ctx context.Context, headState *state.BeaconState) (*pb.LightClientUpdate, error) {
+	headBlock, err := s.headFetcher.HeadBlock(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve head block")
+	}
+	if headBlock == nil || headBlock.Block == nil {
+		return nil, errors.New("nil head block")
+	}
+	attestedHeader, err := BlockHeader(headBlock.Block)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute head block header")
+	}
+
+	proof, err := headState.FieldProof(ctx, "finalized_checkpoint")
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute finalized checkpoint proof")
+	}
+	if !bytes.Equal(proof.StateRoot[:], attestedHeader.StateRoot) {
+		return nil, nil
+	}
+
+	cp := headState.FinalizedCheckpoint()
+	finalizedBlock, err := s.beaconDB.Block(ctx, bytesutil.ToBytes32(cp.Root))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve finalized block")
+	}
+	if finalizedBlock == nil || finalizedBlock.Block == nil {
+		return nil, fmt.Errorf("could not find finalized block %#x", cp.Root)
+	}
+	finalizedHeader, err := BlockHeader(finalizedBlock.Block)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute finalized block header")
+	}
+
+	// The finalized root is the right child of the finalized checkpoint, its sibling
+	// being the root of the checkpoint epoch.
+	epochRoot := stateutil.Uint64Root(cp.Epoch)
+	branch := append([][]byte{epochRoot[:]}, proof.Branch...)
+	update := &pb.LightClientUpdate{
+		AttestedHeader:  attestedHeader,
+		FinalizedHeader: finalizedHeader,
+		FinalityBranch:  branch,
+	}
+	if s.syncCommittee != nil && s.signingKey != nil {
+		if err := s.syncCommittee.SignUpdate(update, s.signingKey); err != nil {
+			return nil, errors.Wrap(err, "could not sign update")
+		}
+	}
+	return update, nil
+}
+
+// This updates the latest light client update if the head state finalized a new epoch.
+func (s *Service) onBlockProcessed(ctx context.Context) error {
+	headState, err := s.headFetcher.HeadState(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve head state")
+	}
+	if headState == nil {
+		return errors.New("nil head state")
+	}
+	epoch := headState.FinalizedCheckpointEpoch()
+	if epoch == 0 || epoch <= s.latestFinalizedEpoch {
+		return nil
+	}
+	update, err := s.buildUpdate(ctx, headState)
+	if err != nil {
+		return err
+	}
+	if update == nil {
+		return nil
+	}
+
+	s.lock.Lock()
+	s.latestUpdate = update
+	// The state is kept to prove the ancestry of the finalized headers of the light clients.
+	s.latestState = headState.Copy()
+	s.latestFinalizedEpoch = epoch
+	s.lock.Unlock()
+	log.WithFields(logrus.Fields{
+		"finalizedEpoch": epoch,
+		"finalizedSlot":  update.FinalizedHeader.Slot,
+	}).Debug("Updated light client update")
+	return nil
+}
+
+func (s *Service) run(ctx context.Context) {
+	stateChannel := make(chan *feed.Event, 1)
+	stateSub := s.stateNotifier.StateFeed().Subscribe(stateChannel)
+	defer stateSub.Unsubscribe()
+	for {
+		select {
+		case event := <-stateChannel:
+			if event.Type == statefeed.BlockProcessed {
+				if err := s.onBlockProcessed(ctx); err != nil {
+					log.WithError(err).Error("Could not update light client update")
+				}
+			}
+		case <-s.ctx.Done():
+			log.Debug("Context closed, exiting goroutine")
+			return
+		case err := <-stateSub.Err():
+			log.WithError(err).Error("Subscription to state feed notifier failed")
+			return
+		}
+	}
+}
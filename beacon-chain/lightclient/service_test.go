@@ -0,0 +1,190 @@
+package lightclient
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	dbutil "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateutil"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+func testBlock(slot uint64, stateRoot []byte) *ethpb.SignedBeaconBlock {
+	return &ethpb.SignedBeaconBlock{
+		Block: &ethpb.BeaconBlock{
+			Slot:       slot,
+			ParentRoot: make([]byte, 32),
+			StateRoot:  stateRoot,
+			Body: &ethpb.BeaconBlockBody{
+				RandaoReveal: make([]byte, 96),
+				Eth1Data: &ethpb.Eth1Data{
+					DepositRoot: make([]byte, 32),
+					BlockHash:   make([]byte, 32),
+				},
+				Graffiti: make([]byte, 32),
+			},
+		},
+		Signature: make([]byte, 96),
+	}
+}
+
+// This returns a service whose head state finalized a block saved in the DB, along with
+// the root of the finalized block. The service signs its updates with the first key of a
+// sync committee of two members.
+func setupService(t *testing.T, beaconDB db.Database) (*Service, *stateTrie.BeaconState, [32]byte) {
+	ctx := context.Background()
+	finalizedBlock := testBlock(params.BeaconConfig().SlotsPerEpoch, make([]byte, 32))
+	if err := beaconDB.SaveBlock(ctx, finalizedBlock); err != nil {
+		t.Fatal(err)
+	}
+	finalizedRoot, err := ssz.HashTreeRoot(finalizedBlock.Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	headState, _ := testutil.DeterministicGenesisState(t, 16)
+	if err := headState.SetSlot(3 * params.BeaconConfig().SlotsPerEpoch); err != nil {
+		t.Fatal(err)
+	}
+	if err := headState.SetFinalizedCheckpoint(&ethpb.Checkpoint{Epoch: 1, Root: finalizedRoot[:]}); err != nil {
+		t.Fatal(err)
+	}
+	stateRoot, err := headState.HashTreeRoot(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keys := []*bls.SecretKey{bls.RandKey(), bls.RandKey()}
+	committee := &SyncCommittee{PublicKeys: []*bls.PublicKey{keys[0].PublicKey(), keys[1].PublicKey()}}
+	svc := NewLightClientService(ctx, &Config{
+		BeaconDB: beaconDB,
+		HeadFetcher: &mock.ChainService{
+			State: headState,
+			Block: testBlock(headState.Slot(), stateRoot[:]),
+		},
+		SyncCommittee: committee,
+		SigningKey:    keys[0],
+	})
+	return svc, headState, finalizedRoot
+}
+
+func TestService_OnBlockProcessed(t *testing.T) {
+	beaconDB := dbutil.SetupDB(t)
+	defer dbutil.TeardownDB(t, beaconDB)
+	svc, _, finalizedRoot := setupService(t, beaconDB)
+
+	if svc.LatestUpdate() != nil {
+		t.Fatal("Expected no update before processing a block")
+	}
+	if err := svc.onBlockProcessed(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	update := svc.LatestUpdate()
+	if update == nil {
+		t.Fatal("Expected an update")
+	}
+	if err := VerifyUpdate(update, svc.syncCommittee, 1); err != nil {
+		t.Fatalf("Could not verify update: %v", err)
+	}
+	headerRoot, err := stateutil.BlockHeaderRoot(update.FinalizedHeader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if headerRoot != finalizedRoot {
+		t.Errorf("Wanted finalized header root %#x, received %#x", finalizedRoot, headerRoot)
+	}
+	if svc.latestFinalizedEpoch != 1 {
+		t.Errorf("Wanted latest finalized epoch 1, received %d", svc.latestFinalizedEpoch)
+	}
+}
+
+func TestService_OnBlockProcessed_HeadMismatch(t *testing.T) {
+	beaconDB := dbutil.SetupDB(t)
+	defer dbutil.TeardownDB(t, beaconDB)
+	svc, headState, _ := setupService(t, beaconDB)
+
+	// The head block does not commit to the head state.
+	svc.headFetcher = &mock.ChainService{
+		State: headState,
+		Block: testBlock(headState.Slot(), make([]byte, 32)),
+	}
+	if err := svc.onBlockProcessed(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if svc.LatestUpdate() != nil {
+		t.Error("Expected no update when the head block does not commit to the head state")
+	}
+}
+
+func TestService_OnBlockProcessed_NoFinality(t *testing.T) {
+	beaconDB := dbutil.SetupDB(t)
+	defer dbutil.TeardownDB(t, beaconDB)
+	svc, headState, _ := setupService(t, beaconDB)
+
+	if err := headState.SetFinalizedCheckpoint(&ethpb.Checkpoint{Root: make([]byte, 32)}); err != nil {
+		t.Fatal(err)
+	}
+	if err := svc.onBlockProcessed(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if svc.LatestUpdate() != nil {
+		t.Error("Expected no update before the first finalized epoch")
+	}
+}
+
+func TestService_UpdateSince(t *testing.T) {
+	beaconDB := dbutil.SetupDB(t)
+	defer dbutil.TeardownDB(t, beaconDB)
+	ctx := context.Background()
+	svc, headState, _ := setupService(t, beaconDB)
+
+	// The head state is a descendant of the block of slot 16.
+	ancestorSlot := uint64(16)
+	ancestorRoot := [32]byte{'a'}
+	if err := headState.UpdateBlockRootAtIndex(ancestorSlot, ancestorRoot); err != nil {
+		t.Fatal(err)
+	}
+	stateRoot, err := headState.HashTreeRoot(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	svc.headFetcher = &mock.ChainService{
+		State: headState,
+		Block: testBlock(headState.Slot(), stateRoot[:]),
+	}
+	if err := svc.onBlockProcessed(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	update, err := svc.UpdateSince(ctx, ancestorSlot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if update == nil {
+		t.Fatal("Expected an update")
+	}
+	if err := VerifyUpdate(update, svc.syncCommittee, 1); err != nil {
+		t.Fatalf("Could not verify update: %v", err)
+	}
+	if err := VerifyAncestry(update, ancestorSlot, ancestorRoot); err != nil {
+		t.Errorf("Could not verify ancestry: %v", err)
+	}
+	if err := VerifyAncestry(update, ancestorSlot, [32]byte{'b'}); err == nil {
+		t.Error("Expected ancestry of another root to be rejected")
+	}
+
+	update, err = svc.UpdateSince(ctx, update.FinalizedHeader.Slot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if update != nil {
+		t.Error("Expected no update for a known finalized slot")
+	}
+}
@@ -0,0 +1,154 @@
+package lightclient
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateutil"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// SyncCommittee is the committee of keys whose members sign the attested headers of light client
+// updates. The beacon state of phase 0 holds no sync committee, so the committee is configured on
+// both ends: the beacon nodes serving updates sign them with the key of their member of the
+// committee, and light clients only accept updates signed by enough members.
+type SyncCommittee struct {
+	PublicKeys []*bls.PublicKey
+}
+
+// LoadSyncCommittee reads a sync committee from a file holding the hex encoded public key of one
+// member per line.
+func LoadSyncCommittee(path string) (*SyncCommittee, error) {
+	// #nosec G304
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read sync committee file")
+	}
+	c := &SyncCommittee{}
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimPrefix(strings.TrimSpace(line), "0x")
+		if line == "" {
+			continue
+		}
+		b, err := hex.DecodeString(line)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not decode public key %q", line)
+		}
+		pub, err := bls.PublicKeyFromBytes(b)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not parse public key %q", line)
+		}
+		c.PublicKeys = append(c.PublicKeys, pub)
+	}
+	if len(c.PublicKeys) == 0 {
+		return nil, errors.New("empty sync committee")
+	}
+	return c, nil
+}
+
+// LoadSigningKey reads the hex encoded secret key of a member of the sync committee from a file.
+func LoadSigningKey(path string) (*bls.SecretKey, error) {
+	// #nosec G304
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read signing key file")
+	}
+	b, err := hex.DecodeString(strings.TrimPrefix(strings.TrimSpace(string(data)), "0x"))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode signing key")
+	}
+	return bls.SecretKeyFromBytes(b)
+}
+
+// index returns the position of the public key in the committee.
+func (c *SyncCommittee) index(pub *bls.PublicKey) (int, bool) {
+	b := pub.Marshal()
+	for i, member := range c.PublicKeys {
+		if string(member.Marshal()) == string(b) {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// SignUpdate adds the signature of the member of the committee holding the key to the signature
+// of the attested header of the update.
+func (c *SyncCommittee) SignUpdate(update *pb.LightClientUpdate, key *bls.SecretKey) error {
+	if update == nil || update.AttestedHeader == nil {
+		return errors.New("nil update")
+	}
+	i, ok := c.index(key.PublicKey())
+	if !ok {
+		return errors.New("signing key is not a member of the sync committee")
+	}
+	root, err := stateutil.BlockHeaderRoot(update.AttestedHeader)
+	if err != nil {
+		return errors.Wrap(err, "could not compute attested header root")
+	}
+	sig := key.Sign(root[:], syncCommitteeDomain())
+	if len(update.SyncCommitteeBits) == 0 || update.SyncCommitteeBits.Len() != uint64(len(c.PublicKeys)) {
+		update.SyncCommitteeBits = bitfield.NewBitlist(uint64(len(c.PublicKeys)))
+		update.SyncCommitteeSignature = nil
+	}
+	if update.SyncCommitteeBits.BitAt(uint64(i)) {
+		return nil
+	}
+	if len(update.SyncCommitteeSignature) > 0 {
+		aggregate, err := bls.SignatureFromBytes(update.SyncCommitteeSignature)
+		if err != nil {
+			return errors.Wrap(err, "could not parse sync committee signature")
+		}
+		sig = bls.AggregateSignatures([]*bls.Signature{aggregate, sig})
+	}
+	update.SyncCommitteeBits.SetBitAt(uint64(i), true)
+	update.SyncCommitteeSignature = sig.Marshal()
+	return nil
+}
+
+// verifySignature verifies that at least minParticipants members of the committee signed the
+// attested header of the update.
+func (c *SyncCommittee) verifySignature(update *pb.LightClientUpdate, minParticipants uint64) error {
+	if len(update.SyncCommitteeBits) == 0 || update.SyncCommitteeBits.Len() != uint64(len(c.PublicKeys)) {
+		return fmt.Errorf("wrong sync committee bits length, expected %d", len(c.PublicKeys))
+	}
+	var pubKeys []*bls.PublicKey
+	for i, pub := range c.PublicKeys {
+		if update.SyncCommitteeBits.BitAt(uint64(i)) {
+			pubKeys = append(pubKeys, pub)
+		}
+	}
+	if minParticipants == 0 {
+		minParticipants = 1
+	}
+	if uint64(len(pubKeys)) < minParticipants {
+		return fmt.Errorf(
+			"not enough sync committee participants, expected at least %d, received %d",
+			minParticipants,
+			len(pubKeys),
+		)
+	}
+	sig, err := bls.SignatureFromBytes(update.SyncCommitteeSignature)
+	if err != nil {
+		return errors.Wrap(err, "could not parse sync committee signature")
+	}
+	root, err := stateutil.BlockHeaderRoot(update.AttestedHeader)
+	if err != nil {
+		return errors.Wrap(err, "could not compute attested header root")
+	}
+	if !sig.VerifyAggregateCommon(pubKeys, root, syncCommitteeDomain()) {
+		return errors.New("invalid sync committee signature")
+	}
+	return nil
+}
+
+// syncCommitteeDomain is the signature domain of the attested headers. Light clients do not
+// follow the forks of the chain, so the domain is computed with the genesis fork version.
+func syncCommitteeDomain() uint64 {
+	return bls.ComputeDomain(params.BeaconConfig().DomainSyncCommittee)
+}
@@ -0,0 +1,120 @@
+package lightclient
+
+import (
+	"fmt"
+	"math/bits"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateutil"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/trieutil"
+)
+
+const (
+	// FinalizedRootGeneralizedIndex is the generalized index of the root of the finalized
+	// checkpoint in the beacon state tree. The finalized checkpoint is the field at index 19
+	// of the state tree of depth 5, and its root is the right child of the checkpoint.
+	FinalizedRootGeneralizedIndex = 103
+	// FinalityBranchDepth is the length of the Merkle branch of the finalized checkpoint root.
+	FinalityBranchDepth = 6
+	// blockRootsGeneralizedIndex is the generalized index of the root of the block roots in the
+	// beacon state tree, the block roots being the field at index 4 of the state tree of depth 5.
+	blockRootsGeneralizedIndex = 36
+)
+
+// BlockRootGeneralizedIndex returns the generalized index in the beacon state tree of the block
+// root of the given slot, which is kept in the block roots vector of the state for
+// SLOTS_PER_HISTORICAL_ROOT slots.
+func BlockRootGeneralizedIndex(slot uint64) uint64 {
+	length := params.BeaconConfig().SlotsPerHistoricalRoot
+	depth := uint(bits.Len64(length - 1))
+	return blockRootsGeneralizedIndex<<depth | slot%length
+}
+
+// BlockHeader returns the header of a beacon block, which shares the hash tree root of the block.
+func BlockHeader(blk *ethpb.BeaconBlock) (*ethpb.BeaconBlockHeader, error) {
+	if blk == nil || blk.Body == nil {
+		return nil, errors.New("nil block")
+	}
+	bodyRoot, err := stateutil.BlockBodyRoot(blk.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute block body root")
+	}
+	return &ethpb.BeaconBlockHeader{
+		Slot:       blk.Slot,
+		ParentRoot: blk.ParentRoot,
+		StateRoot:  blk.StateRoot,
+		BodyRoot:   bodyRoot[:],
+	}, nil
+}
+
+// VerifyUpdate verifies that the finalized header of a light client update is the finalized
+// checkpoint of the state committed to by the attested header, and that at least minParticipants
+// members of the sync committee signed the attested header.
+func VerifyUpdate(update *pb.LightClientUpdate, committee *SyncCommittee, minParticipants uint64) error {
+	if update == nil || update.AttestedHeader == nil || update.FinalizedHeader == nil {
+		return errors.New("nil update")
+	}
+	if committee == nil || len(committee.PublicKeys) == 0 {
+		return errors.New("no sync committee to verify the update with")
+	}
+	if len(update.FinalityBranch) != FinalityBranchDepth {
+		return fmt.Errorf(
+			"wrong finality branch length, expected %d, received %d",
+			FinalityBranchDepth,
+			len(update.FinalityBranch),
+		)
+	}
+	if update.FinalizedHeader.Slot > update.AttestedHeader.Slot {
+		return fmt.Errorf(
+			"finalized header slot %d is greater than attested header slot %d",
+			update.FinalizedHeader.Slot,
+			update.AttestedHeader.Slot,
+		)
+	}
+	finalizedRoot, err := stateutil.BlockHeaderRoot(update.FinalizedHeader)
+	if err != nil {
+		return errors.Wrap(err, "could not compute finalized header root")
+	}
+	if !trieutil.VerifyMerkleProof(
+		update.AttestedHeader.StateRoot,
+		finalizedRoot[:],
+		update.FinalityBranch,
+		FinalizedRootGeneralizedIndex,
+	) {
+		return errors.New("invalid finality branch")
+	}
+	return committee.verifySignature(update, minParticipants)
+}
+
+// VerifyAncestry verifies that the block of the given root and slot is an ancestor of the finalized
+// header of a verified light client update. The ancestry branch of the update proves the root to be
+// the block root of the slot in the state committed to by the attested header, of which the
+// finalized header is also an ancestor.
+func VerifyAncestry(update *pb.LightClientUpdate, slot uint64, root [32]byte) error {
+	if update.FinalizedHeader.Slot <= slot {
+		return fmt.Errorf(
+			"finalized header slot %d is not greater than ancestor slot %d",
+			update.FinalizedHeader.Slot,
+			slot,
+		)
+	}
+	if update.AttestedHeader.Slot-slot > params.BeaconConfig().SlotsPerHistoricalRoot {
+		return fmt.Errorf(
+			"ancestor slot %d is out of the block roots range of attested header slot %d",
+			slot,
+			update.AttestedHeader.Slot,
+		)
+	}
+	if !trieutil.VerifyMerkleProof(
+		update.AttestedHeader.StateRoot,
+		root[:],
+		update.AncestryBranch,
+		BlockRootGeneralizedIndex(slot),
+	) {
+		return errors.New("invalid ancestry branch")
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+package lightclient
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/prysmaticlabs/go-bitfield"
+	dbutil "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+func TestVerifyUpdate(t *testing.T) {
+	beaconDB := dbutil.SetupDB(t)
+	defer dbutil.TeardownDB(t, beaconDB)
+	svc, _, _ := setupService(t, beaconDB)
+	if err := svc.onBlockProcessed(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		mutate  func(u *pb.LightClientUpdate)
+		wantErr string
+	}{
+		{
+			name:   "valid update",
+			mutate: func(u *pb.LightClientUpdate) {},
+		},
+		{
+			name:    "nil finalized header",
+			mutate:  func(u *pb.LightClientUpdate) { u.FinalizedHeader = nil },
+			wantErr: "nil update",
+		},
+		{
+			name:    "short branch",
+			mutate:  func(u *pb.LightClientUpdate) { u.FinalityBranch = u.FinalityBranch[1:] },
+			wantErr: "wrong finality branch length",
+		},
+		{
+			name:    "finalized header after attested header",
+			mutate:  func(u *pb.LightClientUpdate) { u.FinalizedHeader.Slot = u.AttestedHeader.Slot + 1 },
+			wantErr: "is greater than attested header slot",
+		},
+		{
+			name:    "wrong finalized header",
+			mutate:  func(u *pb.LightClientUpdate) { u.FinalizedHeader.Slot++ },
+			wantErr: "invalid finality branch",
+		},
+		{
+			name:    "wrong attested state root",
+			mutate:  func(u *pb.LightClientUpdate) { u.AttestedHeader.StateRoot = make([]byte, 32) },
+			wantErr: "invalid finality branch",
+		},
+		{
+			name:    "tampered branch",
+			mutate:  func(u *pb.LightClientUpdate) { u.FinalityBranch[0] = make([]byte, 32) },
+			wantErr: "invalid finality branch",
+		},
+		{
+			name: "tampered attested header",
+			mutate: func(u *pb.LightClientUpdate) {
+				u.AttestedHeader.BodyRoot = make([]byte, 32)
+				u.AttestedHeader.BodyRoot[0] = 'a'
+			},
+			wantErr: "invalid sync committee signature",
+		},
+		{
+			name: "signature of another member",
+			mutate: func(u *pb.LightClientUpdate) {
+				u.SyncCommitteeBits.SetBitAt(0, false)
+				u.SyncCommitteeBits.SetBitAt(1, true)
+			},
+			wantErr: "invalid sync committee signature",
+		},
+		{
+			name:    "unsigned update",
+			mutate:  func(u *pb.LightClientUpdate) { u.SyncCommitteeBits.SetBitAt(0, false) },
+			wantErr: "not enough sync committee participants",
+		},
+		{
+			name:    "wrong sync committee bits length",
+			mutate:  func(u *pb.LightClientUpdate) { u.SyncCommitteeBits = bitfield.NewBitlist(3) },
+			wantErr: "wrong sync committee bits length",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			update := svc.LatestUpdate()
+			tt.mutate(update)
+			err := VerifyUpdate(update, svc.syncCommittee, 1)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Expected error containing %q, received %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestVerifyUpdate_MinParticipants(t *testing.T) {
+	beaconDB := dbutil.SetupDB(t)
+	defer dbutil.TeardownDB(t, beaconDB)
+	svc, _, _ := setupService(t, beaconDB)
+	if err := svc.onBlockProcessed(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	update := svc.LatestUpdate()
+	if err := VerifyUpdate(update, svc.syncCommittee, 2); err == nil {
+		t.Fatal("Expected update signed by one member to be rejected")
+	}
+	if err := VerifyUpdate(update, nil, 1); err == nil {
+		t.Fatal("Expected update without sync committee to be rejected")
+	}
+}
@@ -28,6 +28,9 @@ var appFlags = []cli.Flag{
 	flags.DepositContractFlag,
 	flags.Web3ProviderFlag,
 	flags.HTTPWeb3ProviderFlag,
+	flags.FallbackWeb3ProviderFlag,
+	flags.FallbackHTTPWeb3ProviderFlag,
+	flags.Eth1ChainIDFlag,
 	flags.RPCHost,
 	flags.RPCPort,
 	flags.CertFlag,
@@ -48,6 +51,16 @@ var appFlags = []cli.Flag{
 	flags.ArchiveBlocksFlag,
 	flags.ArchiveAttestationsFlag,
 	flags.SlotsPerArchivedPoint,
+	flags.HistoricalStateQueryEpochs,
+	flags.BlockBodyProviderFlag,
+	flags.BlockBodyProviderTimeoutFlag,
+	flags.EnableLightClientServer,
+	flags.LightClientSyncCommitteeFlag,
+	flags.LightClientSigningKeyFlag,
+	flags.BlockBatchLimit,
+	flags.BlockBatchLimitBurstFactor,
+	flags.RPCRequestLimit,
+	flags.RPCGlobalLimitFactor,
 	cmd.BootstrapNode,
 	cmd.NoDiscovery,
 	cmd.StaticPeers,
@@ -82,6 +95,8 @@ var appFlags = []cli.Flag{
 	cmd.LogFileName,
 	cmd.EnableUPnPFlag,
 	cmd.ConfigFileFlag,
+	cmd.ChainConfigFileFlag,
+	cmd.SlotTimingFractionsFlag,
 }
 
 func init() {
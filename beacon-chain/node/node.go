@@ -26,6 +26,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
 	"github.com/prysmaticlabs/prysm/beacon-chain/gateway"
 	interopcoldstart "github.com/prysmaticlabs/prysm/beacon-chain/interop-cold-start"
+	"github.com/prysmaticlabs/prysm/beacon-chain/lightclient"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
@@ -44,6 +45,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/prometheus"
 	"github.com/prysmaticlabs/prysm/shared/sliceutil"
+	"github.com/prysmaticlabs/prysm/shared/slottiming"
 	"github.com/prysmaticlabs/prysm/shared/tracing"
 	"github.com/prysmaticlabs/prysm/shared/version"
 	"github.com/sirupsen/logrus"
@@ -91,6 +93,14 @@ func NewBeaconNode(ctx *cli.Context) (*BeaconNode, error) {
 
 	featureconfig.ConfigureBeaconChain(ctx)
 	flags.ConfigureGlobalFlags(ctx)
+	if err := cmd.LoadChainConfigFile(ctx); err != nil {
+		return nil, err
+	}
+	slotTimingFractions, err := slottiming.ParseFractions(ctx.String(cmd.SlotTimingFractionsFlag.Name))
+	if err != nil {
+		return nil, err
+	}
+	slottiming.SetFractions(slotTimingFractions)
 	registry := shared.NewServiceRegistry()
 
 	beacon := &BeaconNode{
@@ -138,6 +148,10 @@ func NewBeaconNode(ctx *cli.Context) (*BeaconNode, error) {
 		return nil, err
 	}
 
+	if err := beacon.registerLightClientService(ctx); err != nil {
+		return nil, err
+	}
+
 	if err := beacon.registerSyncService(ctx); err != nil {
 		return nil, err
 	}
@@ -186,7 +200,9 @@ func (b *BeaconNode) Start() {
 		"version": version.GetVersion(),
 	}).Info("Starting beacon node")
 
-	b.services.StartAll()
+	if err := b.services.StartAll(); err != nil {
+		log.WithError(err).Fatal("Could not start services")
+	}
 
 	stop := b.stop
 	b.lock.Unlock()
@@ -305,6 +321,7 @@ func (b *BeaconNode) registerP2P(ctx *cli.Context) error {
 		EnableUPnP:        ctx.Bool(cmd.EnableUPnPFlag.Name),
 		EnableDiscv5:      ctx.Bool(flags.EnableDiscv5.Name),
 		Encoding:          ctx.String(cmd.P2PEncoding.Name),
+		StateNotifier:     b,
 	})
 	if err != nil {
 		return err
@@ -375,14 +392,38 @@ func (b *BeaconNode) registerPOWChainService(cliCtx *cli.Context) error {
 		log.Fatalf("Invalid deposit contract address given: %s", depAddress)
 	}
 
+	fallbackEndpoints := cliCtx.StringSlice(flags.FallbackWeb3ProviderFlag.Name)
+	fallbackHTTPEndpoints := cliCtx.StringSlice(flags.FallbackHTTPWeb3ProviderFlag.Name)
+	if len(fallbackEndpoints) != len(fallbackHTTPEndpoints) {
+		return fmt.Errorf(
+			"%d %s given for %d %s, each must be paired",
+			len(fallbackEndpoints),
+			flags.FallbackWeb3ProviderFlag.Name,
+			len(fallbackHTTPEndpoints),
+			flags.FallbackHTTPWeb3ProviderFlag.Name,
+		)
+	}
+	if len(fallbackEndpoints) > 0 && cliCtx.Uint64(flags.Eth1ChainIDFlag.Name) == 0 {
+		return fmt.Errorf("%s is required with %s", flags.Eth1ChainIDFlag.Name, flags.FallbackWeb3ProviderFlag.Name)
+	}
+	fallbacks := make([]powchain.Endpoint, 0, len(fallbackEndpoints))
+	for i := range fallbackEndpoints {
+		fallbacks = append(fallbacks, powchain.Endpoint{
+			Endpoint:     fallbackEndpoints[i],
+			HTTPEndpoint: fallbackHTTPEndpoints[i],
+		})
+	}
+
 	ctx := context.Background()
 	cfg := &powchain.Web3ServiceConfig{
-		ETH1Endpoint:    cliCtx.String(flags.Web3ProviderFlag.Name),
-		HTTPEndPoint:    cliCtx.String(flags.HTTPWeb3ProviderFlag.Name),
-		DepositContract: common.HexToAddress(depAddress),
-		BeaconDB:        b.db,
-		DepositCache:    b.depositCache,
-		StateNotifier:   b,
+		ETH1Endpoint:      cliCtx.String(flags.Web3ProviderFlag.Name),
+		HTTPEndPoint:      cliCtx.String(flags.HTTPWeb3ProviderFlag.Name),
+		FallbackEndpoints: fallbacks,
+		Eth1ChainID:       cliCtx.Uint64(flags.Eth1ChainIDFlag.Name),
+		DepositContract:   common.HexToAddress(depAddress),
+		BeaconDB:          b.db,
+		DepositCache:      b.depositCache,
+		StateNotifier:     b,
 	}
 	web3Service, err := powchain.NewService(ctx, cfg)
 	if err != nil {
@@ -429,6 +470,11 @@ func (b *BeaconNode) registerSyncService(ctx *cli.Context) error {
 		initSync = initSyncTmp
 	}
 
+	lightClientUpdates, err := b.fetchLightClientUpdates()
+	if err != nil {
+		return err
+	}
+
 	rs := prysmsync.NewRegularSync(&prysmsync.Config{
 		DB:                  b.db,
 		P2P:                 b.fetchP2P(ctx),
@@ -441,6 +487,7 @@ func (b *BeaconNode) registerSyncService(ctx *cli.Context) error {
 		ExitPool:            b.exitPool,
 		SlashingPool:        b.slashingsPool,
 		StateSummaryCache:   b.stateSummaryCache,
+		LightClientUpdates:  lightClientUpdates,
 	})
 
 	return b.services.RegisterService(rs)
@@ -499,6 +546,11 @@ func (b *BeaconNode) registerRPCService(ctx *cli.Context) error {
 		syncService = initSyncTmp
 	}
 
+	lightClientUpdates, err := b.fetchLightClientUpdates()
+	if err != nil {
+		return err
+	}
+
 	genesisValidators := ctx.Uint64(flags.InteropNumValidatorsFlag.Name)
 	genesisStatePath := ctx.String(flags.InteropGenesisStateFlag.Name)
 	var depositFetcher depositcache.DepositFetcher
@@ -521,6 +573,8 @@ func (b *BeaconNode) registerRPCService(ctx *cli.Context) error {
 	key := ctx.String(flags.KeyFlag.Name)
 	slasherCert := ctx.String(flags.SlasherCertFlag.Name)
 	slasherProvider := ctx.String(flags.SlasherProviderFlag.Name)
+	blockBodyProvider := ctx.String(flags.BlockBodyProviderFlag.Name)
+	blockBodyProviderTimeout := ctx.Duration(flags.BlockBodyProviderTimeoutFlag.Name)
 
 	mockEth1DataVotes := ctx.Bool(flags.InteropMockEth1DataVotesFlag.Name)
 	rpcService := rpc.NewService(context.Background(), &rpc.Config{
@@ -533,6 +587,7 @@ func (b *BeaconNode) registerRPCService(ctx *cli.Context) error {
 		PeersFetcher:          b.fetchP2P(ctx),
 		HeadFetcher:           chainService,
 		ForkFetcher:           chainService,
+		ForkChoiceFetcher:     chainService,
 		FinalizationFetcher:   chainService,
 		ParticipationFetcher:  chainService,
 		BlockReceiver:         chainService,
@@ -552,7 +607,10 @@ func (b *BeaconNode) registerRPCService(ctx *cli.Context) error {
 		OperationNotifier:     b,
 		SlasherCert:           slasherCert,
 		SlasherProvider:       slasherProvider,
+		BodyProviderEndpoint:  blockBodyProvider,
+		BodyProviderTimeout:   blockBodyProviderTimeout,
 		StateGen:              b.stateGen,
+		LightClientUpdates:    lightClientUpdates,
 	})
 
 	return b.services.RegisterService(rpcService)
@@ -633,3 +691,51 @@ func (b *BeaconNode) registerArchiverService(ctx *cli.Context) error {
 	})
 	return b.services.RegisterService(svc)
 }
+
+func (b *BeaconNode) registerLightClientService(ctx *cli.Context) error {
+	if !flags.Get().EnableLightClientServer {
+		return nil
+	}
+	var chainService *blockchain.Service
+	if err := b.services.FetchService(&chainService); err != nil {
+		return err
+	}
+	committeeFile := ctx.String(flags.LightClientSyncCommitteeFlag.Name)
+	keyFile := ctx.String(flags.LightClientSigningKeyFlag.Name)
+	if committeeFile == "" || keyFile == "" {
+		return fmt.Errorf(
+			"--%s and --%s are required to serve light client updates",
+			flags.LightClientSyncCommitteeFlag.Name,
+			flags.LightClientSigningKeyFlag.Name,
+		)
+	}
+	committee, err := lightclient.LoadSyncCommittee(committeeFile)
+	if err != nil {
+		return err
+	}
+	key, err := lightclient.LoadSigningKey(keyFile)
+	if err != nil {
+		return err
+	}
+	svc := lightclient.NewLightClientService(context.Background(), &lightclient.Config{
+		BeaconDB:      b.db,
+		HeadFetcher:   chainService,
+		StateNotifier: b,
+		SyncCommittee: committee,
+		SigningKey:    key,
+	})
+	return b.services.RegisterService(svc)
+}
+
+// fetchLightClientUpdates returns the light client service if the light client server
+// is enabled, nil otherwise.
+func (b *BeaconNode) fetchLightClientUpdates() (lightclient.UpdateFetcher, error) {
+	if !flags.Get().EnableLightClientServer {
+		return nil, nil
+	}
+	var lightClientService *lightclient.Service
+	if err := b.services.FetchService(&lightClientService); err != nil {
+		return nil, err
+	}
+	return lightClientService, nil
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/prysmaticlabs/go-ssz"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/slotutil"
 	"go.opencensus.io/trace"
 )
 
@@ -17,13 +18,12 @@ var timeToAggregate = time.Duration(params.BeaconConfig().SecondsPerSlot/2) * ti
 
 // This kicks off a routine to aggregate the unaggregated attestations from pool.
 func (s *Service) aggregateRoutine() {
-	ticker := time.NewTicker(timeToAggregate)
 	ctx := context.TODO()
 	for {
 		select {
 		case <-s.ctx.Done():
 			return
-		case <-ticker.C:
+		case <-slotutil.ClockOrDefault(s.clock).After(timeToAggregate):
 			attsToBeAggregated := append(s.pool.UnaggregatedAttestations(), s.pool.AggregatedAttestations()...)
 			if err := s.aggregateAttestations(ctx, attsToBeAggregated); err != nil {
 				log.WithError(err).Error("Could not aggregate attestation")
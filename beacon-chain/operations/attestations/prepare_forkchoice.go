@@ -13,6 +13,7 @@ import (
 	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/slotutil"
 	"go.opencensus.io/trace"
 )
 
@@ -22,11 +23,10 @@ var prepareForkChoiceAttsPeriod = time.Duration(params.BeaconConfig().SecondsPer
 // This prepares fork choice attestations by running batchForkChoiceAtts
 // every prepareForkChoiceAttsPeriod.
 func (s *Service) prepareForkChoiceAtts() {
-	ticker := time.NewTicker(prepareForkChoiceAttsPeriod)
 	for {
 		ctx := context.Background()
 		select {
-		case <-ticker.C:
+		case <-slotutil.ClockOrDefault(s.clock).After(prepareForkChoiceAttsPeriod):
 			if err := s.batchForkChoiceAtts(ctx); err != nil {
 				log.WithError(err).Error("Could not prepare attestations for fork choice")
 			}
@@ -4,7 +4,7 @@ import (
 	"time"
 
 	"github.com/prysmaticlabs/prysm/shared/params"
-	"github.com/prysmaticlabs/prysm/shared/roughtime"
+	"github.com/prysmaticlabs/prysm/shared/slotutil"
 )
 
 // Prune expired attestations from the pool every slot interval.
@@ -13,10 +13,9 @@ var pruneExpiredAttsPeriod = time.Duration(params.BeaconConfig().SecondsPerSlot)
 // This prunes attestations pool by running pruneExpiredAtts
 // at every pruneExpiredAttsPeriod.
 func (s *Service) pruneAttsPool() {
-	ticker := time.NewTicker(pruneExpiredAttsPeriod)
 	for {
 		select {
-		case <-ticker.C:
+		case <-slotutil.ClockOrDefault(s.clock).After(pruneExpiredAttsPeriod):
 			s.pruneExpiredAtts()
 		case <-s.ctx.Done():
 			log.Debug("Context closed, exiting routine")
@@ -63,7 +62,7 @@ func (s *Service) pruneExpiredAtts() {
 func (s *Service) expired(slot uint64) bool {
 	expirationSlot := slot + params.BeaconConfig().SlotsPerEpoch
 	expirationTime := s.genesisTime + expirationSlot*params.BeaconConfig().SecondsPerSlot
-	currentTime := uint64(roughtime.Now().Unix())
+	currentTime := uint64(slotutil.ClockOrDefault(s.clock).Now().Unix())
 	if currentTime >= expirationTime {
 		return true
 	}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	lru "github.com/hashicorp/golang-lru"
+	"github.com/prysmaticlabs/prysm/shared/slotutil"
 )
 
 var forkChoiceProcessedRootsSize = 1 << 16
@@ -16,11 +17,13 @@ type Service struct {
 	err                      error
 	forkChoiceProcessedRoots *lru.Cache
 	genesisTime              uint64
+	clock                    slotutil.Clock
 }
 
 // Config options for the service.
 type Config struct {
-	Pool Pool
+	Pool  Pool
+	Clock slotutil.Clock
 }
 
 // NewService instantiates a new attestation pool service instance that will
@@ -37,6 +40,7 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 		cancel:                   cancel,
 		pool:                     cfg.Pool,
 		forkChoiceProcessedRoots: cache,
+		clock:                    cfg.Clock,
 	}, nil
 }
 
@@ -2,6 +2,7 @@ package p2p
 
 import (
 	"context"
+	"fmt"
 	"reflect"
 	"sync"
 	"testing"
@@ -28,6 +29,8 @@ func TestService_Broadcast(t *testing.T) {
 		cfg: &Config{
 			Encoding: "ssz",
 		},
+		genesisTime:           time.Now(),
+		genesisValidatorsRoot: make([]byte, 32),
 	}
 
 	msg := &testpb.TestSimpleMessage{
@@ -35,10 +38,14 @@ func TestService_Broadcast(t *testing.T) {
 	}
 
 	// Set a test gossip mapping for testpb.TestSimpleMessage.
-	GossipTypeMapping[reflect.TypeOf(msg)] = "/testing"
+	GossipTypeMapping[reflect.TypeOf(msg)] = "/testing/%x"
+	digest, err := p.forkDigest()
+	if err != nil {
+		t.Fatal(err)
+	}
 
 	// External peer subscribes to the topic.
-	topic := "/testing" + p.Encoding().ProtocolSuffix()
+	topic := fmt.Sprintf("/testing/%x", digest) + p.Encoding().ProtocolSuffix()
 	sub, err := p2.PubSub().Subscribe(topic)
 	if err != nil {
 		t.Fatal(err)
@@ -84,6 +91,13 @@ func TestService_Broadcast_ReturnsErr_TopicNotMapped(t *testing.T) {
 	}
 }
 
+func TestService_Broadcast_ReturnsErr_NoForkDigest(t *testing.T) {
+	p := Service{}
+	if err := p.Broadcast(context.Background(), &eth.SignedVoluntaryExit{}); err == nil {
+		t.Fatal("Expected error broadcasting without genesis data")
+	}
+}
+
 func TestService_Attestation_Subnet(t *testing.T) {
 	if gtm := GossipTypeMapping[reflect.TypeOf(&eth.Attestation{})]; gtm != attestationSubnetTopicFormat {
 		t.Errorf("Constant is out of date. Wanted %s, got %s", attestationSubnetTopicFormat, gtm)
@@ -99,7 +113,7 @@ func TestService_Attestation_Subnet(t *testing.T) {
 					CommitteeIndex: 0,
 				},
 			},
-			topic: "/eth2/committee_index0_beacon_attestation",
+			topic: "/eth2/00000000/committee_index0_beacon_attestation",
 		},
 		{
 			att: &eth.Attestation{
@@ -107,7 +121,7 @@ func TestService_Attestation_Subnet(t *testing.T) {
 					CommitteeIndex: 11,
 				},
 			},
-			topic: "/eth2/committee_index11_beacon_attestation",
+			topic: "/eth2/00000000/committee_index11_beacon_attestation",
 		},
 		{
 			att: &eth.Attestation{
@@ -115,7 +129,7 @@ func TestService_Attestation_Subnet(t *testing.T) {
 					CommitteeIndex: 55,
 				},
 			},
-			topic: "/eth2/committee_index55_beacon_attestation",
+			topic: "/eth2/00000000/committee_index55_beacon_attestation",
 		},
 		{
 			att:   &eth.Attestation{},
@@ -126,7 +140,7 @@ func TestService_Attestation_Subnet(t *testing.T) {
 		},
 	}
 	for _, tt := range tests {
-		if res := attestationToTopic(tt.att); res != tt.topic {
+		if res := attestationToTopic(tt.att, [4]byte{} /* fork digest */); res != tt.topic {
 			t.Errorf("Wrong topic, got %s wanted %s", res, tt.topic)
 		}
 	}
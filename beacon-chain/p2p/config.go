@@ -1,5 +1,9 @@
 package p2p
 
+import (
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+)
+
 // Config for the p2p service. These parameters are set from application level flags
 // to initialize the p2p service.
 type Config struct {
@@ -21,4 +25,5 @@ type Config struct {
 	MaxPeers              uint
 	WhitelistCIDR         string
 	Encoding              string
+	StateNotifier         statefeed.Notifier
 }
@@ -16,6 +16,7 @@ import (
 	"github.com/ethereum/go-ethereum/p2p/enr"
 	"github.com/libp2p/go-libp2p-core/host"
 	"github.com/prysmaticlabs/go-bitfield"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/shared/iputils"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
@@ -51,7 +52,12 @@ func createAddrAndPrivKey(t *testing.T) (net.IP, *ecdsa.PrivateKey) {
 func TestCreateListener(t *testing.T) {
 	port := 1024
 	ipAddr, pkey := createAddrAndPrivKey(t)
-	listener := createListener(ipAddr, pkey, &Config{UDPPort: uint(port)})
+	s := &Service{
+		cfg:                   &Config{UDPPort: uint(port)},
+		genesisTime:           time.Now(),
+		genesisValidatorsRoot: make([]byte, 32),
+	}
+	listener := s.createListener(ipAddr, pkey)
 	defer listener.Close()
 
 	if !listener.Self().IP().Equal(ipAddr) {
@@ -73,7 +79,14 @@ func TestCreateListener(t *testing.T) {
 func TestStartDiscV5_DiscoverAllPeers(t *testing.T) {
 	port := 2000
 	ipAddr, pkey := createAddrAndPrivKey(t)
-	bootListener := createListener(ipAddr, pkey, &Config{UDPPort: uint(port)})
+	genesisTime := time.Now()
+	genesisValidatorsRoot := make([]byte, 32)
+	s := &Service{
+		cfg:                   &Config{UDPPort: uint(port)},
+		genesisTime:           genesisTime,
+		genesisValidatorsRoot: genesisValidatorsRoot,
+	}
+	bootListener := s.createListener(ipAddr, pkey)
 	defer bootListener.Close()
 
 	bootNode := bootListener.Self()
@@ -87,7 +100,12 @@ func TestStartDiscV5_DiscoverAllPeers(t *testing.T) {
 		port = 3000 + i
 		cfg.UDPPort = uint(port)
 		ipAddr, pkey := createAddrAndPrivKey(t)
-		listener, err := startDiscoveryV5(ipAddr, pkey, cfg)
+		s = &Service{
+			cfg:                   cfg,
+			genesisTime:           genesisTime,
+			genesisValidatorsRoot: genesisValidatorsRoot,
+		}
+		listener, err := s.startDiscoveryV5(ipAddr, pkey)
 		if err != nil {
 			t.Errorf("Could not start discovery for node: %v", err)
 		}
@@ -113,7 +131,14 @@ func TestStartDiscV5_DiscoverAllPeers(t *testing.T) {
 func TestStartDiscV5_DiscoverPeersWithSubnets(t *testing.T) {
 	port := 2000
 	ipAddr, pkey := createAddrAndPrivKey(t)
-	bootListener := createListener(ipAddr, pkey, &Config{UDPPort: uint(port)})
+	genesisTime := time.Now()
+	genesisValidatorsRoot := make([]byte, 32)
+	s := &Service{
+		cfg:                   &Config{UDPPort: uint(port)},
+		genesisTime:           genesisTime,
+		genesisValidatorsRoot: genesisValidatorsRoot,
+	}
+	bootListener := s.createListener(ipAddr, pkey)
 	defer bootListener.Close()
 
 	bootNode := bootListener.Self()
@@ -122,6 +147,7 @@ func TestStartDiscV5_DiscoverPeersWithSubnets(t *testing.T) {
 		Discv5BootStrapAddr: []string{bootNode.String()},
 		Encoding:            "ssz",
 		MaxPeers:            30,
+		StateNotifier:       &mock.MockStateNotifier{},
 	}
 	// Use shorter period for testing.
 	currentPeriod := pollingPeriod
@@ -135,7 +161,12 @@ func TestStartDiscV5_DiscoverPeersWithSubnets(t *testing.T) {
 		port = 3000 + i
 		cfg.UDPPort = uint(port)
 		ipAddr, pkey := createAddrAndPrivKey(t)
-		listener, err := startDiscoveryV5(ipAddr, pkey, cfg)
+		s = &Service{
+			cfg:                   cfg,
+			genesisTime:           genesisTime,
+			genesisValidatorsRoot: genesisValidatorsRoot,
+		}
+		listener, err := s.startDiscoveryV5(ipAddr, pkey)
 		if err != nil {
 			t.Errorf("Could not start discovery for node: %v", err)
 		}
@@ -154,7 +185,7 @@ func TestStartDiscV5_DiscoverPeersWithSubnets(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	s.Start()
+	startService(s, genesisTime, genesisValidatorsRoot)
 	defer s.Stop()
 
 	// Wait for the nodes to have their local routing tables to be populated with the other nodes
@@ -179,8 +210,8 @@ func TestStartDiscV5_DiscoverPeersWithSubnets(t *testing.T) {
 
 	// update ENR of a peer
 	testService := &Service{dv5Listener: listeners[0]}
-	cache.CommitteeIDs.AddIDs([]uint64{10}, 0)
-	testService.RefreshENR(0)
+	cache.SubnetIDs.AddPersistentCommittee([]byte{'A'}, []uint64{10}, time.Minute)
+	testService.RefreshENR()
 	time.Sleep(2 * time.Second)
 
 	exists, err = s.FindPeersWithSubnet(2)
@@ -197,7 +228,11 @@ func TestStartDiscV5_DiscoverPeersWithSubnets(t *testing.T) {
 func TestMultiAddrsConversion_InvalidIPAddr(t *testing.T) {
 	addr := net.ParseIP("invalidIP")
 	_, pkey := createAddrAndPrivKey(t)
-	node, err := createLocalNode(pkey, addr, 0, 0)
+	s := &Service{
+		genesisTime:           time.Now(),
+		genesisValidatorsRoot: make([]byte, 32),
+	}
+	node, err := s.createLocalNode(pkey, addr, 0, 0)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -210,7 +245,12 @@ func TestMultiAddrsConversion_InvalidIPAddr(t *testing.T) {
 func TestMultiAddrConversion_OK(t *testing.T) {
 	hook := logTest.NewGlobal()
 	ipAddr, pkey := createAddrAndPrivKey(t)
-	listener := createListener(ipAddr, pkey, &Config{})
+	s := &Service{
+		cfg:                   &Config{},
+		genesisTime:           time.Now(),
+		genesisValidatorsRoot: make([]byte, 32),
+	}
+	listener := s.createListener(ipAddr, pkey)
 
 	_ = convertToMultiAddr([]*enode.Node{listener.Self()})
 	testutil.AssertLogsDoNotContain(t, hook, "Node doesn't have an ip4 address")
@@ -219,7 +259,7 @@ func TestMultiAddrConversion_OK(t *testing.T) {
 }
 
 func TestStaticPeering_PeersAreAdded(t *testing.T) {
-	cfg := &Config{Encoding: "ssz", MaxPeers: 30}
+	cfg := &Config{Encoding: "ssz", MaxPeers: 30, StateNotifier: &mock.MockStateNotifier{}}
 	port := 3000
 	var staticPeers []string
 	var hosts []host.Host
@@ -245,7 +285,7 @@ func TestStaticPeering_PeersAreAdded(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	s.Start()
+	startService(s, time.Now(), make([]byte, 32))
 	s.dv5Listener = &mockListener{}
 	defer s.Stop()
 	time.Sleep(100 * time.Millisecond)
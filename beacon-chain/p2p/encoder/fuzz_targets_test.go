@@ -0,0 +1,171 @@
+package encoder_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/encoder"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+// fuzzMessages are the gossip and RPC request messages decoded from the fuzzed bytes.
+var fuzzMessages = []func() proto.Message{
+	func() proto.Message { return &ethpb.SignedBeaconBlock{} },
+	func() proto.Message { return &ethpb.Attestation{} },
+	func() proto.Message { return &ethpb.AggregateAttestationAndProof{} },
+	func() proto.Message { return &ethpb.SignedVoluntaryExit{} },
+	func() proto.Message { return &ethpb.ProposerSlashing{} },
+	func() proto.Message { return &ethpb.AttesterSlashing{} },
+	func() proto.Message { return &pb.Status{} },
+	func() proto.Message { return &pb.BeaconBlocksByRangeRequest{} },
+}
+
+// fuzzSeedMessages returns a populated instance of each of the fuzzed messages.
+func fuzzSeedMessages() []proto.Message {
+	checkpoint := &ethpb.Checkpoint{Epoch: 1, Root: make([]byte, 32)}
+	attData := &ethpb.AttestationData{
+		Slot:            1,
+		BeaconBlockRoot: make([]byte, 32),
+		Source:          checkpoint,
+		Target:          checkpoint,
+	}
+	header := &ethpb.SignedBeaconBlockHeader{
+		Header: &ethpb.BeaconBlockHeader{
+			Slot:       1,
+			ParentRoot: make([]byte, 32),
+			StateRoot:  make([]byte, 32),
+			BodyRoot:   make([]byte, 32),
+		},
+		Signature: make([]byte, 96),
+	}
+	indexedAtt := &ethpb.IndexedAttestation{
+		AttestingIndices: []uint64{1, 2},
+		Data:             attData,
+		Signature:        make([]byte, 96),
+	}
+	att := &ethpb.Attestation{
+		AggregationBits: []byte{0x03},
+		Data:            attData,
+		Signature:       make([]byte, 96),
+	}
+	return []proto.Message{
+		&ethpb.SignedBeaconBlock{
+			Block: &ethpb.BeaconBlock{
+				Slot:       1,
+				ParentRoot: make([]byte, 32),
+				StateRoot:  make([]byte, 32),
+				Body: &ethpb.BeaconBlockBody{
+					RandaoReveal: make([]byte, 96),
+					Eth1Data: &ethpb.Eth1Data{
+						DepositRoot: make([]byte, 32),
+						BlockHash:   make([]byte, 32),
+					},
+					Graffiti:     make([]byte, 32),
+					Attestations: []*ethpb.Attestation{att},
+				},
+			},
+			Signature: make([]byte, 96),
+		},
+		att,
+		&ethpb.AggregateAttestationAndProof{
+			AggregatorIndex: 1,
+			Aggregate:       att,
+			SelectionProof:  make([]byte, 96),
+		},
+		&ethpb.SignedVoluntaryExit{
+			Exit:      &ethpb.VoluntaryExit{Epoch: 1, ValidatorIndex: 1},
+			Signature: make([]byte, 96),
+		},
+		&ethpb.ProposerSlashing{
+			ProposerIndex: 1,
+			Header_1:      header,
+			Header_2:      header,
+		},
+		&ethpb.AttesterSlashing{
+			Attestation_1: indexedAtt,
+			Attestation_2: indexedAtt,
+		},
+		&pb.Status{
+			ForkDigest:    make([]byte, 4),
+			FinalizedRoot: make([]byte, 32),
+			HeadRoot:      make([]byte, 32),
+			HeadSlot:      1,
+		},
+		&pb.BeaconBlocksByRangeRequest{StartSlot: 1, Count: 64, Step: 1},
+	}
+}
+
+// fuzzDecode decodes the fuzzed bytes into each message. Every message which decodes
+// must encode again to an equal message.
+func fuzzDecode(t *testing.T, data []byte, useSnappy bool) {
+	e := &encoder.SszNetworkEncoder{UseSnappyCompression: useSnappy}
+	for _, newMsg := range fuzzMessages {
+		msg := newMsg()
+		if err := e.Decode(data, msg); err != nil {
+			continue
+		}
+		buf := new(bytes.Buffer)
+		if _, err := e.Encode(buf, msg); err != nil {
+			t.Fatalf("Could not encode decoded %T: %v", msg, err)
+		}
+		decoded := newMsg()
+		if err := e.Decode(buf.Bytes(), decoded); err != nil {
+			t.Fatalf("Could not decode encoded %T: %v", msg, err)
+		}
+		if !proto.Equal(msg, decoded) {
+			t.Fatalf("Decoded %T changed after encoding, %v != %v", msg, msg, decoded)
+		}
+	}
+}
+
+// fuzzDecodeWithLength decodes the fuzzed bytes as length prefixed chunks, as read from RPC streams.
+func fuzzDecodeWithLength(data []byte, useSnappy bool) {
+	e := &encoder.SszNetworkEncoder{UseSnappyCompression: useSnappy}
+	for _, newMsg := range fuzzMessages {
+		_ = e.DecodeWithLength(bytes.NewReader(data), newMsg())
+	}
+}
+
+func TestFuzzTargets_Seeds(t *testing.T) {
+	for _, useSnappy := range []bool{false, true} {
+		e := &encoder.SszNetworkEncoder{UseSnappyCompression: useSnappy}
+		for _, msg := range fuzzSeedMessages() {
+			buf := new(bytes.Buffer)
+			if _, err := e.Encode(buf, msg); err != nil {
+				t.Fatal(err)
+			}
+			fuzzDecode(t, buf.Bytes(), useSnappy)
+
+			buf.Reset()
+			if _, err := e.EncodeWithLength(buf, msg); err != nil {
+				t.Fatal(err)
+			}
+			fuzzDecodeWithLength(buf.Bytes(), useSnappy)
+		}
+	}
+}
+
+// Inputs which crashed a target, or which reach the same code paths, are kept here so that
+// every toolchain runs them.
+func TestFuzzTargets_Regressions(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{name: "empty", data: []byte{}},
+		{name: "unterminated length prefix", data: []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}},
+		{name: "length prefix above max chunk size", data: proto.EncodeVarint(encoder.MaxChunkSize + 1)},
+		{name: "length prefix without message", data: proto.EncodeVarint(64)},
+		{name: "snappy literal longer than input", data: []byte{0x20, 0xf0, 0xff, 0xff, 0xff, 0xff}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, useSnappy := range []bool{false, true} {
+				fuzzDecode(t, tt.data, useSnappy)
+				fuzzDecodeWithLength(tt.data, useSnappy)
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+//go:build go1.18
+// +build go1.18
+
+package encoder_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/encoder"
+)
+
+// Run a fuzzer with, for example:
+//
+//	go test ./beacon-chain/p2p/encoder -run=^$ -fuzz=FuzzSszNetworkEncoder_Decode
+
+func FuzzSszNetworkEncoder_Decode(f *testing.F) {
+	for _, useSnappy := range []bool{false, true} {
+		e := &encoder.SszNetworkEncoder{UseSnappyCompression: useSnappy}
+		for _, msg := range fuzzSeedMessages() {
+			buf := new(bytes.Buffer)
+			if _, err := e.Encode(buf, msg); err != nil {
+				f.Fatal(err)
+			}
+			f.Add(buf.Bytes(), useSnappy)
+		}
+	}
+	f.Fuzz(func(t *testing.T, data []byte, useSnappy bool) {
+		fuzzDecode(t, data, useSnappy)
+	})
+}
+
+func FuzzSszNetworkEncoder_DecodeWithLength(f *testing.F) {
+	for _, useSnappy := range []bool{false, true} {
+		e := &encoder.SszNetworkEncoder{UseSnappyCompression: useSnappy}
+		for _, msg := range fuzzSeedMessages() {
+			buf := new(bytes.Buffer)
+			if _, err := e.EncodeWithLength(buf, msg); err != nil {
+				f.Fatal(err)
+			}
+			f.Add(buf.Bytes(), useSnappy)
+		}
+	}
+	f.Fuzz(func(t *testing.T, data []byte, useSnappy bool) {
+		fuzzDecodeWithLength(data, useSnappy)
+	})
+}
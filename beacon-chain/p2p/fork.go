@@ -0,0 +1,169 @@
+package p2p
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/roughtime"
+	"github.com/prysmaticlabs/prysm/shared/slotutil"
+	"github.com/sirupsen/logrus"
+)
+
+// ENR key used for eth2-related fork data.
+const eth2ENRKey = "eth2"
+
+// CreateForkDigest creates the fork digest of the fork active at the current epoch, given
+// the genesis time and genesis validators root of the chain. The digest is part of every
+// gossip topic name and of the ENR of the node, so that peers on different forks or chains
+// do not mix.
+func CreateForkDigest(genesisTime time.Time, genesisValidatorsRoot []byte) ([4]byte, error) {
+	if genesisTime.IsZero() {
+		return [4]byte{}, errors.New("genesis time is not set")
+	}
+	if len(genesisValidatorsRoot) == 0 {
+		return [4]byte{}, errors.New("genesis validators root is not set")
+	}
+	return helpers.ComputeForkDigest(forkVersion(currentEpoch(genesisTime)), genesisValidatorsRoot)
+}
+
+// forkDigest returns the current fork digest of the node.
+func (s *Service) forkDigest() ([4]byte, error) {
+	return CreateForkDigest(s.genesisTime, s.genesisValidatorsRoot)
+}
+
+// forkVersion returns the version of the fork active at the given epoch. The genesis fork
+// version is active until the first fork of the fork version schedule.
+func forkVersion(epoch uint64) []byte {
+	version := params.BeaconConfig().GenesisForkVersion
+	var activationEpoch uint64
+	for forkEpoch, forkVersion := range params.BeaconConfig().ForkVersionSchedule {
+		if forkEpoch <= epoch && forkEpoch >= activationEpoch {
+			version = forkVersion
+			activationEpoch = forkEpoch
+		}
+	}
+	return version
+}
+
+// nextForkData returns the version and epoch of the next scheduled fork after the given
+// epoch. If no fork is scheduled, the current fork version and the far future epoch are
+// returned, as required of the ENR fork entry.
+func nextForkData(epoch uint64) ([]byte, uint64) {
+	version := forkVersion(epoch)
+	nextEpoch := params.BeaconConfig().FarFutureEpoch
+	for forkEpoch, forkVersion := range params.BeaconConfig().ForkVersionSchedule {
+		if forkEpoch > epoch && forkEpoch < nextEpoch {
+			version = forkVersion
+			nextEpoch = forkEpoch
+		}
+	}
+	return version, nextEpoch
+}
+
+// currentEpoch returns the epoch of the wall clock, or the genesis epoch before genesis.
+func currentEpoch(genesisTime time.Time) uint64 {
+	if roughtime.Now().Before(genesisTime) {
+		return 0
+	}
+	return helpers.SlotToEpoch(helpers.SlotsSince(genesisTime))
+}
+
+// addForkEntry sets the eth2 entry of the node's ENR, which holds the digest of the fork
+// active at the given epoch and the version and epoch of the next scheduled fork.
+func addForkEntry(node *enode.LocalNode, epoch uint64, genesisValidatorsRoot []byte) (*enode.LocalNode, error) {
+	digest, err := helpers.ComputeForkDigest(forkVersion(epoch), genesisValidatorsRoot)
+	if err != nil {
+		return nil, err
+	}
+	nextVersion, nextEpoch := nextForkData(epoch)
+	enrForkID := &pb.ENRForkID{
+		CurrentForkDigest: digest[:],
+		NextForkVersion:   nextVersion,
+		NextForkEpoch:     nextEpoch,
+	}
+	enc, err := ssz.Marshal(enrForkID)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal ENR fork entry")
+	}
+	node.Set(enr.WithEntry(eth2ENRKey, enc))
+	return node, nil
+}
+
+// retrieveForkEntry decodes the eth2 entry of an ENR.
+func retrieveForkEntry(record *enr.Record) (*pb.ENRForkID, error) {
+	var enc []byte
+	if err := record.Load(enr.WithEntry(eth2ENRKey, &enc)); err != nil {
+		return nil, err
+	}
+	forkEntry := &pb.ENRForkID{}
+	if err := ssz.Unmarshal(enc, forkEntry); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal ENR fork entry")
+	}
+	return forkEntry, nil
+}
+
+// compareForkENR checks that the ENR of a peer carries the same fork digest as our own ENR.
+// Peers which only disagree on the next scheduled fork are accepted, as they are on the
+// same fork until then.
+func (s *Service) compareForkENR(record *enr.Record) error {
+	localForkEntry, err := retrieveForkEntry(s.dv5Listener.LocalNode().Node().Record())
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve local fork entry")
+	}
+	peerForkEntry, err := retrieveForkEntry(record)
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve peer fork entry")
+	}
+	if !bytes.Equal(localForkEntry.CurrentForkDigest, peerForkEntry.CurrentForkDigest) {
+		return errors.Errorf(
+			"fork digest of peer %#x does not match local fork digest %#x",
+			peerForkEntry.CurrentForkDigest,
+			localForkEntry.CurrentForkDigest,
+		)
+	}
+	if !bytes.Equal(localForkEntry.NextForkVersion, peerForkEntry.NextForkVersion) ||
+		localForkEntry.NextForkEpoch != peerForkEntry.NextForkEpoch {
+		log.WithFields(logrus.Fields{
+			"peerNextForkVersion": peerForkEntry.NextForkVersion,
+			"peerNextForkEpoch":   peerForkEntry.NextForkEpoch,
+		}).Debug("Peer has a different next scheduled fork")
+	}
+	return nil
+}
+
+// forkWatcher updates the fork entry of the node's ENR when the chain reaches the epoch of
+// a scheduled fork.
+func (s *Service) forkWatcher() {
+	slotTicker := slotutil.GetSlotTicker(s.genesisTime, params.BeaconConfig().SecondsPerSlot)
+	defer slotTicker.Done()
+	for {
+		select {
+		case slot := <-slotTicker.C():
+			if !helpers.IsEpochStart(slot) {
+				continue
+			}
+			epoch := helpers.SlotToEpoch(slot)
+			if _, ok := params.BeaconConfig().ForkVersionSchedule[epoch]; !ok {
+				continue
+			}
+			if s.dv5Listener == nil {
+				continue
+			}
+			if _, err := addForkEntry(s.dv5Listener.LocalNode(), epoch, s.genesisValidatorsRoot); err != nil {
+				log.WithError(err).Error("Could not update ENR fork entry")
+				continue
+			}
+			log.WithField("epoch", epoch).Info("Updated ENR fork entry for scheduled fork")
+		case <-s.ctx.Done():
+			log.Debug("Context closed, exiting fork watcher")
+			return
+		}
+	}
+}
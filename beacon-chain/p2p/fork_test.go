@@ -0,0 +1,133 @@
+package p2p
+
+import (
+	"bytes"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func TestForkVersion_Schedule(t *testing.T) {
+	cfg := params.BeaconConfig()
+	cfg.ForkVersionSchedule = map[uint64][]byte{
+		10: {0, 0, 0, 1},
+		20: {0, 0, 0, 2},
+	}
+	params.OverrideBeaconConfig(cfg)
+	defer func() {
+		cfg.ForkVersionSchedule = nil
+		params.OverrideBeaconConfig(cfg)
+	}()
+
+	tests := []struct {
+		epoch       uint64
+		version     []byte
+		nextVersion []byte
+		nextEpoch   uint64
+	}{
+		{epoch: 0, version: cfg.GenesisForkVersion, nextVersion: []byte{0, 0, 0, 1}, nextEpoch: 10},
+		{epoch: 9, version: cfg.GenesisForkVersion, nextVersion: []byte{0, 0, 0, 1}, nextEpoch: 10},
+		{epoch: 10, version: []byte{0, 0, 0, 1}, nextVersion: []byte{0, 0, 0, 2}, nextEpoch: 20},
+		{epoch: 25, version: []byte{0, 0, 0, 2}, nextVersion: []byte{0, 0, 0, 2}, nextEpoch: cfg.FarFutureEpoch},
+	}
+	for _, tt := range tests {
+		if version := forkVersion(tt.epoch); !bytes.Equal(version, tt.version) {
+			t.Errorf("Wrong fork version at epoch %d, wanted %#x but got %#x", tt.epoch, tt.version, version)
+		}
+		nextVersion, nextEpoch := nextForkData(tt.epoch)
+		if !bytes.Equal(nextVersion, tt.nextVersion) || nextEpoch != tt.nextEpoch {
+			t.Errorf(
+				"Wrong next fork at epoch %d, wanted %#x at %d but got %#x at %d",
+				tt.epoch, tt.nextVersion, tt.nextEpoch, nextVersion, nextEpoch,
+			)
+		}
+	}
+}
+
+func TestCreateForkDigest(t *testing.T) {
+	root := make([]byte, 32)
+	digest, err := CreateForkDigest(time.Now(), root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := helpers.ComputeForkDigest(params.BeaconConfig().GenesisForkVersion, root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if digest != want {
+		t.Errorf("Wrong fork digest, wanted %#x but got %#x", want, digest)
+	}
+	if _, err := CreateForkDigest(time.Time{}, root); err == nil {
+		t.Error("Expected error without genesis time")
+	}
+	if _, err := CreateForkDigest(time.Now(), nil); err == nil {
+		t.Error("Expected error without genesis validators root")
+	}
+}
+
+func TestForkEntry_RoundTrip(t *testing.T) {
+	_, pkey := createAddrAndPrivKey(t)
+	db, err := enode.OpenDB("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	localNode := enode.NewLocalNode(db, pkey)
+	root := make([]byte, 32)
+	if _, err := addForkEntry(localNode, 0, root); err != nil {
+		t.Fatal(err)
+	}
+	forkEntry, err := retrieveForkEntry(localNode.Node().Record())
+	if err != nil {
+		t.Fatal(err)
+	}
+	digest, err := helpers.ComputeForkDigest(params.BeaconConfig().GenesisForkVersion, root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(forkEntry.CurrentForkDigest, digest[:]) {
+		t.Errorf("Wrong fork digest, wanted %#x but got %#x", digest, forkEntry.CurrentForkDigest)
+	}
+	if forkEntry.NextForkEpoch != params.BeaconConfig().FarFutureEpoch {
+		t.Errorf("Wrong next fork epoch, wanted %d but got %d", params.BeaconConfig().FarFutureEpoch, forkEntry.NextForkEpoch)
+	}
+}
+
+func TestCompareForkENR(t *testing.T) {
+	ipAddr := net.ParseIP("127.0.0.1")
+	_, pkey := createAddrAndPrivKey(t)
+	s := &Service{
+		cfg:                   &Config{},
+		genesisTime:           time.Now(),
+		genesisValidatorsRoot: make([]byte, 32),
+	}
+	listener := s.createListener(ipAddr, pkey)
+	defer listener.Close()
+	s.dv5Listener = listener
+
+	_, peerKey := createAddrAndPrivKey(t)
+	peer := &Service{
+		genesisTime:           time.Now(),
+		genesisValidatorsRoot: make([]byte, 32),
+	}
+	peerNode, err := peer.createLocalNode(peerKey, ipAddr, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.compareForkENR(peerNode.Node().Record()); err != nil {
+		t.Errorf("Expected peer on the same fork to be accepted: %v", err)
+	}
+
+	// A peer of a chain with other genesis validators is on a different fork.
+	otherRoot := make([]byte, 32)
+	otherRoot[0] = 'A'
+	if _, err := addForkEntry(peerNode, 0, otherRoot); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.compareForkENR(peerNode.Node().Record()); err == nil {
+		t.Error("Expected peer on a different fork to be rejected")
+	}
+}
@@ -8,14 +8,15 @@ import (
 )
 
 // GossipTopicMappings represent the protocol ID to protobuf message type map for easy
-// lookup.
+// lookup. The protocol IDs are formats, which take the fork digest of the network as their
+// first argument.
 var GossipTopicMappings = map[string]proto.Message{
-	"/eth2/beacon_block":                         &pb.SignedBeaconBlock{},
-	"/eth2/committee_index%d_beacon_attestation": &pb.Attestation{},
-	"/eth2/voluntary_exit":                       &pb.SignedVoluntaryExit{},
-	"/eth2/proposer_slashing":                    &pb.ProposerSlashing{},
-	"/eth2/attester_slashing":                    &pb.AttesterSlashing{},
-	"/eth2/beacon_aggregate_and_proof":           &pb.AggregateAttestationAndProof{},
+	"/eth2/%x/beacon_block":                         &pb.SignedBeaconBlock{},
+	"/eth2/%x/committee_index%d_beacon_attestation": &pb.Attestation{},
+	"/eth2/%x/voluntary_exit":                       &pb.SignedVoluntaryExit{},
+	"/eth2/%x/proposer_slashing":                    &pb.ProposerSlashing{},
+	"/eth2/%x/attester_slashing":                    &pb.AttesterSlashing{},
+	"/eth2/%x/beacon_aggregate_and_proof":           &pb.AggregateAttestationAndProof{},
 }
 
 // GossipTypeMapping is the inverse of GossipTopicMappings so that an arbitrary protobuf message
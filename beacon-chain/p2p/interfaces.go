@@ -53,7 +53,7 @@ type PubSubProvider interface {
 type PeerManager interface {
 	Disconnect(peer.ID) error
 	PeerID() peer.ID
-	RefreshENR(epoch uint64)
+	RefreshENR()
 	FindPeersWithSubnet(index uint64) (bool, error)
 }
 
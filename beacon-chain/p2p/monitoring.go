@@ -1,6 +1,8 @@
 package p2p
 
 import (
+	"fmt"
+
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 )
@@ -19,9 +21,11 @@ var (
 )
 
 func (s *Service) updateMetrics() {
-	for topic := range GossipTopicMappings {
-		topic += s.Encoding().ProtocolSuffix()
-		p2pTopicPeerCount.WithLabelValues(topic).Set(float64(len(s.pubsub.ListPeers(topic))))
+	forkDigest, err := s.forkDigest()
+	if err != nil {
+		log.WithError(err).Debug("Could not compute fork digest for topic metrics")
+	} else {
+		s.updateTopicMetrics(forkDigest)
 	}
 	p2pPeerCount.WithLabelValues("Connected").Set(float64(len(s.peers.Connected())))
 	p2pPeerCount.WithLabelValues("Disconnected").Set(float64(len(s.peers.Disconnected())))
@@ -29,3 +33,21 @@ func (s *Service) updateMetrics() {
 	p2pPeerCount.WithLabelValues("Disconnecting").Set(float64(len(s.peers.Disconnecting())))
 	p2pPeerCount.WithLabelValues("Bad").Set(float64(len(s.peers.Bad())))
 }
+
+// updateTopicMetrics reports the number of peers on each gossip topic of the current fork.
+func (s *Service) updateTopicMetrics(forkDigest [4]byte) {
+	for topic := range GossipTopicMappings {
+		if topic == attestationSubnetTopicFormat {
+			for i := uint64(0); i < attestationSubnetCount; i++ {
+				s.updateTopicPeerCount(fmt.Sprintf(topic, forkDigest, i))
+			}
+			continue
+		}
+		s.updateTopicPeerCount(fmt.Sprintf(topic, forkDigest))
+	}
+}
+
+func (s *Service) updateTopicPeerCount(topic string) {
+	topic += s.Encoding().ProtocolSuffix()
+	p2pTopicPeerCount.WithLabelValues(topic).Set(float64(len(s.pubsub.ListPeers(topic))))
+}
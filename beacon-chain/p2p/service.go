@@ -25,9 +25,12 @@ import (
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/go-bitfield"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/encoder"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
 	"github.com/prysmaticlabs/prysm/shared"
+	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/runutil"
 	"github.com/sirupsen/logrus"
 )
@@ -47,18 +50,20 @@ const maxBadResponses = 3
 
 // Service for managing peer to peer (p2p) networking.
 type Service struct {
-	ctx           context.Context
-	cancel        context.CancelFunc
-	started       bool
-	cfg           *Config
-	startupErr    error
-	dv5Listener   Listener
-	host          host.Host
-	pubsub        *pubsub.PubSub
-	exclusionList *ristretto.Cache
-	privKey       *ecdsa.PrivateKey
-	dht           *kaddht.IpfsDHT
-	peers         *peers.Status
+	ctx                   context.Context
+	cancel                context.CancelFunc
+	started               bool
+	cfg                   *Config
+	startupErr            error
+	dv5Listener           Listener
+	host                  host.Host
+	pubsub                *pubsub.PubSub
+	exclusionList         *ristretto.Cache
+	privKey               *ecdsa.PrivateKey
+	dht                   *kaddht.IpfsDHT
+	peers                 *peers.Status
+	genesisTime           time.Time
+	genesisValidatorsRoot []byte
 }
 
 // NewService initializes a new p2p service compatible with shared.Service interface. No
@@ -138,12 +143,26 @@ func NewService(cfg *Config) (*Service, error) {
 	return s, nil
 }
 
-// Start the p2p service.
+// Start the p2p service. The node is started in the background, once the beacon state
+// is initialized.
 func (s *Service) Start() {
 	if s.started {
 		log.Error("Attempted to start p2p service when it was already started")
 		return
 	}
+	// Subscribe before returning, as the blockchain service is started once this service
+	// is, and may send the state initialized event right away.
+	stateChannel := make(chan *feed.Event, 1)
+	stateSub := s.cfg.StateNotifier.StateFeed().Subscribe(stateChannel)
+	go s.start(stateChannel, stateSub)
+}
+
+func (s *Service) start(stateChannel <-chan *feed.Event, stateSub event.Subscription) {
+	// Waits until the state is initialized, as the fork digest of the node's ENR and of
+	// the gossip topics is derived from the genesis time and genesis validators root.
+	if !s.awaitStateInitialized(stateChannel, stateSub) {
+		return
+	}
 
 	var peersToWatch []string
 	if s.cfg.RelayNodeAddr != "" {
@@ -160,7 +179,7 @@ func (s *Service) Start() {
 
 	if (len(s.cfg.Discv5BootStrapAddr) != 0 && !s.cfg.NoDiscovery) || s.cfg.EnableDiscv5 {
 		ipAddr := ipAddr()
-		listener, err := startDiscoveryV5(ipAddr, s.privKey, s.cfg)
+		listener, err := s.startDiscoveryV5(ipAddr, s.privKey)
 		if err != nil {
 			log.WithError(err).Error("Failed to start discovery")
 			s.startupErr = err
@@ -174,6 +193,7 @@ func (s *Service) Start() {
 		}
 		s.dv5Listener = listener
 		go s.listenForNewNodes()
+		go s.forkWatcher()
 	}
 
 	if len(s.cfg.KademliaBootStrapAddr) != 0 && !s.cfg.NoDiscovery {
@@ -235,6 +255,36 @@ func (s *Service) Start() {
 	}
 }
 
+// awaitStateInitialized blocks until the given subscription to the state feed receives the
+// state initialized event, and sets the genesis data of the chain. It returns false if the
+// service was stopped before.
+func (s *Service) awaitStateInitialized(stateChannel <-chan *feed.Event, stateSub event.Subscription) bool {
+	defer stateSub.Unsubscribe()
+	for {
+		select {
+		case event := <-stateChannel:
+			if event.Type == statefeed.Initialized {
+				data, ok := event.Data.(*statefeed.InitializedData)
+				if !ok {
+					log.Error("Received wrong data type for state initialized event")
+					s.startupErr = errors.New("invalid state initialized event data")
+					return false
+				}
+				s.genesisTime = data.StartTime
+				s.genesisValidatorsRoot = data.GenesisValidatorsRoot
+				return true
+			}
+		case <-s.ctx.Done():
+			log.Debug("Context closed, exiting goroutine")
+			return false
+		case err := <-stateSub.Err():
+			log.WithError(err).Error("Subscription to state notifier failed")
+			s.startupErr = err
+			return false
+		}
+	}
+}
+
 // Stop the p2p service and terminate all peer connections.
 func (s *Service) Stop() error {
 	defer s.cancel()
@@ -298,16 +348,16 @@ func (s *Service) Peers() *peers.Status {
 	return s.peers
 }
 
-// RefreshENR uses an epoch to refresh the enr entry for our node
-// with the tracked committee id's for the epoch, allowing our node
-// to be dynamically discoverable by others given our tracked committee id's.
-func (s *Service) RefreshENR(epoch uint64) {
+// RefreshENR refreshes the attestation subnets entry of the enr of our node with the
+// long-lived subnets of the validators connected to the node, allowing our node to be
+// discovered by peers searching for those subnets.
+func (s *Service) RefreshENR() {
 	// return early if discv5 isnt running
 	if s.dv5Listener == nil {
 		return
 	}
 	bitV := bitfield.NewBitvector64()
-	committees := cache.CommitteeIDs.GetIDs(epoch)
+	committees := cache.SubnetIDs.GetAllSubnets()
 	for _, idx := range committees {
 		bitV.SetBitAt(idx, true)
 	}
@@ -319,6 +369,10 @@ func (s *Service) RefreshENR(epoch uint64) {
 // subscribed to a particular subnet. Then we try to connect
 // with those peers.
 func (s *Service) FindPeersWithSubnet(index uint64) (bool, error) {
+	// return early if discv5 isnt running
+	if s.dv5Listener == nil {
+		return false, nil
+	}
 	nodes := make([]*enode.Node, searchLimit)
 	num := s.dv5Listener.ReadRandomNodes(nodes)
 	exists := false
@@ -326,6 +380,10 @@ func (s *Service) FindPeersWithSubnet(index uint64) (bool, error) {
 		if node.IP() == nil {
 			continue
 		}
+		if err := s.compareForkENR(node.Record()); err != nil {
+			log.WithError(err).Trace("Fork ENR mismatches between peer and local node")
+			continue
+		}
 		subnets, err := retrieveAttSubnets(node.Record())
 		if err != nil {
 			return false, errors.Wrap(err, "could not retrieve subnets")
@@ -411,6 +469,11 @@ func (s *Service) processPeers(nodes []*enode.Node) []ma.Multiaddr {
 		if node.IP() == nil {
 			continue
 		}
+		// ignore nodes on a different fork.
+		if err := s.compareForkENR(node.Record()); err != nil {
+			log.WithError(err).Trace("Fork ENR mismatches between peer and local node")
+			continue
+		}
 		multiAddr, err := convertToSingleMultiAddr(node)
 		if err != nil {
 			log.WithError(err).Error("Could not convert to multiAddr")
@@ -14,6 +14,9 @@ import (
 	"github.com/libp2p/go-libp2p-core/host"
 	"github.com/libp2p/go-libp2p-core/peer"
 	multiaddr "github.com/multiformats/go-multiaddr"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 )
@@ -60,13 +63,34 @@ func createPeer(t *testing.T, cfg *Config, port int) (Listener, host.Host) {
 	h, pkey, ipAddr := createHost(t, port)
 	cfg.UDPPort = uint(port)
 	cfg.TCPPort = uint(port)
-	listener, err := startDiscoveryV5(ipAddr, pkey, cfg)
+	s := &Service{
+		cfg:                   cfg,
+		genesisTime:           time.Now(),
+		genesisValidatorsRoot: make([]byte, 32),
+	}
+	listener, err := s.startDiscoveryV5(ipAddr, pkey)
 	if err != nil {
 		t.Errorf("Could not start discovery for node: %v", err)
 	}
 	return listener, h
 }
 
+// startService starts the service, sending it the state initialized event it waits for,
+// and waits for the node to be started.
+func startService(s *Service, genesisTime time.Time, genesisValidatorsRoot []byte) {
+	s.Start()
+	s.cfg.StateNotifier.StateFeed().Send(&feed.Event{
+		Type: statefeed.Initialized,
+		Data: &statefeed.InitializedData{
+			StartTime:             genesisTime,
+			GenesisValidatorsRoot: genesisValidatorsRoot,
+		},
+	})
+	for deadline := time.Now().Add(5 * time.Second); !s.Started() && time.Now().Before(deadline); {
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
 func createHost(t *testing.T, port int) (host.Host, *ecdsa.PrivateKey, net.IP) {
 	ipAddr, pkey := createAddrAndPrivKey(t)
 	ipAddr = net.ParseIP("127.0.0.1")
@@ -97,18 +121,51 @@ func TestService_Stop_DontPanicIfDv5ListenerIsNotInited(t *testing.T) {
 	_ = s.Stop()
 }
 
+func TestService_Start_SubscribesBeforeReturning(t *testing.T) {
+	cfg := &Config{
+		TCPPort:       2001,
+		UDPPort:       2001,
+		Encoding:      "ssz",
+		StateNotifier: &mock.MockStateNotifier{},
+	}
+	s, err := NewService(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Stop()
+	s.Start()
+	// The blockchain service may send the event as soon as this service is started.
+	sent := cfg.StateNotifier.StateFeed().Send(&feed.Event{
+		Type: statefeed.Initialized,
+		Data: &statefeed.InitializedData{
+			StartTime:             time.Now(),
+			GenesisValidatorsRoot: make([]byte, 32),
+		},
+	})
+	if sent != 1 {
+		t.Fatalf("Expected the service to be subscribed to the state feed, sent to %d subscribers", sent)
+	}
+	for deadline := time.Now().Add(5 * time.Second); !s.Started() && time.Now().Before(deadline); {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if !s.Started() {
+		t.Error("Expected the service to be started")
+	}
+}
+
 func TestService_Start_OnlyStartsOnce(t *testing.T) {
 	hook := logTest.NewGlobal()
 
 	cfg := &Config{
-		TCPPort:  2000,
-		UDPPort:  2000,
-		Encoding: "ssz",
+		TCPPort:       2000,
+		UDPPort:       2000,
+		Encoding:      "ssz",
+		StateNotifier: &mock.MockStateNotifier{},
 	}
 	s, _ := NewService(cfg)
 	s.dv5Listener = &mockListener{}
 	defer s.Stop()
-	s.Start()
+	startService(s, time.Now(), make([]byte, 32))
 	if s.started != true {
 		t.Error("Expected service to be started")
 	}
@@ -131,7 +188,12 @@ func TestListenForNewNodes(t *testing.T) {
 	cfg.UDPPort = uint(port)
 	_, pkey := createAddrAndPrivKey(t)
 	ipAddr := net.ParseIP("127.0.0.1")
-	bootListener := createListener(ipAddr, pkey, cfg)
+	s := &Service{
+		cfg:                   cfg,
+		genesisTime:           time.Now(),
+		genesisValidatorsRoot: make([]byte, 32),
+	}
+	bootListener := s.createListener(ipAddr, pkey)
 	defer bootListener.Close()
 
 	// Use shorter period for testing.
@@ -148,6 +210,7 @@ func TestListenForNewNodes(t *testing.T) {
 		Discv5BootStrapAddr: []string{bootNode.String()},
 		Encoding:            "ssz",
 		MaxPeers:            30,
+		StateNotifier:       &mock.MockStateNotifier{},
 	}
 	var listeners []*discover.UDPv5
 	var hosts []host.Host
@@ -173,7 +236,7 @@ func TestListenForNewNodes(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	s.Start()
+	startService(s, time.Now(), make([]byte, 32))
 	defer s.Stop()
 
 	time.Sleep(4 * time.Second)
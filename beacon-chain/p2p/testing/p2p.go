@@ -234,6 +234,6 @@ func (p *TestP2P) FindPeersWithSubnet(index uint64) (bool, error) {
 }
 
 // RefreshENR mocks the p2p func.
-func (p *TestP2P) RefreshENR(epoch uint64) {
+func (p *TestP2P) RefreshENR() {
 	return
 }
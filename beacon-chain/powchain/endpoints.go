@@ -0,0 +1,353 @@
+package powchain
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"math/big"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	gethRPC "github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	contracts "github.com/prysmaticlabs/prysm/contracts/deposit-contract"
+	"github.com/prysmaticlabs/prysm/shared/roughtime"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	endpointHealthGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "powchain_endpoint_health",
+		Help: "The health of an eth1 endpoint: 0 if it cannot be used, 1 if it is syncing or behind, 2 if it is healthy",
+	}, []string{"endpoint"})
+	endpointHeadGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "powchain_endpoint_head_block",
+		Help: "The head block number reported by an eth1 endpoint",
+	}, []string{"endpoint"})
+	endpointActiveGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "powchain_endpoint_active",
+		Help: "1 if the eth1 endpoint is the one serving the powchain service, 0 otherwise",
+	}, []string{"endpoint"})
+	endpointSwitchCount = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "powchain_endpoint_switches",
+		Help: "The number of times the powchain service switched to another eth1 endpoint",
+	})
+)
+
+// time between two health checks of the eth1 endpoints.
+var endpointCheckInterval = 30 * time.Second
+
+// timeout of the requests of a health check of an eth1 endpoint.
+var endpointCheckTimeout = 10 * time.Second
+
+// use a 5 minutes timeout for block time, because the max mining time is 278 sec (block 7208027).
+var staleHeadThreshold = 5 * time.Minute
+
+// Endpoint defines the endpoints of an eth1 node: an IPC or WebSocket endpoint to subscribe
+// to new heads, and an HTTP endpoint for all other requests.
+type Endpoint struct {
+	Endpoint     string
+	HTTPEndpoint string
+}
+
+// HealthClient defines the eth1 RPC methods used to check the health of an eth1 endpoint.
+type HealthClient interface {
+	ChainID(ctx context.Context) (*big.Int, error)
+	SyncProgress(ctx context.Context) (*ethereum.SyncProgress, error)
+	HeaderByNumber(ctx context.Context, number *big.Int) (*gethTypes.Header, error)
+}
+
+// endpointHealth ranks eth1 endpoints, the healthiest endpoint being preferred.
+type endpointHealth int
+
+const (
+	// endpointUnusable endpoints cannot be reached or are on another chain.
+	endpointUnusable endpointHealth = iota
+	// endpointDegraded endpoints are syncing or have a stale head.
+	endpointDegraded
+	// endpointHealthy endpoints are synced to a recent head.
+	endpointHealthy
+)
+
+func (h endpointHealth) String() string {
+	switch h {
+	case endpointDegraded:
+		return "degraded"
+	case endpointHealthy:
+		return "healthy"
+	default:
+		return "unusable"
+	}
+}
+
+// eth1Endpoint is an eth1 endpoint along with the result of its last health check. The
+// endpoints are ordered by priority, the first one being preferred. Both the HTTP endpoint and
+// the IPC or WebSocket endpoint carrying the head subscription are checked.
+type eth1Endpoint struct {
+	Endpoint
+	label              string
+	httpClient         HealthClient
+	subscriptionClient HealthClient
+	health             endpointHealth
+	err                error
+	head               uint64
+}
+
+func newEth1Endpoints(endpoints []Endpoint) ([]*eth1Endpoint, error) {
+	eth1Endpoints := make([]*eth1Endpoint, 0, len(endpoints))
+	for _, e := range endpoints {
+		if !strings.HasPrefix(e.Endpoint, "ws") && !strings.HasPrefix(e.Endpoint, "ipc") {
+			return nil, fmt.Errorf(
+				"powchain service requires either an IPC or WebSocket endpoint, provided %s",
+				e.Endpoint,
+			)
+		}
+		eth1Endpoints = append(eth1Endpoints, &eth1Endpoint{
+			Endpoint: e,
+			label:    endpointLabel(e.HTTPEndpoint),
+		})
+	}
+	return eth1Endpoints, nil
+}
+
+// endpointLabel identifies an endpoint in logs and metrics by its host and port. The path of an
+// endpoint URL may contain an access key, so it is replaced by a short hash which tells apart
+// endpoints of the same host.
+func endpointLabel(endpoint string) string {
+	u, err := url.Parse(endpoint)
+	if err != nil || u.Host == "" {
+		return endpoint
+	}
+	if path := strings.Trim(u.Path, "/"); path != "" {
+		h := sha256.Sum256([]byte(path))
+		return fmt.Sprintf("%s/%x", u.Host, h[:4])
+	}
+	return u.Host
+}
+
+// monitorEndpoints checks the health of the eth1 endpoints periodically and sends the best
+// endpoint to the run loop after every check. The checks run in their own goroutine, so that
+// slow endpoints do not hold up the processing of eth1 heads.
+func (s *Service) monitorEndpoints(ctx context.Context, bestChan chan<- *eth1Endpoint) {
+	ticker := time.NewTicker(endpointCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			s.checkEndpoints(ctx)
+			best := s.bestEndpoint()
+			if best == nil {
+				continue
+			}
+			select {
+			case bestChan <- best:
+			case <-ctx.Done():
+				return
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// checkEndpoints checks the health of every eth1 endpoint.
+func (s *Service) checkEndpoints(ctx context.Context) {
+	s.endpointCheckLock.Lock()
+	defer s.endpointCheckLock.Unlock()
+	for _, e := range s.endpoints {
+		health, head, err := s.endpointHealth(ctx, e)
+
+		s.endpointsLock.Lock()
+		changed := health != e.health || (err == nil) != (e.err == nil)
+		e.health = health
+		e.err = err
+		if head != 0 {
+			e.head = head
+		}
+		head = e.head
+		s.endpointsLock.Unlock()
+
+		if changed {
+			entry := log.WithFields(logrus.Fields{
+				"endpoint": e.label,
+				"health":   health,
+			})
+			if err != nil {
+				entry = entry.WithError(err)
+			}
+			entry.Info("Eth1 endpoint health changed")
+		}
+		endpointHealthGauge.WithLabelValues(e.label).Set(float64(health))
+		endpointHeadGauge.WithLabelValues(e.label).Set(float64(head))
+	}
+}
+
+// endpointHealth checks the HTTP endpoint and the subscription endpoint of an eth1 endpoint,
+// which is only as healthy as the worst of them. It returns the head block number of the HTTP
+// endpoint, or 0 if it is unknown.
+func (s *Service) endpointHealth(ctx context.Context, e *eth1Endpoint) (endpointHealth, uint64, error) {
+	ctx, cancel := context.WithTimeout(ctx, endpointCheckTimeout)
+	defer cancel()
+
+	if e.httpClient == nil {
+		client, err := dialHealthClient(ctx, e.HTTPEndpoint)
+		if err != nil {
+			return endpointUnusable, 0, errors.Wrap(err, "could not dial HTTP endpoint")
+		}
+		e.httpClient = client
+	}
+	if e.subscriptionClient == nil {
+		client, err := dialHealthClient(ctx, e.Endpoint)
+		if err != nil {
+			return endpointUnusable, 0, errors.Wrap(err, "could not dial subscription endpoint")
+		}
+		e.subscriptionClient = client
+	}
+
+	health, head, chainID, err := s.clientHealth(ctx, e.httpClient, s.eth1ChainID)
+	if err != nil {
+		err = errors.Wrap(err, "HTTP endpoint")
+	}
+	// Without a configured chain ID, which is only allowed with a single endpoint, both clients
+	// of the endpoint must be on the same chain.
+	wantChainID := s.eth1ChainID
+	if wantChainID == nil {
+		wantChainID = chainID
+	}
+	subscriptionHealth, _, _, subscriptionErr := s.clientHealth(ctx, e.subscriptionClient, wantChainID)
+	if subscriptionHealth < health {
+		return subscriptionHealth, head, errors.Wrap(subscriptionErr, "subscription endpoint")
+	}
+	return health, head, err
+}
+
+// clientHealth checks that an eth1 node is on the wanted chain, if any, synced, and that its
+// head is recent. It returns the chain ID of the node along with its health and head block
+// number.
+func (s *Service) clientHealth(
+	ctx context.Context, client HealthClient, wantChainID *big.Int,
+) (endpointHealth, uint64, *big.Int, error) {
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		return endpointUnusable, 0, nil, errors.Wrap(err, "could not get chain ID")
+	}
+	if wantChainID != nil && chainID.Cmp(wantChainID) != 0 {
+		return endpointUnusable, 0, chainID, fmt.Errorf("endpoint is on chain %d instead of chain %d", chainID, wantChainID)
+	}
+	progress, err := client.SyncProgress(ctx)
+	if err != nil {
+		return endpointUnusable, 0, chainID, errors.Wrap(err, "could not get sync status")
+	}
+	head, err := client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return endpointUnusable, 0, chainID, errors.Wrap(err, "could not get head block")
+	}
+	number := head.Number.Uint64()
+	if progress != nil {
+		return endpointDegraded, number, chainID, fmt.Errorf("endpoint is syncing at block %d of %d", progress.CurrentBlock, progress.HighestBlock)
+	}
+	if time.Unix(int64(head.Time), 0).Before(roughtime.Now().Add(-staleHeadThreshold)) {
+		return endpointDegraded, number, chainID, fmt.Errorf("head block %d is older than %v", number, staleHeadThreshold)
+	}
+	return endpointHealthy, number, chainID, nil
+}
+
+func dialHealthClient(ctx context.Context, endpoint string) (HealthClient, error) {
+	rpcClient, err := gethRPC.DialContext(ctx, endpoint)
+	if err != nil {
+		return nil, err
+	}
+	return ethclient.NewClient(rpcClient), nil
+}
+
+// bestEndpoint returns the endpoint of highest priority among the healthiest endpoints, or
+// nil if no endpoint can be used.
+func (s *Service) bestEndpoint() *eth1Endpoint {
+	s.endpointsLock.RLock()
+	defer s.endpointsLock.RUnlock()
+	var best *eth1Endpoint
+	for _, e := range s.endpoints {
+		if e.health == endpointUnusable {
+			continue
+		}
+		if best == nil || e.health > best.health {
+			best = e
+		}
+	}
+	return best
+}
+
+// connectToEndpoint switches the connection of the service to the given eth1 endpoint.
+func (s *Service) connectToEndpoint(e *eth1Endpoint) error {
+	powClient, httpClient, rpcClient, err := dialETH1Nodes(e.Endpoint)
+	if err != nil {
+		return errors.Wrap(err, "could not dial eth1 nodes")
+	}
+
+	depositContractCaller, err := contracts.NewDepositContractCaller(s.depositContractAddress, httpClient)
+	if err != nil {
+		powClient.Close()
+		httpClient.Close()
+		return errors.Wrap(err, "could not create deposit contract caller")
+	}
+
+	s.initializeConnection(powClient, httpClient, rpcClient, depositContractCaller)
+	if s.closeConnection != nil {
+		s.closeConnection()
+	}
+	s.closeConnection = func() {
+		powClient.Close()
+		httpClient.Close()
+	}
+
+	s.endpointsLock.RLock()
+	fields := logrus.Fields{
+		"endpoint": e.label,
+		"health":   e.health,
+	}
+	s.endpointsLock.RUnlock()
+	if s.currentEndpoint != nil && s.currentEndpoint != e {
+		endpointSwitchCount.Inc()
+		endpointActiveGauge.WithLabelValues(s.currentEndpoint.label).Set(0)
+		fields["previousEndpoint"] = s.currentEndpoint.label
+	}
+	endpointActiveGauge.WithLabelValues(e.label).Set(1)
+	s.currentEndpoint = e
+	log.WithFields(fields).Info("Connected to eth1 proof-of-work chain")
+	return nil
+}
+
+// switchEndpoint moves the head subscription of the service to the given eth1 endpoint. If the
+// endpoint cannot be connected to, the service waits for a connection to any endpoint.
+func (s *Service) switchEndpoint(e *eth1Endpoint, headSub ethereum.Subscription) (ethereum.Subscription, error) {
+	headSub.Unsubscribe()
+	if err := s.connectToEndpoint(e); err != nil {
+		log.WithError(err).Warn("Could not switch to another eth1 endpoint")
+		s.connectedETH1 = false
+		s.waitForConnection()
+	}
+	return s.reader.SubscribeNewHead(s.ctx, s.headerChan)
+}
+
+func dialETH1Nodes(e Endpoint) (*ethclient.Client, *ethclient.Client, *gethRPC.Client, error) {
+	httpRPCClient, err := gethRPC.Dial(e.HTTPEndpoint)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	httpClient := ethclient.NewClient(httpRPCClient)
+
+	rpcClient, err := gethRPC.Dial(e.Endpoint)
+	if err != nil {
+		httpClient.Close()
+		return nil, nil, nil, err
+	}
+	powClient := ethclient.NewClient(rpcClient)
+
+	return powClient, httpClient, httpRPCClient, nil
+}
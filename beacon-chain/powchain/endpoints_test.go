@@ -0,0 +1,274 @@
+package powchain
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	mockPOW "github.com/prysmaticlabs/prysm/beacon-chain/powchain/testing"
+	"github.com/prysmaticlabs/prysm/shared/roughtime"
+)
+
+func setupFakeEndpoints(t *testing.T, backends ...*mockPOW.FakeEth1Backend) *Service {
+	var endpoints []Endpoint
+	for range backends {
+		endpoints = append(endpoints, Endpoint{Endpoint: "ws://127.0.0.1", HTTPEndpoint: "http://127.0.0.1"})
+	}
+	eth1Endpoints, err := newEth1Endpoints(endpoints)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, e := range eth1Endpoints {
+		e.httpClient = backends[i]
+		e.subscriptionClient = backends[i]
+	}
+	return &Service{endpoints: eth1Endpoints}
+}
+
+func TestNewEth1Endpoints_InvalidEndpoint(t *testing.T) {
+	if _, err := newEth1Endpoints([]Endpoint{
+		{Endpoint: "ws://127.0.0.1", HTTPEndpoint: "http://127.0.0.1"},
+		{Endpoint: "http://127.0.0.1", HTTPEndpoint: "http://127.0.0.1"},
+	}); err == nil {
+		t.Error("Expected a fallback HTTP endpoint to be rejected")
+	}
+}
+
+func TestEndpointHealth(t *testing.T) {
+	stale := mockPOW.NewFakeEth1Backend(5)
+	stale.SetHead(10, uint64(roughtime.Now().Add(-2*staleHeadThreshold).Unix()))
+	syncing := mockPOW.NewFakeEth1Backend(5)
+	syncing.SetSyncing(true)
+	unreachable := mockPOW.NewFakeEth1Backend(5)
+	unreachable.SetError(errors.New("connection refused"))
+
+	tests := []struct {
+		name    string
+		backend *mockPOW.FakeEth1Backend
+		want    endpointHealth
+	}{
+		{name: "healthy", backend: mockPOW.NewFakeEth1Backend(5), want: endpointHealthy},
+		{name: "stale head", backend: stale, want: endpointDegraded},
+		{name: "syncing", backend: syncing, want: endpointDegraded},
+		{name: "unreachable", backend: unreachable, want: endpointUnusable},
+		{name: "other chain", backend: mockPOW.NewFakeEth1Backend(1), want: endpointUnusable},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := setupFakeEndpoints(t, tt.backend)
+			s.eth1ChainID = big.NewInt(5)
+			s.checkEndpoints(context.Background())
+			if s.endpoints[0].health != tt.want {
+				t.Errorf("Expected endpoint to be %v, received %v: %v", tt.want, s.endpoints[0].health, s.endpoints[0].err)
+			}
+		})
+	}
+}
+
+func TestEndpointHealth_SubscriptionEndpoint(t *testing.T) {
+	syncing := mockPOW.NewFakeEth1Backend(5)
+	syncing.SetSyncing(true)
+	unreachable := mockPOW.NewFakeEth1Backend(5)
+	unreachable.SetError(errors.New("connection refused"))
+
+	tests := []struct {
+		name    string
+		backend *mockPOW.FakeEth1Backend
+		want    endpointHealth
+	}{
+		{name: "healthy", backend: mockPOW.NewFakeEth1Backend(5), want: endpointHealthy},
+		{name: "syncing", backend: syncing, want: endpointDegraded},
+		{name: "unreachable", backend: unreachable, want: endpointUnusable},
+		{name: "other chain", backend: mockPOW.NewFakeEth1Backend(1), want: endpointUnusable},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := setupFakeEndpoints(t, mockPOW.NewFakeEth1Backend(5))
+			s.endpoints[0].subscriptionClient = tt.backend
+			s.eth1ChainID = big.NewInt(5)
+			s.checkEndpoints(context.Background())
+			if s.endpoints[0].health != tt.want {
+				t.Errorf("Expected endpoint to be %v, received %v: %v", tt.want, s.endpoints[0].health, s.endpoints[0].err)
+			}
+		})
+	}
+}
+
+func TestEndpoints_NoChainID(t *testing.T) {
+	s := setupFakeEndpoints(t, mockPOW.NewFakeEth1Backend(5))
+	s.checkEndpoints(context.Background())
+	if s.eth1ChainID != nil {
+		t.Errorf("Expected no chain ID to be taken from an endpoint, received %d", s.eth1ChainID)
+	}
+	if s.endpoints[0].health != endpointHealthy {
+		t.Errorf("Expected endpoint to be healthy, received %v: %v", s.endpoints[0].health, s.endpoints[0].err)
+	}
+
+	// The subscription client must be on the chain of the HTTP client.
+	s.endpoints[0].subscriptionClient = mockPOW.NewFakeEth1Backend(1)
+	s.checkEndpoints(context.Background())
+	if s.endpoints[0].health != endpointUnusable {
+		t.Errorf("Expected endpoint with clients on different chains to be unusable, received %v", s.endpoints[0].health)
+	}
+}
+
+func TestEndpointLabel(t *testing.T) {
+	tests := []struct {
+		endpoint string
+		want     string
+	}{
+		{endpoint: "http://127.0.0.1:8545", want: "127.0.0.1:8545"},
+		{endpoint: "http://127.0.0.1:8545/", want: "127.0.0.1:8545"},
+		{endpoint: "/home/user/geth.ipc", want: "/home/user/geth.ipc"},
+	}
+	for _, tt := range tests {
+		if got := endpointLabel(tt.endpoint); got != tt.want {
+			t.Errorf("endpointLabel(%s) = %s, want %s", tt.endpoint, got, tt.want)
+		}
+	}
+
+	// Endpoints of the same host are told apart without showing their access key.
+	a := endpointLabel("https://goerli.infura.io/v3/key-a")
+	b := endpointLabel("https://goerli.infura.io/v3/key-b")
+	if a == b {
+		t.Errorf("Expected different labels for different paths, received %s", a)
+	}
+	if strings.Contains(a, "key-a") || !strings.HasPrefix(a, "goerli.infura.io/") {
+		t.Errorf("Unexpected label %s", a)
+	}
+}
+
+func TestEndpoints_FailoverAndFailback(t *testing.T) {
+	primary := mockPOW.NewFakeEth1Backend(5)
+	fallback := mockPOW.NewFakeEth1Backend(5)
+	s := setupFakeEndpoints(t, primary, fallback)
+	ctx := context.Background()
+
+	s.checkEndpoints(ctx)
+	if best := s.bestEndpoint(); best != s.endpoints[0] {
+		t.Fatal("Expected the primary endpoint to be preferred")
+	}
+
+	// Fail over when the primary endpoint falls behind.
+	primary.SetSyncing(true)
+	s.checkEndpoints(ctx)
+	if best := s.bestEndpoint(); best != s.endpoints[1] {
+		t.Fatal("Expected to fail over to the fallback endpoint")
+	}
+
+	// A degraded endpoint is still better than none.
+	fallback.SetError(errors.New("connection refused"))
+	s.checkEndpoints(ctx)
+	if best := s.bestEndpoint(); best != s.endpoints[0] {
+		t.Fatal("Expected the syncing primary endpoint over an unreachable fallback")
+	}
+
+	// Fail back once the primary endpoint is synced again.
+	fallback.SetError(nil)
+	primary.SetSyncing(false)
+	s.checkEndpoints(ctx)
+	if best := s.bestEndpoint(); best != s.endpoints[0] {
+		t.Fatal("Expected to fail back to the primary endpoint")
+	}
+
+	primary.SetError(errors.New("connection refused"))
+	fallback.SetError(errors.New("connection refused"))
+	s.checkEndpoints(ctx)
+	if best := s.bestEndpoint(); best != nil {
+		t.Error("Expected no usable endpoint")
+	}
+}
+
+func TestService_SwitchesEndpointAndResubscribes(t *testing.T) {
+	defer func(interval time.Duration) {
+		endpointCheckInterval = interval
+	}(endpointCheckInterval)
+	endpointCheckInterval = 10 * time.Millisecond
+
+	primary := mockPOW.NewFakeEth1Backend(5)
+	fallback := mockPOW.NewFakeEth1Backend(5)
+	var endpoints []Endpoint
+	for _, backend := range []*mockPOW.FakeEth1Backend{primary, fallback} {
+		httpEndpoint, wsEndpoint, stop, err := backend.Serve()
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer stop()
+		endpoints = append(endpoints, Endpoint{Endpoint: wsEndpoint, HTTPEndpoint: httpEndpoint})
+	}
+	eth1Endpoints, err := newEth1Endpoints(endpoints)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s := &Service{
+		ctx:         ctx,
+		headerChan:  make(chan *gethTypes.Header, 1),
+		endpoints:   eth1Endpoints,
+		eth1ChainID: big.NewInt(5),
+	}
+	if err := s.connectToPowChain(); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		s.closeConnection()
+	}()
+	if s.currentEndpoint != s.endpoints[0] {
+		t.Fatal("Expected to connect to the primary endpoint")
+	}
+	headSub, err := s.reader.SubscribeNewHead(ctx, s.headerChan)
+	if err != nil {
+		t.Fatal(err)
+	}
+	waitForSubscriptions(t, primary, 1)
+
+	// The monitor sends the fallback endpoint to the run loop once the primary one is syncing.
+	primary.SetSyncing(true)
+	bestChan := make(chan *eth1Endpoint)
+	go s.monitorEndpoints(ctx, bestChan)
+	timeout := time.After(5 * time.Second)
+	for best := s.currentEndpoint; best != s.endpoints[1]; {
+		select {
+		case best = <-bestChan:
+		case <-timeout:
+			t.Fatal("Expected the monitor to select the fallback endpoint")
+		}
+	}
+
+	headSub, err = s.switchEndpoint(s.endpoints[1], headSub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer headSub.Unsubscribe()
+	if s.currentEndpoint != s.endpoints[1] {
+		t.Fatal("Expected to switch to the fallback endpoint")
+	}
+	waitForSubscriptions(t, primary, 0)
+	waitForSubscriptions(t, fallback, 1)
+
+	fallback.SetHead(42, uint64(time.Now().Unix()))
+	select {
+	case header := <-s.headerChan:
+		if header.Number.Uint64() != 42 {
+			t.Errorf("Expected head 42 of the fallback endpoint, received %d", header.Number.Uint64())
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Expected a head from the fallback endpoint")
+	}
+}
+
+func waitForSubscriptions(t *testing.T, backend *mockPOW.FakeEth1Backend, want int) {
+	for i := 0; i < 100; i++ {
+		if backend.Subscriptions() == want {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("Expected %d head subscriptions, received %d", want, backend.Subscriptions())
+}
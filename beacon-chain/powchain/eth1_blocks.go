@@ -0,0 +1,208 @@
+package powchain
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"sync"
+
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	protodb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+var (
+	eth1BlockStoreSize = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "powchain_vote_block_store_size",
+		Help: "The number of eth1 blocks kept to vote for eth1 data",
+	})
+	eth1BlockStoreReorgs = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "powchain_vote_block_store_reorgs",
+		Help: "The number of eth1 chain reorgs which replaced blocks kept to vote for eth1 data",
+	})
+)
+
+// headerBatchSize is the number of headers requested at once when filling the eth1 block store.
+const headerBatchSize = 100
+
+// eth1BlockStore keeps the chain of recent eth1 blocks which may be voted for as eth1 data.
+// The blocks are contiguous and ordered by number, the parent of every block being the block
+// before it, so that a reorg of the eth1 chain replaces the blocks it orphaned.
+type eth1BlockStore struct {
+	blocks []*protodb.ETH1BlockInfo
+	lock   sync.RWMutex
+}
+
+func newEth1BlockStore(blocks []*protodb.ETH1BlockInfo) *eth1BlockStore {
+	s := &eth1BlockStore{}
+	s.insert(blocks)
+	return s
+}
+
+// votingWindow is the time covered by the blocks which may be voted for during a voting period,
+// from the earliest candidate block of the period to its end.
+func votingWindow() uint64 {
+	cfg := params.BeaconConfig()
+	return cfg.SlotsPerEth1VotingPeriod*cfg.SecondsPerSlot + 2*cfg.SecondsPerETH1Block*cfg.Eth1FollowDistance
+}
+
+func headerToBlockInfo(header *gethTypes.Header) *protodb.ETH1BlockInfo {
+	return &protodb.ETH1BlockInfo{
+		Number:     header.Number.Uint64(),
+		Hash:       header.Hash().Bytes(),
+		ParentHash: header.ParentHash.Bytes(),
+		Timestamp:  header.Time,
+	}
+}
+
+// insert adds a chain of blocks, ordered by number, to the store. Stored blocks at the same
+// numbers or after them are replaced. If the first block does not extend the stored chain,
+// every stored block is replaced.
+func (s *eth1BlockStore) insert(blocks []*protodb.ETH1BlockInfo) {
+	if len(blocks) == 0 {
+		return
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	first := blocks[0]
+	if len(s.blocks) > 0 {
+		start := s.blocks[0].Number
+		switch {
+		case first.Number <= start || first.Number > start+uint64(len(s.blocks)):
+			s.blocks = nil
+		case !bytes.Equal(s.blocks[first.Number-start-1].Hash, first.ParentHash):
+			s.blocks = nil
+		default:
+			if replaced := s.blocks[first.Number-start:]; len(replaced) > 0 && !bytes.Equal(replaced[0].Hash, first.Hash) {
+				eth1BlockStoreReorgs.Inc()
+				log.WithField("blockNumber", first.Number).Warn("Eth1 chain reorg, replacing eth1 blocks kept for voting")
+			}
+			s.blocks = s.blocks[:first.Number-start]
+		}
+	}
+	s.blocks = append(s.blocks, blocks...)
+	eth1BlockStoreSize.Set(float64(len(s.blocks)))
+}
+
+// prune removes the blocks older than the given timestamp.
+func (s *eth1BlockStore) prune(minTime uint64) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	i := 0
+	for i < len(s.blocks) && s.blocks[i].Timestamp < minTime {
+		i++
+	}
+	s.blocks = s.blocks[i:]
+	eth1BlockStoreSize.Set(float64(len(s.blocks)))
+}
+
+// contains returns true if the block of the given number and hash is in the store.
+func (s *eth1BlockStore) contains(number uint64, hash []byte) bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	if len(s.blocks) == 0 || number < s.blocks[0].Number || number-s.blocks[0].Number >= uint64(len(s.blocks)) {
+		return false
+	}
+	return bytes.Equal(s.blocks[number-s.blocks[0].Number].Hash, hash)
+}
+
+// candidates returns the blocks which may be voted for during the voting period starting at
+// the given time, oldest first: the blocks produced between two and one follow distances
+// before the start of the period.
+func (s *eth1BlockStore) candidates(periodStart uint64) []*protodb.ETH1BlockInfo {
+	cfg := params.BeaconConfig()
+	followTime := cfg.SecondsPerETH1Block * cfg.Eth1FollowDistance
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	var candidates []*protodb.ETH1BlockInfo
+	for _, blk := range s.blocks {
+		if blk.Timestamp+followTime <= periodStart && blk.Timestamp+2*followTime >= periodStart {
+			candidates = append(candidates, blk)
+		}
+	}
+	return candidates
+}
+
+// all returns a copy of the blocks of the store, to be persisted.
+func (s *eth1BlockStore) all() []*protodb.ETH1BlockInfo {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	blocks := make([]*protodb.ETH1BlockInfo, len(s.blocks))
+	copy(blocks, s.blocks)
+	return blocks
+}
+
+// updateEth1Blocks adds a new head of the eth1 chain to the eth1 block store, along with the
+// ancestors of the head the store does not have yet, either because the node was offline or
+// because of a reorg. Only the blocks recent enough to be voted for are kept.
+func (s *Service) updateEth1Blocks(head *gethTypes.Header) error {
+	var minTime uint64
+	if window := votingWindow(); head.Time > window {
+		minTime = head.Time - window
+	}
+	blocks := []*protodb.ETH1BlockInfo{headerToBlockInfo(head)}
+	for {
+		first := blocks[0]
+		if first.Number == 0 || first.Timestamp < minTime || s.eth1Blocks.contains(first.Number-1, first.ParentHash) {
+			break
+		}
+		end := first.Number - 1
+		start := uint64(0)
+		if end >= headerBatchSize {
+			start = end - headerBatchSize + 1
+		}
+		headers, err := s.batchRequestHeaders(start, end)
+		if err != nil {
+			return errors.Wrap(err, "could not request eth1 headers")
+		}
+		if len(headers) == 0 {
+			return errors.Errorf("no eth1 headers received from %d to %d", start, end)
+		}
+		ancestors := make([]*protodb.ETH1BlockInfo, 0, len(headers))
+		for i := len(headers) - 1; i >= 0; i-- {
+			parent := blocks[0]
+			if len(ancestors) > 0 {
+				parent = ancestors[len(ancestors)-1]
+			}
+			blk := headerToBlockInfo(headers[i])
+			if !bytes.Equal(blk.Hash, parent.ParentHash) {
+				return errors.Errorf("eth1 header %d is not the parent of header %d, the chain changed while requesting it", blk.Number, parent.Number)
+			}
+			ancestors = append(ancestors, blk)
+			if blk.Timestamp < minTime || s.eth1Blocks.contains(blk.Number-1, blk.ParentHash) {
+				break
+			}
+		}
+		for i, j := 0, len(ancestors)-1; i < j; i, j = i+1, j-1 {
+			ancestors[i], ancestors[j] = ancestors[j], ancestors[i]
+		}
+		blocks = append(ancestors, blocks...)
+	}
+	s.eth1Blocks.insert(blocks)
+	s.eth1Blocks.prune(minTime)
+	return nil
+}
+
+// blockEth1Data returns the eth1 data of a block of the eth1 block store, once the deposit logs
+// up to the block were processed. The deposit count and root of the block are kept in the store,
+// so that the block can still be voted for while the eth1 node is offline.
+func (s *Service) blockEth1Data(ctx context.Context, blk *protodb.ETH1BlockInfo) (*protodb.ETH1BlockInfo, bool) {
+	s.eth1Blocks.lock.Lock()
+	defer s.eth1Blocks.lock.Unlock()
+	if len(blk.DepositRoot) == 0 {
+		if s.latestEth1Data.LastRequestedBlock < blk.Number {
+			return nil, false
+		}
+		count, root := s.depositCache.DepositsNumberAndRootAtHeight(ctx, big.NewInt(0).SetUint64(blk.Number))
+		if count == 0 {
+			return nil, false
+		}
+		blk.DepositCount = count
+		blk.DepositRoot = root[:]
+	}
+	return blk, true
+}
@@ -0,0 +1,85 @@
+package powchain
+
+import (
+	"testing"
+
+	protodb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// testEth1Chain returns n contiguous eth1 blocks from the given number, one second apart. Blocks
+// of different forks have different hashes.
+func testEth1Chain(start uint64, n uint64, fork byte, parentHash []byte) []*protodb.ETH1BlockInfo {
+	blocks := make([]*protodb.ETH1BlockInfo, 0, n)
+	for i := start; i < start+n; i++ {
+		blk := &protodb.ETH1BlockInfo{
+			Number:     i,
+			Hash:       []byte{byte(i), fork},
+			ParentHash: parentHash,
+			Timestamp:  1000 + i,
+		}
+		blocks = append(blocks, blk)
+		parentHash = blk.Hash
+	}
+	return blocks
+}
+
+func TestEth1BlockStore_Insert(t *testing.T) {
+	chain := testEth1Chain(10, 5, 0, nil)
+	store := newEth1BlockStore(chain[:3])
+	store.insert(chain[3:])
+	if len(store.all()) != 5 {
+		t.Fatalf("Expected 5 blocks, received %d", len(store.all()))
+	}
+	if !store.contains(14, chain[4].Hash) {
+		t.Error("Expected block 14 to be stored")
+	}
+
+	// A reorg replaces the blocks after the common ancestor.
+	fork := testEth1Chain(13, 3, 1, chain[2].Hash)
+	store.insert(fork)
+	blocks := store.all()
+	if len(blocks) != 6 || blocks[5].Number != 15 {
+		t.Fatalf("Expected blocks 10 to 15, received %d blocks", len(blocks))
+	}
+	if store.contains(13, chain[3].Hash) {
+		t.Error("Expected orphaned block 13 to be replaced")
+	}
+	if !store.contains(13, fork[0].Hash) || !store.contains(12, chain[2].Hash) {
+		t.Error("Expected fork to extend the common ancestor")
+	}
+
+	// Blocks which do not extend the stored chain replace all of it.
+	unrelated := testEth1Chain(20, 2, 2, []byte("unknown"))
+	store.insert(unrelated)
+	blocks = store.all()
+	if len(blocks) != 2 || blocks[0].Number != 20 {
+		t.Errorf("Expected blocks 20 to 21, received %d blocks", len(blocks))
+	}
+}
+
+func TestEth1BlockStore_Prune(t *testing.T) {
+	store := newEth1BlockStore(testEth1Chain(0, 10, 0, nil))
+	store.prune(1005)
+	blocks := store.all()
+	if len(blocks) != 5 || blocks[0].Number != 5 {
+		t.Errorf("Expected blocks 5 to 9, received %d blocks", len(blocks))
+	}
+}
+
+func TestEth1BlockStore_Candidates(t *testing.T) {
+	defer params.UseMainnetConfig()
+	cfg := params.MinimalSpecConfig()
+	cfg.SecondsPerETH1Block = 1
+	cfg.Eth1FollowDistance = 10
+	params.OverrideBeaconConfig(cfg)
+
+	store := newEth1BlockStore(testEth1Chain(0, 100, 0, nil))
+	candidates := store.candidates(1050)
+	if len(candidates) != 11 {
+		t.Fatalf("Expected 11 candidates, received %d", len(candidates))
+	}
+	if candidates[0].Number != 30 || candidates[10].Number != 40 {
+		t.Errorf("Expected candidates from block 30 to 40, received %d to %d", candidates[0].Number, candidates[10].Number)
+	}
+}
@@ -0,0 +1,121 @@
+package powchain
+
+import (
+	"bytes"
+	"context"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"go.opencensus.io/trace"
+)
+
+// Eth1VoteFetcher defines a struct that can choose the eth1 data to vote for in a beacon block.
+type Eth1VoteFetcher interface {
+	Eth1DataVote(ctx context.Context, beaconState *stateTrie.BeaconState, slot uint64) (*ethpb.Eth1Data, error)
+}
+
+// Eth1DataVote returns the eth1 data to vote for in a block proposed at the given slot on top of
+// the given state, following the get_eth1_vote rule of the honest validator spec. The candidates
+// are taken from the eth1 block store, so votes are still served while the eth1 node is offline.
+//
+// Spec pseudocode definition:
+//
+//	def get_eth1_vote(state: BeaconState, eth1_chain: Sequence[Eth1Block]) -> Eth1Data:
+//	 period_start = voting_period_start_time(state)
+//	 # `eth1_chain` abstractly represents all blocks in the eth1 chain sorted by ascending block height
+//	 votes_to_consider = [
+//	     get_eth1_data(block) for block in eth1_chain
+//	     if (
+//	         is_candidate_block(block, period_start)
+//	         # Ensure cannot move back to earlier deposit contract states
+//	         and get_eth1_data(block).deposit_count >= state.eth1_data.deposit_count
+//	     )
+//	 ]
+//
+//	 # Valid votes already cast during this period
+//	 valid_votes = [vote for vote in state.eth1_data_votes if vote in votes_to_consider]
+//
+//	 # Default vote on latest eth1 block data in the period range unless eth1 chain is not live
+//	 default_vote = votes_to_consider[len(votes_to_consider) - 1] if any(votes_to_consider) else state.eth1_data
+//
+//	 return max(
+//	     valid_votes,
+//	     key=lambda v: (valid_votes.count(v), -valid_votes.index(v)),  # Tiebreak by smallest distance
+//	     default=default_vote
+//	 )
+func (s *Service) Eth1DataVote(ctx context.Context, beaconState *stateTrie.BeaconState, slot uint64) (*ethpb.Eth1Data, error) {
+	ctx, span := trace.StartSpan(ctx, "powchain.Eth1DataVote")
+	defer span.End()
+
+	stateEth1Data := beaconState.Eth1Data()
+	var candidates []*ethpb.Eth1Data
+	for _, blk := range s.eth1Blocks.candidates(votingPeriodStartTime(beaconState.GenesisTime(), slot)) {
+		blk, ok := s.blockEth1Data(ctx, blk)
+		if !ok || blk.DepositCount < stateEth1Data.DepositCount {
+			continue
+		}
+		candidates = append(candidates, &ethpb.Eth1Data{
+			DepositRoot:  blk.DepositRoot,
+			DepositCount: blk.DepositCount,
+			BlockHash:    blk.Hash,
+		})
+	}
+	// The votes of the state only count if they were cast during the voting period of the slot.
+	var votes []*ethpb.Eth1Data
+	slotsPerPeriod := params.BeaconConfig().SlotsPerEth1VotingPeriod
+	if beaconState.Slot()/slotsPerPeriod == slot/slotsPerPeriod {
+		votes = beaconState.Eth1DataVotes()
+	}
+	return chooseEth1DataVote(candidates, votes, stateEth1Data), nil
+}
+
+// votingPeriodStartTime returns the start time of the voting period of the given slot.
+func votingPeriodStartTime(genesisTime uint64, slot uint64) uint64 {
+	cfg := params.BeaconConfig()
+	periodStartSlot := slot - slot%cfg.SlotsPerEth1VotingPeriod
+	return genesisTime + periodStartSlot*cfg.SecondsPerSlot
+}
+
+// chooseEth1DataVote returns the vote among the candidates which was cast the most in the voting
+// period, the earliest one winning ties. If none of the votes is a candidate, the latest candidate
+// is returned, or the eth1 data of the state if there are no candidates.
+func chooseEth1DataVote(candidates []*ethpb.Eth1Data, votes []*ethpb.Eth1Data, stateEth1Data *ethpb.Eth1Data) *ethpb.Eth1Data {
+	if len(candidates) == 0 {
+		return stateEth1Data
+	}
+	// The votes are tallied per candidate, remembering the first vote for each.
+	counts := make(map[int]int, len(candidates))
+	firstVote := make(map[int]int, len(candidates))
+	for v, vote := range votes {
+		i := eth1DataIndex(candidates, vote)
+		if i < 0 {
+			continue
+		}
+		if counts[i] == 0 {
+			firstVote[i] = v
+		}
+		counts[i]++
+	}
+	best := -1
+	for i, count := range counts {
+		if best < 0 || count > counts[best] || (count == counts[best] && firstVote[i] < firstVote[best]) {
+			best = i
+		}
+	}
+	if best < 0 {
+		return candidates[len(candidates)-1]
+	}
+	return candidates[best]
+}
+
+func eth1DataIndex(candidates []*ethpb.Eth1Data, vote *ethpb.Eth1Data) int {
+	for i, c := range candidates {
+		if c.DepositCount == vote.DepositCount &&
+			bytes.Equal(c.DepositRoot, vote.DepositRoot) &&
+			bytes.Equal(c.BlockHash, vote.BlockHash) {
+			return i
+		}
+	}
+	return -1
+}
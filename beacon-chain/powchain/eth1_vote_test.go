@@ -0,0 +1,195 @@
+package powchain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	protodb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func testEth1Data(n byte) *ethpb.Eth1Data {
+	return &ethpb.Eth1Data{
+		DepositRoot:  []byte{n},
+		DepositCount: uint64(n),
+		BlockHash:    []byte{n, 0},
+	}
+}
+
+func TestChooseEth1DataVote(t *testing.T) {
+	candidates := []*ethpb.Eth1Data{testEth1Data(1), testEth1Data(2), testEth1Data(3)}
+	stateEth1Data := testEth1Data(0)
+	tests := []struct {
+		name       string
+		candidates []*ethpb.Eth1Data
+		votes      []*ethpb.Eth1Data
+		want       *ethpb.Eth1Data
+	}{
+		{
+			name:       "no candidates",
+			candidates: nil,
+			votes:      []*ethpb.Eth1Data{testEth1Data(1)},
+			want:       stateEth1Data,
+		},
+		{
+			name:       "no valid votes",
+			candidates: candidates,
+			votes:      []*ethpb.Eth1Data{testEth1Data(4), testEth1Data(5)},
+			want:       testEth1Data(3),
+		},
+		{
+			name:       "majority",
+			candidates: candidates,
+			votes:      []*ethpb.Eth1Data{testEth1Data(1), testEth1Data(2), testEth1Data(2), testEth1Data(4), testEth1Data(4)},
+			want:       testEth1Data(2),
+		},
+		{
+			name:       "tie won by the earliest vote",
+			candidates: candidates,
+			votes:      []*ethpb.Eth1Data{testEth1Data(2), testEth1Data(1), testEth1Data(1), testEth1Data(2)},
+			want:       testEth1Data(2),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := chooseEth1DataVote(tt.candidates, tt.votes, stateEth1Data); !proto.Equal(got, tt.want) {
+				t.Errorf("Expected vote %v, received %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestEth1DataVote(t *testing.T) {
+	defer params.UseMainnetConfig()
+	cfg := params.MinimalSpecConfig()
+	cfg.SecondsPerETH1Block = 1
+	cfg.Eth1FollowDistance = 10
+	cfg.SlotsPerEth1VotingPeriod = 4
+	cfg.SecondsPerSlot = 10
+	params.OverrideBeaconConfig(cfg)
+
+	// Blocks 60 to 70 are the candidates of the voting period of slot 9, which starts at 1080.
+	blocks := testEth1Chain(50, 30, 0, nil)
+	for _, blk := range blocks {
+		blk.DepositCount = blk.Number
+		blk.DepositRoot = []byte{byte(blk.Number)}
+	}
+	// The deposits up to block 70 were not processed yet.
+	blocks[20].DepositRoot = nil
+	s := &Service{
+		eth1Blocks:     newEth1BlockStore(blocks),
+		latestEth1Data: &protodb.LatestETH1Data{LastRequestedBlock: 69},
+	}
+	blockEth1Data := func(blk *protodb.ETH1BlockInfo) *ethpb.Eth1Data {
+		return &ethpb.Eth1Data{DepositRoot: blk.DepositRoot, DepositCount: blk.DepositCount, BlockHash: blk.Hash}
+	}
+	votes := []*ethpb.Eth1Data{
+		blockEth1Data(blocks[16]),
+		blockEth1Data(blocks[17]),
+		blockEth1Data(blocks[17]),
+		// Votes with fewer deposits than the state are ignored.
+		blockEth1Data(blocks[12]),
+		blockEth1Data(blocks[12]),
+		blockEth1Data(blocks[12]),
+	}
+
+	tests := []struct {
+		name      string
+		stateSlot uint64
+		want      *ethpb.Eth1Data
+	}{
+		{
+			name:      "votes of the period",
+			stateSlot: 8,
+			want:      blockEth1Data(blocks[17]),
+		},
+		{
+			name:      "votes of a previous period",
+			stateSlot: 7,
+			want:      blockEth1Data(blocks[19]),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			beaconState, err := stateTrie.InitializeFromProto(&pb.BeaconState{
+				GenesisTime:   1000,
+				Slot:          tt.stateSlot,
+				Eth1Data:      &ethpb.Eth1Data{DepositCount: 65},
+				Eth1DataVotes: votes,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := s.Eth1DataVote(context.Background(), beaconState, 9)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !proto.Equal(got, tt.want) {
+				t.Errorf("Expected vote %v, received %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestEth1DataVote_NoCandidateBlocks(t *testing.T) {
+	defer params.UseMainnetConfig()
+	cfg := params.MinimalSpecConfig()
+	cfg.SecondsPerETH1Block = 1
+	cfg.Eth1FollowDistance = 10
+	cfg.SlotsPerEth1VotingPeriod = 4
+	cfg.SecondsPerSlot = 10
+	params.OverrideBeaconConfig(cfg)
+
+	// The latest block is too old to be a candidate of the voting period of slot 9, which
+	// starts at 1080.
+	blocks := testEth1Chain(0, 40, 0, nil)
+	for _, blk := range blocks {
+		blk.DepositCount = blk.Number
+		blk.DepositRoot = []byte{byte(blk.Number)}
+	}
+	s := &Service{
+		eth1Blocks:     newEth1BlockStore(blocks),
+		latestEth1Data: &protodb.LatestETH1Data{LastRequestedBlock: 39},
+	}
+	stateEth1Data := &ethpb.Eth1Data{
+		DepositRoot:  []byte{'r', 'o', 'o', 't'},
+		DepositCount: 10,
+		BlockHash:    []byte{'t', 'e', 's', 't'},
+	}
+	beaconState, err := stateTrie.InitializeFromProto(&pb.BeaconState{
+		GenesisTime:   1000,
+		Slot:          8,
+		Eth1Data:      stateEth1Data,
+		Eth1DataVotes: []*ethpb.Eth1Data{testEth1Data(20), testEth1Data(20)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := s.Eth1DataVote(context.Background(), beaconState, 9)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(got, stateEth1Data) {
+		t.Errorf("Expected the eth1 data of the state %v, received %v", stateEth1Data, got)
+	}
+}
+
+func Benchmark_Eth1DataVote(b *testing.B) {
+	candidates := make([]*ethpb.Eth1Data, 0, 256)
+	for i := 0; i < 256; i++ {
+		candidates = append(candidates, testEth1Data(byte(i)))
+	}
+	votes := make([]*ethpb.Eth1Data, 0, 1024)
+	for i := 0; i < 1024; i++ {
+		votes = append(votes, testEth1Data(byte(i)))
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		chooseEth1DataVote(candidates, votes, testEth1Data(0))
+	}
+}
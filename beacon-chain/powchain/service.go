@@ -3,11 +3,9 @@ package powchain
 
 import (
 	"context"
-	"fmt"
 	"math/big"
 	"reflect"
 	"runtime/debug"
-	"strings"
 	"sync"
 	"time"
 
@@ -89,6 +87,7 @@ type Chain interface {
 	ChainStartFetcher
 	ChainInfoFetcher
 	POWBlockFetcher
+	Eth1VoteFetcher
 }
 
 // Client defines a struct that combines all relevant ETH1.0 mainchain interactions required
@@ -129,15 +128,20 @@ type Service struct {
 	cancel                  context.CancelFunc
 	client                  Client
 	headerChan              chan *gethTypes.Header
-	eth1Endpoint            string
-	httpEndpoint            string
+	endpoints               []*eth1Endpoint // eth1 endpoints, by priority.
+	endpointsLock           sync.RWMutex    // guards the health of the endpoints.
+	endpointCheckLock       sync.Mutex      // serializes the health checks of the endpoints.
+	currentEndpoint         *eth1Endpoint
+	closeConnection         func()
+	eth1ChainID             *big.Int
 	stateNotifier           statefeed.Notifier
 	reader                  Reader
 	logger                  bind.ContractFilterer
 	httpLogger              bind.ContractFilterer
 	blockFetcher            RPCBlockFetcher
 	rpcClient               RPCClient
-	blockCache              *blockCache // cache to store block hash/block height.
+	blockCache              *blockCache     // cache to store block hash/block height.
+	eth1Blocks              *eth1BlockStore // recent eth1 blocks which may be voted for.
 	latestEth1Data          *protodb.LatestETH1Data
 	depositContractCaller   *contracts.DepositContractCaller
 	depositRoot             []byte
@@ -145,29 +149,37 @@ type Service struct {
 	chainStartData          *protodb.ChainStartData
 	beaconDB                db.HeadAccessDatabase // Circular dep if using HeadFetcher.
 	depositCache            *depositcache.DepositCache
-	lastReceivedMerkleIndex int64 // Keeps track of the last received index to prevent log spam.
+	lastReceivedMerkleIndex int64  // Keeps track of the last received index to prevent log spam.
+	newEth1Blocks           uint64 // Number of eth1 heads received, to periodically save the eth1 blocks.
 	runError                error
 	preGenesisState         *stateTrie.BeaconState
 }
 
 // Web3ServiceConfig defines a config struct for web3 service to use through its life cycle.
 type Web3ServiceConfig struct {
-	ETH1Endpoint    string
-	HTTPEndPoint    string
-	DepositContract common.Address
-	BeaconDB        db.HeadAccessDatabase
-	DepositCache    *depositcache.DepositCache
-	StateNotifier   statefeed.Notifier
+	ETH1Endpoint      string
+	HTTPEndPoint      string
+	FallbackEndpoints []Endpoint // Used in order when the endpoints above are unhealthy.
+	Eth1ChainID       uint64     // Required with fallback endpoints. If zero, the chain of the endpoint is not checked.
+	DepositContract   common.Address
+	BeaconDB          db.HeadAccessDatabase
+	DepositCache      *depositcache.DepositCache
+	StateNotifier     statefeed.Notifier
 }
 
 // NewService sets up a new instance with an ethclient when
 // given a web3 endpoint as a string in the config.
 func NewService(ctx context.Context, config *Web3ServiceConfig) (*Service, error) {
-	if !strings.HasPrefix(config.ETH1Endpoint, "ws") && !strings.HasPrefix(config.ETH1Endpoint, "ipc") {
-		return nil, fmt.Errorf(
-			"powchain service requires either an IPC or WebSocket endpoint, provided %s",
-			config.ETH1Endpoint,
-		)
+	// Without a chain ID, a fallback endpoint on another chain could be picked as the reference.
+	if len(config.FallbackEndpoints) > 0 && config.Eth1ChainID == 0 {
+		return nil, errors.New("an eth1 chain ID is required with fallback eth1 endpoints")
+	}
+	endpoints, err := newEth1Endpoints(append(
+		[]Endpoint{{Endpoint: config.ETH1Endpoint, HTTPEndpoint: config.HTTPEndPoint}},
+		config.FallbackEndpoints...,
+	))
+	if err != nil {
+		return nil, err
 	}
 	ctx, cancel := context.WithCancel(ctx)
 	depositTrie, err := trieutil.NewTrie(int(params.BeaconConfig().DepositContractTreeDepth))
@@ -181,11 +193,10 @@ func NewService(ctx context.Context, config *Web3ServiceConfig) (*Service, error
 	}
 
 	s := &Service{
-		ctx:          ctx,
-		cancel:       cancel,
-		headerChan:   make(chan *gethTypes.Header),
-		eth1Endpoint: config.ETH1Endpoint,
-		httpEndpoint: config.HTTPEndPoint,
+		ctx:        ctx,
+		cancel:     cancel,
+		headerChan: make(chan *gethTypes.Header),
+		endpoints:  endpoints,
 		latestEth1Data: &protodb.LatestETH1Data{
 			BlockHeight:        0,
 			BlockTime:          0,
@@ -193,6 +204,7 @@ func NewService(ctx context.Context, config *Web3ServiceConfig) (*Service, error
 			LastRequestedBlock: 0,
 		},
 		blockCache:             newBlockCache(),
+		eth1Blocks:             newEth1BlockStore(nil),
 		depositContractAddress: config.DepositContract,
 		stateNotifier:          config.StateNotifier,
 		depositTrie:            depositTrie,
@@ -206,6 +218,10 @@ func NewService(ctx context.Context, config *Web3ServiceConfig) (*Service, error
 		preGenesisState:         genState,
 	}
 
+	if config.Eth1ChainID != 0 {
+		s.eth1ChainID = new(big.Int).SetUint64(config.Eth1ChainID)
+	}
+
 	eth1Data, err := config.BeaconDB.PowchainData(ctx)
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to retrieve eth1 data")
@@ -220,6 +236,7 @@ func NewService(ctx context.Context, config *Web3ServiceConfig) (*Service, error
 			}
 		}
 		s.latestEth1Data = eth1Data.CurrentEth1Data
+		s.eth1Blocks = newEth1BlockStore(eth1Data.Eth1Blocks)
 		s.lastReceivedMerkleIndex = int64(len(s.depositTrie.Items()) - 1)
 		if err := s.initDepositCaches(ctx, eth1Data.DepositContainers); err != nil {
 			return nil, errors.Wrap(err, "could not initialize caches")
@@ -332,36 +349,14 @@ func (s *Service) AreAllDepositsProcessed() (bool, error) {
 	return true, nil
 }
 
+// connectToPowChain connects the service to the best eth1 endpoint.
 func (s *Service) connectToPowChain() error {
-	powClient, httpClient, rpcClient, err := s.dialETH1Nodes()
-	if err != nil {
-		return errors.Wrap(err, "could not dial eth1 nodes")
+	s.checkEndpoints(s.ctx)
+	e := s.bestEndpoint()
+	if e == nil {
+		return errors.New("no usable eth1 endpoint")
 	}
-
-	depositContractCaller, err := contracts.NewDepositContractCaller(s.depositContractAddress, httpClient)
-	if err != nil {
-		return errors.Wrap(err, "could not create deposit contract caller")
-	}
-
-	s.initializeConnection(powClient, httpClient, rpcClient, depositContractCaller)
-	return nil
-}
-
-func (s *Service) dialETH1Nodes() (*ethclient.Client, *ethclient.Client, *gethRPC.Client, error) {
-	httpRPCClient, err := gethRPC.Dial(s.httpEndpoint)
-	if err != nil {
-		return nil, nil, nil, err
-	}
-	httpClient := ethclient.NewClient(httpRPCClient)
-
-	rpcClient, err := gethRPC.Dial(s.eth1Endpoint)
-	if err != nil {
-		httpClient.Close()
-		return nil, nil, nil, err
-	}
-	powClient := ethclient.NewClient(rpcClient)
-
-	return powClient, httpClient, httpRPCClient, nil
+	return s.connectToEndpoint(e)
 }
 
 func (s *Service) initializeConnection(powClient *ethclient.Client,
@@ -380,9 +375,6 @@ func (s *Service) waitForConnection() {
 	err := s.connectToPowChain()
 	if err == nil {
 		s.connectedETH1 = true
-		log.WithFields(logrus.Fields{
-			"endpoint": s.eth1Endpoint,
-		}).Info("Connected to eth1 proof-of-work chain")
 		return
 	}
 	log.WithError(err).Error("Could not connect to powchain endpoint")
@@ -393,9 +385,6 @@ func (s *Service) waitForConnection() {
 			err := s.connectToPowChain()
 			if err == nil {
 				s.connectedETH1 = true
-				log.WithFields(logrus.Fields{
-					"endpoint": s.eth1Endpoint,
-				}).Info("Connected to eth1 proof-of-work chain")
 				ticker.Stop()
 				return
 			}
@@ -464,6 +453,17 @@ func (s *Service) processSubscribedHeaders(header *gethTypes.Header) {
 		s.runError = err
 		log.Errorf("Unable to add block data to cache %v", err)
 	}
+
+	if err := s.updateEth1Blocks(header); err != nil {
+		log.WithError(err).Error("Unable to update eth1 blocks kept for voting")
+		return
+	}
+	s.newEth1Blocks++
+	if s.newEth1Blocks%eth1BlocksSavingInterval == 0 {
+		if err := s.savePowchainData(s.ctx); err != nil {
+			log.WithError(err).Error("Unable to save eth1 data")
+		}
+	}
 }
 
 // batchRequestHeaders requests the block range specified in the arguments. Instead of requesting
@@ -520,11 +520,8 @@ func safelyHandlePanic() {
 func (s *Service) handleDelayTicker() {
 	defer safelyHandlePanic()
 
-	// use a 5 minutes timeout for block time, because the max mining time is 278 sec (block 7208027)
-	// (analyzed the time of the block from 2018-09-01 to 2019-02-13)
-	fiveMinutesTimeout := time.Now().Add(-5 * time.Minute)
 	// check that web3 client is syncing
-	if time.Unix(int64(s.latestEth1Data.BlockTime), 0).Before(fiveMinutesTimeout) && roughtime.Now().Second()%15 == 0 {
+	if time.Unix(int64(s.latestEth1Data.BlockTime), 0).Before(time.Now().Add(-staleHeadThreshold)) && roughtime.Now().Second()%15 == 0 {
 		log.Warn("eth1 client is not syncing")
 	}
 	if !s.chainStartData.Chainstarted {
@@ -584,9 +581,16 @@ func (s *Service) run(done <-chan struct{}) {
 	}
 
 	ticker := time.NewTicker(1 * time.Second)
-	defer headSub.Unsubscribe()
+	defer func() {
+		headSub.Unsubscribe()
+	}()
 	defer ticker.Stop()
 
+	monitorCtx, cancelMonitor := context.WithCancel(s.ctx)
+	defer cancelMonitor()
+	bestEndpointChan := make(chan *eth1Endpoint)
+	go s.monitorEndpoints(monitorCtx, bestEndpointChan)
+
 	for {
 		select {
 		case <-done:
@@ -612,6 +616,16 @@ func (s *Service) run(done <-chan struct{}) {
 			}
 		case <-ticker.C:
 			s.handleDelayTicker()
+		case best := <-bestEndpointChan:
+			if best == s.currentEndpoint {
+				break
+			}
+			headSub, err = s.switchEndpoint(best, headSub)
+			if err != nil {
+				log.WithError(err).Error("Unable to re-subscribe to incoming ETH1.0 chain headers")
+				s.runError = err
+				return
+			}
 		}
 	}
 }
@@ -189,6 +189,24 @@ func TestNewWeb3Service_OK(t *testing.T) {
 	}); err != nil {
 		t.Errorf("passing in an ipc endpoint should not throw error, received %v", err)
 	}
+	fallback := Endpoint{Endpoint: "ws://127.0.0.2", HTTPEndpoint: "http://127.0.0.2"}
+	if _, err = NewService(ctx, &Web3ServiceConfig{
+		ETH1Endpoint:      "ws://127.0.0.1",
+		FallbackEndpoints: []Endpoint{fallback},
+		DepositContract:   common.Address{},
+		BeaconDB:          beaconDB,
+	}); err == nil {
+		t.Error("passing in fallback endpoints without a chain ID should throw an error, received nil")
+	}
+	if _, err = NewService(ctx, &Web3ServiceConfig{
+		ETH1Endpoint:      "ws://127.0.0.1",
+		FallbackEndpoints: []Endpoint{fallback},
+		Eth1ChainID:       5,
+		DepositContract:   common.Address{},
+		BeaconDB:          beaconDB,
+	}); err != nil {
+		t.Errorf("passing in fallback endpoints with a chain ID should not throw error, received %v", err)
+	}
 }
 
 func TestStart_OK(t *testing.T) {
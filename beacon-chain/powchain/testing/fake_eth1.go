@@ -0,0 +1,188 @@
+package testing
+
+import (
+	"context"
+	"math/big"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	gethRPC "github.com/ethereum/go-ethereum/rpc"
+	"github.com/prysmaticlabs/prysm/shared/roughtime"
+)
+
+// FakeEth1Backend defines a fake eth1 node, of which the chain ID, sync status and head can be
+// changed to check how the powchain service handles the health of its eth1 endpoints.
+type FakeEth1Backend struct {
+	lock          sync.RWMutex
+	chainID       *big.Int
+	syncing       bool
+	head          *gethTypes.Header
+	err           error
+	subscriptions map[gethRPC.ID]*gethRPC.Notifier
+}
+
+// NewFakeEth1Backend returns a synced fake eth1 node on the given chain, with a recent head.
+func NewFakeEth1Backend(chainID uint64) *FakeEth1Backend {
+	return &FakeEth1Backend{
+		chainID:       new(big.Int).SetUint64(chainID),
+		head:          fakeHeader(1, uint64(roughtime.Now().Unix())),
+		subscriptions: make(map[gethRPC.ID]*gethRPC.Notifier),
+	}
+}
+
+// fakeHeader returns a header with the fields required by the JSON-RPC API set.
+func fakeHeader(number uint64, time uint64) *gethTypes.Header {
+	return &gethTypes.Header{
+		Number:     new(big.Int).SetUint64(number),
+		Time:       time,
+		Difficulty: big.NewInt(1),
+		Extra:      []byte{},
+	}
+}
+
+// Serve serves the JSON-RPC API of the node over HTTP and WebSocket, like an eth1 node the
+// powchain service connects to, until the returned function is called.
+func (f *FakeEth1Backend) Serve() (httpEndpoint string, wsEndpoint string, stop func(), err error) {
+	server := gethRPC.NewServer()
+	if err := server.RegisterName("eth", &fakeEthAPI{backend: f}); err != nil {
+		return "", "", nil, err
+	}
+	httpServer := httptest.NewServer(server)
+	wsServer := httptest.NewServer(server.WebsocketHandler([]string{"*"}))
+	stop = func() {
+		server.Stop()
+		wsServer.Close()
+		httpServer.Close()
+	}
+	return httpServer.URL, "ws" + strings.TrimPrefix(wsServer.URL, "http"), stop, nil
+}
+
+// Subscriptions returns the number of active head subscriptions to the node.
+func (f *FakeEth1Backend) Subscriptions() int {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+	return len(f.subscriptions)
+}
+
+// SetSyncing sets whether the node is syncing.
+func (f *FakeEth1Backend) SetSyncing(syncing bool) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.syncing = syncing
+}
+
+// SetHead sets the head block of the node, and sends it to the head subscriptions.
+func (f *FakeEth1Backend) SetHead(number uint64, time uint64) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.head = fakeHeader(number, time)
+	for id, notifier := range f.subscriptions {
+		if err := notifier.Notify(id, f.head); err != nil {
+			delete(f.subscriptions, id)
+		}
+	}
+}
+
+// SetError sets the error returned by every request, nil making the node reachable again.
+func (f *FakeEth1Backend) SetError(err error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.err = err
+}
+
+// ChainID --
+func (f *FakeEth1Backend) ChainID(_ context.Context) (*big.Int, error) {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+	if f.err != nil {
+		return nil, f.err
+	}
+	return new(big.Int).Set(f.chainID), nil
+}
+
+// SyncProgress --
+func (f *FakeEth1Backend) SyncProgress(_ context.Context) (*ethereum.SyncProgress, error) {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+	if f.err != nil {
+		return nil, f.err
+	}
+	if !f.syncing {
+		return nil, nil
+	}
+	return &ethereum.SyncProgress{
+		CurrentBlock: f.head.Number.Uint64(),
+		HighestBlock: f.head.Number.Uint64() + 100,
+	}, nil
+}
+
+// HeaderByNumber returns the head of the node, whatever the number.
+func (f *FakeEth1Backend) HeaderByNumber(_ context.Context, _ *big.Int) (*gethTypes.Header, error) {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+	if f.err != nil {
+		return nil, f.err
+	}
+	return gethTypes.CopyHeader(f.head), nil
+}
+
+// fakeEthAPI serves the eth namespace of the JSON-RPC API of a fake node, as far as it is used
+// to check the health of an endpoint and subscribe to its heads.
+type fakeEthAPI struct {
+	backend *FakeEth1Backend
+}
+
+// ChainId serves eth_chainId.
+func (api *fakeEthAPI) ChainId(ctx context.Context) (*hexutil.Big, error) {
+	chainID, err := api.backend.ChainID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return (*hexutil.Big)(chainID), nil
+}
+
+// Syncing serves eth_syncing.
+func (api *fakeEthAPI) Syncing(ctx context.Context) (interface{}, error) {
+	progress, err := api.backend.SyncProgress(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if progress == nil {
+		return false, nil
+	}
+	return map[string]interface{}{
+		"startingBlock": hexutil.Uint64(progress.StartingBlock),
+		"currentBlock":  hexutil.Uint64(progress.CurrentBlock),
+		"highestBlock":  hexutil.Uint64(progress.HighestBlock),
+		"pulledStates":  hexutil.Uint64(progress.PulledStates),
+		"knownStates":   hexutil.Uint64(progress.KnownStates),
+	}, nil
+}
+
+// GetBlockByNumber serves eth_getBlockByNumber with the head of the node, whatever the number.
+func (api *fakeEthAPI) GetBlockByNumber(ctx context.Context, _ string, _ bool) (*gethTypes.Header, error) {
+	return api.backend.HeaderByNumber(ctx, nil)
+}
+
+// NewHeads serves the newHeads subscription of eth_subscribe.
+func (api *fakeEthAPI) NewHeads(ctx context.Context) (*gethRPC.Subscription, error) {
+	notifier, ok := gethRPC.NotifierFromContext(ctx)
+	if !ok {
+		return nil, gethRPC.ErrNotificationsUnsupported
+	}
+	sub := notifier.CreateSubscription()
+	api.backend.lock.Lock()
+	api.backend.subscriptions[sub.ID] = notifier
+	api.backend.lock.Unlock()
+	go func() {
+		<-sub.Err()
+		api.backend.lock.Lock()
+		delete(api.backend.subscriptions, sub.ID)
+		api.backend.lock.Unlock()
+	}()
+	return sub, nil
+}
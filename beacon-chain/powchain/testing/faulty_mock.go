@@ -86,3 +86,8 @@ func (f *FaultyMockPOWChain) ClearPreGenesisData() {
 func (f *FaultyMockPOWChain) IsConnectedToETH1() bool {
 	return true
 }
+
+// Eth1DataVote --
+func (f *FaultyMockPOWChain) Eth1DataVote(_ context.Context, _ *beaconstate.BeaconState, _ uint64) (*ethpb.Eth1Data, error) {
+	return nil, errors.New("failed")
+}
@@ -110,6 +110,14 @@ func (m *POWChain) IsConnectedToETH1() bool {
 	return true
 }
 
+// Eth1DataVote --
+func (m *POWChain) Eth1DataVote(_ context.Context, beaconState *beaconstate.BeaconState, _ uint64) (*ethpb.Eth1Data, error) {
+	if m.Eth1Data != nil {
+		return m.Eth1Data, nil
+	}
+	return beaconState.Eth1Data(), nil
+}
+
 // RPCClient defines the mock rpc client.
 type RPCClient struct {
 	Backend *backends.SimulatedBackend
@@ -0,0 +1,176 @@
+package debug
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+)
+
+var marshaler = &jsonpb.Marshaler{}
+
+// fieldPath represents a requested state field such as "balances" or "validators[3]".
+type fieldPath struct {
+	name     string
+	index    uint64
+	hasIndex bool
+}
+
+// This parses a field path of the form "name" or "name[index]".
+func parseFieldPath(path string) (*fieldPath, error) {
+	open := strings.Index(path, "[")
+	if open < 0 {
+		if path == "" {
+			return nil, errors.New("empty field path")
+		}
+		return &fieldPath{name: path}, nil
+	}
+	if !strings.HasSuffix(path, "]") || open == 0 {
+		return nil, fmt.Errorf("malformed field path %q", path)
+	}
+	index, err := strconv.ParseUint(path[open+1:len(path)-1], 10, 64)
+	if err != nil {
+		return nil, errors.Wrapf(err, "malformed index in field path %q", path)
+	}
+	return &fieldPath{name: path[:open], index: index, hasIndex: true}, nil
+}
+
+// This returns the value of the state field referred to by the input path.
+func stateFieldValue(st *pbp2p.BeaconState, path string) (interface{}, error) {
+	fp, err := parseFieldPath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var val interface{}
+	switch fp.name {
+	case "genesis_time":
+		val = st.GenesisTime
+	case "slot":
+		val = st.Slot
+	case "fork":
+		val = st.Fork
+	case "latest_block_header":
+		val = st.LatestBlockHeader
+	case "block_roots":
+		val = st.BlockRoots
+	case "state_roots":
+		val = st.StateRoots
+	case "historical_roots":
+		val = st.HistoricalRoots
+	case "eth1_data":
+		val = st.Eth1Data
+	case "eth1_data_votes":
+		val = st.Eth1DataVotes
+	case "eth1_deposit_index":
+		val = st.Eth1DepositIndex
+	case "validators":
+		val = st.Validators
+	case "balances":
+		val = st.Balances
+	case "randao_mixes":
+		val = st.RandaoMixes
+	case "slashings":
+		val = st.Slashings
+	case "previous_epoch_attestations":
+		val = st.PreviousEpochAttestations
+	case "current_epoch_attestations":
+		val = st.CurrentEpochAttestations
+	case "justification_bits":
+		val = st.JustificationBits
+	case "previous_justified_checkpoint":
+		val = st.PreviousJustifiedCheckpoint
+	case "current_justified_checkpoint":
+		val = st.CurrentJustifiedCheckpoint
+	case "finalized_checkpoint":
+		val = st.FinalizedCheckpoint
+	default:
+		return nil, fmt.Errorf("unknown state field %q", fp.name)
+	}
+	if !fp.hasIndex {
+		return val, nil
+	}
+
+	outOfRange := func(length int) error {
+		return fmt.Errorf("index %d out of range for field %s of length %d", fp.index, fp.name, length)
+	}
+	switch list := val.(type) {
+	case [][]byte:
+		if fp.index >= uint64(len(list)) {
+			return nil, outOfRange(len(list))
+		}
+		return list[fp.index], nil
+	case []uint64:
+		if fp.index >= uint64(len(list)) {
+			return nil, outOfRange(len(list))
+		}
+		return list[fp.index], nil
+	case []*ethpb.Validator:
+		if fp.index >= uint64(len(list)) {
+			return nil, outOfRange(len(list))
+		}
+		return list[fp.index], nil
+	case []*ethpb.Eth1Data:
+		if fp.index >= uint64(len(list)) {
+			return nil, outOfRange(len(list))
+		}
+		return list[fp.index], nil
+	case []*pbp2p.PendingAttestation:
+		if fp.index >= uint64(len(list)) {
+			return nil, outOfRange(len(list))
+		}
+		return list[fp.index], nil
+	default:
+		return nil, fmt.Errorf("field %s is not a list or vector", fp.name)
+	}
+}
+
+// This returns the Merkle proof of the state field referred to by the input path.
+func fieldProof(ctx context.Context, st *state.BeaconState, path string) (*state.MerkleProof, error) {
+	fp, err := parseFieldPath(path)
+	if err != nil {
+		return nil, err
+	}
+	if fp.hasIndex {
+		return st.ElementProof(ctx, fp.name, fp.index)
+	}
+	return st.FieldProof(ctx, fp.name)
+}
+
+// This encodes a state or state field value with the requested encoding.
+func encodeValue(val interface{}, encoding pbrpc.StateEncoding) ([]byte, error) {
+	switch encoding {
+	case pbrpc.StateEncoding_SSZ:
+		switch v := val.(type) {
+		case [][]byte:
+			// Lists and vectors of roots are fixed size elements, which serialize as their concatenation.
+			return bytes.Join(v, nil), nil
+		case []byte:
+			return v, nil
+		default:
+			return ssz.Marshal(val)
+		}
+	case pbrpc.StateEncoding_JSON:
+		if msg, ok := val.(proto.Message); ok {
+			var buf bytes.Buffer
+			if err := marshaler.Marshal(&buf, msg); err != nil {
+				return nil, err
+			}
+			return buf.Bytes(), nil
+		}
+		return json.Marshal(val)
+	default:
+		return nil, fmt.Errorf("unknown encoding %v", encoding)
+	}
+}
@@ -0,0 +1,153 @@
+package debug
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/emicklei/dot"
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetForkChoice returns the block nodes of the proto-array fork choice store along with the
+// latest vote of each validator, optionally rendered as a DOT or mermaid graph.
+func (ds *Server) GetForkChoice(_ context.Context, req *pbrpc.ForkChoiceRequest) (*pbrpc.ForkChoiceResponse, error) {
+	store := ds.ForkChoiceFetcher.ForkChoiceStore()
+	if store == nil {
+		return nil, status.Error(codes.Unavailable, "Fork choice store is not initialized")
+	}
+	// The nodes and votes are copied by the store under its locks, so that the response is
+	// not torn by blocks and attestations processed in the meantime.
+	nodes := store.Nodes()
+
+	res := &pbrpc.ForkChoiceResponse{
+		JustifiedEpoch: store.JustifiedEpoch(),
+		FinalizedEpoch: store.FinalizedEpoch(),
+		Nodes:          make([]*pbrpc.ForkChoiceNode, len(nodes)),
+	}
+	for i, n := range nodes {
+		root := n.Root()
+		res.Nodes[i] = &pbrpc.ForkChoiceNode{
+			Slot:           n.Slot,
+			Root:           root[:],
+			ParentRoot:     nodeRoot(nodes, n.Parent),
+			JustifiedEpoch: n.JustifiedEpoch(),
+			FinalizedEpoch: n.FinalizedEpoch(),
+			Weight:         n.Weight,
+			BestChild:      nodeRoot(nodes, n.BestChild()),
+			BestDescendant: nodeRoot(nodes, n.BestDescendent),
+		}
+	}
+
+	if !req.ExcludeVotes {
+		for i, v := range store.Votes() {
+			currentRoot, nextRoot := v.CurrentRoot(), v.NextRoot()
+			// The votes are allocated up to the highest validator index which voted, the
+			// validators which did not vote yet are left out.
+			if currentRoot == params.BeaconConfig().ZeroHash && nextRoot == params.BeaconConfig().ZeroHash {
+				continue
+			}
+			res.Votes = append(res.Votes, &pbrpc.ForkChoiceVote{
+				ValidatorIndex: uint64(i),
+				CurrentRoot:    currentRoot[:],
+				NextRoot:       nextRoot[:],
+				NextEpoch:      v.NextEpoch(),
+			})
+		}
+	}
+
+	switch req.GraphFormat {
+	case pbrpc.ForkChoiceGraphFormat_NO_GRAPH:
+	case pbrpc.ForkChoiceGraphFormat_DOT:
+		res.Graph = dotGraph(nodes)
+	case pbrpc.ForkChoiceGraphFormat_MERMAID:
+		res.Graph = mermaidGraph(nodes)
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "Unknown graph format %v", req.GraphFormat)
+	}
+	return res, nil
+}
+
+// nodeRoot returns the root of the node at the given index, or nil if there is no such node.
+func nodeRoot(nodes []*protoarray.Node, index uint64) []byte {
+	if index >= uint64(len(nodes)) {
+		return nil
+	}
+	root := nodes[index].Root()
+	return root[:]
+}
+
+// headIndex returns the index of the head of the store, which is the best descendant of its
+// oldest node, or the oldest node itself if it has no viable descendant.
+func headIndex(nodes []*protoarray.Node) uint64 {
+	if len(nodes) == 0 || nodes[0].BestDescendent >= uint64(len(nodes)) {
+		return 0
+	}
+	return nodes[0].BestDescendent
+}
+
+func nodeLabel(n *protoarray.Node, sep string) string {
+	root := n.Root()
+	return strings.Join([]string{
+		fmt.Sprintf("slot: %d", n.Slot),
+		fmt.Sprintf("root: %#x", bytesutil.Trunc(root[:])),
+		fmt.Sprintf("weight: %d", n.Weight/params.BeaconConfig().GweiPerEth),
+		fmt.Sprintf("justified: %d", n.JustifiedEpoch()),
+		fmt.Sprintf("finalized: %d", n.FinalizedEpoch()),
+	}, sep)
+}
+
+// dotGraph renders the nodes as a graphviz graph, each node pointing to its parent. The head
+// is colored green and the best child of each node is linked with a bold edge.
+func dotGraph(nodes []*protoarray.Node) string {
+	graph := dot.NewGraph(dot.Directed)
+	graph.Attr("rankdir", "RL")
+	graph.Attr("labeljust", "l")
+
+	dotNodes := make([]dot.Node, len(nodes))
+	for i, n := range nodes {
+		dotNodes[i] = graph.Node(fmt.Sprintf("%d", i)).Box().Attr("label", nodeLabel(n, "\n"))
+	}
+	if len(nodes) > 0 {
+		dotNodes[headIndex(nodes)].Attr("color", "green")
+	}
+	for i, n := range nodes {
+		if n.Parent >= uint64(len(nodes)) {
+			continue
+		}
+		edge := graph.Edge(dotNodes[i], dotNodes[n.Parent])
+		if nodes[n.Parent].BestChild() == uint64(i) {
+			edge.Attr("style", "bold")
+		}
+	}
+	return graph.String()
+}
+
+// mermaidGraph renders the nodes as a mermaid flowchart, each node pointing to its parent. The
+// head is colored green and the best child of each node is linked with a thick edge.
+func mermaidGraph(nodes []*protoarray.Node) string {
+	var b strings.Builder
+	b.WriteString("graph RL\n")
+	for i, n := range nodes {
+		fmt.Fprintf(&b, "  n%d[\"%s\"]\n", i, nodeLabel(n, "<br/>"))
+	}
+	for i, n := range nodes {
+		if n.Parent >= uint64(len(nodes)) {
+			continue
+		}
+		arrow := "-->"
+		if nodes[n.Parent].BestChild() == uint64(i) {
+			arrow = "==>"
+		}
+		fmt.Fprintf(&b, "  n%d %s n%d\n", i, arrow, n.Parent)
+	}
+	if len(nodes) > 0 {
+		fmt.Fprintf(&b, "  style n%d stroke:green,stroke-width:2px\n", headIndex(nodes))
+	}
+	return b.String()
+}
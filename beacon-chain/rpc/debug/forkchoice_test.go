@@ -0,0 +1,126 @@
+package debug
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// setupForkChoice returns a fork choice store with a genesis block and two competing children,
+// the block of slot 2 being the head with the votes of validators 0 and 1.
+func setupForkChoice(t *testing.T) (*protoarray.ForkChoice, [][32]byte) {
+	ctx := context.Background()
+	roots := [][32]byte{{'g'}, {'a'}, {'b'}}
+	store := protoarray.New(0, 0, roots[0])
+	if err := store.ProcessBlock(ctx, 0, roots[0], params.BeaconConfig().ZeroHash, 0, 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.ProcessBlock(ctx, 1, roots[1], roots[0], 0, 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.ProcessBlock(ctx, 2, roots[2], roots[0], 0, 0); err != nil {
+		t.Fatal(err)
+	}
+	store.ProcessAttestation(ctx, []uint64{0, 1}, roots[2], 1)
+	store.ProcessAttestation(ctx, []uint64{3}, roots[1], 1)
+	balances := []uint64{32e9, 32e9, 32e9, 32e9}
+	head, err := store.Head(ctx, 0, roots[0], balances, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if head != roots[2] {
+		t.Fatalf("Expected head %#x, received %#x", roots[2], head)
+	}
+	return store, roots
+}
+
+func TestServer_GetForkChoice(t *testing.T) {
+	store, roots := setupForkChoice(t)
+	server := &Server{ForkChoiceFetcher: &mock.ChainService{ForkChoice: store}}
+
+	res, err := server.GetForkChoice(context.Background(), &pbrpc.ForkChoiceRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Nodes) != 3 {
+		t.Fatalf("Expected 3 nodes, received %d", len(res.Nodes))
+	}
+	genesis, head := res.Nodes[0], res.Nodes[2]
+	if len(genesis.ParentRoot) != 0 {
+		t.Errorf("Expected no parent for the genesis node, received %#x", genesis.ParentRoot)
+	}
+	if !bytes.Equal(genesis.BestChild, roots[2][:]) || !bytes.Equal(genesis.BestDescendant, roots[2][:]) {
+		t.Errorf("Expected the head as best child and descendant of genesis, received %#x and %#x", genesis.BestChild, genesis.BestDescendant)
+	}
+	if !bytes.Equal(head.ParentRoot, roots[0][:]) {
+		t.Errorf("Expected genesis as parent of the head, received %#x", head.ParentRoot)
+	}
+	if head.Weight != 64e9 || genesis.Weight != 96e9 {
+		t.Errorf("Expected weights 64e9 and 96e9, received %d and %d", head.Weight, genesis.Weight)
+	}
+
+	// Validator 2 did not vote.
+	if len(res.Votes) != 3 {
+		t.Fatalf("Expected 3 votes, received %d", len(res.Votes))
+	}
+	if v := res.Votes[2]; v.ValidatorIndex != 3 || !bytes.Equal(v.CurrentRoot, roots[1][:]) || v.NextEpoch != 1 {
+		t.Errorf("Unexpected vote of validator 3: %v", v)
+	}
+	if res.Graph != "" {
+		t.Errorf("Expected no graph, received %s", res.Graph)
+	}
+
+	res, err = server.GetForkChoice(context.Background(), &pbrpc.ForkChoiceRequest{ExcludeVotes: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Votes) != 0 {
+		t.Errorf("Expected votes to be excluded, received %d", len(res.Votes))
+	}
+}
+
+func TestServer_GetForkChoice_Graph(t *testing.T) {
+	store, _ := setupForkChoice(t)
+	server := &Server{ForkChoiceFetcher: &mock.ChainService{ForkChoice: store}}
+
+	res, err := server.GetForkChoice(context.Background(), &pbrpc.ForkChoiceRequest{
+		GraphFormat: pbrpc.ForkChoiceGraphFormat_DOT,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(res.Graph, "digraph") || !strings.Contains(res.Graph, "green") {
+		t.Errorf("Unexpected DOT graph: %s", res.Graph)
+	}
+
+	res, err = server.GetForkChoice(context.Background(), &pbrpc.ForkChoiceRequest{
+		GraphFormat: pbrpc.ForkChoiceGraphFormat_MERMAID,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"graph RL", "n1 --> n0", "n2 ==> n0", "style n2 stroke:green"} {
+		if !strings.Contains(res.Graph, want) {
+			t.Errorf("Expected mermaid graph to contain %q, received %s", want, res.Graph)
+		}
+	}
+
+	if _, err := server.GetForkChoice(context.Background(), &pbrpc.ForkChoiceRequest{GraphFormat: 10}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected invalid argument for an unknown graph format, received %v", err)
+	}
+}
+
+func TestServer_GetForkChoice_NoStore(t *testing.T) {
+	server := &Server{ForkChoiceFetcher: &mock.ChainService{}}
+	if _, err := server.GetForkChoice(context.Background(), &pbrpc.ForkChoiceRequest{}); status.Code(err) != codes.Unavailable {
+		t.Errorf("Expected unavailable without a fork choice store, received %v", err)
+	}
+}
@@ -0,0 +1,19 @@
+// Package debug defines a gRPC server implementation of the debug service which
+// exposes internal beacon node data, such as historical beacon states or the fork
+// choice store, for research and tooling.
+package debug
+
+import (
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+)
+
+// Server defines a server implementation of the gRPC Debug service,
+// providing RPC endpoints to access internal beacon node data.
+type Server struct {
+	BeaconDB          db.ReadOnlyDatabase
+	HeadFetcher       blockchain.HeadFetcher
+	ForkChoiceFetcher blockchain.ForkChoiceFetcher
+	StateGen          *stategen.State
+}
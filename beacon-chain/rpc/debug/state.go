@@ -0,0 +1,290 @@
+package debug
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// stateChunkSize is the maximum size in bytes of a streamed state chunk. It is kept
+// well below the default 4MB gRPC client message size limit.
+const stateChunkSize = 1 << 20
+
+// GetBeaconState retrieves a historical beacon state by slot, block root or state root.
+// The full state is returned unless fields are selected in the request.
+func (ds *Server) GetBeaconState(
+	ctx context.Context, req *pbrpc.BeaconStateRequest,
+) (*pbrpc.BeaconStateResponse, error) {
+	st, err := ds.stateForRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	root, err := st.HashTreeRoot(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not compute state root: %v", err)
+	}
+	res := &pbrpc.BeaconStateResponse{
+		Slot:      st.Slot(),
+		StateRoot: root[:],
+	}
+
+	if len(req.Fields) == 0 {
+		if req.IncludeProofs {
+			return nil, status.Error(codes.InvalidArgument, "Proofs can only be requested for selected fields")
+		}
+		enc, err := encodeValue(st.InnerStateUnsafe(), req.Encoding)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not encode state: %v", err)
+		}
+		res.Encoded = enc
+		return res, nil
+	}
+
+	pbState := st.CloneInnerState()
+	res.Fields = make([]*pbrpc.BeaconStateField, len(req.Fields))
+	for i, path := range req.Fields {
+		val, err := stateFieldValue(pbState, path)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Could not select field %s: %v", path, err)
+		}
+		enc, err := encodeValue(val, req.Encoding)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not encode field %s: %v", path, err)
+		}
+		res.Fields[i] = &pbrpc.BeaconStateField{
+			Path:    path,
+			Encoded: enc,
+		}
+		if req.IncludeProofs {
+			proof, err := fieldProof(ctx, st, path)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "Could not compute proof of field %s: %v", path, err)
+			}
+			res.Fields[i].GeneralizedIndex = proof.GeneralizedIndex
+			res.Fields[i].Proof = proof.Branch
+		}
+	}
+	return res, nil
+}
+
+// StreamBeaconState streams a historical beacon state by slot, block root or state root
+// in chunks. Field selection is not supported as selected fields fit in a single response.
+func (ds *Server) StreamBeaconState(req *pbrpc.BeaconStateRequest, stream pbrpc.Debug_StreamBeaconStateServer) error {
+	if len(req.Fields) != 0 || req.IncludeProofs {
+		return status.Error(codes.InvalidArgument, "Field selection is not supported when streaming states")
+	}
+	ctx := stream.Context()
+	st, err := ds.stateForRequest(ctx, req)
+	if err != nil {
+		return err
+	}
+	root, err := st.HashTreeRoot(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "Could not compute state root: %v", err)
+	}
+	enc, err := encodeValue(st.InnerStateUnsafe(), req.Encoding)
+	if err != nil {
+		return status.Errorf(codes.Internal, "Could not encode state: %v", err)
+	}
+
+	total := uint64(len(enc))
+	for offset := uint64(0); offset < total; offset += stateChunkSize {
+		end := offset + stateChunkSize
+		if end > total {
+			end = total
+		}
+		if err := stream.Send(&pbrpc.BeaconStateChunk{
+			Slot:      st.Slot(),
+			StateRoot: root[:],
+			TotalSize: total,
+			Offset:    offset,
+			Data:      enc[offset:end],
+		}); err != nil {
+			return status.Errorf(codes.Unavailable, "Could not send over stream: %v", err)
+		}
+	}
+	return nil
+}
+
+// This retrieves the state matching the request query filter. States are regenerated
+// with the state management service if it's enabled, otherwise only the states stored
+// in the DB can be served.
+func (ds *Server) stateForRequest(ctx context.Context, req *pbrpc.BeaconStateRequest) (*state.BeaconState, error) {
+	switch q := req.QueryFilter.(type) {
+	case *pbrpc.BeaconStateRequest_Slot:
+		return ds.stateBySlot(ctx, q.Slot)
+	case *pbrpc.BeaconStateRequest_BlockRoot:
+		return ds.stateByBlockRoot(ctx, bytesutil.ToBytes32(q.BlockRoot))
+	case *pbrpc.BeaconStateRequest_StateRoot:
+		slot, err := ds.slotByStateRoot(ctx, q.StateRoot)
+		if err != nil {
+			return nil, err
+		}
+		st, err := ds.stateBySlot(ctx, slot)
+		if err != nil {
+			return nil, err
+		}
+		// The state at the slot may not be the requested one, such as when it was regenerated on
+		// a different fork than the history the slot was found in.
+		root, err := st.HashTreeRoot(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not compute state root: %v", err)
+		}
+		if !bytes.Equal(root[:], q.StateRoot) {
+			return nil, status.Errorf(codes.NotFound, "Could not find state with root %#x", q.StateRoot)
+		}
+		return st, nil
+	default:
+		return nil, status.Error(codes.InvalidArgument, "Must specify a filter criteria for fetching state")
+	}
+}
+
+func (ds *Server) stateBySlot(ctx context.Context, slot uint64) (*state.BeaconState, error) {
+	if err := ds.checkRetention(slot); err != nil {
+		return nil, err
+	}
+
+	if featureconfig.Get().NewStateMgmt {
+		st, err := ds.StateGen.StateBySlot(ctx, slot)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not retrieve state at slot %d: %v", slot, err)
+		}
+		if st == nil {
+			return nil, status.Errorf(codes.NotFound, "Could not find state at slot %d", slot)
+		}
+		return st, nil
+	}
+
+	roots, err := ds.BeaconDB.BlockRoots(ctx, filters.NewFilter().SetStartSlot(slot).SetEndSlot(slot))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve block roots: %v", err)
+	}
+	for _, r := range roots {
+		st, err := ds.BeaconDB.State(ctx, r)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not retrieve state: %v", err)
+		}
+		if st != nil {
+			return st, nil
+		}
+	}
+	return nil, status.Errorf(codes.NotFound, "Could not find state at slot %d", slot)
+}
+
+func (ds *Server) stateByBlockRoot(ctx context.Context, blockRoot [32]byte) (*state.BeaconState, error) {
+	blk, err := ds.BeaconDB.Block(ctx, blockRoot)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve block: %v", err)
+	}
+	if blk == nil || blk.Block == nil {
+		return nil, status.Errorf(codes.NotFound, "Could not find block with root %#x", blockRoot)
+	}
+	if err := ds.checkRetention(blk.Block.Slot); err != nil {
+		return nil, err
+	}
+
+	var st *state.BeaconState
+	if featureconfig.Get().NewStateMgmt {
+		st, err = ds.StateGen.StateByRoot(ctx, blockRoot)
+	} else {
+		st, err = ds.BeaconDB.State(ctx, blockRoot)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve state: %v", err)
+	}
+	if st == nil {
+		return nil, status.Errorf(codes.NotFound, "Could not find state of block root %#x", blockRoot)
+	}
+	return st, nil
+}
+
+// This looks up the slot of a state root using the state root history of the head state. State
+// roots older than the history are looked up in the history of the oldest state covered by it,
+// and so on until the root is found or no older state can be retrieved.
+func (ds *Server) slotByStateRoot(ctx context.Context, stateRoot []byte) (uint64, error) {
+	headState, err := ds.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return 0, status.Errorf(codes.Internal, "Could not retrieve head state: %v", err)
+	}
+	if headState == nil {
+		return 0, status.Error(codes.Internal, "Nil head state")
+	}
+	headRoot, err := headState.HashTreeRoot(ctx)
+	if err != nil {
+		return 0, status.Errorf(codes.Internal, "Could not compute head state root: %v", err)
+	}
+	if bytes.Equal(headRoot[:], stateRoot) {
+		return headState.Slot(), nil
+	}
+
+	st := headState
+	for {
+		slot, found, lowestSlot, err := searchStateRoots(st, stateRoot)
+		if err != nil {
+			return 0, err
+		}
+		if found {
+			return slot, nil
+		}
+		if lowestSlot == 0 {
+			break
+		}
+		st, err = ds.stateBySlot(ctx, lowestSlot)
+		if status.Code(err) == codes.Internal {
+			return 0, err
+		}
+		if err != nil {
+			// Older states are out of the retention limit or not available.
+			break
+		}
+	}
+	return 0, status.Errorf(codes.NotFound, "Could not find state root %#x in state root history", stateRoot)
+}
+
+// This searches the state root history of the state for the input root. It returns the slot of
+// the root if found, along with the lowest slot covered by the history.
+func searchStateRoots(st *state.BeaconState, stateRoot []byte) (uint64, bool, uint64, error) {
+	roots := st.StateRoots()
+	historyLength := uint64(len(roots))
+	if historyLength == 0 {
+		return 0, false, 0, status.Error(codes.Internal, "Empty state root history")
+	}
+	lowestSlot := uint64(0)
+	if st.Slot() > historyLength {
+		lowestSlot = st.Slot() - historyLength
+	}
+	for slot := st.Slot(); slot > lowestSlot; slot-- {
+		if bytes.Equal(roots[(slot-1)%historyLength], stateRoot) {
+			return slot - 1, true, lowestSlot, nil
+		}
+	}
+	return 0, false, lowestSlot, nil
+}
+
+// This rejects requests for states past the head or older than the configured
+// historical state query limit.
+func (ds *Server) checkRetention(slot uint64) error {
+	headSlot := ds.HeadFetcher.HeadSlot()
+	if slot > headSlot {
+		return status.Errorf(codes.InvalidArgument, "Requested slot %d is greater than head slot %d", slot, headSlot)
+	}
+	limit := flags.Get().HistoricalStateQueryEpochs
+	if limit > 0 && helpers.SlotToEpoch(slot)+limit < helpers.SlotToEpoch(headSlot) {
+		return status.Errorf(
+			codes.OutOfRange,
+			"Requested slot %d is older than the historical state query limit of %d epochs",
+			slot,
+			limit,
+		)
+	}
+	return nil
+}
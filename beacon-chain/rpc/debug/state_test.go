@@ -0,0 +1,287 @@
+package debug
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/trieutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func setupServer(t *testing.T, slot uint64) (*Server, [32]byte, func()) {
+	db := dbTest.SetupDB(t)
+	ctx := context.Background()
+
+	st, _ := testutil.DeterministicGenesisState(t, 16)
+	if err := st.SetSlot(slot); err != nil {
+		t.Fatal(err)
+	}
+	blk := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: slot}}
+	root, err := ssz.HashTreeRoot(blk.Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveBlock(ctx, blk); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveState(ctx, st, root); err != nil {
+		t.Fatal(err)
+	}
+
+	server := &Server{
+		BeaconDB:    db,
+		HeadFetcher: &mock.ChainService{State: st},
+	}
+	return server, root, func() { dbTest.TeardownDB(t, db) }
+}
+
+func TestServer_GetBeaconState(t *testing.T) {
+	server, root, teardown := setupServer(t, 10)
+	defer teardown()
+	ctx := context.Background()
+
+	for _, req := range []*pbrpc.BeaconStateRequest{
+		{QueryFilter: &pbrpc.BeaconStateRequest_Slot{Slot: 10}},
+		{QueryFilter: &pbrpc.BeaconStateRequest_BlockRoot{BlockRoot: root[:]}},
+	} {
+		res, err := server.GetBeaconState(ctx, req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.Slot != 10 {
+			t.Errorf("Wanted slot 10, received %d", res.Slot)
+		}
+		received := &pbp2p.BeaconState{}
+		if err := ssz.Unmarshal(res.Encoded, received); err != nil {
+			t.Fatal(err)
+		}
+		wanted, err := server.BeaconDB.State(ctx, root)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !proto.Equal(received, wanted.InnerStateUnsafe()) {
+			t.Error("Did not receive wanted state")
+		}
+	}
+}
+
+func TestServer_GetBeaconState_ByStateRoot(t *testing.T) {
+	server, _, teardown := setupServer(t, 10)
+	defer teardown()
+	ctx := context.Background()
+
+	headState, err := server.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stateRoot, err := headState.HashTreeRoot(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := server.GetBeaconState(ctx, &pbrpc.BeaconStateRequest{
+		QueryFilter: &pbrpc.BeaconStateRequest_StateRoot{StateRoot: stateRoot[:]},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(res.StateRoot, stateRoot[:]) {
+		t.Errorf("Wanted state root %#x, received %#x", stateRoot, res.StateRoot)
+	}
+}
+
+func TestServer_GetBeaconState_ByStateRoot_OlderThanHeadHistory(t *testing.T) {
+	params.UseMinimalConfig()
+	defer params.UseMainnetConfig()
+	db := dbTest.SetupDB(t)
+	defer dbTest.TeardownDB(t, db)
+	ctx := context.Background()
+
+	saveState := func(slot uint64, stateRoots map[uint64][32]byte) [32]byte {
+		st, _ := testutil.DeterministicGenesisState(t, 16)
+		if err := st.SetSlot(slot); err != nil {
+			t.Fatal(err)
+		}
+		for i, r := range stateRoots {
+			if err := st.UpdateStateRootAtIndex(i, r); err != nil {
+				t.Fatal(err)
+			}
+		}
+		blk := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: slot}}
+		blkRoot, err := ssz.HashTreeRoot(blk.Block)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := db.SaveBlock(ctx, blk); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.SaveState(ctx, st, blkRoot); err != nil {
+			t.Fatal(err)
+		}
+		root, err := st.HashTreeRoot(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return root
+	}
+
+	// The state root of slot 20 is only in the history of the state at slot 36, the lowest slot
+	// covered by the history of the head state at slot 100.
+	historyLength := params.BeaconConfig().SlotsPerHistoricalRoot
+	stateRoot := saveState(20, nil)
+	saveState(100-historyLength, map[uint64][32]byte{20: stateRoot})
+	headState, _ := testutil.DeterministicGenesisState(t, 16)
+	if err := headState.SetSlot(100); err != nil {
+		t.Fatal(err)
+	}
+	server := &Server{
+		BeaconDB:    db,
+		HeadFetcher: &mock.ChainService{State: headState},
+	}
+
+	res, err := server.GetBeaconState(ctx, &pbrpc.BeaconStateRequest{
+		QueryFilter: &pbrpc.BeaconStateRequest_StateRoot{StateRoot: stateRoot[:]},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Slot != 20 || !bytes.Equal(res.StateRoot, stateRoot[:]) {
+		t.Errorf("Wanted state root %#x at slot 20, received %#x at slot %d", stateRoot, res.StateRoot, res.Slot)
+	}
+}
+
+func TestServer_GetBeaconState_ByStateRoot_Mismatch(t *testing.T) {
+	server, _, teardown := setupServer(t, 10)
+	defer teardown()
+	ctx := context.Background()
+
+	// The head state history points at the state saved at slot 10 for another root.
+	headState, _ := testutil.DeterministicGenesisState(t, 16)
+	if err := headState.SetSlot(11); err != nil {
+		t.Fatal(err)
+	}
+	otherRoot := [32]byte{'a'}
+	if err := headState.UpdateStateRootAtIndex(10, otherRoot); err != nil {
+		t.Fatal(err)
+	}
+	server.HeadFetcher = &mock.ChainService{State: headState}
+
+	if _, err := server.GetBeaconState(ctx, &pbrpc.BeaconStateRequest{
+		QueryFilter: &pbrpc.BeaconStateRequest_StateRoot{StateRoot: otherRoot[:]},
+	}); status.Code(err) != codes.NotFound {
+		t.Errorf("Wanted not found error for mismatching state root, received %v", err)
+	}
+}
+
+func TestServer_GetBeaconState_Fields(t *testing.T) {
+	server, _, teardown := setupServer(t, 10)
+	defer teardown()
+	ctx := context.Background()
+
+	res, err := server.GetBeaconState(ctx, &pbrpc.BeaconStateRequest{
+		QueryFilter: &pbrpc.BeaconStateRequest_Slot{Slot: 10},
+		Encoding:    pbrpc.StateEncoding_JSON,
+		Fields:      []string{"slot", "validators[3]"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Encoded) != 0 {
+		t.Error("Full state should not be returned when selecting fields")
+	}
+	if len(res.Fields) != 2 {
+		t.Fatalf("Wanted 2 fields, received %d", len(res.Fields))
+	}
+	if string(res.Fields[0].Encoded) != "10" {
+		t.Errorf("Wanted slot 10, received %s", res.Fields[0].Encoded)
+	}
+	if !bytes.Contains(res.Fields[1].Encoded, []byte("publicKey")) {
+		t.Errorf("Wanted JSON encoded validator, received %s", res.Fields[1].Encoded)
+	}
+
+	if _, err := server.GetBeaconState(ctx, &pbrpc.BeaconStateRequest{
+		QueryFilter: &pbrpc.BeaconStateRequest_Slot{Slot: 10},
+		Fields:      []string{"validators[100]"},
+	}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Wanted invalid argument error for out of range index, received %v", err)
+	}
+}
+
+func TestServer_GetBeaconState_Proofs(t *testing.T) {
+	server, _, teardown := setupServer(t, 10)
+	defer teardown()
+	ctx := context.Background()
+
+	res, err := server.GetBeaconState(ctx, &pbrpc.BeaconStateRequest{
+		QueryFilter:   &pbrpc.BeaconStateRequest_Slot{Slot: 10},
+		Fields:        []string{"slot", "block_roots[3]"},
+		IncludeProofs: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	slotLeaf := make([]byte, 32)
+	copy(slotLeaf, res.Fields[0].Encoded)
+	if !trieutil.VerifyMerkleProof(res.StateRoot, slotLeaf, res.Fields[0].Proof, res.Fields[0].GeneralizedIndex) {
+		t.Error("Invalid proof of slot field")
+	}
+	if !trieutil.VerifyMerkleProof(res.StateRoot, res.Fields[1].Encoded, res.Fields[1].Proof, res.Fields[1].GeneralizedIndex) {
+		t.Error("Invalid proof of block root")
+	}
+}
+
+func TestServer_GetBeaconState_Retention(t *testing.T) {
+	flags.Init(&flags.GlobalFlags{HistoricalStateQueryEpochs: 1})
+	defer flags.Init(&flags.GlobalFlags{})
+
+	server, _, teardown := setupServer(t, 200)
+	defer teardown()
+	ctx := context.Background()
+
+	if _, err := server.GetBeaconState(ctx, &pbrpc.BeaconStateRequest{
+		QueryFilter: &pbrpc.BeaconStateRequest_Slot{Slot: 201},
+	}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Wanted invalid argument error for future slot, received %v", err)
+	}
+	if _, err := server.GetBeaconState(ctx, &pbrpc.BeaconStateRequest{
+		QueryFilter: &pbrpc.BeaconStateRequest_Slot{Slot: 1},
+	}); status.Code(err) != codes.OutOfRange {
+		t.Errorf("Wanted out of range error for pruned slot, received %v", err)
+	}
+}
+
+func TestParseFieldPath(t *testing.T) {
+	tests := []struct {
+		path    string
+		want    *fieldPath
+		wantErr bool
+	}{
+		{path: "balances", want: &fieldPath{name: "balances"}},
+		{path: "validators[3]", want: &fieldPath{name: "validators", index: 3, hasIndex: true}},
+		{path: "validators[", wantErr: true},
+		{path: "validators[a]", wantErr: true},
+		{path: "[1]", wantErr: true},
+		{path: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := parseFieldPath(tt.path)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("parseFieldPath(%q) error = %v, wantErr %v", tt.path, err, tt.wantErr)
+			continue
+		}
+		if !tt.wantErr && *got != *tt.want {
+			t.Errorf("parseFieldPath(%q) = %v, want %v", tt.path, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,38 @@
+// Package lightclient defines a gRPC server implementation of the light client service
+// which serves the light client updates maintained by the beacon node.
+package lightclient
+
+import (
+	"context"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/lightclient"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Server defines a server implementation of the gRPC LightClient service,
+// providing RPC endpoints to sync light clients.
+type Server struct {
+	UpdateFetcher lightclient.UpdateFetcher
+}
+
+// GetLightClientUpdate retrieves the latest light client update of the node if its
+// finalized header is more recent than the finalized slot of the request, along with the
+// ancestry branch of the block root of the requested finalized slot.
+func (ls *Server) GetLightClientUpdate(
+	ctx context.Context, req *pb.LightClientUpdateRequest,
+) (*pb.LightClientUpdate, error) {
+	update, err := ls.UpdateFetcher.UpdateSince(ctx, req.FinalizedSlot)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get light client update: %v", err)
+	}
+	if update == nil {
+		return nil, status.Errorf(
+			codes.NotFound,
+			"No light client update more recent than finalized slot %d",
+			req.FinalizedSlot,
+		)
+	}
+	return update, nil
+}
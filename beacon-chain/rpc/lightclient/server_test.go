@@ -0,0 +1,56 @@
+package lightclient
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type mockUpdateFetcher struct {
+	update *pb.LightClientUpdate
+}
+
+func (m *mockUpdateFetcher) LatestUpdate() *pb.LightClientUpdate {
+	return m.update
+}
+
+func (m *mockUpdateFetcher) UpdateSince(_ context.Context, finalizedSlot uint64) (*pb.LightClientUpdate, error) {
+	if m.update == nil || m.update.FinalizedHeader.Slot <= finalizedSlot {
+		return nil, nil
+	}
+	return m.update, nil
+}
+
+func TestServer_GetLightClientUpdate(t *testing.T) {
+	update := &pb.LightClientUpdate{
+		AttestedHeader:  &ethpb.BeaconBlockHeader{Slot: 96},
+		FinalizedHeader: &ethpb.BeaconBlockHeader{Slot: 32},
+	}
+	ls := &Server{UpdateFetcher: &mockUpdateFetcher{update: update}}
+
+	res, err := ls.GetLightClientUpdate(context.Background(), &pb.LightClientUpdateRequest{FinalizedSlot: 0})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(res, update) {
+		t.Errorf("Wanted update %v, received %v", update, res)
+	}
+
+	_, err = ls.GetLightClientUpdate(context.Background(), &pb.LightClientUpdateRequest{FinalizedSlot: 32})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("Expected not found error for a known finalized slot, received %v", err)
+	}
+}
+
+func TestServer_GetLightClientUpdate_NoUpdate(t *testing.T) {
+	ls := &Server{UpdateFetcher: &mockUpdateFetcher{}}
+	_, err := ls.GetLightClientUpdate(context.Background(), &pb.LightClientUpdateRequest{})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("Expected not found error, received %v", err)
+	}
+}
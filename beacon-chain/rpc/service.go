@@ -7,6 +7,8 @@ import (
 	"math/rand"
 	"net"
 	"os"
+	"reflect"
+	"time"
 
 	middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	recovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
@@ -20,20 +22,26 @@ import (
 	opfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/lightclient"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/beacon"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/debug"
+	lightclientrpc "github.com/prysmaticlabs/prysm/beacon-chain/rpc/lightclient"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/node"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/validator"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
 	"github.com/prysmaticlabs/prysm/beacon-chain/sync"
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
+	"github.com/prysmaticlabs/prysm/shared"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/slottiming"
 	"github.com/prysmaticlabs/prysm/shared/traceutil"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/plugin/ocgrpc"
@@ -42,6 +50,8 @@ import (
 	"google.golang.org/grpc/reflection"
 )
 
+var _ = shared.DependentService(&Service{})
+
 var log logrus.FieldLogger
 
 func init() {
@@ -56,6 +66,7 @@ type Service struct {
 	beaconDB               db.HeadAccessDatabase
 	headFetcher            blockchain.HeadFetcher
 	forkFetcher            blockchain.ForkFetcher
+	forkChoiceFetcher      blockchain.ForkChoiceFetcher
 	finalizationFetcher    blockchain.FinalizationFetcher
 	participationFetcher   blockchain.ParticipationFetcher
 	genesisTimeFetcher     blockchain.TimeFetcher
@@ -89,7 +100,12 @@ type Service struct {
 	slasherCert            string
 	slasherCredentialError error
 	slasherClient          slashpb.SlasherClient
+	bodyProviderConn       *grpc.ClientConn
+	blockBodyProvider      validator.BlockBodyProvider
+	bodyProviderEndpoint   string
+	bodyProviderTimeout    time.Duration
 	stateGen               *stategen.State
+	lightClientUpdates     lightclient.UpdateFetcher
 }
 
 // Config options for the beacon node RPC server.
@@ -101,6 +117,7 @@ type Config struct {
 	BeaconDB              db.HeadAccessDatabase
 	HeadFetcher           blockchain.HeadFetcher
 	ForkFetcher           blockchain.ForkFetcher
+	ForkChoiceFetcher     blockchain.ForkChoiceFetcher
 	FinalizationFetcher   blockchain.FinalizationFetcher
 	ParticipationFetcher  blockchain.ParticipationFetcher
 	AttestationReceiver   blockchain.AttestationReceiver
@@ -119,10 +136,14 @@ type Config struct {
 	PendingDepositFetcher depositcache.PendingDepositsFetcher
 	SlasherProvider       string
 	SlasherCert           string
+	BlockBodyProvider     validator.BlockBodyProvider
+	BodyProviderEndpoint  string
+	BodyProviderTimeout   time.Duration
 	StateNotifier         statefeed.Notifier
 	BlockNotifier         blockfeed.Notifier
 	OperationNotifier     opfeed.Notifier
 	StateGen              *stategen.State
+	LightClientUpdates    lightclient.UpdateFetcher
 }
 
 // NewService instantiates a new RPC service instance that will
@@ -135,6 +156,7 @@ func NewService(ctx context.Context, cfg *Config) *Service {
 		beaconDB:              cfg.BeaconDB,
 		headFetcher:           cfg.HeadFetcher,
 		forkFetcher:           cfg.ForkFetcher,
+		forkChoiceFetcher:     cfg.ForkChoiceFetcher,
 		finalizationFetcher:   cfg.FinalizationFetcher,
 		participationFetcher:  cfg.ParticipationFetcher,
 		genesisTimeFetcher:    cfg.GenesisTimeFetcher,
@@ -162,7 +184,11 @@ func NewService(ctx context.Context, cfg *Config) *Service {
 		operationNotifier:     cfg.OperationNotifier,
 		slasherProvider:       cfg.SlasherProvider,
 		slasherCert:           cfg.SlasherCert,
+		blockBodyProvider:     cfg.BlockBodyProvider,
+		bodyProviderEndpoint:  cfg.BodyProviderEndpoint,
+		bodyProviderTimeout:   cfg.BodyProviderTimeout,
 		stateGen:              cfg.StateGen,
+		lightClientUpdates:    cfg.LightClientUpdates,
 	}
 }
 
@@ -191,6 +217,7 @@ func (s *Service) Start() {
 			),
 			grpc_prometheus.UnaryServerInterceptor,
 			grpc_opentracing.UnaryServerInterceptor(),
+			slottiming.UnaryServerInterceptor(),
 		)),
 	}
 	grpc_prometheus.EnableHandlingTimeHistogram()
@@ -231,9 +258,15 @@ func (s *Service) Start() {
 		BlockReceiver:          s.blockReceiver,
 		MockEth1Votes:          s.mockEth1Votes,
 		Eth1BlockFetcher:       s.powChainService,
+		Eth1VoteFetcher:        s.powChainService,
 		PendingDepositsFetcher: s.pendingDepositFetcher,
 		SlashingsPool:          s.slashingsPool,
 		StateGen:               s.stateGen,
+		BlockBodyProvider:      s.blockBodyProvider,
+		BodyProviderTimeout:    s.bodyProviderTimeout,
+	}
+	if s.bodyProviderEndpoint != "" {
+		s.startBlockBodyProviderClient(validatorServer)
 	}
 	nodeServer := &node.Server{
 		BeaconDB:           s.beaconDB,
@@ -262,9 +295,23 @@ func (s *Service) Start() {
 		ReceivedAttestationsBuffer:  make(chan *ethpb.Attestation, 100),
 		CollectedAttestationsBuffer: make(chan []*ethpb.Attestation, 100),
 	}
+	debugServer := &debug.Server{
+		BeaconDB:          s.beaconDB,
+		HeadFetcher:       s.headFetcher,
+		ForkChoiceFetcher: s.forkChoiceFetcher,
+		StateGen:          s.stateGen,
+	}
 	ethpb.RegisterNodeServer(s.grpcServer, nodeServer)
 	ethpb.RegisterBeaconChainServer(s.grpcServer, beaconChainServer)
 	ethpb.RegisterBeaconNodeValidatorServer(s.grpcServer, validatorServer)
+	pbrpc.RegisterValidatorSubnetsServer(s.grpcServer, validatorServer)
+	pbrpc.RegisterDebugServer(s.grpcServer, debugServer)
+	if s.lightClientUpdates != nil {
+		lightClientServer := &lightclientrpc.Server{
+			UpdateFetcher: s.lightClientUpdates,
+		}
+		pbrpc.RegisterLightClientServer(s.grpcServer, lightClientServer)
+	}
 
 	// Register reflection service on gRPC server.
 	reflection.Register(s.grpcServer)
@@ -316,6 +363,28 @@ func (s *Service) startSlasherClient() {
 	s.slasherClient = slashpb.NewSlasherClient(s.slasherConn)
 }
 
+// startBlockBodyProviderClient connects the validator server to the external block body provider,
+// which replaces any block body provider of the configuration. The connection is established in the
+// background, the validator server building block bodies itself until the provider is reachable.
+func (s *Service) startBlockBodyProviderClient(validatorServer *validator.Server) {
+	opts := []grpc.DialOption{
+		grpc.WithInsecure(),
+		grpc.WithStatsHandler(&ocgrpc.ClientHandler{}),
+		grpc.WithUnaryInterceptor(middleware.ChainUnaryClient(
+			grpc_opentracing.UnaryClientInterceptor(),
+			grpc_prometheus.UnaryClientInterceptor,
+		)),
+	}
+	conn, err := grpc.DialContext(s.ctx, s.bodyProviderEndpoint, opts...)
+	if err != nil {
+		log.Errorf("Could not dial block body provider endpoint: %s, %v", s.bodyProviderEndpoint, err)
+		return
+	}
+	log.WithField("endpoint", s.bodyProviderEndpoint).Info("Requesting block bodies from block body provider")
+	s.bodyProviderConn = conn
+	validatorServer.BlockBodyProvider = validator.NewRemoteBlockBodyProvider(conn)
+}
+
 // Stop the service.
 func (s *Service) Stop() error {
 	s.cancel()
@@ -326,6 +395,9 @@ func (s *Service) Stop() error {
 	if s.slasherConn != nil {
 		s.slasherConn.Close()
 	}
+	if s.bodyProviderConn != nil {
+		s.bodyProviderConn.Close()
+	}
 	return nil
 }
 
@@ -339,3 +411,9 @@ func (s *Service) Status() error {
 	}
 	return nil
 }
+
+// Dependencies of the RPC service, which serves the chain, sync and proof of work chain
+// data of the node, and broadcasts the blocks and attestations of validators.
+func (s *Service) Dependencies() []reflect.Type {
+	return shared.DependencyTypes(s.headFetcher, s.syncService, s.powChainService, s.p2p)
+}
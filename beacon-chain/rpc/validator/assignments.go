@@ -4,7 +4,6 @@ import (
 	"context"
 
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
-	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
@@ -39,14 +38,6 @@ func (vs *Server) GetDuties(ctx context.Context, req *ethpb.DutiesRequest) (*eth
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Could not compute committee assignments: %v", err)
 	}
-	// Query the next epoch assignments for committee subnet subscriptions.
-	nextCommitteeAssignments, _, err := helpers.CommitteeAssignments(s, req.Epoch+1)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Could not compute committee assignments: %v", err)
-	}
-
-	var committeeIDs []uint64
-	var nextCommitteeIDs []uint64
 	var validatorAssignments []*ethpb.DutiesResponse_Duty
 	for _, pubKey := range req.PublicKeys {
 		if ctx.Err() != nil {
@@ -71,14 +62,11 @@ func (vs *Server) GetDuties(ctx context.Context, req *ethpb.DutiesRequest) (*eth
 				assignment.AttesterSlot = ca.AttesterSlot
 				assignment.ProposerSlot = proposerIndexToSlot[idx]
 				assignment.CommitteeIndex = ca.CommitteeIndex
-				committeeIDs = append(committeeIDs, ca.CommitteeIndex)
 			}
-			// Save the next epoch assignments.
-			ca, ok = nextCommitteeAssignments[idx]
-			if ok {
-				nextCommitteeIDs = append(nextCommitteeIDs, ca.CommitteeIndex)
+			// Assign long-lived attestation subnets to the validator.
+			if featureconfig.Get().EnableDynamicCommitteeSubnets {
+				assignValidatorToSubnet(pubKey, assignment.Status)
 			}
-
 		} else {
 			vs := vs.validatorStatus(ctx, pubKey, s)
 			assignment.Status = vs.Status
@@ -87,11 +75,6 @@ func (vs *Server) GetDuties(ctx context.Context, req *ethpb.DutiesRequest) (*eth
 
 	}
 
-	if featureconfig.Get().EnableDynamicCommitteeSubnets {
-		cache.CommitteeIDs.AddIDs(committeeIDs, req.Epoch)
-		cache.CommitteeIDs.AddIDs(nextCommitteeIDs, req.Epoch+1)
-	}
-
 	return &ethpb.DutiesResponse{
 		Duties: validatorAssignments,
 	}, nil
@@ -0,0 +1,104 @@
+package validator
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"google.golang.org/grpc"
+)
+
+var _ = BlockBodyProvider(&Server{})
+
+// defaultBodyProviderTimeout is the time a block body provider has to return a block body
+// when no timeout is configured.
+const defaultBodyProviderTimeout = time.Second
+
+// BlockBodyProvider defines the construction policy of the bodies of the blocks proposed by the
+// validators of the node, such as the selection of the attestations to include. The node falls back
+// to building block bodies from its own operation pools if a provided body is invalid.
+type BlockBodyProvider interface {
+	BlockBody(ctx context.Context, req *pb.BlockBodyRequest) (*ethpb.BeaconBlockBody, error)
+}
+
+// RemoteBlockBodyProvider requests block bodies from an external process implementing the
+// BlockBodyProvider gRPC service.
+type RemoteBlockBodyProvider struct {
+	client pb.BlockBodyProviderClient
+}
+
+// NewRemoteBlockBodyProvider returns a block body provider requesting block bodies over the
+// given gRPC connection.
+func NewRemoteBlockBodyProvider(conn *grpc.ClientConn) *RemoteBlockBodyProvider {
+	return &RemoteBlockBodyProvider{client: pb.NewBlockBodyProviderClient(conn)}
+}
+
+// BlockBody requests the block body from the external process.
+func (p *RemoteBlockBodyProvider) BlockBody(ctx context.Context, req *pb.BlockBodyRequest) (*ethpb.BeaconBlockBody, error) {
+	return p.client.GetBlockBody(ctx, req)
+}
+
+// providedBlock returns the block built from the body of the block body provider of the server.
+// The body must hold the randao reveal and graffiti of the request. Its signatures are batch
+// verified, and the rest of the body is validated by computing the post state root of the block.
+func (vs *Server) providedBlock(ctx context.Context, req *pb.BlockBodyRequest) (*ethpb.BeaconBlock, error) {
+	timeout := vs.BodyProviderTimeout
+	if timeout == 0 {
+		timeout = defaultBodyProviderTimeout
+	}
+	providerCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	body, err := vs.BlockBodyProvider.BlockBody(providerCtx, req)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get block body")
+	}
+	if body == nil {
+		return nil, errors.New("nil block body")
+	}
+	// The randao reveal is signed by the proposer, any other reveal would make the block invalid.
+	if !bytes.Equal(body.RandaoReveal, req.RandaoReveal) {
+		return nil, errors.New("block body does not contain the randao reveal of the proposer")
+	}
+	graffiti := bytesutil.ToBytes32(req.Graffiti)
+	if !bytes.Equal(body.Graffiti, graffiti[:]) {
+		return nil, errors.New("block body does not contain the graffiti of the proposer")
+	}
+
+	parentState, err := vs.parentState(ctx, req.ParentRoot)
+	if err != nil {
+		return nil, err
+	}
+	// The state root computation below does not verify signatures, so a body with an
+	// invalid signature would only be rejected once the block is proposed.
+	slotState, err := state.ProcessSlots(ctx, parentState.Copy(), req.Slot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not process slots")
+	}
+	set, err := blocks.BlockBodySignatureSet(ctx, slotState, body)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve block body signature set")
+	}
+	if err := set.Verify(); err != nil {
+		return nil, errors.Wrap(err, "invalid block body signature")
+	}
+
+	blk := &ethpb.BeaconBlock{
+		Slot:       req.Slot,
+		ParentRoot: req.ParentRoot,
+		StateRoot:  params.BeaconConfig().ZeroHash[:],
+		Body:       body,
+	}
+	stateRoot, err := vs.computeStateRootFrom(ctx, parentState, &ethpb.SignedBeaconBlock{Block: blk, Signature: make([]byte, 96)})
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid block body")
+	}
+	blk.StateRoot = stateRoot
+	return blk, nil
+}
@@ -0,0 +1,192 @@
+package validator
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/go-ssz"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	b "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	dbutil "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
+	mockPOW "github.com/prysmaticlabs/prysm/beacon-chain/powchain/testing"
+	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+type mockBlockBodyProvider struct {
+	body  func(req *pb.BlockBodyRequest) *ethpb.BeaconBlockBody
+	err   error
+	delay time.Duration
+}
+
+func (m *mockBlockBodyProvider) BlockBody(ctx context.Context, req *pb.BlockBodyRequest) (*ethpb.BeaconBlockBody, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-time.After(m.delay):
+	}
+	if m.err != nil {
+		return nil, m.err
+	}
+	return m.body(req), nil
+}
+
+func TestGetBlock_BlockBodyProvider(t *testing.T) {
+	db := dbutil.SetupDB(t)
+	defer dbutil.TeardownDB(t, db)
+	ctx := context.Background()
+
+	beaconState, privKeys := testutil.DeterministicGenesisState(t, params.BeaconConfig().MinGenesisActiveValidatorCount)
+	stateRoot, err := beaconState.HashTreeRoot(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	genesis := b.NewGenesisBlock(stateRoot[:])
+	if err := db.SaveBlock(ctx, genesis); err != nil {
+		t.Fatal(err)
+	}
+	parentRoot, err := ssz.HashTreeRoot(genesis.Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveState(ctx, beaconState, parentRoot); err != nil {
+		t.Fatal(err)
+	}
+
+	randaoReveal, err := testutil.RandaoReveal(beaconState, 0, privKeys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	graffiti := bytesutil.ToBytes32([]byte("graffiti"))
+	req := &ethpb.BlockRequest{
+		Slot:         1,
+		RandaoReveal: randaoReveal,
+		Graffiti:     graffiti[:],
+	}
+	// The eth1 data of the provided bodies is the one of the state, the node votes with mocked
+	// eth1 data when it builds the body itself.
+	validBody := func(req *pb.BlockBodyRequest) *ethpb.BeaconBlockBody {
+		return &ethpb.BeaconBlockBody{
+			Eth1Data:     beaconState.Eth1Data(),
+			RandaoReveal: req.RandaoReveal,
+			Graffiti:     req.Graffiti,
+		}
+	}
+	committee, err := helpers.BeaconCommitteeFromState(beaconState, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	aggregationBits := bitfield.NewBitlist(uint64(len(committee)))
+	aggregationBits.SetBitAt(0, true)
+
+	tests := []struct {
+		name         string
+		provider     *mockBlockBodyProvider
+		wantProvided bool
+	}{
+		{
+			name:         "valid body",
+			provider:     &mockBlockBodyProvider{body: validBody},
+			wantProvided: true,
+		},
+		{
+			name:     "provider error",
+			provider: &mockBlockBodyProvider{err: errors.New("builder unavailable")},
+		},
+		{
+			name:     "provider timeout",
+			provider: &mockBlockBodyProvider{body: validBody, delay: time.Second},
+		},
+		{
+			name: "other randao reveal",
+			provider: &mockBlockBodyProvider{body: func(req *pb.BlockBodyRequest) *ethpb.BeaconBlockBody {
+				body := validBody(req)
+				body.RandaoReveal = make([]byte, 96)
+				return body
+			}},
+		},
+		{
+			name: "other graffiti",
+			provider: &mockBlockBodyProvider{body: func(req *pb.BlockBodyRequest) *ethpb.BeaconBlockBody {
+				body := validBody(req)
+				other := bytesutil.ToBytes32([]byte("builder"))
+				body.Graffiti = other[:]
+				return body
+			}},
+		},
+		{
+			name: "invalid body",
+			provider: &mockBlockBodyProvider{body: func(req *pb.BlockBodyRequest) *ethpb.BeaconBlockBody {
+				body := validBody(req)
+				// The state does not expect any deposit.
+				body.Deposits = []*ethpb.Deposit{{Data: &ethpb.Deposit_Data{}}}
+				return body
+			}},
+		},
+		{
+			name: "invalid attestation signature",
+			provider: &mockBlockBodyProvider{body: func(req *pb.BlockBodyRequest) *ethpb.BeaconBlockBody {
+				body := validBody(req)
+				// The attestation is valid apart from its signature, which is not checked when
+				// computing the state root.
+				body.Attestations = []*ethpb.Attestation{{
+					AggregationBits: aggregationBits,
+					Data: &ethpb.AttestationData{
+						BeaconBlockRoot: parentRoot[:],
+						Source:          &ethpb.Checkpoint{Root: params.BeaconConfig().ZeroHash[:]},
+						Target:          &ethpb.Checkpoint{Root: parentRoot[:]},
+					},
+					Signature: privKeys[0].Sign([]byte("not the attestation"), 0).Marshal(),
+				}}
+				return body
+			}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			proposerServer := &Server{
+				BeaconDB:            db,
+				HeadFetcher:         &mock.ChainService{State: beaconState, Root: parentRoot[:]},
+				SyncChecker:         &mockSync.Sync{IsSyncing: false},
+				ChainStartFetcher:   &mockPOW.POWChain{},
+				Eth1InfoFetcher:     &mockPOW.POWChain{},
+				Eth1BlockFetcher:    &mockPOW.POWChain{},
+				MockEth1Votes:       true,
+				AttPool:             attestations.NewPool(),
+				SlashingsPool:       slashings.NewPool(),
+				ExitPool:            voluntaryexits.NewPool(),
+				BlockBodyProvider:   tt.provider,
+				BodyProviderTimeout: 50 * time.Millisecond,
+			}
+			block, err := proposerServer.GetBlock(ctx, req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if provided := proto.Equal(block.Body.Eth1Data, beaconState.Eth1Data()); provided != tt.wantProvided {
+				t.Errorf("Expected provided body %v, received provided body %v", tt.wantProvided, provided)
+			}
+			if !bytes.Equal(block.Body.Graffiti, graffiti[:]) {
+				t.Errorf("Expected graffiti %s, received %s", graffiti, block.Body.Graffiti)
+			}
+			if !bytes.Equal(block.ParentRoot, parentRoot[:]) {
+				t.Error("Expected block to have correct parent root")
+			}
+			if bytes.Equal(block.StateRoot, params.BeaconConfig().ZeroHash[:]) {
+				t.Error("Expected block to have a computed state root")
+			}
+		})
+	}
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"math/big"
-	"math/rand"
 
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
@@ -17,10 +16,12 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state/interop"
 	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
 	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/slottiming"
 	"github.com/prysmaticlabs/prysm/shared/trieutil"
 	"go.opencensus.io/trace"
 	"google.golang.org/grpc/codes"
@@ -46,16 +47,62 @@ func (vs *Server) GetBlock(ctx context.Context, req *ethpb.BlockRequest) (*ethpb
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Could not retrieve head root: %v", err)
 	}
+	bodyReq := &pb.BlockBodyRequest{
+		Slot:         req.Slot,
+		ParentRoot:   parentRoot,
+		RandaoReveal: req.RandaoReveal,
+		Graffiti:     req.Graffiti,
+	}
+
+	if vs.BlockBodyProvider != nil {
+		blk, err := vs.providedBlock(ctx, bodyReq)
+		if err == nil {
+			slottiming.Step(ctx, "stateRoot")
+			return blk, nil
+		}
+		log.WithError(err).WithField("slot", req.Slot).Warn("Could not use block body provider, building block body locally")
+	}
+
+	body, err := vs.BlockBody(ctx, bodyReq)
+	if err != nil {
+		return nil, err
+	}
+	blk := &ethpb.BeaconBlock{
+		Slot:       req.Slot,
+		ParentRoot: parentRoot,
+		// Use zero hash as stub for state root to compute later.
+		StateRoot: params.BeaconConfig().ZeroHash[:],
+		Body:      body,
+	}
+
+	// Compute state root with the newly constructed block.
+	stateRoot, err := vs.computeStateRoot(ctx, &ethpb.SignedBeaconBlock{Block: blk, Signature: make([]byte, 96)})
+	if err != nil {
+		interop.WriteBlockToDisk(&ethpb.SignedBeaconBlock{Block: blk}, true /*failed*/)
+		return nil, status.Errorf(codes.Internal, "Could not compute state root: %v", err)
+	}
+	blk.StateRoot = stateRoot
+	slottiming.Step(ctx, "stateRoot")
+
+	return blk, nil
+}
+
+// BlockBody packs the body of a block from the eth1 data vote, pending deposits and the
+// operations pools of the node. The server is the block body provider used by default, and
+// the one falling back to when an external provider fails.
+func (vs *Server) BlockBody(ctx context.Context, req *pb.BlockBodyRequest) (*ethpb.BeaconBlockBody, error) {
 	eth1Data, err := vs.eth1Data(ctx, req.Slot)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Could not get ETH1 data: %v", err)
 	}
+	slottiming.Step(ctx, "eth1Data")
 
 	// Pack ETH1 deposits which have not been included in the beacon chain.
 	deposits, err := vs.deposits(ctx, eth1Data)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Could not get ETH1 deposits: %v", err)
 	}
+	slottiming.Step(ctx, "deposits")
 
 	// Pack aggregated attestations which have not been included in the beacon chain.
 	atts := vs.AttPool.AggregatedAttestations()
@@ -73,9 +120,7 @@ func (vs *Server) GetBlock(ctx context.Context, req *ethpb.BlockRequest) (*ethpb
 		}
 		atts = append(atts, uAtts...)
 	}
-
-	// Use zero hash as stub for state root to compute later.
-	stateRoot := params.BeaconConfig().ZeroHash[:]
+	slottiming.Step(ctx, "attestations")
 
 	graffiti := bytesutil.ToBytes32(req.Graffiti)
 
@@ -84,31 +129,16 @@ func (vs *Server) GetBlock(ctx context.Context, req *ethpb.BlockRequest) (*ethpb
 		return nil, status.Errorf(codes.Internal, "Could not get head state %v", err)
 	}
 
-	blk := &ethpb.BeaconBlock{
-		Slot:       req.Slot,
-		ParentRoot: parentRoot[:],
-		StateRoot:  stateRoot,
-		Body: &ethpb.BeaconBlockBody{
-			Eth1Data:          eth1Data,
-			Deposits:          deposits,
-			Attestations:      atts,
-			RandaoReveal:      req.RandaoReveal,
-			ProposerSlashings: vs.SlashingsPool.PendingProposerSlashings(ctx),
-			AttesterSlashings: vs.SlashingsPool.PendingAttesterSlashings(ctx),
-			VoluntaryExits:    vs.ExitPool.PendingExits(head, req.Slot),
-			Graffiti:          graffiti[:],
-		},
-	}
-
-	// Compute state root with the newly constructed block.
-	stateRoot, err = vs.computeStateRoot(ctx, &ethpb.SignedBeaconBlock{Block: blk, Signature: make([]byte, 96)})
-	if err != nil {
-		interop.WriteBlockToDisk(&ethpb.SignedBeaconBlock{Block: blk}, true /*failed*/)
-		return nil, status.Errorf(codes.Internal, "Could not compute state root: %v", err)
-	}
-	blk.StateRoot = stateRoot
-
-	return blk, nil
+	return &ethpb.BeaconBlockBody{
+		Eth1Data:          eth1Data,
+		Deposits:          deposits,
+		Attestations:      atts,
+		RandaoReveal:      req.RandaoReveal,
+		ProposerSlashings: vs.SlashingsPool.PendingProposerSlashings(ctx),
+		AttesterSlashings: vs.SlashingsPool.PendingAttesterSlashings(ctx),
+		VoluntaryExits:    vs.ExitPool.PendingExits(head, req.Slot),
+		Graffiti:          graffiti[:],
+	}, nil
 }
 
 // ProposeBlock is called by a proposer during its assigned slot to create a block in an attempt
@@ -127,6 +157,7 @@ func (vs *Server) ProposeBlock(ctx context.Context, blk *ethpb.SignedBeaconBlock
 	if err := vs.BlockReceiver.ReceiveBlock(ctx, blk); err != nil {
 		return nil, status.Errorf(codes.Internal, "Could not process beacon block: %v", err)
 	}
+	slottiming.Step(ctx, "blockProcessed")
 
 	if err := vs.deleteAttsInPool(blk.Block.Body.Attestations); err != nil {
 		return nil, status.Errorf(codes.Internal, "Could not delete attestations in pool: %v", err)
@@ -137,32 +168,22 @@ func (vs *Server) ProposeBlock(ctx context.Context, blk *ethpb.SignedBeaconBlock
 	}, nil
 }
 
-// eth1Data determines the appropriate eth1data for a block proposal. The algorithm for this method
-// is as follows:
-//  - Determine the timestamp for the start slot for the eth1 voting period.
-//  - Determine the most recent eth1 block before that timestamp.
-//  - Subtract that eth1block.number by ETH1_FOLLOW_DISTANCE.
-//  - This is the eth1block to use for the block proposal.
+// eth1Data determines the appropriate eth1data for a block proposal. Unless the votes are mocked,
+// the vote is chosen by the powchain service from the eth1 blocks it keeps, following the
+// get_eth1_vote rule of the honest validator spec on top of the head state.
 func (vs *Server) eth1Data(ctx context.Context, slot uint64) (*ethpb.Eth1Data, error) {
 	if vs.MockEth1Votes {
 		return vs.mockETH1DataVote(ctx, slot)
 	}
-
-	if !vs.Eth1InfoFetcher.IsConnectedToETH1() {
-		return vs.randomETH1DataVote(ctx)
+	headState, err := vs.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get head state")
 	}
-	eth1DataNotification = false
-
-	eth1VotingPeriodStartTime, _ := vs.Eth1InfoFetcher.Eth2GenesisPowchainInfo()
-	eth1VotingPeriodStartTime += (slot - (slot % params.BeaconConfig().SlotsPerEth1VotingPeriod)) * params.BeaconConfig().SecondsPerSlot
-
-	// Look up most recent block up to timestamp
-	blockNumber, err := vs.Eth1BlockFetcher.BlockNumberByTimestamp(ctx, eth1VotingPeriodStartTime)
+	vote, err := vs.Eth1VoteFetcher.Eth1DataVote(ctx, headState, slot)
 	if err != nil {
-		return nil, errors.Wrap(err, "could not get block number from timestamp")
+		return nil, errors.Wrap(err, "could not get eth1 data vote")
 	}
-
-	return vs.defaultEth1DataResponse(ctx, blockNumber)
+	return vote, nil
 }
 
 func (vs *Server) mockETH1DataVote(ctx context.Context, slot uint64) (*ethpb.Eth1Data, error) {
@@ -197,43 +218,19 @@ func (vs *Server) mockETH1DataVote(ctx context.Context, slot uint64) (*ethpb.Eth
 	}, nil
 }
 
-func (vs *Server) randomETH1DataVote(ctx context.Context) (*ethpb.Eth1Data, error) {
-	if !eth1DataNotification {
-		log.Warn("Beacon Node is no longer connected to an ETH1 chain, so ETH1 data votes are now random.")
-		eth1DataNotification = true
-	}
-	headState, err := vs.HeadFetcher.HeadState(ctx)
-	if err != nil {
-		return nil, err
-	}
-	// set random roots and block hashes to prevent a majority from being
-	// built if the eth1 node is offline
-	depRoot := hashutil.Hash(bytesutil.Bytes32(rand.Uint64()))
-	blockHash := hashutil.Hash(bytesutil.Bytes32(rand.Uint64()))
-	return &ethpb.Eth1Data{
-		DepositRoot:  depRoot[:],
-		DepositCount: headState.Eth1DepositIndex(),
-		BlockHash:    blockHash[:],
-	}, nil
-}
-
 // computeStateRoot computes the state root after a block has been processed through a state transition and
 // returns it to the validator client.
 func (vs *Server) computeStateRoot(ctx context.Context, block *ethpb.SignedBeaconBlock) ([]byte, error) {
-	var beaconState *stateTrie.BeaconState
-	var err error
-	if featureconfig.Get().NewStateMgmt {
-		beaconState, err = vs.StateGen.StateByRoot(ctx, bytesutil.ToBytes32(block.Block.ParentRoot))
-		if err != nil {
-			return nil, errors.Wrap(err, "could not retrieve beacon state")
-		}
-	} else {
-		beaconState, err = vs.BeaconDB.State(ctx, bytesutil.ToBytes32(block.Block.ParentRoot))
-		if err != nil {
-			return nil, errors.Wrap(err, "could not retrieve beacon state")
-		}
+	beaconState, err := vs.parentState(ctx, block.Block.ParentRoot)
+	if err != nil {
+		return nil, err
 	}
+	return vs.computeStateRootFrom(ctx, beaconState, block)
+}
 
+// computeStateRootFrom computes the post state root of the block on top of the given state
+// of its parent, which is not modified.
+func (vs *Server) computeStateRootFrom(ctx context.Context, beaconState *stateTrie.BeaconState, block *ethpb.SignedBeaconBlock) ([]byte, error) {
 	root, err := state.CalculateStateRoot(
 		ctx,
 		beaconState,
@@ -247,6 +244,21 @@ func (vs *Server) computeStateRoot(ctx context.Context, block *ethpb.SignedBeaco
 	return root[:], nil
 }
 
+// parentState retrieves the post state of the parent block of a block.
+func (vs *Server) parentState(ctx context.Context, parentRoot []byte) (*stateTrie.BeaconState, error) {
+	var beaconState *stateTrie.BeaconState
+	var err error
+	if featureconfig.Get().NewStateMgmt {
+		beaconState, err = vs.StateGen.StateByRoot(ctx, bytesutil.ToBytes32(parentRoot))
+	} else {
+		beaconState, err = vs.BeaconDB.State(ctx, bytesutil.ToBytes32(parentRoot))
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve beacon state")
+	}
+	return beaconState, nil
+}
+
 // deposits returns a list of pending deposits that are ready for inclusion in the next beacon
 // block. Determining deposits depends on the current eth1data vote for the block and whether or not
 // this eth1data has enough support to be considered for deposits inclusion. If current vote has
@@ -341,29 +353,6 @@ func (vs *Server) canonicalEth1Data(ctx context.Context, beaconState *stateTrie.
 	return canonicalEth1Data, latestEth1DataHeight, nil
 }
 
-// in case no vote for new eth1data vote considered best vote we
-// default into returning the latest deposit root and the block
-// hash of eth1 block hash that is FOLLOW_DISTANCE back from its
-// latest block.
-func (vs *Server) defaultEth1DataResponse(ctx context.Context, currentHeight *big.Int) (*ethpb.Eth1Data, error) {
-	eth1FollowDistance := int64(params.BeaconConfig().Eth1FollowDistance)
-	ancestorHeight := big.NewInt(0).Sub(currentHeight, big.NewInt(eth1FollowDistance))
-	blockHash, err := vs.Eth1BlockFetcher.BlockHashByHeight(ctx, ancestorHeight)
-	if err != nil {
-		return nil, errors.Wrap(err, "could not fetch ETH1_FOLLOW_DISTANCE ancestor")
-	}
-	// Fetch all historical deposits up to an ancestor height.
-	depositsTillHeight, depositRoot := vs.DepositFetcher.DepositsNumberAndRootAtHeight(ctx, ancestorHeight)
-	if depositsTillHeight == 0 {
-		return vs.ChainStartFetcher.ChainStartEth1Data(), nil
-	}
-	return &ethpb.Eth1Data{
-		DepositRoot:  depositRoot[:],
-		BlockHash:    blockHash[:],
-		DepositCount: depositsTillHeight,
-	}, nil
-}
-
 // This filters the input attestations to return a list of valid attestations to be packaged inside a beacon block.
 func (vs *Server) filterAttestationsForBlockInclusion(ctx context.Context, slot uint64, atts []*ethpb.Attestation) ([]*ethpb.Attestation, error) {
 	ctx, span := trace.StartSpan(ctx, "ProposerServer.filterAttestationsForBlockInclusion")
@@ -5,7 +5,6 @@ import (
 	"context"
 	"math/big"
 	"reflect"
-	"strings"
 	"testing"
 
 	"github.com/gogo/protobuf/proto"
@@ -1071,13 +1070,16 @@ func TestPendingDeposits_CantReturnMoreDepositCount(t *testing.T) {
 	}
 }
 
-func TestEth1Data_EmptyVotesFetchBlockHashFailure(t *testing.T) {
-	beaconState, _ := beaconstate.InitializeFromProto(&pbp2p.BeaconState{
+func TestEth1Data_VoteFetchFailure(t *testing.T) {
+	beaconState, err := beaconstate.InitializeFromProto(&pbp2p.BeaconState{
 		Eth1Data: &ethpb.Eth1Data{
 			BlockHash: []byte{'a'},
 		},
 		Eth1DataVotes: []*ethpb.Eth1Data{},
 	})
+	if err != nil {
+		t.Fatal(err)
+	}
 	p := &mockPOW.FaultyMockPOWChain{
 		HashesByHeight: make(map[int][]byte),
 	}
@@ -1085,94 +1087,29 @@ func TestEth1Data_EmptyVotesFetchBlockHashFailure(t *testing.T) {
 		ChainStartFetcher: p,
 		Eth1InfoFetcher:   p,
 		Eth1BlockFetcher:  p,
+		Eth1VoteFetcher:   p,
 		BlockReceiver:     &mock.ChainService{State: beaconState},
 		HeadFetcher:       &mock.ChainService{State: beaconState},
 	}
-	want := "could not fetch ETH1_FOLLOW_DISTANCE ancestor"
-	if _, err := proposerServer.eth1Data(context.Background(), beaconState.Slot()+1); !strings.Contains(err.Error(), want) {
+	want := "could not get eth1 data vote: failed"
+	if _, err := proposerServer.eth1Data(context.Background(), beaconState.Slot()+1); err == nil || err.Error() != want {
 		t.Errorf("Expected error %v, received %v", want, err)
 	}
 }
 
-func TestDefaultEth1Data_NoBlockExists(t *testing.T) {
-	ctx := context.Background()
-
-	height := big.NewInt(int64(params.BeaconConfig().Eth1FollowDistance))
-	deps := []*dbpb.DepositContainer{
-		{
-			Index:           0,
-			Eth1BlockHeight: 8,
-			Deposit: &ethpb.Deposit{
-				Data: &ethpb.Deposit_Data{
-					PublicKey:             []byte("a"),
-					Signature:             make([]byte, 96),
-					WithdrawalCredentials: make([]byte, 32),
-				}},
-		},
-		{
-			Index:           1,
-			Eth1BlockHeight: 14,
-			Deposit: &ethpb.Deposit{
-				Data: &ethpb.Deposit_Data{
-					PublicKey:             []byte("b"),
-					Signature:             make([]byte, 96),
-					WithdrawalCredentials: make([]byte, 32),
-				}},
-		},
-	}
-	depositTrie, err := trieutil.NewTrie(int(params.BeaconConfig().DepositContractTreeDepth))
-	if err != nil {
-		t.Fatalf("could not setup deposit trie: %v", err)
-	}
-	depositCache := depositcache.NewDepositCache()
-	for _, dp := range deps {
-		depositCache.InsertDeposit(context.Background(), dp.Deposit, dp.Eth1BlockHeight, dp.Index, depositTrie.Root())
-	}
+func TestEth1Data(t *testing.T) {
+	slot := uint64(10000)
 
-	p := &mockPOW.POWChain{
-		LatestBlockNumber: height,
-		HashesByHeight: map[int][]byte{
-			0:  []byte("hash0"),
-			12: []byte("hash12"),
+	beaconState, err := beaconstate.InitializeFromProto(&pbp2p.BeaconState{
+		Slot: slot - 1,
+		Eth1Data: &ethpb.Eth1Data{
+			DepositCount: 50,
 		},
-	}
-	proposerServer := &Server{
-		ChainStartFetcher:      p,
-		Eth1InfoFetcher:        p,
-		Eth1BlockFetcher:       p,
-		DepositFetcher:         depositCache,
-		PendingDepositsFetcher: depositCache,
-	}
-
-	defEth1Data := &ethpb.Eth1Data{
-		DepositCount: 10,
-		BlockHash:    []byte{'t', 'e', 's', 't'},
-		DepositRoot:  []byte{'r', 'o', 'o', 't'},
-	}
-
-	p.Eth1Data = defEth1Data
-
-	result, err := proposerServer.defaultEth1DataResponse(ctx, big.NewInt(16))
+	})
 	if err != nil {
 		t.Fatal(err)
 	}
-
-	if !proto.Equal(result, defEth1Data) {
-		t.Errorf("Did not receive default eth1data. Wanted %v but Got %v", defEth1Data, result)
-	}
-}
-
-// TODO(2312): Add more tests for edge cases and better coverage.
-func TestEth1Data(t *testing.T) {
-	slot := uint64(10000)
-
 	p := &mockPOW.POWChain{
-		BlockNumberByHeight: map[uint64]*big.Int{
-			slot * params.BeaconConfig().SecondsPerSlot: big.NewInt(4096),
-		},
-		HashesByHeight: map[int][]byte{
-			4080: []byte("4080"),
-		},
 		Eth1Data: &ethpb.Eth1Data{
 			DepositCount: 55,
 		},
@@ -1181,6 +1118,8 @@ func TestEth1Data(t *testing.T) {
 		ChainStartFetcher: p,
 		Eth1InfoFetcher:   p,
 		Eth1BlockFetcher:  p,
+		Eth1VoteFetcher:   p,
+		HeadFetcher:       &mock.ChainService{State: beaconState},
 		DepositFetcher:    depositcache.NewDepositCache(),
 	}
 
@@ -1336,89 +1275,6 @@ func TestFilterAttestation_OK(t *testing.T) {
 	}
 }
 
-func Benchmark_Eth1Data(b *testing.B) {
-	ctx := context.Background()
-
-	hashesByHeight := make(map[int][]byte)
-
-	beaconState, _ := beaconstate.InitializeFromProto(&pbp2p.BeaconState{
-		Eth1DataVotes: []*ethpb.Eth1Data{},
-		Eth1Data: &ethpb.Eth1Data{
-			BlockHash: []byte("stub"),
-		},
-	})
-	var mockSig [96]byte
-	var mockCreds [32]byte
-	deposits := []*dbpb.DepositContainer{
-		{
-			Index: 0,
-			Deposit: &ethpb.Deposit{
-				Data: &ethpb.Deposit_Data{
-					PublicKey:             []byte("a"),
-					Signature:             mockSig[:],
-					WithdrawalCredentials: mockCreds[:],
-				}},
-		},
-		{
-			Index: 1,
-			Deposit: &ethpb.Deposit{
-				Data: &ethpb.Deposit_Data{
-					PublicKey:             []byte("b"),
-					Signature:             mockSig[:],
-					WithdrawalCredentials: mockCreds[:],
-				}},
-		},
-	}
-
-	depositCache := depositcache.NewDepositCache()
-	for i, dp := range deposits {
-		var root [32]byte
-		copy(root[:], []byte{'d', 'e', 'p', 'o', 's', 'i', 't', byte(i)})
-		depositCache.InsertDeposit(ctx, dp.Deposit, uint64(dp.Index), dp.Index, root)
-	}
-	numOfVotes := 1000
-	for i := 0; i < numOfVotes; i++ {
-		blockhash := []byte{'b', 'l', 'o', 'c', 'k', byte(i)}
-		deposit := []byte{'d', 'e', 'p', 'o', 's', 'i', 't', byte(i)}
-		beaconState.SetEth1DataVotes(append(beaconState.Eth1DataVotes(), &ethpb.Eth1Data{
-			BlockHash:   blockhash,
-			DepositRoot: deposit,
-		}))
-		hashesByHeight[i] = blockhash
-	}
-	hashesByHeight[numOfVotes+1] = []byte("stub")
-
-	blk := &ethpb.BeaconBlock{
-		Slot: beaconState.Slot(),
-	}
-	blkRoot, err := ssz.HashTreeRoot(blk)
-	if err != nil {
-		b.Fatal(err)
-	}
-
-	currentHeight := params.BeaconConfig().Eth1FollowDistance + 5
-	p := &mockPOW.POWChain{
-		LatestBlockNumber: big.NewInt(int64(currentHeight)),
-		HashesByHeight:    hashesByHeight,
-	}
-	proposerServer := &Server{
-		BlockReceiver:          &mock.ChainService{State: beaconState, Root: blkRoot[:]},
-		HeadFetcher:            &mock.ChainService{State: beaconState, Root: blkRoot[:]},
-		ChainStartFetcher:      p,
-		Eth1InfoFetcher:        p,
-		Eth1BlockFetcher:       p,
-		DepositFetcher:         depositCache,
-		PendingDepositsFetcher: depositCache,
-	}
-	b.ResetTimer()
-	for i := 0; i < b.N; i++ {
-		_, err := proposerServer.eth1Data(context.Background(), beaconState.Slot()+1)
-		if err != nil {
-			b.Fatal(err)
-		}
-	}
-}
-
 func TestDeposits_ReturnsEmptyList_IfLatestEth1DataEqGenesisEth1Block(t *testing.T) {
 	ctx := context.Background()
 
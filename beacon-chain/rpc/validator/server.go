@@ -65,9 +65,12 @@ type Server struct {
 	BlockReceiver          blockchain.BlockReceiver
 	MockEth1Votes          bool
 	Eth1BlockFetcher       powchain.POWBlockFetcher
+	Eth1VoteFetcher        powchain.Eth1VoteFetcher
 	PendingDepositsFetcher depositcache.PendingDepositsFetcher
 	OperationNotifier      opfeed.Notifier
 	StateGen               *stategen.State
+	BlockBodyProvider      BlockBodyProvider
+	BodyProviderTimeout    time.Duration
 }
 
 // WaitForActivation checks if a validator public key exists in the active validator registry of the current
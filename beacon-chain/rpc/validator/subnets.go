@@ -0,0 +1,65 @@
+package validator
+
+import (
+	"context"
+	"math/rand"
+	"time"
+
+	ptypes "github.com/gogo/protobuf/types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SubscribeCommitteeSubnets records the attestation subnets of the upcoming duties of the
+// validators. The node searches for peers on the subnets of all the duties, and joins the
+// subnets of the aggregator duties until their slot has passed.
+func (vs *Server) SubscribeCommitteeSubnets(ctx context.Context, req *pb.CommitteeSubnetsSubscribeRequest) (*ptypes.Empty, error) {
+	if len(req.Slots) != len(req.CommitteeIds) || len(req.CommitteeIds) != len(req.IsAggregator) {
+		return nil, status.Error(codes.InvalidArgument, "Request fields are not the same length")
+	}
+
+	currentSlot := vs.GenesisTimeFetcher.CurrentSlot()
+	for i, slot := range req.Slots {
+		// Duties of past slots need no subnet.
+		if slot < currentSlot {
+			continue
+		}
+		if req.CommitteeIds[i] >= params.BeaconConfig().MaxCommitteesPerSlot {
+			return nil, status.Errorf(codes.InvalidArgument, "Committee index %d is out of range", req.CommitteeIds[i])
+		}
+		cache.SubnetIDs.AddAttesterSubnetID(slot, req.CommitteeIds[i])
+		if req.IsAggregator[i] {
+			cache.SubnetIDs.AddAggregatorSubnetID(slot, req.CommitteeIds[i])
+		}
+	}
+	return &ptypes.Empty{}, nil
+}
+
+// assignValidatorToSubnet assigns random long-lived attestation subnets to an active
+// validator, which the node subscribes to and advertises in its ENR. The subnets are kept
+// for EpochsPerRandomSubnetSubscription epochs, after which new ones are picked.
+func assignValidatorToSubnet(pubkey []byte, status ethpb.ValidatorStatus) {
+	if status != ethpb.ValidatorStatus_ACTIVE && status != ethpb.ValidatorStatus_EXITING {
+		return
+	}
+	if _, ok, _ := cache.SubnetIDs.GetPersistentSubnets(pubkey); ok {
+		return
+	}
+
+	subnetCount := params.BeaconConfig().MaxCommitteesPerSlot
+	numSubnets := params.BeaconConfig().RandomSubnetsPerValidator
+	if numSubnets > subnetCount {
+		numSubnets = subnetCount
+	}
+	subnets := make([]uint64, 0, numSubnets)
+	for _, subnet := range rand.Perm(int(subnetCount))[:numSubnets] {
+		subnets = append(subnets, uint64(subnet))
+	}
+	epochDuration := time.Duration(params.BeaconConfig().SlotsPerEpoch*params.BeaconConfig().SecondsPerSlot) * time.Second
+	subscriptionLength := time.Duration(params.BeaconConfig().EpochsPerRandomSubnetSubscription) * epochDuration
+	cache.SubnetIDs.AddPersistentCommittee(pubkey, subnets, subscriptionLength)
+}
@@ -0,0 +1,91 @@
+package validator
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	mockChain "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func TestSubscribeCommitteeSubnets_OK(t *testing.T) {
+	vs := &Server{
+		GenesisTimeFetcher: &mockChain.ChainService{Genesis: time.Now()},
+	}
+	req := &pb.CommitteeSubnetsSubscribeRequest{
+		Slots:        []uint64{1000, 1000, 1001},
+		CommitteeIds: []uint64{1, 2, 3},
+		IsAggregator: []bool{false, true, false},
+	}
+	if _, err := vs.SubscribeCommitteeSubnets(context.Background(), req); err != nil {
+		t.Fatal(err)
+	}
+
+	if ids := cache.SubnetIDs.GetAttesterSubnetIDs(1000); len(ids) != 2 {
+		t.Errorf("Wanted 2 attester subnets at slot 1000, got %v", ids)
+	}
+	if ids := cache.SubnetIDs.GetAggregatorSubnetIDs(1000); !reflect.DeepEqual(ids, []uint64{2}) {
+		t.Errorf("Wanted aggregator subnets %v at slot 1000, got %v", []uint64{2}, ids)
+	}
+	if ids := cache.SubnetIDs.GetAttesterSubnetIDs(1001); !reflect.DeepEqual(ids, []uint64{3}) {
+		t.Errorf("Wanted attester subnets %v at slot 1001, got %v", []uint64{3}, ids)
+	}
+	if ids := cache.SubnetIDs.GetAggregatorSubnetIDs(1001); len(ids) != 0 {
+		t.Errorf("Wanted no aggregator subnets at slot 1001, got %v", ids)
+	}
+}
+
+func TestSubscribeCommitteeSubnets_MismatchedLengths(t *testing.T) {
+	vs := &Server{
+		GenesisTimeFetcher: &mockChain.ChainService{Genesis: time.Now()},
+	}
+	req := &pb.CommitteeSubnetsSubscribeRequest{
+		Slots:        []uint64{1, 2},
+		CommitteeIds: []uint64{1, 2},
+		IsAggregator: []bool{false},
+	}
+	if _, err := vs.SubscribeCommitteeSubnets(context.Background(), req); err == nil || !strings.Contains(err.Error(), "not the same length") {
+		t.Errorf("Expected error about mismatched lengths, got %v", err)
+	}
+}
+
+func TestAssignValidatorToSubnet(t *testing.T) {
+	pubKey := []byte("assign-validator-to-subnet")
+
+	assignValidatorToSubnet(pubKey, ethpb.ValidatorStatus_PENDING)
+	if _, ok, _ := cache.SubnetIDs.GetPersistentSubnets(pubKey); ok {
+		t.Fatal("Expected no subnets for a pending validator")
+	}
+
+	assignValidatorToSubnet(pubKey, ethpb.ValidatorStatus_ACTIVE)
+	subnets, ok, expTime := cache.SubnetIDs.GetPersistentSubnets(pubKey)
+	if !ok {
+		t.Fatal("Expected subnets for an active validator")
+	}
+	if uint64(len(subnets)) != params.BeaconConfig().RandomSubnetsPerValidator {
+		t.Errorf("Wanted %d subnets, got %d", params.BeaconConfig().RandomSubnetsPerValidator, len(subnets))
+	}
+	for _, subnet := range subnets {
+		if subnet >= params.BeaconConfig().MaxCommitteesPerSlot {
+			t.Errorf("Subnet %d is out of range", subnet)
+		}
+	}
+	epochDuration := time.Duration(params.BeaconConfig().SlotsPerEpoch*params.BeaconConfig().SecondsPerSlot) * time.Second
+	minExpiration := time.Now().Add(time.Duration(params.BeaconConfig().EpochsPerRandomSubnetSubscription-1) * epochDuration)
+	if expTime.Before(minExpiration) {
+		t.Errorf("Expected subnets to be held for %d epochs, they expire at %v", params.BeaconConfig().EpochsPerRandomSubnetSubscription, expTime)
+	}
+
+	// The subnets are kept until they expire.
+	assignValidatorToSubnet(pubKey, ethpb.ValidatorStatus_ACTIVE)
+	again, _, _ := cache.SubnetIDs.GetPersistentSubnets(pubKey)
+	if !reflect.DeepEqual(subnets, again) {
+		t.Errorf("Expected subnets %v to be kept, got %v", subnets, again)
+	}
+}
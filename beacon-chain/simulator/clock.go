@@ -0,0 +1,98 @@
+package simulator
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/slotutil"
+)
+
+var _ = slotutil.Clock(&Clock{})
+
+// Clock is the simulated time shared by the nodes of a network. It only moves forward when
+// the network advances it, at which point the timers which are due fire in order.
+type Clock struct {
+	lock   sync.Mutex
+	now    time.Time
+	timers []*timer
+}
+
+// timer fires once the clock reaches its deadline, either by sending the time on its
+// channel or by calling its function.
+type timer struct {
+	at time.Time
+	c  chan time.Time
+	f  func()
+}
+
+// NewClock returns a clock set at the given time.
+func NewClock(now time.Time) *Clock {
+	return &Clock{now: now}
+}
+
+// Now returns the simulated time.
+func (c *Clock) Now() time.Time {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.now
+}
+
+// After returns a channel receiving the simulated time once the clock has been advanced by
+// the duration.
+func (c *Clock) After(d time.Duration) <-chan time.Time {
+	ch := make(chan time.Time, 1)
+	c.schedule(&timer{c: ch}, d)
+	return ch
+}
+
+// AfterFunc calls the function once the clock has been advanced by the duration. The
+// function is called by the goroutine advancing the clock.
+func (c *Clock) AfterFunc(d time.Duration, f func()) {
+	c.schedule(&timer{f: f}, d)
+}
+
+func (c *Clock) schedule(t *timer, d time.Duration) {
+	c.lock.Lock()
+	t.at = c.now.Add(d)
+	if d > 0 {
+		c.timers = append(c.timers, t)
+		c.lock.Unlock()
+		return
+	}
+	now := c.now
+	c.lock.Unlock()
+	t.fire(now)
+}
+
+// Set advances the clock to the given time, and fires the timers due by then in the order
+// of their deadlines. The clock never goes back in time.
+func (c *Clock) Set(now time.Time) {
+	c.lock.Lock()
+	if now.Before(c.now) {
+		c.lock.Unlock()
+		return
+	}
+	c.now = now
+	sort.SliceStable(c.timers, func(i, j int) bool {
+		return c.timers[i].at.Before(c.timers[j].at)
+	})
+	var due []*timer
+	for len(c.timers) > 0 && !c.timers[0].at.After(now) {
+		due = append(due, c.timers[0])
+		c.timers = c.timers[1:]
+	}
+	c.lock.Unlock()
+
+	for _, t := range due {
+		t.fire(now)
+	}
+}
+
+func (t *timer) fire(now time.Time) {
+	if t.f != nil {
+		t.f()
+		return
+	}
+	t.c <- now
+}
@@ -0,0 +1,333 @@
+package simulator
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/libp2p/go-libp2p-core/host"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	dbtest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateutil"
+	prysmsync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
+	initialsync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// maxRoutines is the goroutine limit of the blockchain service of a node, the default of
+// the beacon node.
+const maxRoutines = 5000
+
+// Node is a beacon node of the simulated network. It runs the blockchain, regular sync,
+// initial sync and attestation pool services of a beacon node, which all read the clock of
+// the network.
+type Node struct {
+	index        int
+	p2p          *simP2P
+	db           db.Database
+	attPool      attestations.Pool
+	opsService   *attestations.Service
+	chain        *blockchain.Service
+	initialSync  *initialsync.Service
+	regularSync  *prysmsync.Service
+	stateFeed    *event.Feed
+	blockFeed    *event.Feed
+	opFeed       *event.Feed
+	receivedLock sync.RWMutex
+	blocks       map[[32]byte]bool
+	atts         map[[32]byte]bool
+}
+
+// newNode creates a node of the network, with the genesis state saved in its database.
+func newNode(ctx context.Context, t *testing.T, n *Network, index int, genesisState *stateTrie.BeaconState) *Node {
+	h, err := n.mocknet.GenPeer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	gossipHost, err := n.mocknet.GenPeer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	inboxHost, err := n.mocknet.GenPeer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ps, err := newPubSub(ctx, gossipHost)
+	if err != nil {
+		t.Fatal(err)
+	}
+	inbox, err := newPubSub(ctx, inboxHost)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := n.mocknet.LinkPeers(gossipHost.ID(), inboxHost.ID()); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := n.mocknet.ConnectPeers(gossipHost.ID(), inboxHost.ID()); err != nil {
+		t.Fatal(err)
+	}
+	genesisValidatorsRoot, err := stateutil.ValidatorRegistryRoot(genesisState.Validators())
+	if err != nil {
+		t.Fatal(err)
+	}
+	p2pService := &simP2P{
+		index:                 index,
+		network:               n,
+		host:                  h,
+		gossipHost:            gossipHost,
+		pubsub:                ps,
+		inbox:                 inbox,
+		peers:                 peers.NewStatus(5 /* maxBadResponses */),
+		genesisTime:           n.genesisTime,
+		genesisValidatorsRoot: genesisValidatorsRoot[:],
+	}
+
+	beaconDB := dbtest.SetupDB(t)
+	if err := saveGenesisState(ctx, beaconDB, genesisState); err != nil {
+		t.Fatal(err)
+	}
+	node := &Node{
+		index:     index,
+		p2p:       p2pService,
+		db:        beaconDB,
+		attPool:   attestations.NewPool(),
+		stateFeed: new(event.Feed),
+		blockFeed: new(event.Feed),
+		opFeed:    new(event.Feed),
+		blocks:    make(map[[32]byte]bool),
+		atts:      make(map[[32]byte]bool),
+	}
+	node.recordReceived(ctx)
+	node.opsService, err = attestations.NewService(ctx, &attestations.Config{
+		Pool:  node.attPool,
+		Clock: n.clock,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	stateSummaryCache := cache.NewStateSummaryCache()
+	exitPool := voluntaryexits.NewPool()
+	slashingPool := slashings.NewPool()
+	node.chain, err = blockchain.NewService(ctx, &blockchain.Config{
+		BeaconDB:        beaconDB,
+		DepositCache:    depositcache.NewDepositCache(),
+		AttPool:         node.attPool,
+		ExitPool:        exitPool,
+		SlashingPool:    slashingPool,
+		P2p:             p2pService,
+		MaxRoutines:     maxRoutines,
+		StateNotifier:   node,
+		ForkChoiceStore: protoarray.New(0, 0, params.BeaconConfig().ZeroHash),
+		OpsService:      node.opsService,
+		StateGen:        stategen.New(beaconDB, stateSummaryCache),
+		Clock:           n.clock,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	node.initialSync = initialsync.NewInitialSync(&initialsync.Config{
+		DB:            beaconDB,
+		Chain:         node.chain,
+		P2P:           p2pService,
+		StateNotifier: node,
+		BlockNotifier: node,
+		Clock:         n.clock,
+	})
+	node.regularSync = prysmsync.NewRegularSync(&prysmsync.Config{
+		DB:                  beaconDB,
+		P2P:                 p2pService,
+		Chain:               node.chain,
+		InitialSync:         node.initialSync,
+		StateNotifier:       node,
+		BlockNotifier:       node,
+		AttestationNotifier: node,
+		AttPool:             node.attPool,
+		ExitPool:            exitPool,
+		SlashingPool:        slashingPool,
+		StateSummaryCache:   stateSummaryCache,
+		Clock:               n.clock,
+	})
+	return node
+}
+
+// newPubSub returns a floodsub router for the host. Messages are not signed, as the inbox of
+// a node publishes the messages of every other node.
+func newPubSub(ctx context.Context, h host.Host) (*pubsub.PubSub, error) {
+	return pubsub.NewFloodSub(ctx, h,
+		pubsub.WithMessageSigning(false),
+		pubsub.WithStrictSignatureVerification(false),
+	)
+}
+
+// recordReceived records the blocks processed by the blockchain service of the node, and the
+// attestations received by its sync service, until the context is cancelled. The network
+// waits for the messages it delivers to the node to be recorded before moving on.
+func (nd *Node) recordReceived(ctx context.Context) {
+	stateChannel := make(chan *feed.Event, 1)
+	stateSub := nd.stateFeed.Subscribe(stateChannel)
+	opChannel := make(chan *feed.Event, 1)
+	opSub := nd.opFeed.Subscribe(opChannel)
+	go func() {
+		defer stateSub.Unsubscribe()
+		defer opSub.Unsubscribe()
+		for {
+			select {
+			case event := <-stateChannel:
+				if data, ok := event.Data.(*statefeed.BlockProcessedData); ok {
+					nd.receivedLock.Lock()
+					nd.blocks[data.BlockRoot] = true
+					nd.receivedLock.Unlock()
+				}
+			case event := <-opChannel:
+				data, ok := event.Data.(*operation.UnAggregatedAttReceivedData)
+				if !ok {
+					continue
+				}
+				root, err := ssz.HashTreeRoot(data.Attestation)
+				if err != nil {
+					log.WithError(err).Error("Could not hash attestation")
+					continue
+				}
+				nd.receivedLock.Lock()
+				nd.atts[root] = true
+				nd.receivedLock.Unlock()
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// processedBlock returns whether the blockchain service of the node processed the block.
+func (nd *Node) processedBlock(root [32]byte) bool {
+	nd.receivedLock.RLock()
+	defer nd.receivedLock.RUnlock()
+	return nd.blocks[root]
+}
+
+// receivedAttestation returns whether the sync service of the node received the attestation.
+func (nd *Node) receivedAttestation(root [32]byte) bool {
+	nd.receivedLock.RLock()
+	defer nd.receivedLock.RUnlock()
+	return nd.atts[root]
+}
+
+// start the services of the node. The blockchain service is started first, as the other
+// services wait for the chain to be initialized from the database.
+func (nd *Node) start() {
+	nd.opsService.Start()
+	nd.chain.Start()
+	go nd.initialSync.Start()
+	nd.regularSync.Start()
+}
+
+// stop the services of the node and tear down its database.
+func (nd *Node) stop(t *testing.T) {
+	if err := nd.regularSync.Stop(); err != nil {
+		t.Error(err)
+	}
+	if err := nd.initialSync.Stop(); err != nil {
+		t.Error(err)
+	}
+	if err := nd.chain.Stop(); err != nil {
+		t.Error(err)
+	}
+	if err := nd.opsService.Stop(); err != nil {
+		t.Error(err)
+	}
+	dbtest.TeardownDB(t, nd.db)
+}
+
+// StateFeed implements statefeed.Notifier.
+func (nd *Node) StateFeed() *event.Feed {
+	return nd.stateFeed
+}
+
+// BlockFeed implements blockfeed.Notifier.
+func (nd *Node) BlockFeed() *event.Feed {
+	return nd.blockFeed
+}
+
+// OperationFeed implements opfeed.Notifier.
+func (nd *Node) OperationFeed() *event.Feed {
+	return nd.opFeed
+}
+
+// HeadRoot returns the root of the head block of the node.
+func (nd *Node) HeadRoot() ([32]byte, error) {
+	root, err := nd.chain.HeadRoot(context.Background())
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return bytesutil.ToBytes32(root), nil
+}
+
+// HeadSlot returns the slot of the head block of the node.
+func (nd *Node) HeadSlot() uint64 {
+	return nd.chain.HeadSlot()
+}
+
+// FinalizedCheckpoint returns the finalized checkpoint of the node.
+func (nd *Node) FinalizedCheckpoint() *ethpb.Checkpoint {
+	return nd.chain.FinalizedCheckpt()
+}
+
+// saveGenesisState saves the genesis state and block in the database, and sets them as the
+// head and checkpoints of the chain, as the interop cold start service does.
+func saveGenesisState(ctx context.Context, beaconDB db.Database, genesisState *stateTrie.BeaconState) error {
+	stateRoot, err := genesisState.HashTreeRoot(ctx)
+	if err != nil {
+		return err
+	}
+	genesisBlk := blocks.NewGenesisBlock(stateRoot[:])
+	genesisBlkRoot, err := ssz.HashTreeRoot(genesisBlk.Block)
+	if err != nil {
+		return errors.Wrap(err, "could not get genesis block root")
+	}
+	if err := beaconDB.SaveBlock(ctx, genesisBlk); err != nil {
+		return errors.Wrap(err, "could not save genesis block")
+	}
+	if err := beaconDB.SaveState(ctx, genesisState, genesisBlkRoot); err != nil {
+		return errors.Wrap(err, "could not save genesis state")
+	}
+	if err := beaconDB.SaveGenesisBlockRoot(ctx, genesisBlkRoot); err != nil {
+		return errors.Wrap(err, "could save genesis block root")
+	}
+	if err := beaconDB.SaveHeadBlockRoot(ctx, genesisBlkRoot); err != nil {
+		return errors.Wrap(err, "could not save head block root")
+	}
+	genesisCheckpoint := &ethpb.Checkpoint{Root: genesisBlkRoot[:]}
+	if err := beaconDB.SaveJustifiedCheckpoint(ctx, genesisCheckpoint); err != nil {
+		return errors.Wrap(err, "could save justified checkpoint")
+	}
+	if err := beaconDB.SaveFinalizedCheckpoint(ctx, genesisCheckpoint); err != nil {
+		return errors.Wrap(err, "could save finalized checkpoint")
+	}
+	for i := uint64(0); i < uint64(genesisState.NumValidators()); i++ {
+		pk := genesisState.PubkeyAtIndex(i)
+		if err := beaconDB.SaveValidatorIndex(ctx, pk[:], i); err != nil {
+			return errors.Wrapf(err, "could not save validator index: %d", i)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,187 @@
+package simulator
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/libp2p/go-libp2p-core/host"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/libp2p/go-libp2p-core/protocol"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/encoder"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+)
+
+var _ = p2p.P2P(&simP2P{})
+
+// simP2P is the p2p service of a simulated node. Req/resp streams go through the host of the
+// node over the links of the mock network. Gossip is routed by the network instead: the node
+// only receives gossip through its inbox, a pubsub peer connected to the node alone, which
+// publishes the messages sent to the node over the links of the simulation.
+type simP2P struct {
+	index                 int
+	network               *Network
+	host                  host.Host
+	gossipHost            host.Host
+	pubsub                *pubsub.PubSub
+	inbox                 *pubsub.PubSub
+	peers                 *peers.Status
+	genesisTime           time.Time
+	genesisValidatorsRoot []byte
+}
+
+// simStream is a stream of the mock network, which does not support deadlines. The deadlines
+// set by the req/resp handlers are ignored, streams are reset when the link of the nodes is
+// cut instead.
+type simStream struct {
+	network.Stream
+}
+
+// SetDeadline is a no-op.
+func (s simStream) SetDeadline(time.Time) error {
+	return nil
+}
+
+// SetReadDeadline is a no-op.
+func (s simStream) SetReadDeadline(time.Time) error {
+	return nil
+}
+
+// SetWriteDeadline is a no-op.
+func (s simStream) SetWriteDeadline(time.Time) error {
+	return nil
+}
+
+// Broadcast a message to the gossip topic of its type. The network sends the message over
+// every link of the node, subject to the latency and message filter of the link.
+func (p *simP2P) Broadcast(ctx context.Context, msg proto.Message) error {
+	topic, ok := p2p.GossipTypeMapping[reflect.TypeOf(msg)]
+	if !ok {
+		return p2p.ErrMessageNotMapped
+	}
+	digest, err := p2p.CreateForkDigest(p.genesisTime, p.genesisValidatorsRoot)
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve fork digest")
+	}
+	if att, ok := msg.(*ethpb.Attestation); ok {
+		topic = fmt.Sprintf(topic, digest, att.Data.CommitteeIndex)
+	} else {
+		topic = fmt.Sprintf(topic, digest)
+	}
+	topic += p.Encoding().ProtocolSuffix()
+
+	buf := new(bytes.Buffer)
+	if _, err := p.Encoding().Encode(buf, msg); err != nil {
+		return errors.Wrap(err, "could not encode message")
+	}
+	p.network.gossip(p.index, msg, topic, buf.Bytes())
+	return nil
+}
+
+// SetStreamHandler for RPC.
+func (p *simP2P) SetStreamHandler(topic string, handler network.StreamHandler) {
+	p.host.SetStreamHandler(protocol.ID(topic), func(stream network.Stream) {
+		handler(simStream{stream})
+	})
+}
+
+// Encoding returns ssz encoding.
+func (p *simP2P) Encoding() encoder.NetworkEncoding {
+	return &encoder.SszNetworkEncoder{}
+}
+
+// PubSub returns the floodsub router of the node, which is only connected to the inbox of
+// the node.
+func (p *simP2P) PubSub() *pubsub.PubSub {
+	return p.pubsub
+}
+
+// Disconnect from a peer.
+func (p *simP2P) Disconnect(pid peer.ID) error {
+	return p.host.Network().ClosePeer(pid)
+}
+
+// PeerID returns the Peer ID of the local peer.
+func (p *simP2P) PeerID() peer.ID {
+	return p.host.ID()
+}
+
+// RefreshENR is a no-op, simulated nodes have no ENR.
+func (p *simP2P) RefreshENR() {
+}
+
+// FindPeersWithSubnet is a no-op, the peers of a simulated node are set by the network.
+func (p *simP2P) FindPeersWithSubnet(index uint64) (bool, error) {
+	return false, nil
+}
+
+// Send a request to a peer.
+func (p *simP2P) Send(ctx context.Context, msg interface{}, pid peer.ID) (network.Stream, error) {
+	topic, ok := p2p.RPCTypeMapping[reflect.TypeOf(msg)]
+	if !ok {
+		return nil, fmt.Errorf("protocol doesnt exist for proto message: %v", msg)
+	}
+	stream, err := p.host.NewStream(ctx, pid, protocol.ID(topic+p.Encoding().ProtocolSuffix()))
+	if err != nil {
+		return nil, err
+	}
+	if _, err := p.Encoding().EncodeWithLength(stream, msg); err != nil {
+		return nil, err
+	}
+	// Close stream for writing.
+	if err := stream.Close(); err != nil {
+		return nil, err
+	}
+	return simStream{stream}, nil
+}
+
+// AddConnectionHandler handles the connection with a newly connected peer.
+func (p *simP2P) AddConnectionHandler(f func(ctx context.Context, id peer.ID) error) {
+	p.host.Network().Notify(&network.NotifyBundle{
+		ConnectedF: func(net network.Network, conn network.Conn) {
+			// Must be handled in a goroutine as this callback cannot be blocking.
+			go func() {
+				p.peers.Add(conn.RemotePeer(), conn.RemoteMultiaddr(), conn.Stat().Direction, []uint64{})
+				p.peers.SetConnectionState(conn.RemotePeer(), peers.PeerConnecting)
+				if err := f(context.Background(), conn.RemotePeer()); err != nil {
+					log.WithError(err).WithField("node", p.index).Debug("Handshake with peer failed")
+					if err := p.Disconnect(conn.RemotePeer()); err != nil {
+						log.WithError(err).Errorf("Unable to close peer %s", conn.RemotePeer())
+					}
+					p.peers.SetConnectionState(conn.RemotePeer(), peers.PeerDisconnected)
+					return
+				}
+				p.peers.SetConnectionState(conn.RemotePeer(), peers.PeerConnected)
+			}()
+		},
+	})
+}
+
+// AddDisconnectionHandler handles the disconnection of a peer.
+func (p *simP2P) AddDisconnectionHandler(f func(ctx context.Context, id peer.ID) error) {
+	p.host.Network().Notify(&network.NotifyBundle{
+		DisconnectedF: func(net network.Network, conn network.Conn) {
+			// Must be handled in a goroutine as this callback cannot be blocking.
+			go func() {
+				p.peers.SetConnectionState(conn.RemotePeer(), peers.PeerDisconnecting)
+				if err := f(context.Background(), conn.RemotePeer()); err != nil {
+					log.WithError(err).Debug("Failed to handle peer disconnection")
+				}
+				p.peers.SetConnectionState(conn.RemotePeer(), peers.PeerDisconnected)
+			}()
+		},
+	})
+}
+
+// Peers returns the peer status.
+func (p *simP2P) Peers() *peers.Status {
+	return p.peers
+}
@@ -0,0 +1,519 @@
+// Package simulator runs a network of beacon nodes in a single process, so that sync and
+// fork choice bugs can be reproduced in a go test. Every node runs the blockchain, regular
+// sync, initial sync and attestation pool services of a beacon node, and the validators of
+// an interop genesis state are split across the nodes. The network performs the proposer
+// and attester duties of the validators slot by slot, and its links may be cut, slowed down
+// or made to drop and delay messages.
+//
+// The nodes share a simulated clock, which only moves when the network runs a slot, so a
+// simulation does not depend on the speed of the machine running it. The nodes exchange
+// req/resp messages over an in-memory libp2p network, and the network routes their gossip
+// itself, so that the latency and message filter of every link follow the simulated clock.
+// Gossip is sent to the nodes linked to the sender and is not relayed any further. After
+// every duty, the network waits for the messages it delivered to be processed before moving
+// on.
+package simulator
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	mocknet "github.com/libp2p/go-libp2p/p2p/net/mock"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/interop"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/slotutil"
+)
+
+// genesisTime of every simulated network, so that simulations are reproducible.
+var genesisTime = time.Unix(1577836800, 0)
+
+// pollInterval is the interval at which the network checks whether the nodes processed the
+// messages delivered to them.
+const pollInterval = 10 * time.Millisecond
+
+// processTimeout bounds the time the network waits for a node to process a message. It only
+// guards against a message which is never processed, nodes normally take milliseconds.
+const processTimeout = 10 * time.Second
+
+// MessageFilter decides the fate of a gossip message sent over a link. It returns whether the
+// message is dropped, and otherwise how long it is delayed for, on top of the latency of the
+// link.
+type MessageFilter func(msg proto.Message) (drop bool, delay time.Duration)
+
+// Config of the simulated network.
+type Config struct {
+	NumNodes      int
+	NumValidators uint64
+}
+
+// Network of simulated beacon nodes.
+type Network struct {
+	t              *testing.T
+	ctx            context.Context
+	cancel         context.CancelFunc
+	mocknet        mocknet.Mocknet
+	clock          *Clock
+	nodes          []*Node
+	privKeys       []*bls.SecretKey
+	slot           uint64
+	lock           sync.RWMutex
+	links          [][]*link
+	deliveriesLock sync.Mutex
+	deliveries     []*delivery
+}
+
+// link carries the gossip of a node to another node. Nodes are connected by a link in each
+// direction, which may be slowed down or filter messages independently.
+type link struct {
+	connected bool
+	latency   time.Duration
+	filter    MessageFilter
+}
+
+// delivery of a gossip message to a node, which the network waits for once it is due.
+type delivery struct {
+	to  int
+	msg proto.Message
+	due bool
+}
+
+// New creates a network of nodes sharing an interop genesis state, and running with the
+// beacon config of the process.
+func New(t *testing.T, cfg *Config) *Network {
+	ctx, cancel := context.WithCancel(context.Background())
+	n := &Network{
+		t:       t,
+		ctx:     ctx,
+		cancel:  cancel,
+		mocknet: mocknet.New(ctx),
+		clock:   NewClock(genesisTime),
+		links:   make([][]*link, cfg.NumNodes),
+	}
+	genesisState, _, err := interop.GenerateGenesisState(uint64(genesisTime.Unix()), cfg.NumValidators)
+	if err != nil {
+		t.Fatal(err)
+	}
+	privKeys, _, err := interop.DeterministicallyGenerateKeys(0 /* startIndex */, cfg.NumValidators)
+	if err != nil {
+		t.Fatal(err)
+	}
+	n.privKeys = privKeys
+	for i := 0; i < cfg.NumNodes; i++ {
+		st, err := stateTrie.InitializeFromProto(genesisState)
+		if err != nil {
+			t.Fatal(err)
+		}
+		n.nodes = append(n.nodes, newNode(ctx, t, n, i, st))
+		n.links[i] = make([]*link, cfg.NumNodes)
+		for j := range n.links[i] {
+			n.links[i][j] = &link{}
+		}
+	}
+	return n
+}
+
+// Start the nodes and connect them to each other. The clock of the network stays at the
+// genesis time until slots are run.
+func (n *Network) Start() {
+	for _, nd := range n.nodes {
+		nd.start()
+	}
+	n.Heal()
+}
+
+// Stop the nodes and the mock network.
+func (n *Network) Stop() {
+	n.cancel()
+	for _, nd := range n.nodes {
+		nd.stop(n.t)
+	}
+	if err := n.mocknet.Close(); err != nil {
+		n.t.Error(err)
+	}
+}
+
+// Node returns the node with the given index.
+func (n *Network) Node(i int) *Node {
+	return n.nodes[i]
+}
+
+// Slot returns the last slot run by the network.
+func (n *Network) Slot() uint64 {
+	return n.slot
+}
+
+// RunSlots runs the given number of slots.
+func (n *Network) RunSlots(count uint64) {
+	for i := uint64(0); i < count; i++ {
+		n.runSlot()
+	}
+}
+
+// RunSlotsUntil runs slots until the condition holds, and returns the last error of the
+// condition if it does not hold after the given number of slots.
+func (n *Network) RunSlotsUntil(maxSlots uint64, condition func() error) error {
+	for i := uint64(0); ; i++ {
+		err := condition()
+		if err == nil {
+			return nil
+		}
+		if i == maxSlots {
+			return err
+		}
+		n.runSlot()
+	}
+}
+
+// runSlot performs the duties of the validators at the next slot: the proposer of the slot
+// proposes a block at the start of the slot, and the attesters of the slot attest to the head
+// of their node a third of the way through the slot.
+func (n *Network) runSlot() {
+	n.slot++
+	slot := n.slot
+	start := slotutil.SlotStartTime(uint64(genesisTime.Unix()), slot)
+
+	n.advance(start)
+	n.forEachNode(func(nd *Node) {
+		if err := n.propose(n.ctx, nd, slot); err != nil {
+			log.WithError(err).WithField("node", nd.index).Error("Could not propose block")
+		}
+	})
+	n.waitForDeliveries()
+
+	n.advance(start.Add(time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second / 3))
+	n.forEachNode(func(nd *Node) {
+		if err := n.attest(n.ctx, nd, slot); err != nil {
+			log.WithError(err).WithField("node", nd.index).Error("Could not attest")
+		}
+	})
+	n.waitForDeliveries()
+}
+
+// advance the clock of the network, which delivers the gossip due by then, and waits for the
+// nodes to process it.
+func (n *Network) advance(now time.Time) {
+	n.clock.Set(now)
+	n.waitForDeliveries()
+}
+
+// forEachNode runs the function for every node concurrently, and waits for all of them.
+func (n *Network) forEachNode(f func(nd *Node)) {
+	var wg sync.WaitGroup
+	for _, nd := range n.nodes {
+		wg.Add(1)
+		go func(nd *Node) {
+			defer wg.Done()
+			f(nd)
+		}(nd)
+	}
+	wg.Wait()
+}
+
+// Connect links two nodes to each other.
+func (n *Network) Connect(i, j int) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	n.connect(i, j)
+}
+
+// Disconnect cuts the links between two nodes.
+func (n *Network) Disconnect(i, j int) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	n.disconnect(i, j)
+}
+
+// Partition splits the network into the given groups of node indices. Nodes stay connected
+// to the nodes of their group only, a node which is not part of any group is isolated.
+func (n *Network) Partition(groups ...[]int) {
+	group := make(map[int]int)
+	for g, indices := range groups {
+		for _, i := range indices {
+			group[i] = g + 1
+		}
+	}
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	for i := range n.nodes {
+		for j := i + 1; j < len(n.nodes); j++ {
+			if group[i] == 0 || group[i] != group[j] {
+				n.disconnect(i, j)
+			}
+		}
+	}
+}
+
+// Heal connects every node of the network to each other.
+func (n *Network) Heal() {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	for i := range n.nodes {
+		for j := i + 1; j < len(n.nodes); j++ {
+			n.connect(i, j)
+		}
+	}
+}
+
+// connect links the hosts of two nodes in the mock network and connects them, which starts
+// the handshake of their sync services. The lock of the network must be held.
+func (n *Network) connect(i, j int) {
+	if i == j || n.links[i][j].connected {
+		return
+	}
+	n.links[i][j].connected, n.links[j][i].connected = true, true
+	a, b := n.nodes[i].p2p.host.ID(), n.nodes[j].p2p.host.ID()
+	if _, err := n.mocknet.LinkPeers(a, b); err != nil {
+		log.WithError(err).Errorf("Could not link node %d to node %d", i, j)
+		return
+	}
+	if _, err := n.mocknet.ConnectPeers(a, b); err != nil {
+		log.WithError(err).Errorf("Could not connect node %d to node %d", i, j)
+	}
+}
+
+// disconnect the hosts of two nodes and unlinks them in the mock network, so that they
+// cannot dial each other again. The lock of the network must be held.
+func (n *Network) disconnect(i, j int) {
+	if i == j || !n.links[i][j].connected {
+		return
+	}
+	n.links[i][j].connected, n.links[j][i].connected = false, false
+	a, b := n.nodes[i].p2p.host.ID(), n.nodes[j].p2p.host.ID()
+	if err := n.mocknet.DisconnectPeers(a, b); err != nil {
+		log.WithError(err).Errorf("Could not disconnect node %d from node %d", i, j)
+	}
+	if err := n.mocknet.UnlinkPeers(a, b); err != nil {
+		log.WithError(err).Errorf("Could not unlink node %d from node %d", i, j)
+	}
+}
+
+// SetLatency sets the delay of the gossip sent by a node to another node.
+func (n *Network) SetLatency(from, to int, latency time.Duration) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	n.links[from][to].latency = latency
+}
+
+// SetMessageFilter sets the filter applied to the gossip sent by a node to another node, a
+// nil filter lets every message through.
+func (n *Network) SetMessageFilter(from, to int, filter MessageFilter) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	n.links[from][to].filter = filter
+}
+
+// gossip sends a message broadcast by a node over its links. A message is delivered once the
+// latency of its link has elapsed on the clock of the network, unless the link drops it or is
+// cut in the meantime.
+func (n *Network) gossip(from int, msg proto.Message, topic string, data []byte) {
+	for to := range n.nodes {
+		if to == from {
+			continue
+		}
+		n.lock.RLock()
+		l := *n.links[from][to]
+		n.lock.RUnlock()
+		if !l.connected {
+			continue
+		}
+		delay := l.latency
+		if l.filter != nil {
+			drop, filterDelay := l.filter(msg)
+			if drop {
+				continue
+			}
+			delay += filterDelay
+		}
+		d := &delivery{to: to, msg: msg}
+		n.deliveriesLock.Lock()
+		n.deliveries = append(n.deliveries, d)
+		n.deliveriesLock.Unlock()
+		n.clock.AfterFunc(delay, func() {
+			n.deliver(from, d, topic, data)
+		})
+	}
+}
+
+// deliver a message to a node, by publishing it from the inbox of the node.
+func (n *Network) deliver(from int, d *delivery, topic string, data []byte) {
+	n.lock.RLock()
+	connected := n.links[from][d.to].connected
+	n.lock.RUnlock()
+	if !connected {
+		n.forget(d)
+		return
+	}
+	// The inbox learns about the subscriptions of the node asynchronously. A node which does
+	// not subscribe to the topic in time does not receive the message.
+	p := n.nodes[d.to].p2p
+	subscribed := func() error {
+		for _, pid := range p.inbox.ListPeers(topic) {
+			if pid == p.gossipHost.ID() {
+				return nil
+			}
+		}
+		return fmt.Errorf("node %d is not subscribed to %s", d.to, topic)
+	}
+	if err := n.waitFor(processTimeout, subscribed); err != nil {
+		log.WithError(err).Warn("Could not deliver message")
+		n.forget(d)
+		return
+	}
+	if err := p.inbox.Publish(topic, data); err != nil {
+		log.WithError(err).WithField("node", d.to).Error("Could not publish message")
+		n.forget(d)
+		return
+	}
+	n.deliveriesLock.Lock()
+	d.due = true
+	n.deliveriesLock.Unlock()
+}
+
+// forget a delivery which did not happen.
+func (n *Network) forget(d *delivery) {
+	n.deliveriesLock.Lock()
+	defer n.deliveriesLock.Unlock()
+	for i, other := range n.deliveries {
+		if other == d {
+			n.deliveries = append(n.deliveries[:i], n.deliveries[i+1:]...)
+			return
+		}
+	}
+}
+
+// waitForDeliveries waits for the nodes to process the messages delivered to them so far.
+// Messages which are not due yet are waited for once the clock reaches them.
+func (n *Network) waitForDeliveries() {
+	n.deliveriesLock.Lock()
+	var due, pending []*delivery
+	for _, d := range n.deliveries {
+		if d.due {
+			due = append(due, d)
+		} else {
+			pending = append(pending, d)
+		}
+	}
+	n.deliveries = pending
+	n.deliveriesLock.Unlock()
+
+	for _, d := range due {
+		if err := n.waitFor(processTimeout, func() error { return n.processed(d) }); err != nil {
+			log.WithError(err).Warn("Message was not processed")
+		}
+	}
+}
+
+// processed returns an error unless the node processed the message delivered to it. Blocks
+// are processed once the blockchain service of the node processed them, or their ancestors
+// fetched from the peers of the node. Attestations are processed once the sync service of
+// the node received them, or queued them until the block they vote for is known.
+func (n *Network) processed(d *delivery) error {
+	nd := n.nodes[d.to]
+	switch msg := d.msg.(type) {
+	case *ethpb.SignedBeaconBlock:
+		root, err := ssz.HashTreeRoot(msg.Block)
+		if err != nil {
+			return err
+		}
+		if !nd.processedBlock(root) {
+			return fmt.Errorf("node %d did not process block %#x of slot %d", d.to, root[:8], msg.Block.Slot)
+		}
+	case *ethpb.Attestation:
+		root, err := ssz.HashTreeRoot(msg)
+		if err != nil {
+			return err
+		}
+		if nd.receivedAttestation(root) || !nd.db.HasBlock(n.ctx, bytesutil.ToBytes32(msg.Data.BeaconBlockRoot)) {
+			return nil
+		}
+		if ok, err := nd.attPool.HasAggregatedAttestation(msg); err != nil || ok {
+			return err
+		}
+		return fmt.Errorf("node %d did not receive attestation %#x of slot %d", d.to, root[:8], msg.Data.Slot)
+	}
+	return nil
+}
+
+// HeadsConverged returns an error with the heads of the nodes, unless every node has the
+// same head block.
+func (n *Network) HeadsConverged() error {
+	var first [32]byte
+	for i, nd := range n.nodes {
+		root, err := nd.HeadRoot()
+		if err != nil {
+			return err
+		}
+		if i == 0 {
+			first = root
+			continue
+		}
+		if root != first {
+			return fmt.Errorf("heads did not converge: %s", n.heads())
+		}
+	}
+	return nil
+}
+
+// Finalized returns an error with the finalized checkpoints of the nodes, unless every node
+// finalized the same checkpoint, at the given epoch or later.
+func (n *Network) Finalized(epoch uint64) error {
+	first := n.nodes[0].FinalizedCheckpoint()
+	for _, nd := range n.nodes {
+		cp := nd.FinalizedCheckpoint()
+		if cp.Epoch < epoch || !proto.Equal(cp, first) {
+			return fmt.Errorf("nodes did not finalize epoch %d: %s", epoch, n.finalizedCheckpoints())
+		}
+	}
+	return nil
+}
+
+// waitFor polls the condition until it holds or the timeout expires, in which case the last
+// error of the condition is returned.
+func (n *Network) waitFor(timeout time.Duration, condition func() error) error {
+	deadline := time.After(timeout)
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	for {
+		err := condition()
+		if err == nil {
+			return nil
+		}
+		select {
+		case <-ticker.C:
+		case <-deadline:
+			return err
+		case <-n.ctx.Done():
+			return n.ctx.Err()
+		}
+	}
+}
+
+func (n *Network) heads() string {
+	heads := ""
+	for _, nd := range n.nodes {
+		root, err := nd.HeadRoot()
+		if err != nil {
+			return err.Error()
+		}
+		heads += fmt.Sprintf("[node %d: slot %d root %#x] ", nd.index, nd.HeadSlot(), root[:8])
+	}
+	return heads
+}
+
+func (n *Network) finalizedCheckpoints() string {
+	checkpoints := ""
+	for _, nd := range n.nodes {
+		cp := nd.FinalizedCheckpoint()
+		checkpoints += fmt.Sprintf("[node %d: epoch %d root %#x] ", nd.index, cp.Epoch, cp.Root)
+	}
+	return checkpoints
+}
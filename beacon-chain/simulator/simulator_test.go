@@ -0,0 +1,104 @@
+package simulator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func TestNetwork_HeadsConverge(t *testing.T) {
+	n := New(t, &Config{NumNodes: 4, NumValidators: 64})
+	n.Start()
+	defer n.Stop()
+
+	n.RunSlots(6)
+	if err := n.HeadsConverged(); err != nil {
+		t.Fatal(err)
+	}
+	if slot := n.Node(0).HeadSlot(); slot != 6 {
+		t.Errorf("Expected the head of the nodes at slot 6, received %d", slot)
+	}
+}
+
+func TestNetwork_PartitionHeals(t *testing.T) {
+	n := New(t, &Config{NumNodes: 4, NumValidators: 64})
+	n.Start()
+	defer n.Stop()
+
+	n.RunSlots(2)
+	n.Partition([]int{0, 1}, []int{2, 3})
+	n.RunSlots(6)
+	for _, pair := range [][2]int{{0, 1}, {2, 3}} {
+		a, err := n.Node(pair[0]).HeadRoot()
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, err := n.Node(pair[1]).HeadRoot()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if a != b {
+			t.Errorf("Expected nodes %d and %d to follow the head of their partition", pair[0], pair[1])
+		}
+	}
+	if err := n.HeadsConverged(); err == nil {
+		t.Fatal("Expected the heads of the partitioned network to diverge")
+	}
+
+	n.Heal()
+	if err := n.RunSlotsUntil(16, n.HeadsConverged); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNetwork_LinkLatencyDelaysBlocks(t *testing.T) {
+	n := New(t, &Config{NumNodes: 2, NumValidators: 64})
+	// Messages between the nodes take two slots to arrive.
+	latency := 2 * time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+	n.SetLatency(0, 1, latency)
+	n.SetLatency(1, 0, latency)
+	n.Start()
+	defer n.Stop()
+
+	n.RunSlots(1)
+	if err := n.HeadsConverged(); err == nil {
+		t.Fatal("Expected the delayed block of slot 1 not to reach every node yet")
+	}
+	n.SetLatency(0, 1, 0)
+	n.SetLatency(1, 0, 0)
+	if err := n.RunSlotsUntil(8, n.HeadsConverged); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNetwork_FinalizesWithLatencyAndMessageLoss(t *testing.T) {
+	n := New(t, &Config{NumNodes: 4, NumValidators: 64})
+	for from := 0; from < 4; from++ {
+		for to := 0; to < 4; to++ {
+			if from == to {
+				continue
+			}
+			// Delay every message by a tenth of a slot, blocks by another tenth, and drop one
+			// attestation out of ten.
+			n.SetLatency(from, to, time.Duration(params.BeaconConfig().SecondsPerSlot)*time.Second/10)
+			var count int
+			n.SetMessageFilter(from, to, func(msg proto.Message) (bool, time.Duration) {
+				if _, ok := msg.(*ethpb.Attestation); ok {
+					count++
+					return count%10 == 0, 0
+				}
+				return false, time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second / 10
+			})
+		}
+	}
+	n.Start()
+	defer n.Stop()
+
+	maxSlots := 5 * params.BeaconConfig().SlotsPerEpoch
+	if err := n.RunSlotsUntil(maxSlots, func() error { return n.Finalized(1) }); err != nil {
+		t.Fatal(err)
+	}
+}
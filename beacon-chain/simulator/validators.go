@@ -0,0 +1,176 @@
+package simulator
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+// hostsValidator returns whether the validator with the given index is run by the node.
+// The validators of the genesis state are assigned to the nodes in turn.
+func (n *Network) hostsValidator(nd *Node, validatorIndex uint64) bool {
+	return validatorIndex%uint64(len(n.nodes)) == uint64(nd.index)
+}
+
+// headStateAtSlot returns a copy of the head state of the node, processed up to the slot.
+func headStateAtSlot(ctx context.Context, nd *Node, slot uint64) (*stateTrie.BeaconState, error) {
+	headState, err := nd.chain.HeadState(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get head state")
+	}
+	headState = headState.Copy()
+	if headState.Slot() < slot {
+		headState, err = state.ProcessSlots(ctx, headState, slot)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not process slots up to %d", slot)
+		}
+	}
+	return headState, nil
+}
+
+// propose builds a block for the slot on top of the head of the node, and submits it
+// to the node, which broadcasts it to the network. Nothing is proposed if the proposer
+// of the slot is run by another node.
+func (n *Network) propose(ctx context.Context, nd *Node, slot uint64) error {
+	parentRoot, err := nd.chain.HeadRoot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get head root")
+	}
+	st, err := headStateAtSlot(ctx, nd, slot)
+	if err != nil {
+		return err
+	}
+	proposerIndex, err := helpers.BeaconProposerIndex(st)
+	if err != nil {
+		return errors.Wrap(err, "could not get proposer index")
+	}
+	if !n.hostsValidator(nd, proposerIndex) {
+		return nil
+	}
+	randaoReveal, err := testutil.RandaoReveal(st, helpers.SlotToEpoch(slot), n.privKeys)
+	if err != nil {
+		return errors.Wrap(err, "could not compute randao reveal")
+	}
+	atts, err := attestationsForBlock(ctx, nd, st)
+	if err != nil {
+		return err
+	}
+	blk := &ethpb.BeaconBlock{
+		Slot:       slot,
+		ParentRoot: parentRoot,
+		Body: &ethpb.BeaconBlockBody{
+			Eth1Data:     st.Eth1Data(),
+			RandaoReveal: randaoReveal,
+			Attestations: atts,
+			Graffiti:     make([]byte, 32),
+		},
+	}
+	// The signature helper sets the state root of the block.
+	sig, err := testutil.BlockSignature(st, blk, n.privKeys)
+	if err != nil {
+		return errors.Wrap(err, "could not sign block")
+	}
+	return nd.chain.ReceiveBlock(ctx, &ethpb.SignedBeaconBlock{Block: blk, Signature: sig.Marshal()})
+}
+
+// attestationsForBlock returns the attestations of the pool of the node which can be
+// included in a block on top of the given state. Unaggregated attestations are aggregated
+// first, as the aggregator duty is not simulated.
+func attestationsForBlock(ctx context.Context, nd *Node, st *stateTrie.BeaconState) ([]*ethpb.Attestation, error) {
+	unaggregated, err := helpers.AggregateAttestations(nd.attPool.UnaggregatedAttestations())
+	if err != nil {
+		return nil, errors.Wrap(err, "could not aggregate attestations")
+	}
+	candidates := append(nd.attPool.AggregatedAttestations(), unaggregated...)
+
+	st = st.Copy()
+	atts := make([]*ethpb.Attestation, 0, len(candidates))
+	for _, att := range candidates {
+		if len(atts) == int(params.BeaconConfig().MaxAttestations) {
+			break
+		}
+		if _, err := blocks.ProcessAttestation(ctx, st, att); err != nil {
+			continue
+		}
+		atts = append(atts, att)
+	}
+	return atts, nil
+}
+
+// attest creates and broadcasts the attestations of the validators of the node which are
+// in a committee of the slot, voting for the head of the node.
+func (n *Network) attest(ctx context.Context, nd *Node, slot uint64) error {
+	headRoot, err := nd.chain.HeadRoot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get head root")
+	}
+	st, err := headStateAtSlot(ctx, nd, slot)
+	if err != nil {
+		return err
+	}
+	epoch := helpers.SlotToEpoch(slot)
+	targetRoot := headRoot
+	if epochStart := helpers.StartSlot(epoch); epochStart < st.Slot() {
+		targetRoot, err = helpers.BlockRootAtSlot(st, epochStart)
+		if err != nil {
+			return errors.Wrap(err, "could not get target root")
+		}
+	}
+	activeCount, err := helpers.ActiveValidatorCount(st, epoch)
+	if err != nil {
+		return errors.Wrap(err, "could not get active validator count")
+	}
+	domain, err := helpers.Domain(st.Fork(), epoch, params.BeaconConfig().DomainBeaconAttester)
+	if err != nil {
+		return errors.Wrap(err, "could not get attester domain")
+	}
+
+	for c := uint64(0); c < helpers.SlotCommitteeCount(activeCount); c++ {
+		committee, err := helpers.BeaconCommitteeFromState(st, slot, c)
+		if err != nil {
+			return errors.Wrapf(err, "could not get committee %d", c)
+		}
+		data := &ethpb.AttestationData{
+			Slot:            slot,
+			CommitteeIndex:  c,
+			BeaconBlockRoot: headRoot,
+			Source:          st.CurrentJustifiedCheckpoint(),
+			Target: &ethpb.Checkpoint{
+				Epoch: epoch,
+				Root:  targetRoot,
+			},
+		}
+		dataRoot, err := ssz.HashTreeRoot(data)
+		if err != nil {
+			return errors.Wrap(err, "could not hash attestation data")
+		}
+		for i, validatorIndex := range committee {
+			if !n.hostsValidator(nd, validatorIndex) {
+				continue
+			}
+			aggregationBits := bitfield.NewBitlist(uint64(len(committee)))
+			aggregationBits.SetBitAt(uint64(i), true)
+			att := &ethpb.Attestation{
+				Data:            data,
+				AggregationBits: aggregationBits,
+				Signature:       n.privKeys[validatorIndex].Sign(dataRoot[:], domain).Marshal(),
+			}
+			if err := nd.attPool.SaveUnaggregatedAttestation(att); err != nil {
+				return errors.Wrap(err, "could not save attestation")
+			}
+			if err := nd.p2p.Broadcast(ctx, att); err != nil {
+				return errors.Wrap(err, "could not broadcast attestation")
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,295 @@
+package state
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateutil"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/trieutil"
+	"go.opencensus.io/trace"
+)
+
+// MerkleProof is an SSZ Merkle proof of a leaf in the hash tree of the beacon state.
+// It can be verified against the state root with trieutil.VerifyMerkleProof.
+type MerkleProof struct {
+	// StateRoot is the hash tree root of the state the proof was generated against.
+	StateRoot [32]byte
+	// Leaf is the proven 32 byte chunk of the state hash tree.
+	Leaf [32]byte
+	// GeneralizedIndex is the generalized index of the leaf in the state hash tree.
+	GeneralizedIndex uint64
+	// Branch is the Merkle branch of the leaf, from the leaf up to the state root.
+	Branch [][]byte
+}
+
+// fieldIndices maps the spec names of the beacon state fields to their field index.
+var fieldIndices = map[string]fieldIndex{
+	"genesis_time":                  genesisTime,
+	"slot":                          slot,
+	"fork":                          fork,
+	"latest_block_header":           latestBlockHeader,
+	"block_roots":                   blockRoots,
+	"state_roots":                   stateRoots,
+	"historical_roots":              historicalRoots,
+	"eth1_data":                     eth1Data,
+	"eth1_data_votes":               eth1DataVotes,
+	"eth1_deposit_index":            eth1DepositIndex,
+	"validators":                    validators,
+	"balances":                      balances,
+	"randao_mixes":                  randaoMixes,
+	"slashings":                     slashings,
+	"previous_epoch_attestations":   previousEpochAttestations,
+	"current_epoch_attestations":    currentEpochAttestations,
+	"justification_bits":            justificationBits,
+	"previous_justified_checkpoint": previousJustifiedCheckpoint,
+	"current_justified_checkpoint":  currentJustifiedCheckpoint,
+	"finalized_checkpoint":          finalizedCheckpoint,
+}
+
+// FieldProof returns the Merkle proof of the state field with the given spec name, such as
+// "finalized_checkpoint", against the state root. The proven leaf is the hash tree root of the field.
+func (b *BeaconState) FieldProof(ctx context.Context, name string) (*MerkleProof, error) {
+	_, span := trace.StartSpan(ctx, "beaconState.FieldProof")
+	defer span.End()
+
+	field, ok := fieldIndices[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown state field %s", name)
+	}
+	if !b.HasInnerState() {
+		return nil, ErrNilInnerState
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	root, err := b.hashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+	return &MerkleProof{
+		StateRoot:        root,
+		Leaf:             bytesutil.ToBytes32(b.merkleLayers[0][field]),
+		GeneralizedIndex: b.fieldGeneralizedIndex(field),
+		Branch:           b.fieldBranch(field),
+	}, nil
+}
+
+// ElementProof returns the Merkle proof of the element at the given index of a list or vector
+// state field, such as "validators" or "block_roots", against the state root. The elements of
+// the balances and slashings fields are packed by 4 in 32 byte chunks, the proven leaf is then
+// the chunk containing the element.
+//
+// The cached field trie is reused for the fields maintained with a field trie, the other fields
+// are merkleized on the fly.
+func (b *BeaconState) ElementProof(ctx context.Context, name string, index uint64) (*MerkleProof, error) {
+	_, span := trace.StartSpan(ctx, "beaconState.ElementProof")
+	defer span.End()
+
+	field, ok := fieldIndices[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown state field %s", name)
+	}
+	if !b.HasInnerState() {
+		return nil, ErrNilInnerState
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	root, err := b.hashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+	leaf, elementBranch, elementIndex, err := b.elementBranch(field, index)
+	if err != nil {
+		return nil, err
+	}
+
+	// The generalized index of the element in the state tree is the concatenation of the
+	// generalized index of the field and the one of the element within the field subtree.
+	depth := uint64(len(elementBranch))
+	generalizedIndex := b.fieldGeneralizedIndex(field)<<depth | (elementIndex - 1<<depth)
+	return &MerkleProof{
+		StateRoot:        root,
+		Leaf:             leaf,
+		GeneralizedIndex: generalizedIndex,
+		Branch:           append(elementBranch, b.fieldBranch(field)...),
+	}, nil
+}
+
+// This returns the generalized index of a field root in the state tree.
+func (b *BeaconState) fieldGeneralizedIndex(field fieldIndex) uint64 {
+	return uint64(len(b.merkleLayers[0])) + uint64(field)
+}
+
+// This returns the Merkle branch of a field root against the state root from the
+// cached state trie layers.
+func (b *BeaconState) fieldBranch(field fieldIndex) [][]byte {
+	depth := len(b.merkleLayers) - 1
+	branch := make([][]byte, depth)
+	idx := int(field)
+	for i := 0; i < depth; i++ {
+		branch[i] = bytesutil.SafeCopyBytes(b.merkleLayers[i][idx^1])
+		idx /= 2
+	}
+	return branch
+}
+
+// This returns the leaf, Merkle branch against the field root and generalized index within
+// the field subtree of the element at the given index of a list or vector field.
+func (b *BeaconState) elementBranch(field fieldIndex, index uint64) ([32]byte, [][]byte, uint64, error) {
+	var count uint64
+	var isList bool
+	var layers [][]*[32]byte
+	leafIndex := index
+
+	switch field {
+	case blockRoots, stateRoots, randaoMixes, eth1DataVotes, validators, previousEpochAttestations, currentEpochAttestations:
+		elements, length := b.fieldTrieElements(field)
+		count = uint64(length)
+		if index >= count {
+			return [32]byte{}, nil, 0, fmt.Errorf("index %d out of range for field of length %d", index, count)
+		}
+		isList = fieldMap[field] == compositeArray
+		var err error
+		layers, err = b.fieldTrieLayers(field, elements)
+		if err != nil {
+			return [32]byte{}, nil, 0, err
+		}
+	case historicalRoots:
+		count = uint64(len(b.state.HistoricalRoots))
+		if index >= count {
+			return [32]byte{}, nil, 0, fmt.Errorf("index %d out of range for field of length %d", index, count)
+		}
+		isList = true
+		chunks := make([][32]byte, count)
+		for i, r := range b.state.HistoricalRoots {
+			chunks[i] = bytesutil.ToBytes32(r)
+		}
+		layers = stateutil.ReturnTrieLayerVariable(chunks, params.BeaconConfig().HistoricalRootsLimit)
+	case balances:
+		count = uint64(len(b.state.Balances))
+		if index >= count {
+			return [32]byte{}, nil, 0, fmt.Errorf("index %d out of range for field of length %d", index, count)
+		}
+		isList = true
+		leafIndex = index / 4
+		limit := (params.BeaconConfig().ValidatorRegistryLimit*8 + 31) / 32
+		layers = stateutil.ReturnTrieLayerVariable(packUint64s(b.state.Balances), limit)
+	case slashings:
+		count = uint64(len(b.state.Slashings))
+		if index >= count {
+			return [32]byte{}, nil, 0, fmt.Errorf("index %d out of range for field of length %d", index, count)
+		}
+		leafIndex = index / 4
+		limit := (params.BeaconConfig().EpochsPerSlashingsVector*8 + 31) / 32
+		layers = stateutil.ReturnTrieLayerVariable(packUint64s(b.state.Slashings), limit)
+	default:
+		return [32]byte{}, nil, 0, fmt.Errorf("field %d is not a list or vector", field)
+	}
+
+	branch := layersBranch(layers, leafIndex)
+	depth := uint64(len(branch))
+	elementIndex := uint64(1)<<depth | leafIndex
+	if isList {
+		// The list data root is the left child of the field root, the right child
+		// being the mixed in list length.
+		lengthChunk := make([]byte, 32)
+		binary.LittleEndian.PutUint64(lengthChunk, count)
+		branch = append(branch, lengthChunk)
+		elementIndex = uint64(1)<<(depth+1) | leafIndex
+	}
+	return *layers[0][leafIndex], branch, elementIndex, nil
+}
+
+// This returns the elements of a field maintained with a field trie along with their count.
+func (b *BeaconState) fieldTrieElements(field fieldIndex) (interface{}, int) {
+	switch field {
+	case blockRoots:
+		return b.state.BlockRoots, len(b.state.BlockRoots)
+	case stateRoots:
+		return b.state.StateRoots, len(b.state.StateRoots)
+	case randaoMixes:
+		return b.state.RandaoMixes, len(b.state.RandaoMixes)
+	case eth1DataVotes:
+		return b.state.Eth1DataVotes, len(b.state.Eth1DataVotes)
+	case validators:
+		return b.state.Validators, len(b.state.Validators)
+	case previousEpochAttestations:
+		return b.state.PreviousEpochAttestations, len(b.state.PreviousEpochAttestations)
+	case currentEpochAttestations:
+		return b.state.CurrentEpochAttestations, len(b.state.CurrentEpochAttestations)
+	}
+	return nil, 0
+}
+
+// This returns the trie layers of a field maintained with a field trie. The cached field
+// trie is used if field tries are enabled, otherwise a trie is built for the field.
+func (b *BeaconState) fieldTrieLayers(field fieldIndex, elements interface{}) ([][]*[32]byte, error) {
+	length := fieldTrieLength(field)
+	if !featureconfig.Get().EnableFieldTrie {
+		fTrie, err := NewFieldTrie(field, elements, length)
+		if err != nil {
+			return nil, err
+		}
+		return fTrie.fieldLayers, nil
+	}
+	if b.rebuildTrie[field] {
+		if err := b.resetFieldTrie(field, elements, length); err != nil {
+			return nil, err
+		}
+		delete(b.rebuildTrie, field)
+	}
+	fTrie := b.stateFieldLeaves[field]
+	fTrie.Lock()
+	defer fTrie.Unlock()
+	return fTrie.fieldLayers, nil
+}
+
+// This returns the length of the vector or limit of the list of a field maintained
+// with a field trie.
+func fieldTrieLength(field fieldIndex) uint64 {
+	switch field {
+	case blockRoots, stateRoots:
+		return params.BeaconConfig().SlotsPerHistoricalRoot
+	case randaoMixes:
+		return params.BeaconConfig().EpochsPerHistoricalVector
+	case eth1DataVotes:
+		return params.BeaconConfig().SlotsPerEth1VotingPeriod
+	case validators:
+		return params.BeaconConfig().ValidatorRegistryLimit
+	case previousEpochAttestations, currentEpochAttestations:
+		return params.BeaconConfig().MaxAttestations * params.BeaconConfig().SlotsPerEpoch
+	}
+	return 0
+}
+
+// This returns the Merkle branch of the leaf at the given index from the trie layers,
+// missing nodes of sparse layers being zero hashes.
+func layersBranch(layers [][]*[32]byte, index uint64) [][]byte {
+	depth := len(layers) - 1
+	branch := make([][]byte, depth)
+	for i := 0; i < depth; i++ {
+		node := trieutil.ZeroHashes[i]
+		if sibling := index ^ 1; sibling < uint64(len(layers[i])) {
+			node = *layers[i][sibling]
+		}
+		branch[i] = node[:]
+		index /= 2
+	}
+	return branch
+}
+
+// This packs uint64 values in 32 byte chunks, following the SSZ serialization of basic lists.
+func packUint64s(vals []uint64) [][32]byte {
+	chunks := make([][32]byte, (len(vals)+3)/4)
+	for i, v := range vals {
+		binary.LittleEndian.PutUint64(chunks[i/4][(i%4)*8:], v)
+	}
+	return chunks
+}
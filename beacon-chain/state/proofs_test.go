@@ -0,0 +1,148 @@
+package state_test
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateutil"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/trieutil"
+)
+
+func proofTestState(t *testing.T) *stateTrie.BeaconState {
+	genesis := setupGenesisState(t, 64)
+	genesis.HistoricalRoots = [][]byte{
+		bytesutil.PadTo([]byte{'a'}, 32),
+		bytesutil.PadTo([]byte{'b'}, 32),
+		bytesutil.PadTo([]byte{'c'}, 32),
+	}
+	genesis.Eth1DataVotes = []*ethpb.Eth1Data{{DepositCount: 1}, {DepositCount: 2}}
+	genesis.Slashings[3] = 100
+	st, err := stateTrie.InitializeFromProto(genesis)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return st
+}
+
+func verifyProof(t *testing.T, st *stateTrie.BeaconState, proof *stateTrie.MerkleProof) {
+	root, err := st.HashTreeRoot(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if proof.StateRoot != root {
+		t.Errorf("Wanted state root %#x, received %#x", root, proof.StateRoot)
+	}
+	if !trieutil.VerifyMerkleProof(root[:], proof.Leaf[:], proof.Branch, proof.GeneralizedIndex) {
+		t.Errorf("Invalid proof at generalized index %d", proof.GeneralizedIndex)
+	}
+}
+
+func TestBeaconState_FieldProof(t *testing.T) {
+	params.UseMinimalConfig()
+	defer params.UseMainnetConfig()
+	ctx := context.Background()
+	st := proofTestState(t)
+
+	proof, err := st.FieldProof(ctx, "finalized_checkpoint")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The finalized checkpoint is the 20th field of the state, padded to 32 leaves.
+	if proof.GeneralizedIndex != 51 {
+		t.Errorf("Wanted generalized index 51, received %d", proof.GeneralizedIndex)
+	}
+	wanted, err := stateutil.CheckpointRoot(hashutil.CustomSHA256Hasher(), st.FinalizedCheckpoint())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if proof.Leaf != wanted {
+		t.Errorf("Wanted leaf %#x, received %#x", wanted, proof.Leaf)
+	}
+	verifyProof(t, st, proof)
+
+	// Proofs are consistent with updates to the state.
+	if err := st.SetSlot(10); err != nil {
+		t.Fatal(err)
+	}
+	proof, err = st.FieldProof(ctx, "slot")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if proof.Leaf != stateutil.Uint64Root(10) {
+		t.Error("Did not receive updated slot leaf")
+	}
+	verifyProof(t, st, proof)
+
+	if _, err := st.FieldProof(ctx, "unknown"); err == nil {
+		t.Error("Expected error for unknown field")
+	}
+}
+
+func TestBeaconState_ElementProof(t *testing.T) {
+	params.UseMinimalConfig()
+	defer params.UseMainnetConfig()
+
+	for _, fieldTrie := range []bool{false, true} {
+		featureconfig.Init(&featureconfig.Flags{EnableFieldTrie: fieldTrie})
+		ctx := context.Background()
+		st := proofTestState(t)
+
+		tests := []struct {
+			name  string
+			index uint64
+		}{
+			{name: "block_roots", index: 2},
+			{name: "state_roots", index: 7},
+			{name: "randao_mixes", index: 1},
+			{name: "historical_roots", index: 1},
+			{name: "eth1_data_votes", index: 1},
+			{name: "validators", index: 3},
+			{name: "validators", index: 63},
+			{name: "balances", index: 5},
+			{name: "slashings", index: 3},
+		}
+		for _, tt := range tests {
+			proof, err := st.ElementProof(ctx, tt.name, tt.index)
+			if err != nil {
+				t.Fatalf("%s[%d]: %v", tt.name, tt.index, err)
+			}
+			verifyProof(t, st, proof)
+		}
+
+		// Proofs are consistent with updates to the state.
+		val, err := st.ValidatorAtIndex(3)
+		if err != nil {
+			t.Fatal(err)
+		}
+		val.Slashed = true
+		if err := st.UpdateValidatorAtIndex(3, val); err != nil {
+			t.Fatal(err)
+		}
+		proof, err := st.ElementProof(ctx, "validators", 3)
+		if err != nil {
+			t.Fatal(err)
+		}
+		wanted, err := stateutil.ValidatorRoot(hashutil.CustomSHA256Hasher(), val)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if proof.Leaf != wanted {
+			t.Errorf("Wanted leaf %#x, received %#x", wanted, proof.Leaf)
+		}
+		verifyProof(t, st, proof)
+
+		if _, err := st.ElementProof(ctx, "validators", 64); err == nil {
+			t.Error("Expected error for out of range index")
+		}
+		if _, err := st.ElementProof(ctx, "slot", 0); err == nil {
+			t.Error("Expected error for element of non list field")
+		}
+	}
+	featureconfig.Init(&featureconfig.Flags{})
+}
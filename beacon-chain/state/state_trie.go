@@ -184,7 +184,12 @@ func (b *BeaconState) HashTreeRoot(ctx context.Context) ([32]byte, error) {
 
 	b.lock.Lock()
 	defer b.lock.Unlock()
+	return b.hashTreeRoot()
+}
 
+// hashTreeRoot recomputes the dirty fields of the state trie and returns its root.
+// The caller must hold the state write lock.
+func (b *BeaconState) hashTreeRoot() ([32]byte, error) {
 	if b.merkleLayers == nil || len(b.merkleLayers) == 0 {
 		fieldRoots, err := stateutil.ComputeFieldRoots(b.state)
 		if err != nil {
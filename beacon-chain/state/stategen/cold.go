@@ -8,6 +8,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 )
@@ -22,21 +23,97 @@ func (s *State) saveColdState(ctx context.Context, blockRoot [32]byte, state *st
 		return errSlotNonArchivedPoint
 	}
 
-	if err := s.beaconDB.SaveState(ctx, state, blockRoot); err != nil {
-		return err
-	}
 	archivedIndex := state.Slot() / s.slotsPerArchivedPoint
+	enc, err := s.archivedStateDiff(ctx, archivedIndex, state)
+	if err != nil {
+		return errors.Wrap(err, "could not compute archived state diff")
+	}
+	if enc != nil {
+		if err := s.beaconDB.SaveStateDiff(ctx, blockRoot, enc); err != nil {
+			return err
+		}
+	} else {
+		if err := s.beaconDB.SaveState(ctx, state, blockRoot); err != nil {
+			return err
+		}
+	}
 	if err := s.beaconDB.SaveArchivedPointRoot(ctx, blockRoot, archivedIndex); err != nil {
 		return err
 	}
 
+	msg := "Saved full state on archived point"
+	if enc != nil {
+		msg = "Saved state diff on archived point"
+	}
 	log.WithFields(logrus.Fields{
 		"slot":      state.Slot(),
-		"blockRoot": hex.EncodeToString(bytesutil.Trunc(blockRoot[:]))}).Info("Saved full state on archived point")
+		"blockRoot": hex.EncodeToString(bytesutil.Trunc(blockRoot[:]))}).Info(msg)
 
 	return nil
 }
 
+// This returns the encoded diff of an archived state against the full snapshot state of its
+// snapshot range. It returns nil if state diff storage is disabled, the archived index is a
+// snapshot index, or the snapshot state is not available as a full state in the DB. In those
+// cases the caller should save the full state.
+func (s *State) archivedStateDiff(ctx context.Context, archivedIndex uint64, st *state.BeaconState) ([]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "stateGen.archivedStateDiff")
+	defer span.End()
+
+	if !featureconfig.Get().EnableStateDiffStorage || archivedIndex%s.archivedPointsPerSnapshot == 0 {
+		return nil, nil
+	}
+
+	snapshotIndex := archivedIndex - archivedIndex%s.archivedPointsPerSnapshot
+	if !s.beaconDB.HasArchivedPoint(ctx, snapshotIndex) {
+		return nil, nil
+	}
+	snapshotRoot := s.beaconDB.ArchivedPointRoot(ctx, snapshotIndex)
+	if !s.beaconDB.HasState(ctx, snapshotRoot) {
+		return nil, nil
+	}
+	snapshotState, err := s.beaconDB.State(ctx, snapshotRoot)
+	if err != nil {
+		return nil, err
+	}
+	if snapshotState == nil {
+		return nil, nil
+	}
+
+	diff, err := computeStateDiff(snapshotRoot, snapshotState, st)
+	if err != nil {
+		return nil, err
+	}
+	return encodeStateDiff(diff)
+}
+
+// This loads an archived state which was saved as a diff by reconstructing it on top of its
+// snapshot state.
+func (s *State) loadColdStateFromDiff(ctx context.Context, blockRoot [32]byte) (*state.BeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "stateGen.loadColdStateFromDiff")
+	defer span.End()
+
+	enc, err := s.beaconDB.StateDiff(ctx, blockRoot)
+	if err != nil {
+		return nil, err
+	}
+	if enc == nil {
+		return nil, errUnknownStateDiff
+	}
+	diff, err := decodeStateDiff(enc)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode state diff")
+	}
+	snapshotState, err := s.beaconDB.State(ctx, bytesutil.ToBytes32(diff.BaseRoot))
+	if err != nil {
+		return nil, err
+	}
+	if snapshotState == nil {
+		return nil, errUnknownArchivedState
+	}
+	return applyStateDiff(snapshotState, diff)
+}
+
 // This loads the cold state by block root, it decides whether to load from archived point (faster) or
 // somewhere between archived points (slower) because it requires replaying blocks.
 // This method is more efficient than load cold state by slot.
@@ -65,13 +142,44 @@ func (s *State) loadColdStateByRoot(ctx context.Context, blockRoot [32]byte) (*s
 	return s.loadColdIntermediateStateByRoot(ctx, summary.Slot, blockRoot)
 }
 
-// This loads the cold state for the input archived point.
+// This loads the cold state for the input archived point. The state is transparently
+// reconstructed if it was saved as a diff.
 func (s *State) loadColdStateByArchivedPoint(ctx context.Context, archivedPoint uint64) (*state.BeaconState, error) {
-	states, err := s.beaconDB.HighestSlotStatesBelow(ctx, archivedPoint*s.slotsPerArchivedPoint+1)
-	if err != nil {
-		return nil, err
+	if s.beaconDB.HasArchivedPoint(ctx, archivedPoint) {
+		root := s.beaconDB.ArchivedPointRoot(ctx, archivedPoint)
+		if s.beaconDB.HasStateDiff(ctx, root) {
+			return s.loadColdStateFromDiff(ctx, root)
+		}
+	}
+	return s.lastSavedState(ctx, archivedPoint*s.slotsPerArchivedPoint)
+}
+
+// This returns the state of the highest archived point at or below the input slot, and above the
+// slot of the last full state, which was saved as a diff. It returns nil if there is none.
+// An archived point saved as a diff is at most archivedPointsPerSnapshot archived points above its
+// full snapshot state, so only as many archived points above the last full state are checked.
+func (s *State) closestArchivedStateDiff(ctx context.Context, slot uint64, lastFullSlot uint64) (*state.BeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "stateGen.closestArchivedStateDiff")
+	defer span.End()
+
+	if s.slotsPerArchivedPoint == 0 || s.archivedPointsPerSnapshot == 0 {
+		return nil, nil
+	}
+	lowIdx := lastFullSlot / s.slotsPerArchivedPoint
+	highIdx := slot / s.slotsPerArchivedPoint
+	if highIdx > lowIdx+s.archivedPointsPerSnapshot {
+		highIdx = lowIdx + s.archivedPointsPerSnapshot
+	}
+	for idx := highIdx; idx > lowIdx; idx-- {
+		if !s.beaconDB.HasArchivedPoint(ctx, idx) {
+			continue
+		}
+		root := s.beaconDB.ArchivedPointRoot(ctx, idx)
+		if s.beaconDB.HasStateDiff(ctx, root) {
+			return s.loadColdStateFromDiff(ctx, root)
+		}
 	}
-	return states[0], nil
+	return nil, nil
 }
 
 // This loads a cold state by slot and block root combinations.
@@ -0,0 +1,232 @@
+package stategen
+
+import (
+	"bytes"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+// archivedPointsPerSnapshot is the number of archived points covered by one full state snapshot
+// when state diff storage is enabled. Every archived point in between is saved as a diff against
+// the snapshot at the start of its range.
+const archivedPointsPerSnapshot = 32
+
+// stateDiff represents an archived state as the difference against a full snapshot state.
+// Only the large fields which mostly repeat across archived points are diffed (validators,
+// balances, randao mixes, block roots and state roots), the remaining fields are stored as is.
+type stateDiff struct {
+	BaseRoot      []byte `ssz-size:"32"`
+	State         []byte
+	NumValidators uint64
+	Validators    []*validatorDiff
+	NumBalances   uint64
+	Balances      []*balanceDiff
+	RandaoMixes   []*rootDiff
+	BlockRoots    []*rootDiff
+	StateRoots    []*rootDiff
+}
+
+type validatorDiff struct {
+	Index     uint64
+	Validator *ethpb.Validator
+}
+
+type balanceDiff struct {
+	Index   uint64
+	Balance uint64
+}
+
+type rootDiff struct {
+	Index uint64
+	Root  []byte `ssz-size:"32"`
+}
+
+// This computes the diff of the target state against the base snapshot state saved under base root.
+func computeStateDiff(baseRoot [32]byte, base *state.BeaconState, target *state.BeaconState) (*stateDiff, error) {
+	if base == nil || target == nil {
+		return nil, errors.New("nil state")
+	}
+
+	// Strip the diffed fields from a copy of the target state, the rest is stored in full.
+	partial := target.CloneInnerState()
+	partial.Validators = nil
+	partial.Balances = nil
+	partial.RandaoMixes = nil
+	partial.BlockRoots = nil
+	partial.StateRoots = nil
+	enc, err := proto.Marshal(partial)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal partial state")
+	}
+
+	diff := &stateDiff{
+		BaseRoot:      baseRoot[:],
+		State:         enc,
+		NumValidators: uint64(target.NumValidators()),
+		NumBalances:   uint64(target.BalancesLength()),
+	}
+
+	baseVals := base.ValidatorsReadOnly()
+	targetVals := target.Validators()
+	if len(targetVals) < len(baseVals) {
+		return nil, errors.New("target state has less validators than base state")
+	}
+	for i, v := range targetVals {
+		if i < len(baseVals) && validatorEqual(baseVals[i], v) {
+			continue
+		}
+		diff.Validators = append(diff.Validators, &validatorDiff{Index: uint64(i), Validator: v})
+	}
+
+	baseBals := base.Balances()
+	targetBals := target.Balances()
+	if len(targetBals) < len(baseBals) {
+		return nil, errors.New("target state has less balances than base state")
+	}
+	for i, b := range targetBals {
+		if i < len(baseBals) && baseBals[i] == b {
+			continue
+		}
+		diff.Balances = append(diff.Balances, &balanceDiff{Index: uint64(i), Balance: b})
+	}
+
+	if diff.RandaoMixes, err = diffRoots(base.RandaoMixes(), target.RandaoMixes()); err != nil {
+		return nil, errors.Wrap(err, "could not diff randao mixes")
+	}
+	if diff.BlockRoots, err = diffRoots(base.BlockRoots(), target.BlockRoots()); err != nil {
+		return nil, errors.Wrap(err, "could not diff block roots")
+	}
+	if diff.StateRoots, err = diffRoots(base.StateRoots(), target.StateRoots()); err != nil {
+		return nil, errors.Wrap(err, "could not diff state roots")
+	}
+
+	return diff, nil
+}
+
+// This reconstructs the full state by applying the diff on top of the base snapshot state.
+func applyStateDiff(base *state.BeaconState, diff *stateDiff) (*state.BeaconState, error) {
+	if base == nil || diff == nil {
+		return nil, errors.New("nil state or diff")
+	}
+
+	pbState := &pb.BeaconState{}
+	if err := proto.Unmarshal(diff.State, pbState); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal partial state")
+	}
+
+	vals := base.Validators()
+	if diff.NumValidators < uint64(len(vals)) {
+		return nil, errors.New("diff has less validators than base state")
+	}
+	for _, d := range diff.Validators {
+		switch {
+		case d.Index < uint64(len(vals)):
+			vals[d.Index] = d.Validator
+		case d.Index == uint64(len(vals)):
+			vals = append(vals, d.Validator)
+		default:
+			return nil, errors.Errorf("validator diff index %d out of range", d.Index)
+		}
+	}
+	if uint64(len(vals)) != diff.NumValidators {
+		return nil, errors.Errorf("wanted %d validators, received %d", diff.NumValidators, len(vals))
+	}
+
+	bals := base.Balances()
+	if diff.NumBalances < uint64(len(bals)) {
+		return nil, errors.New("diff has less balances than base state")
+	}
+	for _, d := range diff.Balances {
+		switch {
+		case d.Index < uint64(len(bals)):
+			bals[d.Index] = d.Balance
+		case d.Index == uint64(len(bals)):
+			bals = append(bals, d.Balance)
+		default:
+			return nil, errors.Errorf("balance diff index %d out of range", d.Index)
+		}
+	}
+	if uint64(len(bals)) != diff.NumBalances {
+		return nil, errors.Errorf("wanted %d balances, received %d", diff.NumBalances, len(bals))
+	}
+
+	var err error
+	pbState.Validators = vals
+	pbState.Balances = bals
+	if pbState.RandaoMixes, err = patchRoots(base.RandaoMixes(), diff.RandaoMixes); err != nil {
+		return nil, errors.Wrap(err, "could not patch randao mixes")
+	}
+	if pbState.BlockRoots, err = patchRoots(base.BlockRoots(), diff.BlockRoots); err != nil {
+		return nil, errors.Wrap(err, "could not patch block roots")
+	}
+	if pbState.StateRoots, err = patchRoots(base.StateRoots(), diff.StateRoots); err != nil {
+		return nil, errors.Wrap(err, "could not patch state roots")
+	}
+
+	return state.InitializeFromProtoUnsafe(pbState)
+}
+
+// This encodes the state diff for storage in DB.
+func encodeStateDiff(diff *stateDiff) ([]byte, error) {
+	enc, err := ssz.Marshal(diff)
+	if err != nil {
+		return nil, err
+	}
+	return snappy.Encode(nil, enc), nil
+}
+
+// This decodes a state diff previously encoded with encodeStateDiff.
+func decodeStateDiff(enc []byte) (*stateDiff, error) {
+	dec, err := snappy.Decode(nil, enc)
+	if err != nil {
+		return nil, err
+	}
+	diff := &stateDiff{}
+	if err := ssz.Unmarshal(dec, diff); err != nil {
+		return nil, err
+	}
+	return diff, nil
+}
+
+// Fixed size root vectors, only the changed indices are kept.
+func diffRoots(base [][]byte, target [][]byte) ([]*rootDiff, error) {
+	if len(base) != len(target) {
+		return nil, errors.Errorf("mismatched vector length %d != %d", len(base), len(target))
+	}
+	var diffs []*rootDiff
+	for i := range target {
+		if bytes.Equal(base[i], target[i]) {
+			continue
+		}
+		diffs = append(diffs, &rootDiff{Index: uint64(i), Root: target[i]})
+	}
+	return diffs, nil
+}
+
+func patchRoots(base [][]byte, diffs []*rootDiff) ([][]byte, error) {
+	for _, d := range diffs {
+		if d.Index >= uint64(len(base)) {
+			return nil, errors.Errorf("root diff index %d out of range", d.Index)
+		}
+		base[d.Index] = d.Root
+	}
+	return base, nil
+}
+
+func validatorEqual(a *state.ReadOnlyValidator, b *ethpb.Validator) bool {
+	pubkey := a.PublicKey()
+	return bytes.Equal(pubkey[:], b.PublicKey) &&
+		bytes.Equal(a.WithdrawalCredentials(), b.WithdrawalCredentials) &&
+		a.EffectiveBalance() == b.EffectiveBalance &&
+		a.Slashed() == b.Slashed &&
+		a.ActivationEligibilityEpoch() == b.ActivationEligibilityEpoch &&
+		a.ActivationEpoch() == b.ActivationEpoch &&
+		a.ExitEpoch() == b.ExitEpoch &&
+		a.WithdrawableEpoch() == b.WithdrawableEpoch
+}
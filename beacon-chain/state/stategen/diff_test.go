@@ -0,0 +1,296 @@
+package stategen
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/golang/snappy"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+// This mutates a copy of the input state the way a few epochs of processing would.
+func advancedState(t testing.TB, base *state.BeaconState, slot uint64) *state.BeaconState {
+	st := base.Copy()
+	if err := st.SetSlot(slot); err != nil {
+		t.Fatal(err)
+	}
+	if err := st.UpdateBalancesAtIndex(0, 1); err != nil {
+		t.Fatal(err)
+	}
+	if err := st.UpdateRandaoMixesAtIndex([]byte{'a'}, 1); err != nil {
+		t.Fatal(err)
+	}
+	if err := st.UpdateBlockRootAtIndex(2, [32]byte{'b'}); err != nil {
+		t.Fatal(err)
+	}
+	if err := st.UpdateStateRootAtIndex(3, [32]byte{'c'}); err != nil {
+		t.Fatal(err)
+	}
+	v, err := st.ValidatorAtIndex(4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	v.Slashed = true
+	if err := st.UpdateValidatorAtIndex(4, v); err != nil {
+		t.Fatal(err)
+	}
+	if err := st.AppendValidator(&ethpb.Validator{PublicKey: []byte{'d'}, WithdrawalCredentials: []byte{'e'}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := st.AppendBalance(params.BeaconConfig().MaxEffectiveBalance); err != nil {
+		t.Fatal(err)
+	}
+	return st
+}
+
+func TestStateDiff_ComputeApply(t *testing.T) {
+	base, _ := testutil.DeterministicGenesisState(t, 32)
+	target := advancedState(t, base, 64)
+
+	diff, err := computeStateDiff([32]byte{'a'}, base, target)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(diff.Validators) != 2 {
+		t.Errorf("Wanted 2 validator diffs, received %d", len(diff.Validators))
+	}
+	if len(diff.Balances) != 2 {
+		t.Errorf("Wanted 2 balance diffs, received %d", len(diff.Balances))
+	}
+	if len(diff.RandaoMixes) != 1 || len(diff.BlockRoots) != 1 || len(diff.StateRoots) != 1 {
+		t.Error("Wanted 1 diff for each root vector")
+	}
+
+	enc, err := encodeStateDiff(diff)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dec, err := decodeStateDiff(enc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reconstructed, err := applyStateDiff(base, dec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(reconstructed.InnerStateUnsafe(), target.InnerStateUnsafe()) {
+		t.Error("Reconstructed state is not equal to target state")
+	}
+}
+
+func TestStateDiff_ApplyOutOfRange(t *testing.T) {
+	base, _ := testutil.DeterministicGenesisState(t, 32)
+	diff, err := computeStateDiff([32]byte{'a'}, base, base)
+	if err != nil {
+		t.Fatal(err)
+	}
+	diff.NumValidators++
+	diff.Validators = append(diff.Validators, &validatorDiff{Index: 100, Validator: &ethpb.Validator{}})
+	if _, err := applyStateDiff(base, diff); err == nil {
+		t.Error("Expected error for out of range validator diff")
+	}
+}
+
+func TestSaveColdState_SavesDiff(t *testing.T) {
+	featureconfig.Init(&featureconfig.Flags{EnableStateDiffStorage: true})
+	defer featureconfig.Init(&featureconfig.Flags{})
+
+	ctx := context.Background()
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+
+	service := New(db, cache.NewStateSummaryCache())
+	service.slotsPerArchivedPoint = 1
+	service.archivedPointsPerSnapshot = 4
+
+	base, _ := testutil.DeterministicGenesisState(t, 32)
+	if err := service.saveColdState(ctx, [32]byte{'a'}, base); err != nil {
+		t.Fatal(err)
+	}
+	if !service.beaconDB.HasState(ctx, [32]byte{'a'}) {
+		t.Fatal("Snapshot archived point should be saved as full state")
+	}
+
+	target := advancedState(t, base, 1)
+	r := [32]byte{'b'}
+	if err := service.saveColdState(ctx, r, target); err != nil {
+		t.Fatal(err)
+	}
+	if service.beaconDB.HasState(ctx, r) {
+		t.Error("Archived point should not be saved as full state")
+	}
+	if !service.beaconDB.HasStateDiff(ctx, r) {
+		t.Fatal("Archived point should be saved as state diff")
+	}
+
+	loaded, err := service.loadColdStateByArchivedPoint(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(loaded.InnerStateUnsafe(), target.InnerStateUnsafe()) {
+		t.Error("Did not correctly reconstruct state")
+	}
+}
+
+func TestSaveColdState_NoSnapshotSavesFullState(t *testing.T) {
+	featureconfig.Init(&featureconfig.Flags{EnableStateDiffStorage: true})
+	defer featureconfig.Init(&featureconfig.Flags{})
+
+	ctx := context.Background()
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+
+	service := New(db, cache.NewStateSummaryCache())
+	service.slotsPerArchivedPoint = 1
+	service.archivedPointsPerSnapshot = 4
+
+	beaconState, _ := testutil.DeterministicGenesisState(t, 32)
+	beaconState.SetSlot(1)
+	r := [32]byte{'a'}
+	if err := service.saveColdState(ctx, r, beaconState); err != nil {
+		t.Fatal(err)
+	}
+	if !service.beaconDB.HasState(ctx, r) {
+		t.Error("Should have saved full state without a snapshot")
+	}
+	if service.beaconDB.HasStateDiff(ctx, r) {
+		t.Error("Should not have saved state diff without a snapshot")
+	}
+}
+
+func TestLastSavedState_ResolvesStateDiff(t *testing.T) {
+	featureconfig.Init(&featureconfig.Flags{EnableStateDiffStorage: true})
+	defer featureconfig.Init(&featureconfig.Flags{})
+
+	ctx := context.Background()
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+
+	service := New(db, cache.NewStateSummaryCache())
+	service.slotsPerArchivedPoint = 1
+	service.archivedPointsPerSnapshot = 4
+
+	base, _ := testutil.DeterministicGenesisState(t, 32)
+	if err := base.SetSlot(4); err != nil {
+		t.Fatal(err)
+	}
+	if err := service.saveColdState(ctx, [32]byte{'a'}, base); err != nil {
+		t.Fatal(err)
+	}
+	var target *state.BeaconState
+	for i, r := range [][32]byte{{'b'}, {'c'}} {
+		target = advancedState(t, base, uint64(5+i))
+		if err := service.saveColdState(ctx, r, target); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// The closest archived point is reconstructed from its diff rather than replayed from the
+	// snapshot state.
+	loaded, err := service.lastSavedState(ctx, 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(loaded.InnerStateUnsafe(), target.InnerStateUnsafe()) {
+		t.Errorf("Wanted state of slot %d, received slot %d", target.Slot(), loaded.Slot())
+	}
+
+	loaded, err = service.lastSavedState(ctx, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loaded.Slot() != 4 {
+		t.Errorf("Wanted snapshot state of slot 4, received slot %d", loaded.Slot())
+	}
+}
+
+func encodedStateSize(b *testing.B, st *state.BeaconState) int {
+	enc, err := proto.Marshal(st.InnerStateUnsafe())
+	if err != nil {
+		b.Fatal(err)
+	}
+	return len(snappy.Encode(nil, enc))
+}
+
+func BenchmarkColdState_DiskUsage(b *testing.B) {
+	base, _ := testutil.DeterministicGenesisState(b, 16384)
+	target := advancedState(b, base, 64)
+
+	b.Run("full state", func(b *testing.B) {
+		var size int
+		for i := 0; i < b.N; i++ {
+			size = encodedStateSize(b, target)
+		}
+		b.ReportMetric(float64(size), "bytes/state")
+	})
+	b.Run("state diff", func(b *testing.B) {
+		var size int
+		for i := 0; i < b.N; i++ {
+			diff, err := computeStateDiff([32]byte{'a'}, base, target)
+			if err != nil {
+				b.Fatal(err)
+			}
+			enc, err := encodeStateDiff(diff)
+			if err != nil {
+				b.Fatal(err)
+			}
+			size = len(enc)
+		}
+		b.ReportMetric(float64(size), "bytes/state")
+	})
+}
+
+func BenchmarkColdState_LoadLatency(b *testing.B) {
+	ctx := context.Background()
+	db := testDB.SetupDB(b)
+	defer testDB.TeardownDB(b, db)
+
+	service := New(db, cache.NewStateSummaryCache())
+	service.slotsPerArchivedPoint = 1
+	service.archivedPointsPerSnapshot = 4
+
+	base, _ := testutil.DeterministicGenesisState(b, 16384)
+	target := advancedState(b, base, 1)
+	if err := service.beaconDB.SaveState(ctx, base, [32]byte{'a'}); err != nil {
+		b.Fatal(err)
+	}
+	if err := service.beaconDB.SaveArchivedPointRoot(ctx, [32]byte{'a'}, 0); err != nil {
+		b.Fatal(err)
+	}
+	if err := service.beaconDB.SaveState(ctx, target, [32]byte{'b'}); err != nil {
+		b.Fatal(err)
+	}
+	diff, err := computeStateDiff([32]byte{'a'}, base, target)
+	if err != nil {
+		b.Fatal(err)
+	}
+	enc, err := encodeStateDiff(diff)
+	if err != nil {
+		b.Fatal(err)
+	}
+	if err := service.beaconDB.SaveStateDiff(ctx, [32]byte{'c'}, enc); err != nil {
+		b.Fatal(err)
+	}
+
+	b.Run("full state", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if _, err := service.beaconDB.State(ctx, [32]byte{'b'}); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("state diff", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if _, err := service.loadColdStateFromDiff(ctx, [32]byte{'c'}); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
@@ -9,3 +9,4 @@ var errUnknownBoundaryRoot = errors.New("unknown boundary root")
 var errUnknownState = errors.New("unknown state")
 var errUnknownBlock = errors.New("unknown block")
 var errSlotNonArchivedPoint = errors.New("slot is not an archived point index")
+var errUnknownStateDiff = errors.New("unknown state diff")
@@ -7,6 +7,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 )
@@ -67,6 +68,13 @@ func (s *State) MigrateToCold(ctx context.Context, finalizedSlot uint64, finaliz
 			if err := s.beaconDB.SaveLastArchivedIndex(ctx, archivedPointIndex); err != nil {
 				return err
 			}
+			// Replace the full archived state with its diff against the snapshot state when
+			// state diff storage is enabled. The current finalized state is kept in full.
+			if r != finalizedRoot {
+				if err := s.replaceWithStateDiff(ctx, r, archivedPointIndex); err != nil {
+					return err
+				}
+			}
 			log.WithFields(logrus.Fields{
 				"slot":         stateSummary.Slot,
 				"archiveIndex": archivedPointIndex,
@@ -97,3 +105,29 @@ func (s *State) MigrateToCold(ctx context.Context, finalizedSlot uint64, finaliz
 
 	return nil
 }
+
+// This replaces the full archived state of the input block root with its diff against the
+// snapshot state. The full state is kept if no diff can be computed for the archived index.
+func (s *State) replaceWithStateDiff(ctx context.Context, blockRoot [32]byte, archivedIndex uint64) error {
+	if !featureconfig.Get().EnableStateDiffStorage {
+		return nil
+	}
+	st, err := s.beaconDB.State(ctx, blockRoot)
+	if err != nil {
+		return err
+	}
+	if st == nil {
+		return nil
+	}
+	enc, err := s.archivedStateDiff(ctx, archivedIndex, st)
+	if err != nil {
+		return err
+	}
+	if enc == nil {
+		return nil
+	}
+	if err := s.beaconDB.SaveStateDiff(ctx, blockRoot, enc); err != nil {
+		return err
+	}
+	return s.beaconDB.DeleteState(ctx, blockRoot)
+}
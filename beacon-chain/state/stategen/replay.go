@@ -289,6 +289,16 @@ func (s *State) lastSavedState(ctx context.Context, slot uint64) (*state.BeaconS
 		return nil, errUnknownState
 	}
 
+	// Archived points saved as diffs have no full state in DB. Reconstruct the closest one above
+	// the last full state instead of replaying blocks from its snapshot state.
+	diffState, err := s.closestArchivedStateDiff(ctx, slot, lastSaved[0].Slot())
+	if err != nil {
+		return nil, errors.Wrap(err, "could not load archived state diff")
+	}
+	if diffState != nil {
+		return diffState, nil
+	}
+
 	return lastSaved[0], nil
 }
 
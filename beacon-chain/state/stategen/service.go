@@ -17,13 +17,14 @@ const archivedInterval = 256
 // State represents a management object that handles the internal
 // logic of maintaining both hot and cold states in DB.
 type State struct {
-	beaconDB                db.NoHeadAccessDatabase
-	slotsPerArchivedPoint   uint64
-	epochBoundarySlotToRoot map[uint64][32]byte
-	epochBoundaryLock       sync.RWMutex
-	hotStateCache           *cache.HotStateCache
-	splitInfo               *splitSlotAndRoot
-	stateSummaryCache       *cache.StateSummaryCache
+	beaconDB                  db.NoHeadAccessDatabase
+	slotsPerArchivedPoint     uint64
+	archivedPointsPerSnapshot uint64
+	epochBoundarySlotToRoot   map[uint64][32]byte
+	epochBoundaryLock         sync.RWMutex
+	hotStateCache             *cache.HotStateCache
+	splitInfo                 *splitSlotAndRoot
+	stateSummaryCache         *cache.StateSummaryCache
 }
 
 // This tracks the split point. The point where slot and the block root of
@@ -36,12 +37,13 @@ type splitSlotAndRoot struct {
 // New returns a new state management object.
 func New(db db.NoHeadAccessDatabase, stateSummaryCache *cache.StateSummaryCache) *State {
 	return &State{
-		beaconDB:                db,
-		epochBoundarySlotToRoot: make(map[uint64][32]byte),
-		hotStateCache:           cache.NewHotStateCache(),
-		splitInfo:               &splitSlotAndRoot{slot: 0, root: params.BeaconConfig().ZeroHash},
-		slotsPerArchivedPoint:   archivedInterval,
-		stateSummaryCache:       stateSummaryCache,
+		beaconDB:                  db,
+		epochBoundarySlotToRoot:   make(map[uint64][32]byte),
+		hotStateCache:             cache.NewHotStateCache(),
+		splitInfo:                 &splitSlotAndRoot{slot: 0, root: params.BeaconConfig().ZeroHash},
+		slotsPerArchivedPoint:     archivedInterval,
+		archivedPointsPerSnapshot: archivedPointsPerSnapshot,
+		stateSummaryCache:         stateSummaryCache,
 	}
 }
 
@@ -55,6 +57,13 @@ func (s *State) Resume(ctx context.Context) (*state.BeaconState, error) {
 	if err != nil {
 		return nil, err
 	}
+	// The last archived state may have been saved as a diff.
+	if lastArchivedState == nil && s.beaconDB.HasStateDiff(ctx, lastArchivedRoot) {
+		lastArchivedState, err = s.loadColdStateFromDiff(ctx, lastArchivedRoot)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	// Resume as genesis state if there's no last archived state.
 	if lastArchivedState == nil {
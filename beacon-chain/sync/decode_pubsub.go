@@ -16,6 +16,10 @@ func (r *Service) decodePubsubMessage(msg *pubsub.Message) (proto.Message, error
 	}
 	topic := msg.TopicIDs[0]
 	topic = strings.TrimSuffix(topic, r.p2p.Encoding().ProtocolSuffix())
+	topic, err := replaceForkDigest(topic)
+	if err != nil {
+		return nil, err
+	}
 	base, ok := p2p.GossipTopicMappings[topic]
 	if !ok {
 		return nil, fmt.Errorf("no message mapped for topic %s", topic)
@@ -26,3 +30,14 @@ func (r *Service) decodePubsubMessage(msg *pubsub.Message) (proto.Message, error
 	}
 	return m, nil
 }
+
+// replaceForkDigest replaces the fork digest of a gossip topic with the format verb it takes,
+// to look the topic up in the gossip topic mappings.
+func replaceForkDigest(topic string) (string, error) {
+	subStrings := strings.Split(topic, "/")
+	if len(subStrings) != 4 {
+		return "", fmt.Errorf("invalid topic %s", topic)
+	}
+	subStrings[2] = "%x"
+	return strings.Join(subStrings, "/"), nil
+}
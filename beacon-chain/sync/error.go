@@ -11,16 +11,22 @@ import (
 const genericError = "internal service error"
 const rateLimitedError = "rate limited"
 
-var errWrongForkVersion = errors.New("wrong fork version")
+var errWrongForkDigestVersion = errors.New("wrong fork digest version")
 var errInvalidEpoch = errors.New("invalid epoch")
+var errRateLimited = errors.New(rateLimitedError)
 
 var responseCodeSuccess = byte(0x00)
 var responseCodeInvalidRequest = byte(0x01)
 var responseCodeServerError = byte(0x02)
 
 func (r *Service) generateErrorResponse(code byte, reason string) ([]byte, error) {
+	return createErrorResponse(r.p2p.Encoding(), code, reason)
+}
+
+// createErrorResponse encodes an error response chunk with the given response code and reason.
+func createErrorResponse(encoding encoder.NetworkEncoding, code byte, reason string) ([]byte, error) {
 	buf := bytes.NewBuffer([]byte{code})
-	if _, err := r.p2p.Encoding().EncodeWithLength(buf, []byte(reason)); err != nil {
+	if _, err := encoding.EncodeWithLength(buf, []byte(reason)); err != nil {
 		return nil, err
 	}
 
@@ -0,0 +1,49 @@
+package sync
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/slotutil"
+	"github.com/sirupsen/logrus"
+)
+
+// forkDigest returns the fork digest of the current fork of the chain.
+func (r *Service) forkDigest() ([4]byte, error) {
+	genesisValidatorsRoot := r.chain.GenesisValidatorRoot()
+	return p2p.CreateForkDigest(r.chain.GenesisTime(), genesisValidatorsRoot[:])
+}
+
+// forkWatcher moves the gossip subscriptions of the service to the topics of the next fork
+// once the chain reaches the epoch of a scheduled fork. The fork digest is checked every slot,
+// the subscriptions to the topics of the new fork are made before the previous ones are
+// cancelled.
+func (r *Service) forkWatcher(digest [4]byte, cancelSubs context.CancelFunc) {
+	slotTicker := slotutil.GetSlotTickerWithClock(slotutil.ClockOrDefault(r.clock), r.chain.GenesisTime(), params.BeaconConfig().SecondsPerSlot)
+	defer slotTicker.Done()
+	for {
+		select {
+		case <-slotTicker.C():
+			nextDigest, err := r.forkDigest()
+			if err != nil {
+				log.WithError(err).Error("Could not compute fork digest")
+				continue
+			}
+			if nextDigest == digest {
+				continue
+			}
+			log.WithFields(logrus.Fields{
+				"previousForkDigest": fmt.Sprintf("%#x", digest),
+				"forkDigest":         fmt.Sprintf("%#x", nextDigest),
+			}).Info("Moving gossip subscriptions to the topics of the new fork")
+			nextCancelSubs := r.subscribeToFork(nextDigest)
+			cancelSubs()
+			digest, cancelSubs = nextDigest, nextCancelSubs
+		case <-r.ctx.Done():
+			log.Debug("Context closed, exiting fork watcher")
+			return
+		}
+	}
+}
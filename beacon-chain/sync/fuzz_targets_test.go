@@ -0,0 +1,223 @@
+package sync
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/go-ssz"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	dbtest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/encoder"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+// gossipValidator is the signature shared by the pubsub validators of the service.
+type gossipValidator func(*Service, context.Context, peer.ID, *pubsub.Message) bool
+
+// fuzzP2P provides the peer ID and encoding used by the gossip validators, without starting
+// a libp2p host for every fuzzed message. Messages are plain SSZ so that the fuzzer mutates
+// the SSZ encoding directly, snappy decoding is fuzzed in the p2p encoder package.
+type fuzzP2P struct {
+	p2p.P2P
+}
+
+func (fuzzP2P) PeerID() peer.ID {
+	return "local"
+}
+
+func (fuzzP2P) Encoding() encoder.NetworkEncoding {
+	return &encoder.SszNetworkEncoder{}
+}
+
+// gossipFuzzer holds the chain the gossip validators are fuzzed against: a head state in
+// slot 1 whose parent block and state are in the database.
+type gossipFuzzer struct {
+	db        db.Database
+	headState *stateTrie.BeaconState
+	genesis   time.Time
+	// seeds holds valid gossip messages, built from a block carrying one of each operation.
+	seeds map[reflect.Type][]byte
+}
+
+func newGossipFuzzer(tb testing.TB, beaconDB db.Database) *gossipFuzzer {
+	ctx := context.Background()
+	beaconState, privKeys := testutil.DeterministicGenesisState(tb, 64)
+	genesis := blocks.NewGenesisBlock(make([]byte, 32))
+	genesisRoot, err := ssz.HashTreeRoot(genesis.Block)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	if err := beaconDB.SaveBlock(ctx, genesis); err != nil {
+		tb.Fatal(err)
+	}
+	if err := beaconDB.SaveState(ctx, beaconState, genesisRoot); err != nil {
+		tb.Fatal(err)
+	}
+
+	blk, err := testutil.GenerateFullBlock(beaconState, privKeys, &testutil.BlockGenConfig{
+		NumProposerSlashings: 1,
+		NumAttesterSlashings: 1,
+		NumAttestations:      1,
+		NumVoluntaryExits:    1,
+	}, 1)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	body := blk.Block.Body
+	// Vote for the block in the database with a single attester, as required of unaggregated attestations.
+	att := body.Attestations[0]
+	att.Data.BeaconBlockRoot = genesisRoot[:]
+	unaggregated := &ethpb.Attestation{
+		AggregationBits: bitfield.NewBitlist(att.AggregationBits.Len()),
+		Data:            att.Data,
+		Signature:       att.Signature,
+	}
+	unaggregated.AggregationBits.SetBitAt(0, true)
+	aggregate := &ethpb.AggregateAttestationAndProof{
+		Aggregate:      att,
+		SelectionProof: make([]byte, 96),
+	}
+
+	f := &gossipFuzzer{
+		db:        beaconDB,
+		headState: beaconState,
+		genesis:   time.Now().Add(-time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second),
+		seeds:     make(map[reflect.Type][]byte),
+	}
+	for _, msg := range []interface{}{blk, unaggregated, aggregate, body.VoluntaryExits[0], body.ProposerSlashings[0], body.AttesterSlashings[0]} {
+		enc, err := ssz.Marshal(msg)
+		if err != nil {
+			tb.Fatal(err)
+		}
+		f.seeds[reflect.TypeOf(msg)] = enc
+	}
+	return f
+}
+
+// seed returns the SSZ encoding of the seed message of the given type.
+func (f *gossipFuzzer) seed(msg interface{}) []byte {
+	return f.seeds[reflect.TypeOf(msg)]
+}
+
+// validate runs the validator on the fuzzed gossip message, received from a remote peer on
+// the topic of the given message type. Validators may advance the head state they are given,
+// so each message is validated by a new service around a copy of the head state. The context
+// deadline bounds the slots processed for messages from the far future, as pubsub does.
+func (f *gossipFuzzer) validate(validator gossipValidator, msg interface{}, data []byte) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	r := &Service{
+		db:          f.db,
+		p2p:         fuzzP2P{},
+		initialSync: &mockSync.Sync{IsSyncing: false},
+		chain: &mock.ChainService{
+			State:               f.headState.Copy(),
+			Genesis:             f.genesis,
+			FinalizedCheckPoint: &ethpb.Checkpoint{},
+		},
+		attPool:              attestations.NewPool(),
+		seenPendingBlocks:    make(map[[32]byte]bool),
+		blkRootToPendingAtts: make(map[[32]byte][]*ethpb.AggregateAttestationAndProof),
+		stateSummaryCache:    cache.NewStateSummaryCache(),
+	}
+	digest, err := r.forkDigest()
+	if err != nil {
+		return false
+	}
+	topic := fmt.Sprintf(p2p.GossipTypeMapping[reflect.TypeOf(msg)], digest)
+	if _, ok := msg.(*ethpb.Attestation); ok {
+		topic = fmt.Sprintf(p2p.GossipTypeMapping[reflect.TypeOf(msg)], digest, 0)
+	}
+	m := &pubsub.Message{
+		Message: &pubsubpb.Message{
+			Data:     data,
+			TopicIDs: []string{topic},
+		},
+	}
+	return validator(r, ctx, "remote", m)
+}
+
+func TestGossipFuzzer_Seeds(t *testing.T) {
+	beaconDB := dbtest.SetupDB(t)
+	defer dbtest.TeardownDB(t, beaconDB)
+	f := newGossipFuzzer(t, beaconDB)
+
+	tests := []struct {
+		msg      interface{}
+		validate gossipValidator
+	}{
+		{&ethpb.SignedBeaconBlock{}, (*Service).validateBeaconBlockPubSub},
+		{&ethpb.Attestation{}, (*Service).validateCommitteeIndexBeaconAttestation},
+		{&ethpb.AggregateAttestationAndProof{}, (*Service).validateAggregateAndProof},
+		{&ethpb.SignedVoluntaryExit{}, (*Service).validateVoluntaryExit},
+		{&ethpb.ProposerSlashing{}, (*Service).validateProposerSlashing},
+		{&ethpb.AttesterSlashing{}, (*Service).validateAttesterSlashing},
+	}
+	for _, tt := range tests {
+		if len(f.seed(tt.msg)) == 0 {
+			t.Fatalf("No seed for %T", tt.msg)
+		}
+		f.validate(tt.validate, tt.msg, f.seed(tt.msg))
+	}
+	if !f.validate((*Service).validateBeaconBlockPubSub, &ethpb.SignedBeaconBlock{}, f.seed(&ethpb.SignedBeaconBlock{})) {
+		t.Error("Expected the seed block to pass validation")
+	}
+}
+
+// Inputs which crashed a validator, or which reach the same code paths, are kept here so that
+// every toolchain runs them.
+func TestGossipFuzzer_Regressions(t *testing.T) {
+	beaconDB := dbtest.SetupDB(t)
+	defer dbtest.TeardownDB(t, beaconDB)
+	f := newGossipFuzzer(t, beaconDB)
+
+	// Committee indices out of range sliced past the shuffled validators on committee cache misses.
+	att := &ethpb.Attestation{}
+	if err := ssz.Unmarshal(f.seed(att), att); err != nil {
+		t.Fatal(err)
+	}
+	att.Data.CommitteeIndex = 1 << 40
+	aggregate := &ethpb.AggregateAttestationAndProof{}
+	if err := ssz.Unmarshal(f.seed(aggregate), aggregate); err != nil {
+		t.Fatal(err)
+	}
+	aggregate.Aggregate.Data.CommitteeIndex = 1 << 40
+
+	tests := []struct {
+		name     string
+		msg      interface{}
+		validate gossipValidator
+	}{
+		{"attestation committee index out of range", att, (*Service).validateCommitteeIndexBeaconAttestation},
+		{"aggregate committee index out of range", aggregate, (*Service).validateAggregateAndProof},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			enc, err := ssz.Marshal(tt.msg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			helpers.ClearCache()
+			if f.validate(tt.validate, tt.msg, enc) {
+				t.Error("Expected message to be rejected")
+			}
+		})
+	}
+}
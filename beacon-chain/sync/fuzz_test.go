@@ -0,0 +1,61 @@
+//go:build go1.18
+// +build go1.18
+
+package sync
+
+import (
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	dbtest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+)
+
+// Run a fuzzer with, for example:
+//
+//	go test ./beacon-chain/sync -run=^$ -fuzz=FuzzValidateBeaconBlockPubSub
+
+func FuzzValidateBeaconBlockPubSub(f *testing.F) {
+	fuzzGossipValidator(f, &ethpb.SignedBeaconBlock{}, (*Service).validateBeaconBlockPubSub)
+}
+
+func FuzzValidateCommitteeIndexBeaconAttestation(f *testing.F) {
+	fuzzGossipValidator(f, &ethpb.Attestation{}, (*Service).validateCommitteeIndexBeaconAttestation)
+}
+
+func FuzzValidateAggregateAndProof(f *testing.F) {
+	fuzzGossipValidator(f, &ethpb.AggregateAttestationAndProof{}, (*Service).validateAggregateAndProof)
+}
+
+func FuzzValidateVoluntaryExit(f *testing.F) {
+	fuzzGossipValidator(f, &ethpb.SignedVoluntaryExit{}, (*Service).validateVoluntaryExit)
+}
+
+func FuzzValidateProposerSlashing(f *testing.F) {
+	fuzzGossipValidator(f, &ethpb.ProposerSlashing{}, (*Service).validateProposerSlashing)
+}
+
+func FuzzValidateAttesterSlashing(f *testing.F) {
+	fuzzGossipValidator(f, &ethpb.AttesterSlashing{}, (*Service).validateAttesterSlashing)
+}
+
+// fuzzGossipValidator fuzzes the validator with gossip messages of the given type, seeded
+// with a valid message of that type.
+func fuzzGossipValidator(f *testing.F, msg interface{}, validate gossipValidator) {
+	beaconDB := dbtest.SetupDB(f)
+	defer dbtest.TeardownDB(f, beaconDB)
+	fuzzer := newGossipFuzzer(f, beaconDB)
+	f.Add(fuzzer.seed(msg))
+
+	// Mutated messages are never signed, skip the signature checks so the fuzzer explores
+	// the validation conditions that come after them.
+	resetCfg := featureconfig.Get()
+	cfg := *resetCfg
+	cfg.SkipBLSVerify = true
+	featureconfig.Init(&cfg)
+	defer featureconfig.Init(resetCfg)
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		fuzzer.validate(validate, msg, data)
+	})
+}
@@ -2,6 +2,7 @@ package initialsyncold
 
 import (
 	"context"
+	"reflect"
 	"time"
 
 	"github.com/kevinms/leakybucket-go"
@@ -20,7 +21,7 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
-var _ = shared.Service(&Service{})
+var _ = shared.DependentService(&Service{})
 
 type blockchainService interface {
 	blockchain.BlockReceiver
@@ -71,8 +72,13 @@ func NewInitialSync(cfg *Config) *Service {
 	}
 }
 
-// Start the initial sync service.
+// Start the initial sync service in the background, as it runs until the node is synced.
 func (s *Service) Start() {
+	go s.run()
+}
+
+// run waits for the chain to start and syncs the node up to the head of its peers.
+func (s *Service) run() {
 	var genesis time.Time
 
 	headState, err := s.chain.HeadState(s.ctx)
@@ -146,6 +152,12 @@ func (s *Service) Status() error {
 	return nil
 }
 
+// Dependencies of initial sync, which requests blocks from the peers of the p2p service and
+// processes them with the blockchain service.
+func (s *Service) Dependencies() []reflect.Type {
+	return shared.DependencyTypes(s.p2p, s.chain)
+}
+
 // Syncing returns true if initial sync is still running.
 func (s *Service) Syncing() bool {
 	return !s.synced
@@ -2,6 +2,7 @@ package initialsync
 
 import (
 	"context"
+	"reflect"
 	"time"
 
 	"github.com/kevinms/leakybucket-go"
@@ -16,11 +17,11 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/shared"
 	"github.com/prysmaticlabs/prysm/shared/params"
-	"github.com/prysmaticlabs/prysm/shared/roughtime"
+	"github.com/prysmaticlabs/prysm/shared/slotutil"
 	"github.com/sirupsen/logrus"
 )
 
-var _ = shared.Service(&Service{})
+var _ = shared.DependentService(&Service{})
 
 type blockchainService interface {
 	blockchain.BlockReceiver
@@ -42,6 +43,7 @@ type Config struct {
 	Chain         blockchainService
 	StateNotifier statefeed.Notifier
 	BlockNotifier blockfeed.Notifier
+	Clock         slotutil.Clock
 }
 
 // Service service.
@@ -55,6 +57,7 @@ type Service struct {
 	stateNotifier     statefeed.Notifier
 	blockNotifier     blockfeed.Notifier
 	blocksRateLimiter *leakybucket.Collector
+	clock             slotutil.Clock
 }
 
 // NewInitialSync configures the initial sync service responsible for bringing the node up to the
@@ -68,11 +71,17 @@ func NewInitialSync(cfg *Config) *Service {
 		stateNotifier:     cfg.StateNotifier,
 		blockNotifier:     cfg.BlockNotifier,
 		blocksRateLimiter: leakybucket.NewCollector(allowedBlocksPerSecond, allowedBlocksPerSecond, false /* deleteEmptyBuckets */),
+		clock:             cfg.Clock,
 	}
 }
 
-// Start the initial sync service.
+// Start the initial sync service in the background, as it runs until the node is synced.
 func (s *Service) Start() {
+	go s.run()
+}
+
+// run waits for the chain to start and syncs the node up to the head of its peers.
+func (s *Service) run() {
 	var genesis time.Time
 
 	headState, err := s.chain.HeadState(s.ctx)
@@ -104,15 +113,16 @@ func (s *Service) Start() {
 		genesis = time.Unix(int64(headState.GenesisTime()), 0)
 	}
 
-	if genesis.After(roughtime.Now()) {
+	clock := slotutil.ClockOrDefault(s.clock)
+	if now := clock.Now(); genesis.After(now) {
 		log.WithField(
 			"genesis time",
 			genesis,
 		).Warn("Genesis time is in the future - waiting to start sync...")
-		time.Sleep(roughtime.Until(genesis))
+		<-clock.After(genesis.Sub(now))
 	}
 	s.chainStarted = true
-	currentSlot := helpers.SlotsSince(genesis)
+	currentSlot := uint64(clock.Now().Sub(genesis).Seconds()) / params.BeaconConfig().SecondsPerSlot
 	if helpers.SlotToEpoch(currentSlot) == 0 {
 		log.Info("Chain started within the last epoch - not syncing")
 		s.synced = true
@@ -146,6 +156,12 @@ func (s *Service) Status() error {
 	return nil
 }
 
+// Dependencies of initial sync, which requests blocks from the peers of the p2p service and
+// processes them with the blockchain service.
+func (s *Service) Dependencies() []reflect.Type {
+	return shared.DependencyTypes(s.p2p, s.chain)
+}
+
 // Syncing returns true if initial sync is still running.
 func (s *Service) Syncing() bool {
 	return !s.synced
@@ -51,6 +51,13 @@ var (
 			Help: "Count the number of times attestation recovered because of missing block",
 		},
 	)
+	rpcRequestsRateLimitedCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "p2p_rpc_requests_rate_limited_total",
+			Help: "Count of RPC requests rejected by the rate limiter, by topic and by the exceeded limit (peer or global).",
+		},
+		[]string{"topic", "limit"},
+	)
 	numberOfAttsNotRecovered = promauto.NewCounter(
 		prometheus.CounterOpts{
 			Name: "beacon_attestations_not_recovered_total",
@@ -0,0 +1,164 @@
+package sync
+
+import (
+	"sync"
+
+	"github.com/kevinms/leakybucket-go"
+	libp2pcore "github.com/libp2p/go-libp2p-core"
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+// Default limits, used when the rate limit flags are not set.
+const (
+	defaultBlockBatchLimit            = 32
+	defaultBlockBatchLimitBurstFactor = 10
+	defaultRPCRequestLimit            = 1
+	defaultRPCGlobalLimitFactor       = 16
+	// Burst factor of the protocols which are not limited by block count.
+	rpcRequestBurstFactor = 5
+)
+
+// The key of the bucket shared by all peers in the global collectors.
+const globalBucketKey = "global"
+
+// limiter rate limits the incoming requests of each req/resp protocol with leaky buckets.
+// Every protocol has a bucket per peer and a global bucket, shared by all peers. Requests
+// for blocks cost a token per requested block, any other request costs a single token.
+type limiter struct {
+	p2p          p2p.P2P
+	peerLimiters map[string]*leakybucket.Collector
+	globalLimits map[string]*leakybucket.Collector
+	// lock makes checking the remaining tokens and taking the cost of a request atomic,
+	// so that concurrent streams can not both pass the check.
+	lock sync.Mutex
+}
+
+// rateLimit is the rate and burst capacity of the buckets of a protocol, in tokens.
+type rateLimit struct {
+	rate     float64
+	capacity int64
+}
+
+// newRateLimiter creates the rate limiter of the req/resp protocols registered in
+// registerRPCHandlers, with the limits set by the beacon node flags.
+func newRateLimiter(p2pProvider p2p.P2P) *limiter {
+	cfg := flags.Get()
+	blockLimit := valueOrDefault(cfg.BlockBatchLimit, defaultBlockBatchLimit)
+	blockBurstFactor := valueOrDefault(cfg.BlockBatchLimitBurstFactor, defaultBlockBatchLimitBurstFactor)
+	requestLimit := valueOrDefault(cfg.RPCRequestLimit, defaultRPCRequestLimit)
+	globalFactor := valueOrDefault(cfg.RPCGlobalLimitFactor, defaultRPCGlobalLimitFactor)
+
+	blockRate := rateLimit{rate: float64(blockLimit), capacity: int64(blockLimit * blockBurstFactor)}
+	requestRate := rateLimit{rate: float64(requestLimit), capacity: int64(requestLimit * rpcRequestBurstFactor)}
+	topicLimits := map[string]rateLimit{
+		"/eth2/beacon_chain/req/status/1":                 requestRate,
+		"/eth2/beacon_chain/req/goodbye/1":                requestRate,
+		"/eth2/beacon_chain/req/beacon_blocks_by_range/1": blockRate,
+		"/eth2/beacon_chain/req/beacon_blocks_by_root/1":  blockRate,
+		"/eth2/beacon_chain/req/light_client_update/1":    requestRate,
+	}
+
+	l := &limiter{
+		p2p:          p2pProvider,
+		peerLimiters: make(map[string]*leakybucket.Collector, len(topicLimits)),
+		globalLimits: make(map[string]*leakybucket.Collector, len(topicLimits)),
+	}
+	for topic, limit := range topicLimits {
+		topic += p2pProvider.Encoding().ProtocolSuffix()
+		l.peerLimiters[topic] = leakybucket.NewCollector(limit.rate, limit.capacity, true /* deleteEmptyBuckets */)
+		l.globalLimits[topic] = leakybucket.NewCollector(
+			limit.rate*float64(globalFactor),
+			limit.capacity*int64(globalFactor),
+			false, /* deleteEmptyBuckets */
+		)
+	}
+	return l
+}
+
+// validateRequest checks that the peer of the stream can make a request of the given cost
+// on the topic of the stream, and takes the cost from the buckets of the topic if so.
+// A rate limited request is answered with an error response and counts as a bad
+// response of the peer, the peer is disconnected once it is considered bad.
+func (l *limiter) validateRequest(stream libp2pcore.Stream, topic string, cost uint64) error {
+	peerLimiter, ok := l.peerLimiters[topic]
+	if !ok {
+		// Every topic of registerRPCHandlers has limits, this is only reached by topics
+		// registered elsewhere, such as in tests.
+		return nil
+	}
+	globalLimiter := l.globalLimits[topic]
+	remotePeer := stream.Conn().RemotePeer()
+	key := remotePeer.String()
+
+	l.lock.Lock()
+	if !withinLimit(cost, peerLimiter.Remaining(key)) {
+		l.lock.Unlock()
+		rpcRequestsRateLimitedCounter.WithLabelValues(topic, "peer").Inc()
+		l.p2p.Peers().IncrementBadResponses(remotePeer)
+		if l.p2p.Peers().IsBad(remotePeer) {
+			log.WithField("peer", remotePeer.Pretty()).Debug("Disconnecting bad peer")
+			defer func() {
+				if err := l.p2p.Disconnect(remotePeer); err != nil {
+					log.WithError(err).Error("Failed to disconnect from peer")
+				}
+			}()
+		}
+		l.writeErrorResponse(stream)
+		return errRateLimited
+	}
+	// Requests over the global limit are not the fault of the peer, so they do not
+	// count against its reputation.
+	if !withinLimit(cost, globalLimiter.Remaining(globalBucketKey)) {
+		l.lock.Unlock()
+		rpcRequestsRateLimitedCounter.WithLabelValues(topic, "global").Inc()
+		l.writeErrorResponse(stream)
+		return errRateLimited
+	}
+
+	// The cost is at most the remaining tokens of the buckets, so it fits in an int64.
+	peerLimiter.Add(key, int64(cost))
+	globalLimiter.Add(globalBucketKey, int64(cost))
+	l.lock.Unlock()
+	return nil
+}
+
+// withinLimit returns whether a request of the given cost can be taken from a bucket with
+// the given remaining tokens. The cost is compared as an unsigned integer, a request for
+// more than math.MaxInt64 blocks must not be turned into a negative cost which would
+// refill the buckets.
+func withinLimit(cost uint64, remaining int64) bool {
+	return remaining >= 0 && cost <= uint64(remaining)
+}
+
+func (l *limiter) writeErrorResponse(stream libp2pcore.Stream) {
+	resp, err := createErrorResponse(l.p2p.Encoding(), responseCodeInvalidRequest, rateLimitedError)
+	if err != nil {
+		log.WithError(err).Error("Failed to generate a response error")
+		return
+	}
+	if _, err := stream.Write(resp); err != nil {
+		log.WithError(err).Error("Failed to write to stream")
+	}
+}
+
+// requestCost returns the number of tokens a decoded request takes from the buckets of
+// its topic.
+func requestCost(msg interface{}) uint64 {
+	switch m := msg.(type) {
+	case *pb.BeaconBlocksByRangeRequest:
+		return m.Count
+	case [][32]byte:
+		return uint64(len(m))
+	default:
+		return 1
+	}
+}
+
+func valueOrDefault(value int, defaultValue int) int {
+	if value <= 0 {
+		return defaultValue
+	}
+	return value
+}
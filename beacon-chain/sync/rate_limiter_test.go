@@ -0,0 +1,184 @@
+package sync
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/protocol"
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
+	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+func TestRateLimiter_ExceedPeerCapacity(t *testing.T) {
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+
+	l := newRateLimiter(p1)
+	topic := "/eth2/beacon_chain/req/beacon_blocks_by_range/1" + p1.Encoding().ProtocolSuffix()
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	p2.Host.SetStreamHandler(protocol.ID(topic), func(stream network.Stream) {
+		defer wg.Done()
+		code, errMsg, err := ReadStatusCode(stream, p2.Encoding())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if code != responseCodeInvalidRequest {
+			t.Errorf("Expected response code %d, got %d", responseCodeInvalidRequest, code)
+		}
+		if errMsg != rateLimitedError {
+			t.Errorf("Expected error message %q, got %q", rateLimitedError, errMsg)
+		}
+	})
+	stream, err := p1.Host.NewStream(context.Background(), p2.PeerID(), protocol.ID(topic))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	capacity := uint64(defaultBlockBatchLimit * defaultBlockBatchLimitBurstFactor)
+	if err := l.validateRequest(stream, topic, capacity); err != nil {
+		t.Fatalf("Expected request within capacity to be accepted: %v", err)
+	}
+	if err := l.validateRequest(stream, topic, 1); err != errRateLimited {
+		t.Errorf("Expected error %v, got %v", errRateLimited, err)
+	}
+	if testutil.WaitTimeout(&wg, time.Second) {
+		t.Fatal("Did not receive stream within 1 sec")
+	}
+
+	badResponses, err := p1.Peers().BadResponses(p2.PeerID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if badResponses != 1 {
+		t.Errorf("Expected one bad response, got %d", badResponses)
+	}
+}
+
+func TestRateLimiter_ExceedGlobalCapacity(t *testing.T) {
+	flags.Init(&flags.GlobalFlags{
+		RPCRequestLimit:      1,
+		RPCGlobalLimitFactor: 1,
+	})
+	defer flags.Init(&flags.GlobalFlags{})
+
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p3 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+	p1.Connect(p3)
+
+	l := newRateLimiter(p1)
+	topic := "/eth2/beacon_chain/req/status/1" + p1.Encoding().ProtocolSuffix()
+	p2.Host.SetStreamHandler(protocol.ID(topic), func(stream network.Stream) {})
+	p3.Host.SetStreamHandler(protocol.ID(topic), func(stream network.Stream) {})
+
+	stream2, err := p1.Host.NewStream(context.Background(), p2.PeerID(), protocol.ID(topic))
+	if err != nil {
+		t.Fatal(err)
+	}
+	stream3, err := p1.Host.NewStream(context.Background(), p3.PeerID(), protocol.ID(topic))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The first peer takes the whole capacity of the global bucket.
+	if err := l.validateRequest(stream2, topic, rpcRequestBurstFactor); err != nil {
+		t.Fatalf("Expected request within capacity to be accepted: %v", err)
+	}
+	if err := l.validateRequest(stream3, topic, 1); err != errRateLimited {
+		t.Errorf("Expected error %v, got %v", errRateLimited, err)
+	}
+	// The global limit does not count against the reputation of the peer.
+	if badResponses, err := p1.Peers().BadResponses(p3.PeerID()); err == nil && badResponses != 0 {
+		t.Errorf("Expected no bad responses, got %d", badResponses)
+	}
+}
+
+func TestRateLimiter_RejectsOverflowingCost(t *testing.T) {
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+
+	l := newRateLimiter(p1)
+	topic := "/eth2/beacon_chain/req/beacon_blocks_by_range/1" + p1.Encoding().ProtocolSuffix()
+	p2.Host.SetStreamHandler(protocol.ID(topic), func(stream network.Stream) {})
+	stream, err := p1.Host.NewStream(context.Background(), p2.PeerID(), protocol.ID(topic))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cost := requestCost(&pb.BeaconBlocksByRangeRequest{Count: 1 << 63})
+	if err := l.validateRequest(stream, topic, cost); err != errRateLimited {
+		t.Errorf("Expected error %v, got %v", errRateLimited, err)
+	}
+	// The rejected request did not refill the buckets.
+	capacity := uint64(defaultBlockBatchLimit * defaultBlockBatchLimitBurstFactor)
+	if err := l.validateRequest(stream, topic, capacity); err != nil {
+		t.Fatalf("Expected request within capacity to be accepted: %v", err)
+	}
+	if err := l.validateRequest(stream, topic, 1); err != errRateLimited {
+		t.Errorf("Expected error %v, got %v", errRateLimited, err)
+	}
+}
+
+func TestRateLimiter_ConcurrentRequests(t *testing.T) {
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+
+	l := newRateLimiter(p1)
+	topic := "/eth2/beacon_chain/req/beacon_blocks_by_range/1" + p1.Encoding().ProtocolSuffix()
+	p2.Host.SetStreamHandler(protocol.ID(topic), func(stream network.Stream) {})
+	stream, err := p1.Host.NewStream(context.Background(), p2.PeerID(), protocol.ID(topic))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Twice the capacity of the peer bucket is requested concurrently, only half of the
+	// requests fit in the bucket.
+	const requests = 10
+	cost := uint64(defaultBlockBatchLimit*defaultBlockBatchLimitBurstFactor) / (requests / 2)
+	var wg sync.WaitGroup
+	var lock sync.Mutex
+	accepted := 0
+	for i := 0; i < requests; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := l.validateRequest(stream, topic, cost); err == nil {
+				lock.Lock()
+				accepted++
+				lock.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	if accepted > requests/2 {
+		t.Errorf("Expected at most %d accepted requests, got %d", requests/2, accepted)
+	}
+}
+
+func TestRequestCost(t *testing.T) {
+	tests := []struct {
+		msg  interface{}
+		cost uint64
+	}{
+		{msg: &pb.BeaconBlocksByRangeRequest{Count: 64}, cost: 64},
+		{msg: [][32]byte{{'a'}, {'b'}, {'c'}}, cost: 3},
+		{msg: &pb.Status{}, cost: 1},
+		{msg: new(uint64), cost: 1},
+	}
+	for _, tt := range tests {
+		if cost := requestCost(tt.msg); cost != tt.cost {
+			t.Errorf("Wrong cost for %T, wanted %d but got %d", tt.msg, tt.cost, cost)
+		}
+	}
+}
@@ -50,6 +50,13 @@ func (r *Service) registerRPCHandlers() {
 		[][32]byte{},
 		r.beaconBlocksRootRPCHandler,
 	)
+	if r.lightClientUpdates != nil {
+		r.registerRPC(
+			"/eth2/beacon_chain/req/light_client_update/1",
+			&pb.LightClientUpdateRequest{},
+			r.lightClientUpdateRPCHandler,
+		)
+	}
 }
 
 // registerRPC for a given topic with an expected protobuf message type.
@@ -77,6 +84,7 @@ func (r *Service) registerRPC(topic string, base interface{}, handle rpcHandler)
 		// Given we have an input argument that can be pointer or [][32]byte, this gives us
 		// a way to check for its reflect.Kind and based on the result, we can decode
 		// accordingly.
+		var req interface{}
 		t := reflect.TypeOf(base)
 		if t.Kind() == reflect.Ptr {
 			msg := reflect.New(t.Elem())
@@ -85,13 +93,7 @@ func (r *Service) registerRPC(topic string, base interface{}, handle rpcHandler)
 				traceutil.AnnotateError(span, err)
 				return
 			}
-			if err := handle(ctx, msg.Interface(), stream); err != nil {
-				messageFailedProcessingCounter.WithLabelValues(topic).Inc()
-				if err != errWrongForkVersion {
-					log.WithError(err).Warn("Failed to handle p2p RPC")
-				}
-				traceutil.AnnotateError(span, err)
-			}
+			req = msg.Interface()
 		} else {
 			msg := reflect.New(t)
 			if err := r.p2p.Encoding().DecodeWithLength(stream, msg.Interface()); err != nil {
@@ -99,14 +101,21 @@ func (r *Service) registerRPC(topic string, base interface{}, handle rpcHandler)
 				traceutil.AnnotateError(span, err)
 				return
 			}
-			if err := handle(ctx, msg.Elem().Interface(), stream); err != nil {
-				messageFailedProcessingCounter.WithLabelValues(topic).Inc()
-				if err != errWrongForkVersion {
-					log.WithError(err).Warn("Failed to handle p2p RPC")
-				}
-				traceutil.AnnotateError(span, err)
-			}
+			req = msg.Elem().Interface()
 		}
 
+		if err := r.rateLimiter.validateRequest(stream, topic, requestCost(req)); err != nil {
+			log.WithError(err).Debug("Rejected p2p RPC request")
+			traceutil.AnnotateError(span, err)
+			return
+		}
+
+		if err := handle(ctx, req, stream); err != nil {
+			messageFailedProcessingCounter.WithLabelValues(topic).Inc()
+			if err != errWrongForkDigestVersion {
+				log.WithError(err).Warn("Failed to handle p2p RPC")
+			}
+			traceutil.AnnotateError(span, err)
+		}
 	})
 }
@@ -0,0 +1,38 @@
+package sync
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	libp2pcore "github.com/libp2p/go-libp2p-core"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+// lightClientUpdateRPCHandler responds with the latest light client update of the node if
+// its finalized header is more recent than the finalized slot of the request. No response
+// chunk is written if the node has no such update.
+func (r *Service) lightClientUpdateRPCHandler(ctx context.Context, msg interface{}, stream libp2pcore.Stream) error {
+	defer stream.Close()
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	setRPCStreamDeadlines(stream)
+
+	m, ok := msg.(*pb.LightClientUpdateRequest)
+	if !ok {
+		return fmt.Errorf("wrong message type for light client update, got %T, wanted *pb.LightClientUpdateRequest", msg)
+	}
+	update, err := r.lightClientUpdates.UpdateSince(ctx, m.FinalizedSlot)
+	if err != nil {
+		log.WithError(err).Error("Failed to get light client update")
+		return err
+	}
+	if update == nil {
+		return nil
+	}
+	if err := r.chunkWriter(stream, update); err != nil {
+		log.WithError(err).Error("Failed to send light client update")
+		return err
+	}
+	return nil
+}
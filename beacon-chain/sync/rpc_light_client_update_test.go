@@ -0,0 +1,103 @@
+package sync
+
+import (
+	"context"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/protocol"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+type mockUpdateFetcher struct {
+	update *pb.LightClientUpdate
+}
+
+func (m *mockUpdateFetcher) LatestUpdate() *pb.LightClientUpdate {
+	return m.update
+}
+
+func (m *mockUpdateFetcher) UpdateSince(_ context.Context, finalizedSlot uint64) (*pb.LightClientUpdate, error) {
+	if m.update == nil || m.update.FinalizedHeader.Slot <= finalizedSlot {
+		return nil, nil
+	}
+	return m.update, nil
+}
+
+func TestLightClientUpdateRPCHandler(t *testing.T) {
+	update := &pb.LightClientUpdate{
+		AttestedHeader: &ethpb.BeaconBlockHeader{
+			Slot:       96,
+			ParentRoot: make([]byte, 32),
+			StateRoot:  make([]byte, 32),
+			BodyRoot:   make([]byte, 32),
+		},
+		FinalizedHeader: &ethpb.BeaconBlockHeader{
+			Slot:       32,
+			ParentRoot: make([]byte, 32),
+			StateRoot:  make([]byte, 32),
+			BodyRoot:   make([]byte, 32),
+		},
+		FinalityBranch: make([][]byte, 6),
+	}
+	for i := range update.FinalityBranch {
+		update.FinalityBranch[i] = make([]byte, 32)
+	}
+
+	tests := []struct {
+		name          string
+		finalizedSlot uint64
+		wantUpdate    bool
+	}{
+		{name: "newer update", finalizedSlot: 0, wantUpdate: true},
+		{name: "known update", finalizedSlot: 32, wantUpdate: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p1 := p2ptest.NewTestP2P(t)
+			p2 := p2ptest.NewTestP2P(t)
+			p1.Connect(p2)
+			r := &Service{p2p: p1, lightClientUpdates: &mockUpdateFetcher{update: update}}
+			pcl := protocol.ID("/testing")
+
+			var wg sync.WaitGroup
+			wg.Add(1)
+			p2.Host.SetStreamHandler(pcl, func(stream network.Stream) {
+				defer wg.Done()
+				if !tt.wantUpdate {
+					if _, err := stream.Read(make([]byte, 1)); err != io.EOF {
+						t.Errorf("Expected no response chunk, received error %v", err)
+					}
+					return
+				}
+				expectSuccess(t, r, stream)
+				res := &pb.LightClientUpdate{}
+				if err := r.p2p.Encoding().DecodeWithLength(stream, res); err != nil {
+					t.Error(err)
+				}
+				if !proto.Equal(res, update) {
+					t.Errorf("Wanted update %v, received %v", update, res)
+				}
+			})
+
+			stream, err := p1.Host.NewStream(context.Background(), p2.Host.ID(), pcl)
+			if err != nil {
+				t.Fatal(err)
+			}
+			req := &pb.LightClientUpdateRequest{FinalizedSlot: tt.finalizedSlot}
+			if err := r.lightClientUpdateRPCHandler(context.Background(), req, stream); err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+			if testutil.WaitTimeout(&wg, 1*time.Second) {
+				t.Fatal("Did not receive stream within 1 sec")
+			}
+		})
+	}
+}
@@ -45,9 +45,9 @@ func (r *Service) maintainPeerStatuses() {
 // in which case we attempt a resync using the initial sync method to catch up.
 func (r *Service) resyncIfBehind() {
 	// Run sixteen times per epoch.
-	interval := time.Duration(params.BeaconConfig().SecondsPerSlot*params.BeaconConfig().SlotsPerEpoch/16) * time.Second
+	interval := time.Duration(params.BeaconConfig().SecondsPerSlot*params.BeaconConfig().SlotsPerEpoch) * time.Second / 16
 	runutil.RunEvery(r.ctx, interval, func() {
-		currentEpoch := uint64(roughtime.Now().Unix()-r.chain.GenesisTime().Unix()) / (params.BeaconConfig().SecondsPerSlot * params.BeaconConfig().SlotsPerEpoch)
+		currentEpoch := uint64(slotutil.ClockOrDefault(r.clock).Now().Unix()-r.chain.GenesisTime().Unix()) / (params.BeaconConfig().SecondsPerSlot * params.BeaconConfig().SlotsPerEpoch)
 		syncedEpoch := helpers.SlotToEpoch(r.chain.HeadSlot())
 		if r.initialSync != nil && !r.initialSync.Syncing() && syncedEpoch < currentEpoch-1 {
 			_, highestEpoch, _ := r.p2p.Peers().BestFinalized(params.BeaconConfig().MaxPeersToSync, syncedEpoch)
@@ -77,12 +77,16 @@ func (r *Service) sendRPCStatusRequest(ctx context.Context, id peer.ID) error {
 		return err
 	}
 
+	forkDigest, err := r.forkDigest()
+	if err != nil {
+		return err
+	}
 	resp := &pb.Status{
-		HeadForkVersion: r.chain.CurrentFork().CurrentVersion,
-		FinalizedRoot:   r.chain.FinalizedCheckpt().Root,
-		FinalizedEpoch:  r.chain.FinalizedCheckpt().Epoch,
-		HeadRoot:        headRoot,
-		HeadSlot:        r.chain.HeadSlot(),
+		ForkDigest:     forkDigest[:],
+		FinalizedRoot:  r.chain.FinalizedCheckpt().Root,
+		FinalizedEpoch: r.chain.FinalizedCheckpt().Epoch,
+		HeadRoot:       headRoot,
+		HeadSlot:       r.chain.HeadSlot(),
 	}
 	stream, err := r.p2p.Send(ctx, resp, id)
 	if err != nil {
@@ -117,7 +121,7 @@ func (r *Service) removeDisconnectedPeerStatus(ctx context.Context, pid peer.ID)
 }
 
 // statusRPCHandler reads the incoming Status RPC from the peer and responds with our version of a status message.
-// This handler will disconnect any peer that does not match our fork version.
+// This handler will disconnect any peer that does not match our fork digest.
 func (r *Service) statusRPCHandler(ctx context.Context, msg interface{}, stream libp2pcore.Stream) error {
 	defer stream.Close()
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
@@ -127,7 +131,7 @@ func (r *Service) statusRPCHandler(ctx context.Context, msg interface{}, stream
 	m := msg.(*pb.Status)
 
 	if err := r.validateStatusMessage(m, stream); err != nil {
-		log.WithField("peer", stream.Conn().RemotePeer()).Debug("Invalid fork version from peer")
+		log.WithField("peer", stream.Conn().RemotePeer()).Debug("Invalid status message from peer")
 		r.p2p.Peers().IncrementBadResponses(stream.Conn().RemotePeer())
 		originalErr := err
 		resp, err := r.generateErrorResponse(responseCodeInvalidRequest, err.Error())
@@ -135,7 +139,7 @@ func (r *Service) statusRPCHandler(ctx context.Context, msg interface{}, stream
 			log.WithError(err).Error("Failed to generate a response error")
 		} else {
 			if _, err := stream.Write(resp); err != nil {
-				// The peer may already be ignoring us, as we disagree on fork version, so log this as debug only.
+				// The peer may already be ignoring us, as we disagree on fork digest, so log this as debug only.
 				log.WithError(err).Debug("Failed to write to stream")
 			}
 		}
@@ -155,12 +159,16 @@ func (r *Service) statusRPCHandler(ctx context.Context, msg interface{}, stream
 		return err
 	}
 
+	forkDigest, err := r.forkDigest()
+	if err != nil {
+		return err
+	}
 	resp := &pb.Status{
-		HeadForkVersion: r.chain.CurrentFork().CurrentVersion,
-		FinalizedRoot:   r.chain.FinalizedCheckpt().Root,
-		FinalizedEpoch:  r.chain.FinalizedCheckpt().Epoch,
-		HeadRoot:        headRoot,
-		HeadSlot:        r.chain.HeadSlot(),
+		ForkDigest:     forkDigest[:],
+		FinalizedRoot:  r.chain.FinalizedCheckpt().Root,
+		FinalizedEpoch: r.chain.FinalizedCheckpt().Epoch,
+		HeadRoot:       headRoot,
+		HeadSlot:       r.chain.HeadSlot(),
 	}
 
 	if _, err := stream.Write([]byte{responseCodeSuccess}); err != nil {
@@ -172,8 +180,12 @@ func (r *Service) statusRPCHandler(ctx context.Context, msg interface{}, stream
 }
 
 func (r *Service) validateStatusMessage(msg *pb.Status, stream network.Stream) error {
-	if !bytes.Equal(params.BeaconConfig().GenesisForkVersion, msg.HeadForkVersion) {
-		return errWrongForkVersion
+	forkDigest, err := r.forkDigest()
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(forkDigest[:], msg.ForkDigest) {
+		return errWrongForkDigestVersion
 	}
 	genesis := r.chain.GenesisTime()
 	maxEpoch := slotutil.EpochsSinceGenesis(genesis)
@@ -2,10 +2,10 @@ package sync
 
 import (
 	"context"
+	"reflect"
 	"sync"
 	"time"
 
-	"github.com/kevinms/leakybucket-go"
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
@@ -15,6 +15,7 @@ import (
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/lightclient"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
@@ -22,12 +23,10 @@ import (
 	"github.com/prysmaticlabs/prysm/shared"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/runutil"
+	"github.com/prysmaticlabs/prysm/shared/slotutil"
 )
 
-var _ = shared.Service(&Service{})
-
-const allowedBlocksPerSecond = 32.0
-const allowedBlocksBurst = 10 * allowedBlocksPerSecond
+var _ = shared.DependentService(&Service{})
 
 // refresh enr every quarter of an epoch
 var refreshRate = (params.BeaconConfig().SecondsPerSlot * params.BeaconConfig().SlotsPerEpoch) / 4
@@ -45,6 +44,8 @@ type Config struct {
 	BlockNotifier       blockfeed.Notifier
 	AttestationNotifier operation.Notifier
 	StateSummaryCache   *cache.StateSummaryCache
+	LightClientUpdates  lightclient.UpdateFetcher
+	Clock               slotutil.Clock
 }
 
 // This defines the interface for interacting with block chain service
@@ -73,11 +74,14 @@ func NewRegularSync(cfg *Config) *Service {
 		attestationNotifier:  cfg.AttestationNotifier,
 		slotToPendingBlocks:  make(map[uint64]*ethpb.SignedBeaconBlock),
 		seenPendingBlocks:    make(map[[32]byte]bool),
+		subnetSearches:       make(map[uint64]bool),
 		blkRootToPendingAtts: make(map[[32]byte][]*ethpb.AggregateAttestationAndProof),
 		stateNotifier:        cfg.StateNotifier,
 		blockNotifier:        cfg.BlockNotifier,
 		stateSummaryCache:    cfg.StateSummaryCache,
-		blocksRateLimiter:    leakybucket.NewCollector(allowedBlocksPerSecond, allowedBlocksBurst, false /* deleteEmptyBuckets */),
+		lightClientUpdates:   cfg.LightClientUpdates,
+		clock:                cfg.Clock,
+		rateLimiter:          newRateLimiter(cfg.P2P),
 	}
 
 	r.registerRPCHandlers()
@@ -107,9 +111,13 @@ type Service struct {
 	validateBlockLock    sync.RWMutex
 	stateNotifier        statefeed.Notifier
 	blockNotifier        blockfeed.Notifier
-	blocksRateLimiter    *leakybucket.Collector
+	rateLimiter          *limiter
 	attestationNotifier  operation.Notifier
 	stateSummaryCache    *cache.StateSummaryCache
+	lightClientUpdates   lightclient.UpdateFetcher
+	clock                slotutil.Clock
+	subnetSearches       map[uint64]bool
+	subnetSearchLock     sync.Mutex
 }
 
 // Start the regular sync service.
@@ -145,6 +153,12 @@ func (r *Service) Status() error {
 	return nil
 }
 
+// Dependencies of the regular sync service, which handles the messages of the p2p network
+// once the blockchain and initial sync services are started.
+func (r *Service) Dependencies() []reflect.Type {
+	return shared.DependencyTypes(r.p2p, r.chain, r.initialSync)
+}
+
 // Checker defines a struct which can verify whether a node is currently
 // synchronizing a chain with the rest of peers in the network.
 type Checker interface {
@@ -158,7 +172,6 @@ func (r *Service) refreshENR() {
 	ctx := context.Background()
 	refreshTime := time.Duration(refreshRate) * time.Second
 	runutil.RunEvery(ctx, refreshTime, func() {
-		currentEpoch := helpers.SlotToEpoch(helpers.SlotsSince(r.chain.GenesisTime()))
-		r.p2p.RefreshENR(currentEpoch)
+		r.p2p.RefreshENR()
 	})
 }
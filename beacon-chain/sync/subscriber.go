@@ -3,21 +3,20 @@ package sync
 import (
 	"context"
 	"fmt"
-	"reflect"
 	"runtime/debug"
 	"time"
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/libp2p/go-libp2p-core/peer"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
-	pb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/messagehandler"
 	"github.com/prysmaticlabs/prysm/shared/params"
-	"github.com/prysmaticlabs/prysm/shared/roughtime"
+	"github.com/prysmaticlabs/prysm/shared/sliceutil"
+	"github.com/prysmaticlabs/prysm/shared/slotutil"
 	"github.com/prysmaticlabs/prysm/shared/traceutil"
 	"go.opencensus.io/trace"
 )
@@ -51,9 +50,18 @@ func (r *Service) registerSubscribers() {
 				if event.Type == statefeed.Initialized {
 					data := event.Data.(*statefeed.InitializedData)
 					log.WithField("starttime", data.StartTime).Debug("Received state initialized event")
-					if data.StartTime.After(roughtime.Now()) {
+					// Gossip topics are named after the fork digest, which is only known once
+					// the genesis validators are.
+					digest, err := p2p.CreateForkDigest(data.StartTime, data.GenesisValidatorsRoot)
+					if err != nil {
+						log.WithError(err).Error("Could not compute fork digest, not subscribing to gossip topics")
+						return
+					}
+					cancelSubs := r.subscribeToFork(digest)
+					go r.forkWatcher(digest, cancelSubs)
+					if now := slotutil.ClockOrDefault(r.clock).Now(); data.StartTime.After(now) {
 						stateSub.Unsubscribe()
-						time.Sleep(roughtime.Until(data.StartTime))
+						<-slotutil.ClockOrDefault(r.clock).After(data.StartTime.Sub(now))
 					}
 					r.chainStarted = true
 				}
@@ -66,59 +74,82 @@ func (r *Service) registerSubscribers() {
 			}
 		}
 	}()
+}
+
+// subscribeToFork subscribes to the gossip topics of the fork with the given digest. The
+// subscriptions last until the returned cancel function is called.
+func (r *Service) subscribeToFork(digest [4]byte) context.CancelFunc {
+	ctx, cancel := context.WithCancel(r.ctx)
 	r.subscribe(
-		"/eth2/beacon_block",
+		ctx,
+		"/eth2/%x/beacon_block",
+		digest,
 		r.validateBeaconBlockPubSub,
 		r.beaconBlockSubscriber,
 	)
 	r.subscribe(
-		"/eth2/beacon_aggregate_and_proof",
+		ctx,
+		"/eth2/%x/beacon_aggregate_and_proof",
+		digest,
 		r.validateAggregateAndProof,
 		r.beaconAggregateProofSubscriber,
 	)
 	r.subscribe(
-		"/eth2/voluntary_exit",
+		ctx,
+		"/eth2/%x/voluntary_exit",
+		digest,
 		r.validateVoluntaryExit,
 		r.voluntaryExitSubscriber,
 	)
 	r.subscribe(
-		"/eth2/proposer_slashing",
+		ctx,
+		"/eth2/%x/proposer_slashing",
+		digest,
 		r.validateProposerSlashing,
 		r.proposerSlashingSubscriber,
 	)
 	r.subscribe(
-		"/eth2/attester_slashing",
+		ctx,
+		"/eth2/%x/attester_slashing",
+		digest,
 		r.validateAttesterSlashing,
 		r.attesterSlashingSubscriber,
 	)
 	if featureconfig.Get().EnableDynamicCommitteeSubnets {
 		r.subscribeDynamicWithSubnets(
-			"/eth2/committee_index%d_beacon_attestation",
-			r.committeeIndices,                          /* determineSubsLen */
+			ctx,
+			"/eth2/%x/committee_index%d_beacon_attestation",
+			digest,
+			r.subscribedSubnetIndices, /* determineSubIndices */
+			r.attesterSubnetIndices,   /* determinePeerIndices */
 			r.validateCommitteeIndexBeaconAttestation,   /* validator */
 			r.committeeIndexBeaconAttestationSubscriber, /* message handler */
 		)
 	} else {
 		r.subscribeDynamic(
-			"/eth2/committee_index%d_beacon_attestation",
-			r.committeesCount,                           /* determineSubsLen */
+			ctx,
+			"/eth2/%x/committee_index%d_beacon_attestation",
+			digest,
+			r.committeesCount, /* determineSubsLen */
 			r.validateCommitteeIndexBeaconAttestation,   /* validator */
 			r.committeeIndexBeaconAttestationSubscriber, /* message handler */
 		)
 	}
+	return cancel
 }
 
-// subscribe to a given topic with a given validator and subscription handler.
+// subscribe to a given topic with a given validator and subscription handler, until the
+// context is cancelled. The topic is a format which takes the fork digest.
 // The base protobuf message is used to initialize new messages for decoding.
-func (r *Service) subscribe(topic string, validator pubsub.Validator, handle subHandler) *pubsub.Subscription {
+func (r *Service) subscribe(ctx context.Context, topic string, digest [4]byte, validator pubsub.Validator, handle subHandler) *pubsub.Subscription {
 	base := p2p.GossipTopicMappings[topic]
 	if base == nil {
 		panic(fmt.Sprintf("%s is not mapped to any message in GossipTopicMappings", topic))
 	}
-	return r.subscribeWithBase(base, topic, validator, handle)
+	return r.subscribeWithBase(ctx, base, fmt.Sprintf(topic, digest), validator, handle)
 }
 
-func (r *Service) subscribeWithBase(base proto.Message, topic string, validator pubsub.Validator, handle subHandler) *pubsub.Subscription {
+func (r *Service) subscribeWithBase(ctx context.Context, base proto.Message, topic string, validator pubsub.Validator, handle subHandler) *pubsub.Subscription {
 	topic += r.p2p.Encoding().ProtocolSuffix()
 	log := log.WithField("topic", topic)
 
@@ -168,8 +199,17 @@ func (r *Service) subscribeWithBase(base proto.Message, topic string, validator
 	// The main message loop for receiving incoming messages from this subscription.
 	messageLoop := func() {
 		for {
-			msg, err := sub.Next(r.ctx)
+			msg, err := sub.Next(ctx)
 			if err != nil {
+				// The subscription of a previous fork is cancelled once the service moved
+				// on to the topics of the next fork.
+				if ctx.Err() != nil && r.ctx.Err() == nil {
+					sub.Cancel()
+					if err := r.p2p.PubSub().UnregisterTopicValidator(topic); err != nil {
+						log.WithError(err).Error("Failed to unregister validator")
+					}
+					return
+				}
 				// This should only happen when the context is cancelled or subscription is cancelled.
 				log.WithError(err).Error("Subscription next failed")
 				return
@@ -203,11 +243,17 @@ func wrapAndReportValidation(topic string, v pubsub.Validator) (string, pubsub.V
 }
 
 // subscribe to a dynamically changing list of subnets. This method expects a fmt compatible
-// string for the topic name and the list of subnets for subscribed topics that should be
-// maintained.
+// string for the topic name, which takes the fork digest and the subnet. At every slot, the
+// node joins the subnets returned by determineSubIndices and leaves the ones it no longer
+// needs, and searches the network for peers on those subnets and on the subnets returned by
+// determinePeerIndices, which the node publishes to without joining them. This goes on until
+// the context is cancelled.
 func (r *Service) subscribeDynamicWithSubnets(
+	ctx context.Context,
 	topicFormat string,
-	determineSubIndices func() []uint64,
+	digest [4]byte,
+	determineSubIndices func(currentSlot uint64) []uint64,
+	determinePeerIndices func(currentSlot uint64) []uint64,
 	validate pubsub.Validator,
 	handle subHandler,
 ) {
@@ -216,75 +262,89 @@ func (r *Service) subscribeDynamicWithSubnets(
 		panic(fmt.Sprintf("%s is not mapped to any message in GossipTopicMappings", topicFormat))
 	}
 
-	subscriptions := make(map[uint64]*pubsub.Subscription, params.BeaconConfig().MaxCommitteesPerSlot)
+	// Every subnet subscription has its own context, cancelling it leaves the subnet.
+	subscriptions := make(map[uint64]context.CancelFunc, params.BeaconConfig().MaxCommitteesPerSlot)
 
-	stateChannel := make(chan *feed.Event, 1)
-	stateSub := r.stateNotifier.StateFeed().Subscribe(stateChannel)
+	slotTicker := slotutil.GetSlotTickerWithClock(slotutil.ClockOrDefault(r.clock), r.chain.GenesisTime(), params.BeaconConfig().SecondsPerSlot)
 	go func() {
 		for {
 			select {
-			case <-r.ctx.Done():
-				stateSub.Unsubscribe()
+			case <-ctx.Done():
+				slotTicker.Done()
 				return
-			case <-stateChannel:
+			case currentSlot := <-slotTicker.C():
 				if r.chainStarted && r.initialSync.Syncing() {
 					continue
 				}
-				// Update desired topic indices.
-				wantedSubs := determineSubIndices()
-				// Resize as appropriate.
-				for k, v := range subscriptions {
-					var wanted bool
-					for _, idx := range wantedSubs {
-						if k == idx {
-							wanted = true
-							break
-						}
-					}
-					if !wanted && v != nil {
-						v.Cancel()
-						r.p2p.PubSub().UnregisterTopicValidator(fmt.Sprintf(topicFormat, k))
-						delete(subscriptions, k)
+				wantedSubs := determineSubIndices(currentSlot)
+				for idx, cancel := range subscriptions {
+					if !sliceutil.IsInUint64(idx, wantedSubs) {
+						cancel()
+						delete(subscriptions, idx)
 					}
 				}
 				for _, idx := range wantedSubs {
 					if _, exists := subscriptions[idx]; !exists {
-						// do not subscribe if we have no peers in the same
-						// subnet
-						topic := p2p.GossipTypeMapping[reflect.TypeOf(&pb.Attestation{})]
-						subnetTopic := fmt.Sprintf(topic, idx)
-						numOfPeers := r.p2p.PubSub().ListPeers(subnetTopic)
-						if len(r.p2p.Peers().SubscribedToSubnet(idx)) == 0 && len(numOfPeers) == 0 {
-							log.Debugf("No peers found subscribed to attestation gossip subnet with "+
-								"committee index %d. Searching network for peers subscribed to the subnet.", idx)
-							go func(idx uint64) {
-								peerExists, err := r.p2p.FindPeersWithSubnet(idx)
-								if err != nil {
-									log.Errorf("Could not search for peers: %v", err)
-									return
-								}
-								// do not subscribe if we couldn't find a connected peer.
-								if !peerExists {
-									return
-								}
-								subscriptions[idx] = r.subscribeWithBase(base, subnetTopic, validate, handle)
-							}(idx)
-							continue
-						}
-						subscriptions[idx] = r.subscribeWithBase(base, subnetTopic, validate, handle)
+						subCtx, cancel := context.WithCancel(ctx)
+						r.subscribeWithBase(subCtx, base, fmt.Sprintf(topicFormat, digest, idx), validate, handle)
+						subscriptions[idx] = cancel
 					}
 				}
+				peerSubs := sliceutil.UnionUint64(wantedSubs, determinePeerIndices(currentSlot))
+				r.findPeersForSubnets(topicFormat, digest, peerSubs)
 			}
 		}
 	}()
 }
 
+// findPeersForSubnets searches the network for peers on the given subnets, if the node is
+// not connected to any peer of the subnet yet. A subnet is only searched once at a time, as
+// a search may outlast the slot which started it.
+func (r *Service) findPeersForSubnets(topicFormat string, digest [4]byte, subnets []uint64) {
+	for _, idx := range subnets {
+		topic := fmt.Sprintf(topicFormat, digest, idx) + r.p2p.Encoding().ProtocolSuffix()
+		if len(r.p2p.PubSub().ListPeers(topic)) > 0 || len(r.p2p.Peers().SubscribedToSubnet(idx)) > 0 {
+			continue
+		}
+		if !r.startSubnetSearch(idx) {
+			continue
+		}
+		log.Debugf("No peers found subscribed to attestation gossip subnet with "+
+			"committee index %d. Searching network for peers subscribed to the subnet.", idx)
+		go func(idx uint64) {
+			defer r.endSubnetSearch(idx)
+			if _, err := r.p2p.FindPeersWithSubnet(idx); err != nil {
+				log.WithError(err).Error("Could not search for peers")
+			}
+		}(idx)
+	}
+}
+
+// startSubnetSearch records a search for peers on the subnet, and returns false if a search
+// of the subnet is already in flight.
+func (r *Service) startSubnetSearch(subnet uint64) bool {
+	r.subnetSearchLock.Lock()
+	defer r.subnetSearchLock.Unlock()
+	if r.subnetSearches[subnet] {
+		return false
+	}
+	r.subnetSearches[subnet] = true
+	return true
+}
+
+// endSubnetSearch records the end of the search for peers on the subnet.
+func (r *Service) endSubnetSearch(subnet uint64) {
+	r.subnetSearchLock.Lock()
+	defer r.subnetSearchLock.Unlock()
+	delete(r.subnetSearches, subnet)
+}
+
 // subscribe to a dynamically increasing index of topics. This method expects a fmt compatible
-// string for the topic name and a maxID to represent the number of subscribed topics that should be
-// maintained. As the state feed emits a newly updated state, the maxID function will be called to
-// determine the appropriate number of topics. This method supports only sequential number ranges
-// for topics.
-func (r *Service) subscribeDynamic(topicFormat string, determineSubsLen func() int, validate pubsub.Validator, handle subHandler) {
+// string for the topic name, which takes the fork digest and the index, and a maxID to represent
+// the number of subscribed topics that should be maintained until the context is cancelled. As the
+// state feed emits a newly updated state, the maxID function will be called to determine the
+// appropriate number of topics. This method supports only sequential number ranges for topics.
+func (r *Service) subscribeDynamic(ctx context.Context, topicFormat string, digest [4]byte, determineSubsLen func() int, validate pubsub.Validator, handle subHandler) {
 	base := p2p.GossipTopicMappings[topicFormat]
 	if base == nil {
 		panic(fmt.Sprintf("%s is not mapped to any message in GossipTopicMappings", topicFormat))
@@ -297,7 +357,7 @@ func (r *Service) subscribeDynamic(topicFormat string, determineSubsLen func() i
 	go func() {
 		for {
 			select {
-			case <-r.ctx.Done():
+			case <-ctx.Done():
 				stateSub.Unsubscribe()
 				return
 			case <-stateChannel:
@@ -312,11 +372,11 @@ func (r *Service) subscribeDynamic(topicFormat string, determineSubsLen func() i
 					subscriptions, cancelSubs = subscriptions[:wantedSubs-1], subscriptions[wantedSubs:]
 					for i, sub := range cancelSubs {
 						sub.Cancel()
-						r.p2p.PubSub().UnregisterTopicValidator(fmt.Sprintf(topicFormat, i+wantedSubs))
+						r.p2p.PubSub().UnregisterTopicValidator(fmt.Sprintf(topicFormat, digest, i+wantedSubs))
 					}
 				} else if len(subscriptions) < wantedSubs { // Increase topics
 					for i := len(subscriptions); i < wantedSubs; i++ {
-						sub := r.subscribeWithBase(base, fmt.Sprintf(topicFormat, i), validate, handle)
+						sub := r.subscribeWithBase(ctx, base, fmt.Sprintf(topicFormat, digest, i), validate, handle)
 						subscriptions = append(subscriptions, sub)
 					}
 				}
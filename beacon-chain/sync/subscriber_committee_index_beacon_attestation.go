@@ -10,6 +10,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/sliceutil"
 )
 
@@ -43,8 +44,29 @@ func (r *Service) committeesCount() int {
 	return int(helpers.SlotCommitteeCount(uint64(len(activeValidatorIndices))))
 }
 
-func (r *Service) committeeIndices() []uint64 {
-	currentEpoch := helpers.SlotToEpoch(r.chain.HeadSlot())
-	return sliceutil.UnionUint64(cache.CommitteeIDs.GetIDs(currentEpoch),
-		cache.CommitteeIDs.GetIDs(currentEpoch+1))
+// subscribedSubnetIndices returns the subnets the node is subscribed to at the given slot:
+// the long-lived subnets of its validators, and the subnets of their aggregator duties up to
+// an epoch ahead, so that the node joins them before the duties start.
+func (r *Service) subscribedSubnetIndices(currentSlot uint64) []uint64 {
+	return sliceutil.UnionUint64(cache.SubnetIDs.GetAllSubnets(), r.aggregatorSubnetIndices(currentSlot))
+}
+
+// aggregatorSubnetIndices returns the subnets of the aggregator duties from the given slot up
+// to an epoch ahead.
+func (r *Service) aggregatorSubnetIndices(currentSlot uint64) []uint64 {
+	var subnets []uint64
+	for slot := currentSlot; slot <= currentSlot+params.BeaconConfig().SlotsPerEpoch; slot++ {
+		subnets = append(subnets, cache.SubnetIDs.GetAggregatorSubnetIDs(slot)...)
+	}
+	return sliceutil.SetUint64(subnets)
+}
+
+// attesterSubnetIndices returns the subnets of the attester duties from the given slot up to
+// an epoch ahead.
+func (r *Service) attesterSubnetIndices(currentSlot uint64) []uint64 {
+	var subnets []uint64
+	for slot := currentSlot; slot <= currentSlot+params.BeaconConfig().SlotsPerEpoch; slot++ {
+		subnets = append(subnets, cache.SubnetIDs.GetAttesterSubnetIDs(slot)...)
+	}
+	return sliceutil.SetUint64(subnets)
 }
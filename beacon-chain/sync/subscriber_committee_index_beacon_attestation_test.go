@@ -2,6 +2,7 @@ package sync
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
@@ -65,7 +66,8 @@ func TestService_committeeIndexBeaconAttestationSubscriber_ValidMessage(t *testi
 	r.stateNotifier.StateFeed().Send(&feed.Event{
 		Type: statefeed.Initialized,
 		Data: &statefeed.InitializedData{
-			StartTime: time.Now(),
+			StartTime:             time.Now(),
+			GenesisValidatorsRoot: make([]byte, 32),
 		},
 	})
 
@@ -78,7 +80,11 @@ func TestService_committeeIndexBeaconAttestationSubscriber_ValidMessage(t *testi
 		Signature:       sKeys[0].Sign([]byte("foo"), 0).Marshal(),
 	}
 
-	p.ReceivePubSub("/eth2/committee_index0_beacon_attestation", att)
+	digest, err := r.forkDigest()
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.ReceivePubSub(fmt.Sprintf("/eth2/%x/committee_index0_beacon_attestation", digest), att)
 
 	time.Sleep(time.Second)
 
@@ -2,8 +2,10 @@ package sync
 
 import (
 	"context"
+	"fmt"
 	"reflect"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -31,11 +33,11 @@ func TestSubscribe_ReceivesValidMessage(t *testing.T) {
 		p2p:         p2p,
 		initialSync: &mockSync.Sync{IsSyncing: false},
 	}
-	topic := "/eth2/voluntary_exit"
+	topic := "/eth2/%x/voluntary_exit"
 	var wg sync.WaitGroup
 	wg.Add(1)
 
-	r.subscribe(topic, r.noopValidator, func(_ context.Context, msg proto.Message) error {
+	r.subscribe(context.Background(), topic, [4]byte{}, r.noopValidator, func(_ context.Context, msg proto.Message) error {
 		m := msg.(*pb.SignedVoluntaryExit)
 		if m.Exit == nil || m.Exit.Epoch != 55 {
 			t.Errorf("Unexpected incoming message: %+v", m)
@@ -45,7 +47,7 @@ func TestSubscribe_ReceivesValidMessage(t *testing.T) {
 	})
 	r.chainStarted = true
 
-	p2p.ReceivePubSub(topic, &pb.SignedVoluntaryExit{Exit: &pb.VoluntaryExit{Epoch: 55}})
+	p2p.ReceivePubSub(fmt.Sprintf(topic, [4]byte{}), &pb.SignedVoluntaryExit{Exit: &pb.VoluntaryExit{Epoch: 55}})
 
 	if testutil.WaitTimeout(&wg, time.Second) {
 		t.Fatal("Did not receive PubSub in 1 second")
@@ -66,11 +68,11 @@ func TestSubscribe_ReceivesAttesterSlashing(t *testing.T) {
 		chain:        chainService,
 		db:           d,
 	}
-	topic := "/eth2/attester_slashing"
+	topic := "/eth2/%x/attester_slashing"
 	var wg sync.WaitGroup
 	wg.Add(1)
 	params.OverrideBeaconConfig(params.MinimalSpecConfig())
-	r.subscribe(topic, r.noopValidator, func(ctx context.Context, msg proto.Message) error {
+	r.subscribe(context.Background(), topic, [4]byte{}, r.noopValidator, func(ctx context.Context, msg proto.Message) error {
 		r.attesterSlashingSubscriber(ctx, msg)
 		wg.Done()
 		return nil
@@ -87,7 +89,7 @@ func TestSubscribe_ReceivesAttesterSlashing(t *testing.T) {
 		t.Fatalf("Error generating attester slashing")
 	}
 	r.db.SaveState(ctx, beaconState, bytesutil.ToBytes32(attesterSlashing.Attestation_1.Data.BeaconBlockRoot))
-	p2p.ReceivePubSub(topic, attesterSlashing)
+	p2p.ReceivePubSub(fmt.Sprintf(topic, [4]byte{}), attesterSlashing)
 
 	if testutil.WaitTimeout(&wg, time.Second) {
 		t.Fatal("Did not receive PubSub in 1 second")
@@ -112,11 +114,11 @@ func TestSubscribe_ReceivesProposerSlashing(t *testing.T) {
 		chain:        chainService,
 		db:           d,
 	}
-	topic := "/eth2/proposer_slashing"
+	topic := "/eth2/%x/proposer_slashing"
 	var wg sync.WaitGroup
 	wg.Add(1)
 	params.OverrideBeaconConfig(params.MinimalSpecConfig())
-	r.subscribe(topic, r.noopValidator, func(ctx context.Context, msg proto.Message) error {
+	r.subscribe(context.Background(), topic, [4]byte{}, r.noopValidator, func(ctx context.Context, msg proto.Message) error {
 		r.proposerSlashingSubscriber(ctx, msg)
 		wg.Done()
 		return nil
@@ -134,7 +136,7 @@ func TestSubscribe_ReceivesProposerSlashing(t *testing.T) {
 	}
 	root, err := ssz.HashTreeRoot(proposerSlashing.Header_1.Header)
 	r.db.SaveState(ctx, beaconState, root)
-	p2p.ReceivePubSub(topic, proposerSlashing)
+	p2p.ReceivePubSub(fmt.Sprintf(topic, [4]byte{}), proposerSlashing)
 
 	if testutil.WaitTimeout(&wg, time.Second) {
 		t.Fatal("Did not receive PubSub in 1 second")
@@ -146,22 +148,25 @@ func TestSubscribe_ReceivesProposerSlashing(t *testing.T) {
 }
 
 func TestSubscribe_WaitToSync(t *testing.T) {
-	p2p := p2ptest.NewTestP2P(t)
-	chainService := &mockChain.ChainService{}
+	p2pService := p2ptest.NewTestP2P(t)
+	genesisTime := time.Now()
+	chainService := &mockChain.ChainService{Genesis: genesisTime}
 	r := Service{
 		ctx:           context.Background(),
-		p2p:           p2p,
+		p2p:           p2pService,
 		chain:         chainService,
 		stateNotifier: chainService.StateNotifier(),
 		initialSync:   &mockSync.Sync{IsSyncing: false},
 	}
 
-	topic := "/eth2/beacon_block"
+	topic := "/eth2/%x/beacon_block"
 	r.registerSubscribers()
+	genesisValidatorsRoot := make([]byte, 32)
 	i := r.stateNotifier.StateFeed().Send(&feed.Event{
 		Type: statefeed.Initialized,
 		Data: &statefeed.InitializedData{
-			StartTime: time.Now(),
+			StartTime:             genesisTime,
+			GenesisValidatorsRoot: genesisValidatorsRoot,
 		},
 	})
 	if i == 0 {
@@ -180,7 +185,11 @@ func TestSubscribe_WaitToSync(t *testing.T) {
 		},
 		Signature: sk.Sign([]byte("data"), 0).Marshal(),
 	}
-	p2p.ReceivePubSub(topic, msg)
+	digest, err := p2p.CreateForkDigest(genesisTime, genesisValidatorsRoot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p2pService.ReceivePubSub(fmt.Sprintf(topic, digest), msg)
 	// wait for chainstart to be sent
 	time.Sleep(400 * time.Millisecond)
 	if !r.chainStarted {
@@ -200,14 +209,68 @@ func TestSubscribe_HandlesPanic(t *testing.T) {
 	var wg sync.WaitGroup
 	wg.Add(1)
 
-	r.subscribe(topic, r.noopValidator, func(_ context.Context, msg proto.Message) error {
+	r.subscribe(context.Background(), topic, [4]byte{}, r.noopValidator, func(_ context.Context, msg proto.Message) error {
 		defer wg.Done()
 		panic("bad")
 	})
 	r.chainStarted = true
-	p.ReceivePubSub(topic, &pb.SignedVoluntaryExit{Exit: &pb.VoluntaryExit{Epoch: 55}})
+	p.ReceivePubSub(fmt.Sprintf(topic, [4]byte{}), &pb.SignedVoluntaryExit{Exit: &pb.VoluntaryExit{Epoch: 55}})
 
 	if testutil.WaitTimeout(&wg, time.Second) {
 		t.Fatal("Did not receive PubSub in 1 second")
 	}
 }
+
+// subnetSearchP2P counts the searches for subnet peers, which block until released.
+type subnetSearchP2P struct {
+	*p2ptest.TestP2P
+	searches uint64
+	release  chan struct{}
+}
+
+func (p *subnetSearchP2P) FindPeersWithSubnet(index uint64) (bool, error) {
+	atomic.AddUint64(&p.searches, 1)
+	<-p.release
+	return true, nil
+}
+
+func TestFindPeersForSubnets_DedupesInFlightSearches(t *testing.T) {
+	p := &subnetSearchP2P{TestP2P: p2ptest.NewTestP2P(t), release: make(chan struct{})}
+	r := Service{
+		ctx:            context.Background(),
+		p2p:            p,
+		subnetSearches: make(map[uint64]bool),
+	}
+	topic := "/eth2/%x/committee_index%d_beacon_attestation"
+	waitForSearches := func(want uint64) {
+		deadline := time.Now().Add(time.Second)
+		for atomic.LoadUint64(&p.searches) != want {
+			if time.Now().After(deadline) {
+				t.Fatalf("Wanted %d searches, received %d", want, atomic.LoadUint64(&p.searches))
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+
+	// The searches of the first slot are still in flight at the next slot.
+	r.findPeersForSubnets(topic, [4]byte{}, []uint64{1, 2})
+	r.findPeersForSubnets(topic, [4]byte{}, []uint64{1, 2})
+	waitForSearches(2)
+
+	close(p.release)
+	deadline := time.Now().Add(time.Second)
+	for {
+		r.subnetSearchLock.Lock()
+		inFlight := len(r.subnetSearches)
+		r.subnetSearchLock.Unlock()
+		if inFlight == 0 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("Wanted the searches to end, %d still in flight", inFlight)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	r.findPeersForSubnets(topic, [4]byte{}, []uint64{1, 2})
+	waitForSearches(4)
+}
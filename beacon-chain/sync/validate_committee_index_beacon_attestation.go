@@ -9,11 +9,11 @@ import (
 	"github.com/libp2p/go-libp2p-core/peer"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
-	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/slotutil"
 	"github.com/prysmaticlabs/prysm/shared/traceutil"
 	"go.opencensus.io/trace"
 )
@@ -55,8 +55,14 @@ func (s *Service) validateCommitteeIndexBeaconAttestation(ctx context.Context, p
 		return false
 	}
 
+	digest, err := s.forkDigest()
+	if err != nil {
+		log.WithError(err).Error("Failed to compute fork digest")
+		traceutil.AnnotateError(span, err)
+		return false
+	}
 	// The attestation's committee index (attestation.data.index) is for the correct subnet.
-	if !strings.HasPrefix(originalTopic, fmt.Sprintf(format, att.Data.CommitteeIndex)) {
+	if !strings.HasPrefix(originalTopic, fmt.Sprintf(format, digest, att.Data.CommitteeIndex)) {
 		return false
 	}
 
@@ -66,7 +72,7 @@ func (s *Service) validateCommitteeIndexBeaconAttestation(ctx context.Context, p
 	}
 
 	// Attestation's slot is within ATTESTATION_PROPAGATION_SLOT_RANGE.
-	currentSlot := helpers.SlotsSince(s.chain.GenesisTime())
+	currentSlot := uint64(slotutil.ClockOrDefault(s.clock).Now().Unix()-s.chain.GenesisTime().Unix()) / params.BeaconConfig().SecondsPerSlot
 	upper := att.Data.Slot + params.BeaconConfig().AttestationPropagationSlotRange
 	lower := att.Data.Slot
 	if currentSlot > upper || currentSlot < lower {
@@ -3,6 +3,7 @@ package sync
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
@@ -57,6 +58,11 @@ func TestService_validateCommitteeIndexBeaconAttestation(t *testing.T) {
 	savedState, _ := beaconstate.InitializeFromProto(&pb.BeaconState{})
 	db.SaveState(context.Background(), savedState, validBlockRoot)
 
+	digest, err := s.forkDigest()
+	if err != nil {
+		t.Fatal(err)
+	}
+
 	tests := []struct {
 		name                      string
 		msg                       *ethpb.Attestation
@@ -74,7 +80,7 @@ func TestService_validateCommitteeIndexBeaconAttestation(t *testing.T) {
 					Slot:            63,
 				},
 			},
-			topic:                     "/eth2/committee_index1_beacon_attestation",
+			topic:                     fmt.Sprintf("/eth2/%x/committee_index1_beacon_attestation", digest),
 			validAttestationSignature: true,
 			want:                      true,
 		},
@@ -88,7 +94,7 @@ func TestService_validateCommitteeIndexBeaconAttestation(t *testing.T) {
 					Slot:            63,
 				},
 			},
-			topic:                     "/eth2/committee_index3_beacon_attestation",
+			topic:                     fmt.Sprintf("/eth2/%x/committee_index3_beacon_attestation", digest),
 			validAttestationSignature: true,
 			want:                      false,
 		},
@@ -102,7 +108,7 @@ func TestService_validateCommitteeIndexBeaconAttestation(t *testing.T) {
 					Slot:            63,
 				},
 			},
-			topic:                     "/eth2/committee_index1_beacon_attestation",
+			topic:                     fmt.Sprintf("/eth2/%x/committee_index1_beacon_attestation", digest),
 			validAttestationSignature: true,
 			want:                      false,
 		},
@@ -116,7 +122,7 @@ func TestService_validateCommitteeIndexBeaconAttestation(t *testing.T) {
 					Slot:            63,
 				},
 			},
-			topic:                     "/eth2/committee_index1_beacon_attestation",
+			topic:                     fmt.Sprintf("/eth2/%x/committee_index1_beacon_attestation", digest),
 			validAttestationSignature: true,
 			want:                      false,
 		},
@@ -130,7 +136,7 @@ func TestService_validateCommitteeIndexBeaconAttestation(t *testing.T) {
 					Slot:            63,
 				},
 			},
-			topic:                     "/eth2/committee_index1_beacon_attestation",
+			topic:                     fmt.Sprintf("/eth2/%x/committee_index1_beacon_attestation", digest),
 			validAttestationSignature: false,
 			want:                      false,
 		},
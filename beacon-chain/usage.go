@@ -61,6 +61,8 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.ForceClearDB,
 			cmd.ClearDB,
 			cmd.ConfigFileFlag,
+			cmd.ChainConfigFileFlag,
+			cmd.SlotTimingFractionsFlag,
 		},
 	},
 	{
@@ -89,9 +91,18 @@ var appHelpFlagGroups = []flagGroup{
 			flags.KeyFlag,
 			flags.GRPCGatewayPort,
 			flags.HTTPWeb3ProviderFlag,
+			flags.FallbackWeb3ProviderFlag,
+			flags.FallbackHTTPWeb3ProviderFlag,
+			flags.Eth1ChainIDFlag,
 			flags.SetGCPercent,
 			flags.UnsafeSync,
 			flags.SlotsPerArchivedPoint,
+			flags.HistoricalStateQueryEpochs,
+			flags.BlockBodyProviderFlag,
+			flags.BlockBodyProviderTimeoutFlag,
+			flags.EnableLightClientServer,
+			flags.LightClientSyncCommitteeFlag,
+			flags.LightClientSigningKeyFlag,
 			flags.EnableDiscv5,
 		},
 	},
@@ -108,6 +119,10 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.EnableUPnPFlag,
 			cmd.P2PEncoding,
 			flags.MinSyncPeers,
+			flags.BlockBatchLimit,
+			flags.BlockBatchLimitBurstFactor,
+			flags.RPCRequestLimit,
+			flags.RPCGlobalLimitFactor,
 		},
 	},
 	{
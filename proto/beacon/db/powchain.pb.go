@@ -30,6 +30,7 @@ type ETH1ChainData struct {
 	BeaconState          *v1.BeaconState     `protobuf:"bytes,3,opt,name=beacon_state,json=beaconState,proto3" json:"beacon_state,omitempty"`
 	Trie                 *SparseMerkleTrie   `protobuf:"bytes,4,opt,name=trie,proto3" json:"trie,omitempty"`
 	DepositContainers    []*DepositContainer `protobuf:"bytes,5,rep,name=deposit_containers,json=depositContainers,proto3" json:"deposit_containers,omitempty"`
+	Eth1Blocks           []*ETH1BlockInfo    `protobuf:"bytes,6,rep,name=eth1_blocks,json=eth1Blocks,proto3" json:"eth1_blocks,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
 	XXX_unrecognized     []byte              `json:"-"`
 	XXX_sizecache        int32               `json:"-"`
@@ -103,6 +104,13 @@ func (m *ETH1ChainData) GetDepositContainers() []*DepositContainer {
 	return nil
 }
 
+func (m *ETH1ChainData) GetEth1Blocks() []*ETH1BlockInfo {
+	if m != nil {
+		return m.Eth1Blocks
+	}
+	return nil
+}
+
 type LatestETH1Data struct {
 	BlockHeight          uint64   `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
 	BlockTime            uint64   `protobuf:"varint,3,opt,name=block_time,json=blockTime,proto3" json:"block_time,omitempty"`
@@ -434,6 +442,93 @@ func (m *DepositContainer) GetDepositRoot() []byte {
 	return nil
 }
 
+type ETH1BlockInfo struct {
+	Number               uint64   `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	Hash                 []byte   `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	ParentHash           []byte   `protobuf:"bytes,3,opt,name=parent_hash,json=parentHash,proto3" json:"parent_hash,omitempty"`
+	Timestamp            uint64   `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	DepositCount         uint64   `protobuf:"varint,5,opt,name=deposit_count,json=depositCount,proto3" json:"deposit_count,omitempty"`
+	DepositRoot          []byte   `protobuf:"bytes,6,opt,name=deposit_root,json=depositRoot,proto3" json:"deposit_root,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ETH1BlockInfo) Reset()         { *m = ETH1BlockInfo{} }
+func (m *ETH1BlockInfo) String() string { return proto.CompactTextString(m) }
+func (*ETH1BlockInfo) ProtoMessage()    {}
+func (*ETH1BlockInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_338787f8da2f3d61, []int{6}
+}
+func (m *ETH1BlockInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ETH1BlockInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ETH1BlockInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ETH1BlockInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ETH1BlockInfo.Merge(m, src)
+}
+func (m *ETH1BlockInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ETH1BlockInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ETH1BlockInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ETH1BlockInfo proto.InternalMessageInfo
+
+func (m *ETH1BlockInfo) GetNumber() uint64 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+func (m *ETH1BlockInfo) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *ETH1BlockInfo) GetParentHash() []byte {
+	if m != nil {
+		return m.ParentHash
+	}
+	return nil
+}
+
+func (m *ETH1BlockInfo) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *ETH1BlockInfo) GetDepositCount() uint64 {
+	if m != nil {
+		return m.DepositCount
+	}
+	return 0
+}
+
+func (m *ETH1BlockInfo) GetDepositRoot() []byte {
+	if m != nil {
+		return m.DepositRoot
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ETH1ChainData)(nil), "prysm.beacon.db.ETH1ChainData")
 	proto.RegisterType((*LatestETH1Data)(nil), "prysm.beacon.db.LatestETH1Data")
@@ -441,54 +536,62 @@ func init() {
 	proto.RegisterType((*SparseMerkleTrie)(nil), "prysm.beacon.db.SparseMerkleTrie")
 	proto.RegisterType((*TrieLayer)(nil), "prysm.beacon.db.TrieLayer")
 	proto.RegisterType((*DepositContainer)(nil), "prysm.beacon.db.DepositContainer")
+	proto.RegisterType((*ETH1BlockInfo)(nil), "prysm.beacon.db.ETH1BlockInfo")
 }
 
 func init() { proto.RegisterFile("proto/beacon/db/powchain.proto", fileDescriptor_338787f8da2f3d61) }
 
 var fileDescriptor_338787f8da2f3d61 = []byte{
-	// 666 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0xcf, 0x4e, 0xdb, 0x4e,
-	0x10, 0x96, 0x93, 0xc0, 0x0f, 0x36, 0x21, 0x81, 0xfd, 0x71, 0x88, 0x90, 0x1a, 0x20, 0xa8, 0x12,
-	0xea, 0xc1, 0x6e, 0x52, 0x55, 0xea, 0x81, 0x53, 0x80, 0x2a, 0x55, 0xa9, 0x5a, 0x2d, 0x9c, 0x7a,
-	0x89, 0xd6, 0xf1, 0x28, 0x5e, 0xe1, 0xd8, 0xae, 0x77, 0x42, 0xcb, 0xb9, 0xc7, 0x3e, 0x46, 0x9f,
-	0xa0, 0x6f, 0xd1, 0x63, 0x1f, 0xa1, 0xe2, 0x25, 0x7a, 0xad, 0x76, 0x76, 0x4d, 0x48, 0x00, 0xf5,
-	0x38, 0x33, 0xdf, 0xf7, 0xed, 0xfc, 0xf9, 0x6c, 0xd6, 0xc9, 0x8b, 0x0c, 0xb3, 0x20, 0x04, 0x39,
-	0xce, 0xd2, 0x20, 0x0a, 0x83, 0x3c, 0xfb, 0x3c, 0x8e, 0xa5, 0x4a, 0x7d, 0x2a, 0xf0, 0x56, 0x5e,
-	0x5c, 0xeb, 0xa9, 0x6f, 0xeb, 0x7e, 0x14, 0xee, 0xec, 0x02, 0xc6, 0xc1, 0x55, 0x4f, 0x26, 0x79,
-	0x2c, 0x7b, 0x8e, 0x37, 0x0a, 0x93, 0x6c, 0x7c, 0x69, 0x19, 0x3b, 0xbb, 0x0b, 0x8a, 0x79, 0x3f,
-	0x0f, 0xae, 0x7a, 0x01, 0x5e, 0xe7, 0xa0, 0x2d, 0xa0, 0xfb, 0xa7, 0xc2, 0x36, 0x4e, 0x2f, 0x86,
-	0xbd, 0x63, 0xf3, 0xcc, 0x89, 0x44, 0xc9, 0xdf, 0xb2, 0xad, 0xf1, 0xac, 0x28, 0x20, 0xc5, 0x11,
-	0x60, 0xdc, 0x1b, 0x45, 0x12, 0x65, 0xdb, 0xdb, 0xf3, 0x0e, 0xeb, 0xfd, 0x5d, 0x7f, 0xa9, 0x01,
-	0xff, 0x4c, 0x22, 0x68, 0x34, 0x02, 0x86, 0x2b, 0x5a, 0x8e, 0x79, 0x8a, 0x31, 0x25, 0xf8, 0x90,
-	0xb5, 0x68, 0x00, 0x8d, 0xb2, 0x40, 0x2b, 0x55, 0x79, 0x44, 0x8a, 0x3a, 0x38, 0x37, 0x38, 0x92,
-	0x6a, 0xce, 0x79, 0xa4, 0xf4, 0x9a, 0x35, 0xdc, 0x7c, 0x1a, 0x25, 0x42, 0xbb, 0x4a, 0x32, 0x07,
-	0x3e, 0x60, 0x0c, 0x05, 0xcc, 0x6e, 0x95, 0xf2, 0x7e, 0xee, 0x5f, 0xf5, 0xfc, 0x01, 0x45, 0xe7,
-	0x06, 0x2a, 0xea, 0xe1, 0x3c, 0xe0, 0x2f, 0x59, 0x0d, 0x0b, 0x05, 0xed, 0x1a, 0xf1, 0xf7, 0xef,
-	0xb5, 0x71, 0x9e, 0xcb, 0x42, 0xc3, 0x3b, 0x28, 0x2e, 0x13, 0xb8, 0x28, 0x14, 0x08, 0x82, 0xf3,
-	0x0f, 0x8c, 0x47, 0x90, 0x67, 0x5a, 0xe1, 0x68, 0x9c, 0xa5, 0x28, 0x55, 0x0a, 0x85, 0x6e, 0xaf,
-	0xec, 0x55, 0x1f, 0x14, 0x39, 0xb1, 0xd0, 0xe3, 0x12, 0x29, 0xb6, 0xa2, 0xa5, 0x8c, 0xee, 0x7e,
-	0xf7, 0x58, 0x73, 0x71, 0x7d, 0x7c, 0x9f, 0x35, 0xe8, 0x78, 0xa3, 0x18, 0xd4, 0x24, 0x46, 0x5a,
-	0x55, 0x4d, 0xd4, 0x29, 0x37, 0xa4, 0x14, 0x7f, 0xc2, 0x98, 0x85, 0xa0, 0x9a, 0xda, 0x25, 0xd4,
-	0xc4, 0x3a, 0x65, 0x2e, 0xd4, 0x14, 0xe6, 0xe5, 0x58, 0xea, 0x98, 0x66, 0x6c, 0xb8, 0xf2, 0x50,
-	0xea, 0x98, 0x3f, 0x67, 0xdb, 0x89, 0xd4, 0x38, 0x2a, 0xe0, 0xd3, 0x0c, 0x34, 0x42, 0x64, 0xcd,
-	0xd2, 0x5e, 0x21, 0x1d, 0x6e, 0x6a, 0xa2, 0x2c, 0x0d, 0x4c, 0xa5, 0xfb, 0xad, 0xc2, 0x9a, 0x8b,
-	0x97, 0xe1, 0x5d, 0xd6, 0x98, 0xdf, 0x06, 0x22, 0xf2, 0xc6, 0x9a, 0x58, 0xc8, 0x99, 0x49, 0x26,
-	0x90, 0x82, 0x56, 0xda, 0x36, 0xea, 0x26, 0x71, 0x39, 0x6a, 0xf5, 0x80, 0x6d, 0x94, 0x10, 0xdb,
-	0x84, 0x1d, 0xa6, 0xe4, 0xd1, 0xf3, 0xfc, 0x88, 0xad, 0xcf, 0x4d, 0x58, 0x73, 0xce, 0xb9, 0x3d,
-	0x39, 0x60, 0xec, 0x97, 0xee, 0xf7, 0x4b, 0xcf, 0x89, 0x35, 0x28, 0xdd, 0xf7, 0x9e, 0xfd, 0x7f,
-	0xd7, 0x7d, 0xf6, 0x04, 0xe5, 0xd5, 0x3a, 0x8f, 0xe8, 0xb8, 0xdb, 0x09, 0x7e, 0xc7, 0x80, 0x8e,
-	0xd9, 0xfd, 0xea, 0xb1, 0xcd, 0x65, 0x83, 0xf0, 0x6d, 0xb6, 0x12, 0x41, 0x8e, 0x31, 0x2d, 0xa2,
-	0x26, 0x6c, 0xc0, 0xfb, 0x6c, 0x35, 0x91, 0xd7, 0xc6, 0x24, 0x15, 0x7a, 0x6e, 0xe7, 0x9e, 0x49,
-	0x0c, 0xf9, 0xcc, 0x40, 0x84, 0x43, 0xf2, 0xa7, 0xac, 0x99, 0x15, 0x6a, 0xa2, 0x52, 0x99, 0x8c,
-	0x14, 0xc2, 0x54, 0xb7, 0xab, 0x7b, 0xd5, 0xc3, 0x86, 0xd8, 0x28, 0xb3, 0x6f, 0x4c, 0xb2, 0xbb,
-	0xcf, 0xd6, 0x6f, 0xb9, 0xe6, 0x75, 0x62, 0xb7, 0x3d, 0x82, 0xda, 0xa0, 0xfb, 0xc3, 0x63, 0x9b,
-	0xcb, 0x26, 0x34, 0x50, 0x95, 0x46, 0xf0, 0x85, 0x1a, 0xad, 0x0a, 0x1b, 0xf0, 0x67, 0x6c, 0x8b,
-	0x56, 0xfc, 0x80, 0xf3, 0x5a, 0xa6, 0x30, 0xb8, 0xe3, 0xbe, 0x57, 0xec, 0x3f, 0xb7, 0x45, 0xf7,
-	0xfd, 0xfd, 0x6b, 0x89, 0x25, 0xdc, 0x18, 0xa2, 0xfc, 0x7e, 0x8a, 0x2c, 0x43, 0x67, 0xcd, 0xba,
-	0xcb, 0x89, 0x2c, 0xc3, 0xc1, 0xd1, 0xcf, 0x9b, 0x8e, 0xf7, 0xeb, 0xa6, 0xe3, 0xfd, 0xbe, 0xe9,
-	0x78, 0x1f, 0xfd, 0x89, 0xc2, 0x78, 0x16, 0xfa, 0xe3, 0x6c, 0x1a, 0xd0, 0xe6, 0x24, 0xaa, 0x71,
-	0x22, 0x43, 0x6d, 0xa3, 0x60, 0xe9, 0x57, 0x19, 0xae, 0x52, 0xe2, 0xc5, 0xdf, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x45, 0x35, 0x8c, 0x86, 0x44, 0x05, 0x00, 0x00,
+	// 770 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x55, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x06, 0x25, 0x5a, 0xb5, 0x46, 0xb2, 0x64, 0x6f, 0x8d, 0x82, 0x30, 0x5a, 0xc9, 0xa6, 0x51,
+	0xc0, 0xe8, 0x81, 0xac, 0x54, 0x14, 0xe8, 0xc1, 0x40, 0x01, 0xff, 0x04, 0x32, 0xe2, 0x20, 0xc1,
+	0xda, 0xa7, 0x5c, 0x88, 0xa5, 0xb8, 0x11, 0x09, 0x4b, 0x24, 0xc3, 0x5d, 0x39, 0xf1, 0x39, 0xc7,
+	0x3c, 0x46, 0x9e, 0x20, 0xb7, 0x9c, 0x73, 0xca, 0x31, 0x8f, 0x10, 0xf8, 0x49, 0x82, 0x9d, 0x5d,
+	0x8a, 0x96, 0x14, 0x23, 0x37, 0xce, 0xcc, 0x37, 0xdf, 0xce, 0xcf, 0xb7, 0x4b, 0xe8, 0xe5, 0x45,
+	0x26, 0x33, 0x3f, 0xe4, 0x6c, 0x9c, 0xa5, 0x7e, 0x14, 0xfa, 0x79, 0xf6, 0x66, 0x1c, 0xb3, 0x24,
+	0xf5, 0x30, 0x40, 0xba, 0x79, 0x71, 0x27, 0x66, 0x9e, 0x8e, 0x7b, 0x51, 0xb8, 0xd7, 0xe7, 0x32,
+	0xf6, 0x6f, 0x07, 0x6c, 0x9a, 0xc7, 0x6c, 0x60, 0xf2, 0x82, 0x70, 0x9a, 0x8d, 0x6f, 0x74, 0xc6,
+	0x5e, 0x7f, 0x89, 0x31, 0x1f, 0xe6, 0xfe, 0xed, 0xc0, 0x97, 0x77, 0x39, 0x17, 0x1a, 0xe0, 0x7e,
+	0xaa, 0xc3, 0xd6, 0xf9, 0xf5, 0x68, 0x70, 0xaa, 0x8e, 0x39, 0x63, 0x92, 0x91, 0xa7, 0xb0, 0x33,
+	0x9e, 0x17, 0x05, 0x4f, 0x65, 0xc0, 0x65, 0x3c, 0x08, 0x22, 0x26, 0x99, 0x63, 0xed, 0x5b, 0x47,
+	0xad, 0x61, 0xdf, 0x5b, 0x29, 0xc0, 0xbb, 0x64, 0x92, 0x0b, 0xa9, 0x08, 0x54, 0x2e, 0xed, 0x9a,
+	0xcc, 0x73, 0x19, 0xa3, 0x83, 0x8c, 0xa0, 0x8b, 0x0d, 0x08, 0xc9, 0x0a, 0xa9, 0xa9, 0x6a, 0x8f,
+	0x50, 0x61, 0x05, 0x57, 0x0a, 0x87, 0x54, 0x9d, 0x2a, 0x0f, 0x99, 0x9e, 0x40, 0xdb, 0xf4, 0x27,
+	0x24, 0x93, 0xdc, 0xa9, 0x23, 0xcd, 0xa1, 0xc7, 0x65, 0xcc, 0x0b, 0x3e, 0x5f, 0x30, 0xe5, 0xc3,
+	0xdc, 0xbb, 0x1d, 0x78, 0x27, 0x68, 0x5d, 0x29, 0x28, 0x6d, 0x85, 0x95, 0x41, 0xfe, 0x05, 0x5b,
+	0x16, 0x09, 0x77, 0x6c, 0xcc, 0x3f, 0x58, 0x2b, 0xe3, 0x2a, 0x67, 0x85, 0xe0, 0xcf, 0x78, 0x71,
+	0x33, 0xe5, 0xd7, 0x45, 0xc2, 0x29, 0xc2, 0xc9, 0x0b, 0x20, 0x11, 0xcf, 0x33, 0x91, 0xc8, 0x60,
+	0x9c, 0xa5, 0x92, 0x25, 0x29, 0x2f, 0x84, 0xb3, 0xb1, 0x5f, 0xff, 0x21, 0xc9, 0x99, 0x86, 0x9e,
+	0x96, 0x48, 0xba, 0x13, 0xad, 0x78, 0x04, 0xf9, 0x1f, 0x5a, 0x38, 0x5f, 0x5c, 0x97, 0x70, 0x1a,
+	0x48, 0xd5, 0x5b, 0xa3, 0x52, 0xb3, 0x3d, 0x51, 0x90, 0x8b, 0xf4, 0x55, 0x46, 0x41, 0xa5, 0xa0,
+	0x29, 0xdc, 0x0f, 0x16, 0x74, 0x96, 0xe7, 0x4f, 0x0e, 0xa0, 0x8d, 0x74, 0x41, 0xcc, 0x93, 0x49,
+	0x2c, 0x71, 0xd6, 0x36, 0x6d, 0xa1, 0x6f, 0x84, 0x2e, 0xf2, 0x07, 0x80, 0x86, 0xc8, 0x64, 0xa6,
+	0xa7, 0x68, 0xd3, 0x26, 0x7a, 0xae, 0x93, 0x19, 0xaf, 0xc2, 0x31, 0x13, 0x31, 0x0e, 0xa9, 0x6d,
+	0xc2, 0x23, 0x26, 0x62, 0xf2, 0x37, 0xec, 0x4e, 0x99, 0x90, 0x41, 0xc1, 0x5f, 0xcf, 0xb9, 0x90,
+	0x3c, 0xd2, 0xe5, 0x3b, 0x1b, 0xc8, 0x43, 0x54, 0x8c, 0x96, 0x21, 0x2c, 0xd3, 0x7d, 0x5f, 0x83,
+	0xce, 0xf2, 0x6a, 0x89, 0x0b, 0xed, 0x6a, 0xb9, 0x3c, 0x42, 0x71, 0x6d, 0xd2, 0x25, 0x9f, 0xea,
+	0x64, 0xc2, 0x53, 0x2e, 0x12, 0xa1, 0x0b, 0x35, 0x9d, 0x18, 0x1f, 0x96, 0x7a, 0x08, 0x5b, 0x25,
+	0x44, 0x17, 0xa1, 0x9b, 0x29, 0xf3, 0xf0, 0x78, 0x72, 0x0c, 0xcd, 0x4a, 0xc5, 0xb6, 0x91, 0xde,
+	0x42, 0x33, 0x5c, 0xc6, 0x5e, 0x79, 0x7d, 0xbc, 0x52, 0xb4, 0x74, 0x93, 0x9b, 0x2f, 0xf2, 0x1c,
+	0x7e, 0x7d, 0x28, 0x5f, 0xbd, 0xc3, 0x72, 0xed, 0xbd, 0x47, 0x78, 0xcc, 0xf2, 0x29, 0x79, 0xa0,
+	0x60, 0x93, 0xe9, 0xbe, 0xb3, 0x60, 0x7b, 0x55, 0x61, 0x64, 0x17, 0x36, 0x22, 0x9e, 0xcb, 0x18,
+	0x07, 0x61, 0x53, 0x6d, 0x90, 0x21, 0x34, 0xa6, 0xec, 0x4e, 0xa9, 0xac, 0x86, 0xc7, 0xed, 0xad,
+	0x49, 0x43, 0x25, 0x5f, 0x2a, 0x08, 0x35, 0x48, 0xf2, 0x27, 0x74, 0xb2, 0x22, 0x99, 0x24, 0x29,
+	0x9b, 0x06, 0x89, 0xe4, 0x33, 0xe1, 0xd4, 0xf7, 0xeb, 0x47, 0x6d, 0xba, 0x55, 0x7a, 0x2f, 0x94,
+	0xd3, 0x3d, 0x80, 0xe6, 0x22, 0x57, 0x9d, 0x8e, 0xd9, 0x8e, 0x85, 0x50, 0x6d, 0xb8, 0x1f, 0x2d,
+	0xd8, 0x5e, 0x55, 0xb1, 0x82, 0x26, 0x69, 0xc4, 0xdf, 0x62, 0xa1, 0x75, 0xaa, 0x0d, 0xf2, 0x17,
+	0xec, 0x54, 0x42, 0x5e, 0x56, 0x5e, 0x77, 0x21, 0x57, 0xa3, 0xbe, 0xff, 0xe0, 0x17, 0x33, 0x45,
+	0x73, 0x81, 0x7f, 0x36, 0xc4, 0x12, 0xae, 0x04, 0x61, 0x3e, 0x83, 0x22, 0xcb, 0xa4, 0x91, 0x66,
+	0xcb, 0xf8, 0x68, 0x96, 0x49, 0xf7, 0xb3, 0xa5, 0xdf, 0xb2, 0xc5, 0x75, 0x21, 0xbf, 0x41, 0x23,
+	0x9d, 0xcf, 0x42, 0x6c, 0x4e, 0xd5, 0x63, 0x2c, 0x42, 0xc0, 0x46, 0x7d, 0xd7, 0x90, 0x04, 0xbf,
+	0x49, 0x1f, 0x5a, 0x39, 0xc3, 0x67, 0x0f, 0x43, 0x75, 0x0c, 0x81, 0x76, 0xa1, 0xf6, 0x7f, 0x87,
+	0xa6, 0x92, 0xa2, 0x90, 0x6c, 0x96, 0xe3, 0xf1, 0x36, 0xad, 0x1c, 0x4a, 0x8d, 0xd5, 0x03, 0x31,
+	0x4f, 0xa5, 0xb9, 0x12, 0xed, 0xc5, 0xc5, 0x9f, 0xa7, 0xeb, 0x4d, 0x34, 0xd6, 0x9a, 0x38, 0x39,
+	0xfe, 0x72, 0xdf, 0xb3, 0xbe, 0xde, 0xf7, 0xac, 0x6f, 0xf7, 0x3d, 0xeb, 0xa5, 0x37, 0x49, 0x64,
+	0x3c, 0x0f, 0xbd, 0x71, 0x36, 0xf3, 0x71, 0xfd, 0x4c, 0x26, 0xe3, 0x29, 0x0b, 0x85, 0xb6, 0xfc,
+	0x95, 0x1f, 0x46, 0xd8, 0x40, 0xc7, 0x3f, 0xdf, 0x07, 0x00, 0x0a, 0x93, 0xe5, 0x1d, 0x4a, 0x06,
+	0x00, 0x00,
 }
 
 func (m *ETH1ChainData) Marshal() (dAtA []byte, err error) {
@@ -515,6 +618,20 @@ func (m *ETH1ChainData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Eth1Blocks) > 0 {
+		for iNdEx := len(m.Eth1Blocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Eth1Blocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPowchain(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.DepositContainers) > 0 {
 		for iNdEx := len(m.DepositContainers) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -849,6 +966,69 @@ func (m *DepositContainer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ETH1BlockInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ETH1BlockInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ETH1BlockInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.DepositRoot) > 0 {
+		i -= len(m.DepositRoot)
+		copy(dAtA[i:], m.DepositRoot)
+		i = encodeVarintPowchain(dAtA, i, uint64(len(m.DepositRoot)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.DepositCount != 0 {
+		i = encodeVarintPowchain(dAtA, i, uint64(m.DepositCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintPowchain(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ParentHash) > 0 {
+		i -= len(m.ParentHash)
+		copy(dAtA[i:], m.ParentHash)
+		i = encodeVarintPowchain(dAtA, i, uint64(len(m.ParentHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintPowchain(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Number != 0 {
+		i = encodeVarintPowchain(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintPowchain(dAtA []byte, offset int, v uint64) int {
 	offset -= sovPowchain(v)
 	base := offset
@@ -888,6 +1068,12 @@ func (m *ETH1ChainData) Size() (n int) {
 			n += 1 + l + sovPowchain(uint64(l))
 		}
 	}
+	if len(m.Eth1Blocks) > 0 {
+		for _, e := range m.Eth1Blocks {
+			l = e.Size()
+			n += 1 + l + sovPowchain(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1021,6 +1207,39 @@ func (m *DepositContainer) Size() (n int) {
 	return n
 }
 
+func (m *ETH1BlockInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Number != 0 {
+		n += 1 + sovPowchain(uint64(m.Number))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovPowchain(uint64(l))
+	}
+	l = len(m.ParentHash)
+	if l > 0 {
+		n += 1 + l + sovPowchain(uint64(l))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovPowchain(uint64(m.Timestamp))
+	}
+	if m.DepositCount != 0 {
+		n += 1 + sovPowchain(uint64(m.DepositCount))
+	}
+	l = len(m.DepositRoot)
+	if l > 0 {
+		n += 1 + l + sovPowchain(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovPowchain(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1234,6 +1453,40 @@ func (m *ETH1ChainData) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Eth1Blocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPowchain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPowchain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPowchain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Eth1Blocks = append(m.Eth1Blocks, &ETH1BlockInfo{})
+			if err := m.Eth1Blocks[len(m.Eth1Blocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPowchain(dAtA[iNdEx:])
@@ -1973,6 +2226,219 @@ func (m *DepositContainer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ETH1BlockInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPowchain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ETH1BlockInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ETH1BlockInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPowchain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPowchain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPowchain
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPowchain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPowchain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPowchain
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPowchain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParentHash = append(m.ParentHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ParentHash == nil {
+				m.ParentHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPowchain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositCount", wireType)
+			}
+			m.DepositCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPowchain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DepositCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPowchain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPowchain
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPowchain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepositRoot = append(m.DepositRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.DepositRoot == nil {
+				m.DepositRoot = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPowchain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPowchain
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPowchain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipPowchain(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -7,6 +7,8 @@ import (
 	fmt "fmt"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	v1alpha1 "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	github_com_prysmaticlabs_go_bitfield "github.com/prysmaticlabs/go-bitfield"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -24,7 +26,7 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type Status struct {
-	HeadForkVersion      []byte   `protobuf:"bytes,1,opt,name=head_fork_version,json=headForkVersion,proto3" json:"head_fork_version,omitempty" ssz-size:"4"`
+	ForkDigest           []byte   `protobuf:"bytes,1,opt,name=fork_digest,json=forkDigest,proto3" json:"fork_digest,omitempty" ssz-size:"4"`
 	FinalizedRoot        []byte   `protobuf:"bytes,2,opt,name=finalized_root,json=finalizedRoot,proto3" json:"finalized_root,omitempty" ssz-size:"32"`
 	FinalizedEpoch       uint64   `protobuf:"varint,3,opt,name=finalized_epoch,json=finalizedEpoch,proto3" json:"finalized_epoch,omitempty"`
 	HeadRoot             []byte   `protobuf:"bytes,4,opt,name=head_root,json=headRoot,proto3" json:"head_root,omitempty" ssz-size:"32"`
@@ -67,9 +69,9 @@ func (m *Status) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Status proto.InternalMessageInfo
 
-func (m *Status) GetHeadForkVersion() []byte {
+func (m *Status) GetForkDigest() []byte {
 	if m != nil {
-		return m.HeadForkVersion
+		return m.ForkDigest
 	}
 	return nil
 }
@@ -102,6 +104,69 @@ func (m *Status) GetHeadSlot() uint64 {
 	return 0
 }
 
+type ENRForkID struct {
+	CurrentForkDigest    []byte   `protobuf:"bytes,1,opt,name=current_fork_digest,json=currentForkDigest,proto3" json:"current_fork_digest,omitempty" ssz-size:"4"`
+	NextForkVersion      []byte   `protobuf:"bytes,2,opt,name=next_fork_version,json=nextForkVersion,proto3" json:"next_fork_version,omitempty" ssz-size:"4"`
+	NextForkEpoch        uint64   `protobuf:"varint,3,opt,name=next_fork_epoch,json=nextForkEpoch,proto3" json:"next_fork_epoch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ENRForkID) Reset()         { *m = ENRForkID{} }
+func (m *ENRForkID) String() string { return proto.CompactTextString(m) }
+func (*ENRForkID) ProtoMessage()    {}
+func (*ENRForkID) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1d590cda035b632, []int{1}
+}
+func (m *ENRForkID) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ENRForkID) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ENRForkID.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ENRForkID) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ENRForkID.Merge(m, src)
+}
+func (m *ENRForkID) XXX_Size() int {
+	return m.Size()
+}
+func (m *ENRForkID) XXX_DiscardUnknown() {
+	xxx_messageInfo_ENRForkID.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ENRForkID proto.InternalMessageInfo
+
+func (m *ENRForkID) GetCurrentForkDigest() []byte {
+	if m != nil {
+		return m.CurrentForkDigest
+	}
+	return nil
+}
+
+func (m *ENRForkID) GetNextForkVersion() []byte {
+	if m != nil {
+		return m.NextForkVersion
+	}
+	return nil
+}
+
+func (m *ENRForkID) GetNextForkEpoch() uint64 {
+	if m != nil {
+		return m.NextForkEpoch
+	}
+	return 0
+}
+
 type BeaconBlocksByRangeRequest struct {
 	HeadBlockRoot        []byte   `protobuf:"bytes,1,opt,name=head_block_root,json=headBlockRoot,proto3" json:"head_block_root,omitempty" ssz-size:"32"`
 	StartSlot            uint64   `protobuf:"varint,2,opt,name=start_slot,json=startSlot,proto3" json:"start_slot,omitempty"`
@@ -116,7 +181,7 @@ func (m *BeaconBlocksByRangeRequest) Reset()         { *m = BeaconBlocksByRangeR
 func (m *BeaconBlocksByRangeRequest) String() string { return proto.CompactTextString(m) }
 func (*BeaconBlocksByRangeRequest) ProtoMessage()    {}
 func (*BeaconBlocksByRangeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a1d590cda035b632, []int{1}
+	return fileDescriptor_a1d590cda035b632, []int{2}
 }
 func (m *BeaconBlocksByRangeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -173,9 +238,146 @@ func (m *BeaconBlocksByRangeRequest) GetStep() uint64 {
 	return 0
 }
 
+type LightClientUpdateRequest struct {
+	FinalizedSlot        uint64   `protobuf:"varint,1,opt,name=finalized_slot,json=finalizedSlot,proto3" json:"finalized_slot,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LightClientUpdateRequest) Reset()         { *m = LightClientUpdateRequest{} }
+func (m *LightClientUpdateRequest) String() string { return proto.CompactTextString(m) }
+func (*LightClientUpdateRequest) ProtoMessage()    {}
+func (*LightClientUpdateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1d590cda035b632, []int{3}
+}
+func (m *LightClientUpdateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LightClientUpdateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LightClientUpdateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LightClientUpdateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LightClientUpdateRequest.Merge(m, src)
+}
+func (m *LightClientUpdateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *LightClientUpdateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LightClientUpdateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LightClientUpdateRequest proto.InternalMessageInfo
+
+func (m *LightClientUpdateRequest) GetFinalizedSlot() uint64 {
+	if m != nil {
+		return m.FinalizedSlot
+	}
+	return 0
+}
+
+type LightClientUpdate struct {
+	AttestedHeader         *v1alpha1.BeaconBlockHeader                  `protobuf:"bytes,1,opt,name=attested_header,json=attestedHeader,proto3" json:"attested_header,omitempty"`
+	FinalizedHeader        *v1alpha1.BeaconBlockHeader                  `protobuf:"bytes,2,opt,name=finalized_header,json=finalizedHeader,proto3" json:"finalized_header,omitempty"`
+	FinalityBranch         [][]byte                                     `protobuf:"bytes,3,rep,name=finality_branch,json=finalityBranch,proto3" json:"finality_branch,omitempty" ssz-size:"6,32"`
+	AncestryBranch         [][]byte                                     `protobuf:"bytes,4,rep,name=ancestry_branch,json=ancestryBranch,proto3" json:"ancestry_branch,omitempty" ssz-size:"?,32" ssz-max:"64"`
+	SyncCommitteeBits      github_com_prysmaticlabs_go_bitfield.Bitlist `protobuf:"bytes,5,opt,name=sync_committee_bits,json=syncCommitteeBits,proto3,casttype=github.com/prysmaticlabs/go-bitfield.Bitlist" json:"sync_committee_bits,omitempty" ssz-max:"512"`
+	SyncCommitteeSignature []byte                                       `protobuf:"bytes,6,opt,name=sync_committee_signature,json=syncCommitteeSignature,proto3" json:"sync_committee_signature,omitempty" ssz-size:"96"`
+	XXX_NoUnkeyedLiteral   struct{}                                     `json:"-"`
+	XXX_unrecognized       []byte                                       `json:"-"`
+	XXX_sizecache          int32                                        `json:"-"`
+}
+
+func (m *LightClientUpdate) Reset()         { *m = LightClientUpdate{} }
+func (m *LightClientUpdate) String() string { return proto.CompactTextString(m) }
+func (*LightClientUpdate) ProtoMessage()    {}
+func (*LightClientUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1d590cda035b632, []int{4}
+}
+func (m *LightClientUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LightClientUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LightClientUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LightClientUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LightClientUpdate.Merge(m, src)
+}
+func (m *LightClientUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *LightClientUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_LightClientUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LightClientUpdate proto.InternalMessageInfo
+
+func (m *LightClientUpdate) GetAttestedHeader() *v1alpha1.BeaconBlockHeader {
+	if m != nil {
+		return m.AttestedHeader
+	}
+	return nil
+}
+
+func (m *LightClientUpdate) GetFinalizedHeader() *v1alpha1.BeaconBlockHeader {
+	if m != nil {
+		return m.FinalizedHeader
+	}
+	return nil
+}
+
+func (m *LightClientUpdate) GetFinalityBranch() [][]byte {
+	if m != nil {
+		return m.FinalityBranch
+	}
+	return nil
+}
+
+func (m *LightClientUpdate) GetAncestryBranch() [][]byte {
+	if m != nil {
+		return m.AncestryBranch
+	}
+	return nil
+}
+
+func (m *LightClientUpdate) GetSyncCommitteeBits() github_com_prysmaticlabs_go_bitfield.Bitlist {
+	if m != nil {
+		return m.SyncCommitteeBits
+	}
+	return nil
+}
+
+func (m *LightClientUpdate) GetSyncCommitteeSignature() []byte {
+	if m != nil {
+		return m.SyncCommitteeSignature
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Status)(nil), "ethereum.beacon.p2p.v1.Status")
+	proto.RegisterType((*ENRForkID)(nil), "ethereum.beacon.p2p.v1.ENRForkID")
 	proto.RegisterType((*BeaconBlocksByRangeRequest)(nil), "ethereum.beacon.p2p.v1.BeaconBlocksByRangeRequest")
+	proto.RegisterType((*LightClientUpdateRequest)(nil), "ethereum.beacon.p2p.v1.LightClientUpdateRequest")
+	proto.RegisterType((*LightClientUpdate)(nil), "ethereum.beacon.p2p.v1.LightClientUpdate")
 }
 
 func init() {
@@ -183,30 +385,52 @@ func init() {
 }
 
 var fileDescriptor_a1d590cda035b632 = []byte{
-	// 365 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xc1, 0x6e, 0xb2, 0x40,
-	0x10, 0xc7, 0xb3, 0x7e, 0x68, 0x3e, 0x37, 0xfa, 0xf9, 0xb9, 0x69, 0x1a, 0x62, 0x53, 0x35, 0x5c,
-	0xea, 0x45, 0x88, 0xda, 0x43, 0xdb, 0xf4, 0x44, 0xd2, 0x3e, 0x00, 0x26, 0xbd, 0x12, 0xc0, 0x11,
-	0x88, 0xc8, 0x50, 0x76, 0x31, 0xa9, 0x4f, 0xd3, 0xc7, 0xe9, 0xb1, 0x4f, 0x60, 0x1a, 0x1f, 0xc1,
-	0x43, 0xcf, 0x0d, 0x83, 0xa9, 0x27, 0x6f, 0xbb, 0x33, 0xbf, 0xf9, 0xfd, 0x19, 0x96, 0x1b, 0x59,
-	0x8e, 0x0a, 0x2d, 0x1f, 0xbc, 0x00, 0x53, 0x2b, 0x9b, 0x66, 0xd6, 0x66, 0x62, 0xad, 0x41, 0x4a,
-	0x2f, 0x04, 0x69, 0x52, 0x53, 0x5c, 0x82, 0x8a, 0x20, 0x87, 0x62, 0x6d, 0x56, 0x98, 0x99, 0x4d,
-	0x33, 0x73, 0x33, 0xe9, 0x8d, 0xc3, 0x58, 0x45, 0x85, 0x6f, 0x06, 0xb8, 0xb6, 0x42, 0x0c, 0xd1,
-	0x22, 0xdc, 0x2f, 0x96, 0x74, 0xab, 0xc4, 0xe5, 0xa9, 0xd2, 0x18, 0xdf, 0x8c, 0x37, 0xe6, 0xca,
-	0x53, 0x85, 0x14, 0x8f, 0xbc, 0x1b, 0x81, 0xb7, 0x70, 0x97, 0x98, 0xaf, 0xdc, 0x0d, 0xe4, 0x32,
-	0xc6, 0x54, 0x67, 0x43, 0x36, 0x6a, 0xd9, 0xff, 0x0f, 0xbb, 0x41, 0x4b, 0xca, 0xed, 0x58, 0xc6,
-	0x5b, 0x78, 0x30, 0x6e, 0x0d, 0xa7, 0x53, 0xa2, 0xcf, 0x98, 0xaf, 0x5e, 0x2a, 0x50, 0xdc, 0xf1,
-	0x7f, 0xcb, 0x38, 0xf5, 0x92, 0x78, 0x0b, 0x0b, 0x37, 0x47, 0x54, 0x7a, 0x8d, 0x46, 0xbb, 0x87,
-	0xdd, 0xa0, 0x7d, 0x1a, 0x9d, 0x4d, 0x0d, 0xa7, 0xfd, 0x0b, 0x3a, 0x88, 0x4a, 0xdc, 0xf0, 0xce,
-	0x69, 0x12, 0x32, 0x0c, 0x22, 0xfd, 0xcf, 0x90, 0x8d, 0x34, 0xe7, 0x24, 0x7c, 0x2a, 0xab, 0xc2,
-	0xe4, 0x4d, 0xfa, 0x40, 0xb2, 0x6b, 0xe7, 0xec, 0x7f, 0x4b, 0x86, 0xc4, 0x57, 0x47, 0x5e, 0x26,
-	0xa8, 0xf4, 0x3a, 0x29, 0xa9, 0x39, 0x4f, 0x50, 0x19, 0xef, 0x8c, 0xf7, 0x6c, 0xfa, 0x73, 0x76,
-	0x82, 0xc1, 0x4a, 0xda, 0x6f, 0x8e, 0x97, 0x86, 0xe0, 0xc0, 0x6b, 0x01, 0x52, 0x89, 0x7b, 0x4e,
-	0x1b, 0xba, 0x7e, 0xd9, 0xac, 0x12, 0xd9, 0xd9, 0x7d, 0x4a, 0x92, 0x2c, 0x14, 0x7b, 0xcd, 0xb9,
-	0x54, 0x5e, 0xae, 0xaa, 0xdc, 0x1a, 0xe5, 0x36, 0xa9, 0x52, 0x06, 0x8b, 0x0b, 0x5e, 0x0f, 0xb0,
-	0x48, 0xd5, 0x71, 0xc9, 0xea, 0x22, 0x04, 0xd7, 0xa4, 0x82, 0x8c, 0xd6, 0xd2, 0x1c, 0x3a, 0xdb,
-	0xad, 0x8f, 0x7d, 0x9f, 0x7d, 0xee, 0xfb, 0xec, 0x6b, 0xdf, 0x67, 0x7e, 0x83, 0x1e, 0x6c, 0xf6,
-	0x13, 0x00, 0x00, 0xff, 0xff, 0x12, 0xe0, 0xa8, 0xc9, 0x1d, 0x02, 0x00, 0x00,
+	// 705 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xcd, 0x4e, 0xdb, 0x4a,
+	0x14, 0xc7, 0xe5, 0x10, 0xd0, 0x65, 0x08, 0x09, 0x31, 0x57, 0xc8, 0xe2, 0xde, 0x4b, 0x22, 0x4b,
+	0xf7, 0xde, 0x2c, 0xc0, 0x56, 0x02, 0x45, 0x05, 0x21, 0xb5, 0x98, 0x0f, 0x15, 0xb5, 0xaa, 0x54,
+	0x47, 0xed, 0xd6, 0x1a, 0x3b, 0x13, 0x7b, 0x84, 0xe3, 0x31, 0x9e, 0xe3, 0x88, 0xb0, 0xee, 0x83,
+	0xf4, 0x1d, 0xfa, 0x12, 0x5d, 0xf6, 0x09, 0xa2, 0x8a, 0x6d, 0x77, 0x59, 0x76, 0x55, 0xcd, 0xd8,
+	0x8e, 0xd3, 0x0f, 0xa4, 0x76, 0xe7, 0x33, 0xfe, 0xff, 0x7f, 0x73, 0xe6, 0x9c, 0x33, 0x83, 0xf4,
+	0x38, 0x61, 0xc0, 0x4c, 0x97, 0x60, 0x8f, 0x45, 0x66, 0xdc, 0x8b, 0xcd, 0x71, 0xd7, 0x1c, 0x11,
+	0xce, 0xb1, 0x4f, 0xb8, 0x21, 0x7f, 0xaa, 0x5b, 0x04, 0x02, 0x92, 0x90, 0x74, 0x64, 0x64, 0x32,
+	0x23, 0xee, 0xc5, 0xc6, 0xb8, 0xbb, 0xdd, 0x22, 0x10, 0x98, 0xe3, 0x2e, 0x0e, 0xe3, 0x00, 0x77,
+	0x73, 0x84, 0xe3, 0x86, 0xcc, 0xbb, 0xce, 0x8c, 0xdb, 0x7b, 0x3e, 0x85, 0x20, 0x75, 0x0d, 0x8f,
+	0x8d, 0x4c, 0x9f, 0xf9, 0xcc, 0x94, 0xcb, 0x6e, 0x3a, 0x94, 0x51, 0xb6, 0xb3, 0xf8, 0xca, 0xe4,
+	0xfa, 0x67, 0x05, 0xad, 0xf4, 0x01, 0x43, 0xca, 0xd5, 0x2e, 0x5a, 0x1b, 0xb2, 0xe4, 0xda, 0x19,
+	0x50, 0x9f, 0x70, 0xd0, 0x94, 0xb6, 0xd2, 0xa9, 0x59, 0x1b, 0xb3, 0x69, 0xab, 0xc6, 0xf9, 0xdd,
+	0x1e, 0xa7, 0x77, 0xe4, 0x58, 0x3f, 0xd0, 0x6d, 0x24, 0x44, 0xe7, 0x52, 0xa3, 0x3e, 0x46, 0xf5,
+	0x21, 0x8d, 0x70, 0x48, 0xef, 0xc8, 0xc0, 0x49, 0x18, 0x03, 0xad, 0x22, 0x5d, 0xcd, 0xd9, 0xb4,
+	0xb5, 0x5e, 0xba, 0xf6, 0x7b, 0xba, 0xbd, 0x3e, 0x17, 0xda, 0x8c, 0x81, 0xfa, 0x3f, 0x6a, 0x94,
+	0x4e, 0x12, 0x33, 0x2f, 0xd0, 0x96, 0xda, 0x4a, 0xa7, 0x6a, 0x97, 0xc0, 0x0b, 0xb1, 0xaa, 0x1a,
+	0x68, 0x35, 0x20, 0x38, 0xa7, 0x57, 0x1f, 0xa2, 0xff, 0x21, 0x34, 0x12, 0xfc, 0x57, 0xae, 0xe7,
+	0x21, 0x03, 0x6d, 0x59, 0x22, 0xe5, 0xcf, 0x7e, 0xc8, 0x40, 0x7f, 0xaf, 0xa0, 0xd5, 0x8b, 0x97,
+	0xf6, 0x25, 0x4b, 0xae, 0xaf, 0xce, 0xd5, 0xa7, 0x68, 0xd3, 0x4b, 0x93, 0x84, 0x44, 0xe0, 0xfc,
+	0xca, 0xc1, 0x9b, 0xb9, 0xf8, 0xb2, 0x3c, 0xff, 0x09, 0x6a, 0x46, 0xe4, 0x36, 0xb7, 0x8f, 0x49,
+	0xc2, 0x29, 0x8b, 0xb4, 0xca, 0x03, 0xfe, 0x86, 0x90, 0x0a, 0xf3, 0x9b, 0x4c, 0xa8, 0xfe, 0x87,
+	0x1a, 0xa5, 0x7b, 0xb1, 0x06, 0xeb, 0x85, 0x52, 0x96, 0x40, 0x7f, 0xa7, 0xa0, 0x6d, 0x4b, 0x76,
+	0xda, 0x12, 0x8d, 0xe6, 0xd6, 0xc4, 0xc6, 0x91, 0x4f, 0x6c, 0x72, 0x93, 0x8a, 0x24, 0x8e, 0x50,
+	0x43, 0x9e, 0x58, 0x4e, 0x41, 0x56, 0x27, 0xe5, 0xc1, 0x2e, 0x08, 0xa5, 0xa4, 0xc8, 0x62, 0xfd,
+	0x83, 0x10, 0x07, 0x9c, 0x40, 0x56, 0xad, 0x8a, 0xdc, 0x7c, 0x55, 0xae, 0x88, 0x72, 0xa9, 0x7f,
+	0xa2, 0x65, 0x8f, 0xa5, 0x11, 0xe4, 0x69, 0x65, 0x81, 0xaa, 0xa2, 0x2a, 0x07, 0x12, 0xcb, 0x66,
+	0x54, 0x6d, 0xf9, 0xad, 0x9f, 0x22, 0xed, 0x05, 0xf5, 0x03, 0x38, 0x0b, 0x29, 0x89, 0xe0, 0x75,
+	0x3c, 0xc0, 0x30, 0xcf, 0xef, 0xdf, 0xc5, 0x21, 0xe1, 0x61, 0x9e, 0x5e, 0x75, 0x61, 0x22, 0x64,
+	0x6f, 0xde, 0x56, 0x51, 0xf3, 0x07, 0x86, 0xfa, 0x0a, 0x35, 0x30, 0x00, 0xe1, 0x40, 0x06, 0x8e,
+	0xc8, 0x9d, 0x24, 0xd2, 0xbd, 0xd6, 0xeb, 0x18, 0xf3, 0x1b, 0x42, 0x20, 0x30, 0x8a, 0x2b, 0x61,
+	0x2c, 0x14, 0xea, 0x99, 0xd4, 0xdb, 0xf5, 0x02, 0x90, 0xc5, 0x6a, 0x1f, 0x6d, 0x94, 0xf9, 0xe4,
+	0xcc, 0xca, 0x6f, 0x32, 0xcb, 0xe1, 0xcd, 0xa1, 0x27, 0xc5, 0x3c, 0xc3, 0xc4, 0x71, 0x13, 0x1c,
+	0xc9, 0x5e, 0x2e, 0x75, 0x6a, 0xd6, 0xe6, 0x6c, 0xda, 0x6a, 0x94, 0x4d, 0x38, 0xdc, 0x15, 0x6d,
+	0xa8, 0x17, 0x5a, 0x4b, 0x4a, 0xd5, 0x2b, 0xd4, 0xc0, 0x91, 0x47, 0x38, 0x24, 0x73, 0x77, 0x55,
+	0xba, 0xdb, 0xb3, 0x69, 0xeb, 0xef, 0xd2, 0xfd, 0x44, 0xb8, 0xdb, 0x22, 0x1e, 0xe1, 0xdb, 0x63,
+	0xfd, 0xf0, 0x40, 0xb7, 0xeb, 0x85, 0x31, 0x47, 0xdd, 0xa0, 0x4d, 0x3e, 0x89, 0x3c, 0xc7, 0x63,
+	0xa3, 0x11, 0x05, 0x20, 0xc4, 0x71, 0x29, 0x70, 0x79, 0x13, 0x6a, 0xd6, 0x69, 0x31, 0x11, 0xd2,
+	0xfe, 0xa8, 0xdb, 0xd3, 0xbf, 0x4c, 0x5b, 0xbb, 0x0b, 0x2f, 0x46, 0x9c, 0x4c, 0xf8, 0x08, 0x03,
+	0xf5, 0x42, 0xec, 0x72, 0xd3, 0x67, 0x7b, 0x2e, 0x85, 0x21, 0x25, 0xe1, 0xc0, 0xb0, 0x28, 0x84,
+	0x94, 0x83, 0xdd, 0x14, 0xf4, 0xb3, 0x02, 0x6e, 0x51, 0xe0, 0xea, 0x73, 0xa4, 0x7d, 0xb7, 0x25,
+	0xa7, 0x7e, 0x84, 0x21, 0x4d, 0x88, 0xb6, 0xf2, 0xb3, 0x49, 0x3c, 0x3a, 0xd4, 0xed, 0xad, 0x6f,
+	0x38, 0xfd, 0xc2, 0x60, 0xd5, 0x3e, 0xdc, 0xef, 0x28, 0x1f, 0xef, 0x77, 0x94, 0x4f, 0xf7, 0x3b,
+	0x8a, 0xbb, 0x22, 0x5f, 0xa9, 0xfd, 0xaf, 0x03, 0x00, 0xe3, 0x7b, 0x2c, 0x7f, 0x33, 0x05, 0x00,
+	0x00,
 }
 
 func (m *Status) Marshal() (dAtA []byte, err error) {
@@ -257,10 +481,56 @@ func (m *Status) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.HeadForkVersion) > 0 {
-		i -= len(m.HeadForkVersion)
-		copy(dAtA[i:], m.HeadForkVersion)
-		i = encodeVarintMessages(dAtA, i, uint64(len(m.HeadForkVersion)))
+	if len(m.ForkDigest) > 0 {
+		i -= len(m.ForkDigest)
+		copy(dAtA[i:], m.ForkDigest)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.ForkDigest)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ENRForkID) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ENRForkID) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ENRForkID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.NextForkEpoch != 0 {
+		i = encodeVarintMessages(dAtA, i, uint64(m.NextForkEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.NextForkVersion) > 0 {
+		i -= len(m.NextForkVersion)
+		copy(dAtA[i:], m.NextForkVersion)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.NextForkVersion)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CurrentForkDigest) > 0 {
+		i -= len(m.CurrentForkDigest)
+		copy(dAtA[i:], m.CurrentForkDigest)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.CurrentForkDigest)))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -316,6 +586,121 @@ func (m *BeaconBlocksByRangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *LightClientUpdateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LightClientUpdateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LightClientUpdateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.FinalizedSlot != 0 {
+		i = encodeVarintMessages(dAtA, i, uint64(m.FinalizedSlot))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LightClientUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LightClientUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LightClientUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.SyncCommitteeSignature) > 0 {
+		i -= len(m.SyncCommitteeSignature)
+		copy(dAtA[i:], m.SyncCommitteeSignature)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.SyncCommitteeSignature)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.SyncCommitteeBits) > 0 {
+		i -= len(m.SyncCommitteeBits)
+		copy(dAtA[i:], m.SyncCommitteeBits)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.SyncCommitteeBits)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.AncestryBranch) > 0 {
+		for iNdEx := len(m.AncestryBranch) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AncestryBranch[iNdEx])
+			copy(dAtA[i:], m.AncestryBranch[iNdEx])
+			i = encodeVarintMessages(dAtA, i, uint64(len(m.AncestryBranch[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.FinalityBranch) > 0 {
+		for iNdEx := len(m.FinalityBranch) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FinalityBranch[iNdEx])
+			copy(dAtA[i:], m.FinalityBranch[iNdEx])
+			i = encodeVarintMessages(dAtA, i, uint64(len(m.FinalityBranch[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.FinalizedHeader != nil {
+		{
+			size, err := m.FinalizedHeader.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessages(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AttestedHeader != nil {
+		{
+			size, err := m.AttestedHeader.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessages(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMessages(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMessages(v)
 	base := offset
@@ -333,7 +718,7 @@ func (m *Status) Size() (n int) {
 	}
 	var l int
 	_ = l
-	l = len(m.HeadForkVersion)
+	l = len(m.ForkDigest)
 	if l > 0 {
 		n += 1 + l + sovMessages(uint64(l))
 	}
@@ -357,6 +742,29 @@ func (m *Status) Size() (n int) {
 	return n
 }
 
+func (m *ENRForkID) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CurrentForkDigest)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	l = len(m.NextForkVersion)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.NextForkEpoch != 0 {
+		n += 1 + sovMessages(uint64(m.NextForkEpoch))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *BeaconBlocksByRangeRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -382,11 +790,66 @@ func (m *BeaconBlocksByRangeRequest) Size() (n int) {
 	return n
 }
 
-func sovMessages(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *LightClientUpdateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FinalizedSlot != 0 {
+		n += 1 + sovMessages(uint64(m.FinalizedSlot))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
 }
-func sozMessages(x uint64) (n int) {
-	return sovMessages(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *LightClientUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AttestedHeader != nil {
+		l = m.AttestedHeader.Size()
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.FinalizedHeader != nil {
+		l = m.FinalizedHeader.Size()
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if len(m.FinalityBranch) > 0 {
+		for _, b := range m.FinalityBranch {
+			l = len(b)
+			n += 1 + l + sovMessages(uint64(l))
+		}
+	}
+	if len(m.AncestryBranch) > 0 {
+		for _, b := range m.AncestryBranch {
+			l = len(b)
+			n += 1 + l + sovMessages(uint64(l))
+		}
+	}
+	l = len(m.SyncCommitteeBits)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	l = len(m.SyncCommitteeSignature)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovMessages(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMessages(x uint64) (n int) {
+	return sovMessages(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *Status) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
@@ -419,7 +882,7 @@ func (m *Status) Unmarshal(dAtA []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HeadForkVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ForkDigest", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -446,9 +909,9 @@ func (m *Status) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HeadForkVersion = append(m.HeadForkVersion[:0], dAtA[iNdEx:postIndex]...)
-			if m.HeadForkVersion == nil {
-				m.HeadForkVersion = []byte{}
+			m.ForkDigest = append(m.ForkDigest[:0], dAtA[iNdEx:postIndex]...)
+			if m.ForkDigest == nil {
+				m.ForkDigest = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
@@ -582,6 +1045,147 @@ func (m *Status) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ENRForkID) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ENRForkID: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ENRForkID: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentForkDigest", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentForkDigest = append(m.CurrentForkDigest[:0], dAtA[iNdEx:postIndex]...)
+			if m.CurrentForkDigest == nil {
+				m.CurrentForkDigest = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextForkVersion", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextForkVersion = append(m.NextForkVersion[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextForkVersion == nil {
+				m.NextForkVersion = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextForkEpoch", wireType)
+			}
+			m.NextForkEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextForkEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *BeaconBlocksByRangeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -727,6 +1331,337 @@ func (m *BeaconBlocksByRangeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *LightClientUpdateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LightClientUpdateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LightClientUpdateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedSlot", wireType)
+			}
+			m.FinalizedSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinalizedSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LightClientUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LightClientUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LightClientUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestedHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AttestedHeader == nil {
+				m.AttestedHeader = &v1alpha1.BeaconBlockHeader{}
+			}
+			if err := m.AttestedHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FinalizedHeader == nil {
+				m.FinalizedHeader = &v1alpha1.BeaconBlockHeader{}
+			}
+			if err := m.FinalizedHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalityBranch", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FinalityBranch = append(m.FinalityBranch, make([]byte, postIndex-iNdEx))
+			copy(m.FinalityBranch[len(m.FinalityBranch)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AncestryBranch", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AncestryBranch = append(m.AncestryBranch, make([]byte, postIndex-iNdEx))
+			copy(m.AncestryBranch[len(m.AncestryBranch)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncCommitteeBits", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SyncCommitteeBits = append(m.SyncCommitteeBits[:0], dAtA[iNdEx:postIndex]...)
+			if m.SyncCommitteeBits == nil {
+				m.SyncCommitteeBits = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncCommitteeSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SyncCommitteeSignature = append(m.SyncCommitteeSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.SyncCommitteeSignature == nil {
+				m.SyncCommitteeSignature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipMessages(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -287,6 +287,61 @@ func (m *Fork) GetEpoch() uint64 {
 	return 0
 }
 
+type ForkData struct {
+	CurrentVersion        []byte   `protobuf:"bytes,1,opt,name=current_version,json=currentVersion,proto3" json:"current_version,omitempty" ssz-size:"4"`
+	GenesisValidatorsRoot []byte   `protobuf:"bytes,2,opt,name=genesis_validators_root,json=genesisValidatorsRoot,proto3" json:"genesis_validators_root,omitempty" ssz-size:"32"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
+}
+
+func (m *ForkData) Reset()         { *m = ForkData{} }
+func (m *ForkData) String() string { return proto.CompactTextString(m) }
+func (*ForkData) ProtoMessage()    {}
+func (*ForkData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e719e7d82cfa7b0d, []int{2}
+}
+func (m *ForkData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForkData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForkData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ForkData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForkData.Merge(m, src)
+}
+func (m *ForkData) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForkData) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForkData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForkData proto.InternalMessageInfo
+
+func (m *ForkData) GetCurrentVersion() []byte {
+	if m != nil {
+		return m.CurrentVersion
+	}
+	return nil
+}
+
+func (m *ForkData) GetGenesisValidatorsRoot() []byte {
+	if m != nil {
+		return m.GenesisValidatorsRoot
+	}
+	return nil
+}
+
 type PendingAttestation struct {
 	AggregationBits      github_com_prysmaticlabs_go_bitfield.Bitlist `protobuf:"bytes,1,opt,name=aggregation_bits,json=aggregationBits,proto3,casttype=github.com/prysmaticlabs/go-bitfield.Bitlist" json:"aggregation_bits,omitempty" ssz-max:"2048"`
 	Data                 *v1alpha1.AttestationData                    `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
@@ -301,7 +356,7 @@ func (m *PendingAttestation) Reset()         { *m = PendingAttestation{} }
 func (m *PendingAttestation) String() string { return proto.CompactTextString(m) }
 func (*PendingAttestation) ProtoMessage()    {}
 func (*PendingAttestation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e719e7d82cfa7b0d, []int{2}
+	return fileDescriptor_e719e7d82cfa7b0d, []int{3}
 }
 func (m *PendingAttestation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -370,7 +425,7 @@ func (m *ValidatorLatestVote) Reset()         { *m = ValidatorLatestVote{} }
 func (m *ValidatorLatestVote) String() string { return proto.CompactTextString(m) }
 func (*ValidatorLatestVote) ProtoMessage()    {}
 func (*ValidatorLatestVote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e719e7d82cfa7b0d, []int{3}
+	return fileDescriptor_e719e7d82cfa7b0d, []int{4}
 }
 func (m *ValidatorLatestVote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -425,7 +480,7 @@ func (m *HistoricalBatch) Reset()         { *m = HistoricalBatch{} }
 func (m *HistoricalBatch) String() string { return proto.CompactTextString(m) }
 func (*HistoricalBatch) ProtoMessage()    {}
 func (*HistoricalBatch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e719e7d82cfa7b0d, []int{4}
+	return fileDescriptor_e719e7d82cfa7b0d, []int{5}
 }
 func (m *HistoricalBatch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -480,7 +535,7 @@ func (m *StateSummary) Reset()         { *m = StateSummary{} }
 func (m *StateSummary) String() string { return proto.CompactTextString(m) }
 func (*StateSummary) ProtoMessage()    {}
 func (*StateSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e719e7d82cfa7b0d, []int{5}
+	return fileDescriptor_e719e7d82cfa7b0d, []int{6}
 }
 func (m *StateSummary) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -526,6 +581,7 @@ func (m *StateSummary) GetRoot() []byte {
 func init() {
 	proto.RegisterType((*BeaconState)(nil), "ethereum.beacon.p2p.v1.BeaconState")
 	proto.RegisterType((*Fork)(nil), "ethereum.beacon.p2p.v1.Fork")
+	proto.RegisterType((*ForkData)(nil), "ethereum.beacon.p2p.v1.ForkData")
 	proto.RegisterType((*PendingAttestation)(nil), "ethereum.beacon.p2p.v1.PendingAttestation")
 	proto.RegisterType((*ValidatorLatestVote)(nil), "ethereum.beacon.p2p.v1.ValidatorLatestVote")
 	proto.RegisterType((*HistoricalBatch)(nil), "ethereum.beacon.p2p.v1.HistoricalBatch")
@@ -535,75 +591,79 @@ func init() {
 func init() { proto.RegisterFile("proto/beacon/p2p/v1/types.proto", fileDescriptor_e719e7d82cfa7b0d) }
 
 var fileDescriptor_e719e7d82cfa7b0d = []byte{
-	// 1088 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xd7, 0x26, 0x06, 0xda, 0x89, 0x1b, 0xa7, 0xe3, 0xaa, 0x59, 0xd2, 0x90, 0x35, 0x2b, 0xd1,
-	0x46, 0xa8, 0x59, 0x67, 0x9d, 0xc4, 0x4e, 0x52, 0xd1, 0x8a, 0x6d, 0x8b, 0x0a, 0x02, 0x09, 0x6d,
-	0x21, 0x12, 0x12, 0x62, 0x35, 0x5e, 0x8f, 0xbd, 0x43, 0xd6, 0x3b, 0xab, 0x9d, 0xb1, 0x95, 0x44,
-	0x42, 0x1c, 0x38, 0x71, 0x40, 0x5c, 0xf8, 0x07, 0xe0, 0xbf, 0x00, 0x4e, 0x7c, 0x1c, 0x38, 0xf2,
-	0x75, 0x81, 0x83, 0x85, 0x72, 0x03, 0x4e, 0xf8, 0xc8, 0x09, 0xcd, 0xec, 0xa7, 0xa9, 0x0d, 0x3e,
-	0xf4, 0xb6, 0xfb, 0xde, 0xef, 0xf7, 0x7b, 0x6f, 0xde, 0x7b, 0xfb, 0x66, 0x81, 0x16, 0x46, 0x94,
-	0xd3, 0x7a, 0x1b, 0x23, 0x97, 0x06, 0xf5, 0xb0, 0x11, 0xd6, 0x87, 0x66, 0x9d, 0x9f, 0x86, 0x98,
-	0x19, 0xd2, 0x03, 0xaf, 0x62, 0xee, 0xe1, 0x08, 0x0f, 0xfa, 0x46, 0x8c, 0x31, 0xc2, 0x46, 0x68,
-	0x0c, 0xcd, 0xb5, 0x0d, 0xcc, 0xbd, 0xfa, 0xd0, 0x44, 0x7e, 0xe8, 0x21, 0xb3, 0x8e, 0x38, 0xc7,
-	0x8c, 0x23, 0x4e, 0x04, 0x40, 0xf0, 0xd6, 0xb4, 0x09, 0x7f, 0xcc, 0x75, 0xda, 0x3e, 0x75, 0x8f,
-	0x13, 0xc0, 0xfa, 0x04, 0x60, 0x88, 0x7c, 0xd2, 0x41, 0x9c, 0x46, 0x89, 0x77, 0xab, 0x47, 0xb8,
-	0x37, 0x68, 0x1b, 0x2e, 0xed, 0xd7, 0x7b, 0xb4, 0x47, 0xeb, 0xd2, 0xdc, 0x1e, 0x74, 0xe5, 0x5b,
-	0x9c, 0xb4, 0x78, 0x8a, 0xe1, 0xfa, 0x47, 0x65, 0xb0, 0x64, 0xc9, 0x18, 0x0f, 0x39, 0xe2, 0x18,
-	0xea, 0xa0, 0xdc, 0xc3, 0x01, 0x66, 0x84, 0x39, 0x9c, 0xf4, 0xb1, 0xfa, 0xfb, 0x53, 0x35, 0x65,
-	0xb3, 0x64, 0x2f, 0x25, 0xc6, 0x37, 0x48, 0x1f, 0xc3, 0x2a, 0x28, 0x31, 0x9f, 0x72, 0xf5, 0x8f,
-	0xd8, 0x27, 0x5f, 0xa0, 0x09, 0x4a, 0x5d, 0x1a, 0x1d, 0xab, 0x7f, 0x0a, 0xe3, 0x52, 0x63, 0xdd,
-	0x98, 0x7e, 0x7c, 0xe3, 0x25, 0x1a, 0x1d, 0xdb, 0x12, 0x0a, 0xdf, 0x02, 0x55, 0x1f, 0x89, 0xe3,
-	0xc7, 0xc7, 0x73, 0x3c, 0x8c, 0x3a, 0x38, 0x52, 0x7f, 0xa8, 0x48, 0x85, 0xcd, 0x5c, 0x01, 0x73,
-	0xcf, 0x48, 0x0f, 0x6c, 0xc4, 0xd9, 0x5a, 0x82, 0xf1, 0x40, 0x12, 0xec, 0xcb, 0xb1, 0x4a, 0xc1,
-	0x04, 0xf7, 0xc1, 0x52, 0xac, 0x19, 0x51, 0xca, 0x99, 0xfa, 0x63, 0xa5, 0xb6, 0xb8, 0x59, 0xb6,
-	0xae, 0x8e, 0x47, 0x1a, 0x64, 0xec, 0x6c, 0x8b, 0x91, 0x33, 0x7c, 0xa8, 0xef, 0x9b, 0x07, 0x8d,
-	0x9b, 0x3b, 0x0d, 0xdd, 0x06, 0x12, 0x6b, 0x0b, 0xa8, 0x60, 0x8a, 0x7e, 0xe0, 0x84, 0xf9, 0xd3,
-	0xff, 0x30, 0x25, 0x36, 0x66, 0xda, 0x60, 0xc5, 0x23, 0x8c, 0xd3, 0x88, 0xb8, 0xc8, 0x4f, 0xe8,
-	0x3f, 0xc7, 0xf4, 0xeb, 0xe3, 0x91, 0xa6, 0xe7, 0xf4, 0x3b, 0x82, 0x5b, 0x13, 0xef, 0x7d, 0x74,
-	0x72, 0xa8, 0x9b, 0xcd, 0x56, 0xab, 0xd5, 0x30, 0x9b, 0xba, 0x5d, 0xc9, 0x05, 0x62, 0xcd, 0x17,
-	0xc0, 0x45, 0xcc, 0x3d, 0xd3, 0xe9, 0x20, 0x8e, 0xd4, 0xcf, 0x57, 0x65, 0x61, 0xb4, 0x19, 0x85,
-	0xb9, 0xcf, 0x3d, 0xf3, 0x1e, 0xe2, 0xc8, 0xbe, 0x80, 0x93, 0x27, 0xf8, 0x36, 0xa8, 0x64, 0x74,
-	0x67, 0x48, 0x39, 0x66, 0xea, 0x17, 0xab, 0xb5, 0xc5, 0x39, 0x44, 0x2c, 0x38, 0x1e, 0x69, 0xcb,
-	0x79, 0x8a, 0xdb, 0x8d, 0x5d, 0xdd, 0xbe, 0x94, 0x0a, 0x1f, 0x09, 0x29, 0xb8, 0x05, 0x60, 0xac,
-	0x8e, 0x43, 0xca, 0x08, 0x77, 0x48, 0xd0, 0xc1, 0x27, 0xea, 0x97, 0xab, 0x72, 0x2a, 0x56, 0x24,
-	0x36, 0xf6, 0xbc, 0x2c, 0x1c, 0xf0, 0x1d, 0x00, 0xb2, 0x61, 0x65, 0xea, 0xa7, 0x9a, 0xcc, 0xa3,
-	0x36, 0x23, 0x8f, 0xa3, 0x14, 0x69, 0x5d, 0x1b, 0x8f, 0xb4, 0xd5, 0x42, 0x22, 0x07, 0x07, 0x7b,
-	0xa6, 0xd9, 0x6c, 0xb4, 0x5a, 0xad, 0xa6, 0x6e, 0x17, 0x14, 0xe1, 0x3e, 0xb8, 0xd0, 0x46, 0x3e,
-	0x0a, 0x5c, 0xcc, 0xd4, 0xcf, 0x84, 0x7a, 0xe9, 0xbf, 0xb9, 0x19, 0x1a, 0xde, 0x02, 0xe5, 0x08,
-	0x05, 0x1d, 0x44, 0x9d, 0x3e, 0x39, 0xc1, 0x4c, 0xfd, 0xf0, 0x86, 0xec, 0xda, 0xea, 0x78, 0xa4,
-	0x55, 0xf3, 0xae, 0x35, 0xf7, 0xf6, 0x76, 0x9a, 0xb2, 0xeb, 0x4b, 0x31, 0xfa, 0x35, 0x01, 0x86,
-	0x0d, 0x70, 0x91, 0xf9, 0x88, 0x79, 0x24, 0xe8, 0x31, 0xf5, 0x2f, 0x43, 0xc6, 0xad, 0x8e, 0x47,
-	0x5a, 0x65, 0x72, 0x5c, 0x74, 0x3b, 0x87, 0xc1, 0xf7, 0xc1, 0xb5, 0x30, 0xc2, 0x43, 0x42, 0x07,
-	0xcc, 0xc1, 0x21, 0x75, 0x3d, 0xa7, 0xb0, 0x07, 0x98, 0xfa, 0x4b, 0x53, 0xd6, 0xe6, 0xf9, 0x59,
-	0xdf, 0xd0, 0xeb, 0x38, 0xe8, 0x90, 0xa0, 0xf7, 0x62, 0xce, 0xf9, 0x57, 0xbb, 0x76, 0xb7, 0x0f,
-	0x9a, 0xba, 0xfd, 0x74, 0x1a, 0xe3, 0xbe, 0x08, 0x51, 0x40, 0x33, 0xf8, 0x1e, 0x58, 0x73, 0x07,
-	0x51, 0x84, 0x03, 0x3e, 0x2d, 0xfe, 0xaf, 0x8f, 0x27, 0xbe, 0x9a, 0x84, 0x78, 0x34, 0x3c, 0x03,
-	0xf0, 0xdd, 0x01, 0xe3, 0xa4, 0x4b, 0x5c, 0x69, 0x71, 0xda, 0x84, 0x33, 0xf5, 0xab, 0xdb, 0x35,
-	0x65, 0xb3, 0x6c, 0xdd, 0x1d, 0x8f, 0xb4, 0x72, 0x5e, 0x3c, 0x53, 0xff, 0x7b, 0xa4, 0xd5, 0x0b,
-	0x5b, 0x2d, 0x8c, 0x4e, 0x59, 0x1f, 0x71, 0xe2, 0xfa, 0xa8, 0xcd, 0xea, 0x3d, 0xba, 0xd5, 0x26,
-	0xbc, 0x4b, 0xb0, 0xdf, 0x31, 0x2c, 0xc2, 0x87, 0xd8, 0xe5, 0x34, 0xda, 0xb5, 0x2f, 0x4f, 0xe8,
-	0x5b, 0x84, 0x33, 0xd8, 0x05, 0xcf, 0x64, 0x45, 0x4f, 0xbc, 0xb8, 0xe3, 0xb8, 0x1e, 0x76, 0x8f,
-	0x43, 0x4a, 0x02, 0xae, 0x7e, 0x7d, 0x5b, 0x7e, 0x5f, 0xcf, 0xce, 0x18, 0xc9, 0xbb, 0x19, 0xd2,
-	0xce, 0xba, 0xf7, 0x4a, 0xaa, 0x93, 0x3b, 0x61, 0x07, 0xac, 0xa7, 0xb5, 0x9d, 0x1a, 0xe6, 0x9b,
-	0xb9, 0xc3, 0xa4, 0x3d, 0x9a, 0x16, 0xe5, 0x4d, 0x70, 0xa5, 0x4b, 0x02, 0xe4, 0x93, 0xb3, 0x49,
-	0xf5, 0x6f, 0xe7, 0x56, 0xaf, 0x66, 0xfc, 0xdc, 0xa8, 0x7f, 0xa2, 0x80, 0x92, 0x58, 0xd1, 0xf0,
-	0x16, 0x58, 0xc9, 0xaa, 0x35, 0xc4, 0x11, 0x23, 0x34, 0x50, 0x15, 0xd9, 0x9f, 0x95, 0xc9, 0xfe,
-	0xec, 0xea, 0x76, 0x25, 0x45, 0x1e, 0xc5, 0x40, 0x78, 0x00, 0x2a, 0x69, 0x09, 0x52, 0xee, 0xc2,
-	0x0c, 0xee, 0x72, 0x02, 0x4c, 0xa9, 0x57, 0xc0, 0x13, 0x72, 0x22, 0xd5, 0x45, 0xb9, 0x46, 0xe2,
-	0x17, 0xfd, 0xe3, 0x05, 0x00, 0x1f, 0x9d, 0x3a, 0xd8, 0x07, 0x2b, 0xa8, 0xd7, 0x8b, 0x70, 0xaf,
-	0x30, 0x45, 0x71, 0x92, 0xd6, 0xc4, 0x3c, 0x36, 0xb6, 0x77, 0xf7, 0xc5, 0x18, 0xdd, 0x9c, 0x77,
-	0x8c, 0x7c, 0xc2, 0xb8, 0x5d, 0x29, 0x68, 0xcb, 0x09, 0x3a, 0x04, 0x25, 0xb9, 0x88, 0x17, 0x64,
-	0x89, 0xaf, 0xcf, 0x28, 0x71, 0x21, 0x41, 0xb9, 0x8e, 0x25, 0x07, 0xde, 0x00, 0x15, 0x12, 0xb8,
-	0xfe, 0x40, 0x1c, 0xd2, 0xe9, 0x60, 0x1f, 0x9d, 0x26, 0x27, 0x5c, 0xce, 0xcc, 0xf7, 0x84, 0x15,
-	0x3e, 0x07, 0x96, 0xc3, 0x88, 0x86, 0x94, 0xe1, 0x28, 0xd9, 0xa8, 0x25, 0x89, 0xbb, 0x94, 0x5a,
-	0xe5, 0x36, 0xd5, 0xef, 0x80, 0x6a, 0xb6, 0x23, 0x5f, 0x95, 0xf7, 0x9f, 0x58, 0xca, 0x79, 0xf9,
-	0x94, 0x42, 0xf9, 0x20, 0x04, 0x25, 0x71, 0x1f, 0xc5, 0x4d, 0xb0, 0xe5, 0xb3, 0xfe, 0x81, 0x02,
-	0x2a, 0x0f, 0xb2, 0xeb, 0xc6, 0x42, 0xdc, 0xf5, 0x60, 0x6b, 0xf2, 0xda, 0x54, 0xe6, 0xbe, 0x35,
-	0x5b, 0x93, 0xb7, 0xe6, 0xc2, 0xbc, 0x97, 0xa6, 0xde, 0x04, 0x65, 0xf9, 0xe3, 0xf1, 0x70, 0xd0,
-	0xef, 0xa3, 0xe8, 0x54, 0x64, 0x2a, 0xff, 0x2d, 0x94, 0xc2, 0xaf, 0xc5, 0x94, 0xec, 0xad, 0xf2,
-	0x77, 0xe7, 0x1b, 0xca, 0xf7, 0xe7, 0x1b, 0xca, 0x6f, 0xe7, 0x1b, 0x4a, 0xfb, 0x49, 0xf9, 0x33,
-	0xb3, 0xf3, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x0e, 0x9c, 0x5c, 0x20, 0x95, 0x09, 0x00, 0x00,
+	// 1150 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xcd, 0x6f, 0xdc, 0x44,
+	0x14, 0x97, 0xd3, 0x05, 0xda, 0xc9, 0x36, 0x9b, 0x4c, 0x0a, 0x71, 0x93, 0x34, 0x5e, 0x2c, 0xd1,
+	0x06, 0x94, 0x78, 0xeb, 0x4d, 0xd8, 0x6d, 0x02, 0xf4, 0xc3, 0x4d, 0x51, 0x8b, 0x40, 0x42, 0x2e,
+	0x44, 0xe2, 0x82, 0x35, 0xeb, 0x9d, 0x5d, 0x0f, 0xf1, 0x7a, 0x2c, 0xcf, 0xec, 0x2a, 0xc9, 0x7f,
+	0xc0, 0x09, 0x0e, 0x20, 0x24, 0x6e, 0xf0, 0x5f, 0x00, 0x27, 0x3e, 0x0e, 0x1c, 0xf9, 0xba, 0xc0,
+	0xc1, 0x42, 0xb9, 0x01, 0x27, 0x7c, 0x83, 0x13, 0xf2, 0xf8, 0x73, 0xe9, 0x6e, 0x95, 0xdc, 0xec,
+	0xf7, 0x7e, 0xef, 0x37, 0xbf, 0x79, 0x6f, 0x66, 0xde, 0x03, 0x8a, 0x1f, 0x50, 0x4e, 0x1b, 0x1d,
+	0x8c, 0x6c, 0xea, 0x35, 0xfc, 0xa6, 0xdf, 0x18, 0xe9, 0x0d, 0x7e, 0xe4, 0x63, 0xa6, 0x09, 0x0f,
+	0x7c, 0x06, 0x73, 0x07, 0x07, 0x78, 0x38, 0xd0, 0x12, 0x8c, 0xe6, 0x37, 0x7d, 0x6d, 0xa4, 0x2f,
+	0xaf, 0x61, 0xee, 0x34, 0x46, 0x3a, 0x72, 0x7d, 0x07, 0xe9, 0x0d, 0xc4, 0x39, 0x66, 0x1c, 0x71,
+	0x42, 0xbd, 0x24, 0x6e, 0x59, 0x19, 0xf3, 0x27, 0xb1, 0x56, 0xc7, 0xa5, 0xf6, 0x41, 0x0a, 0x58,
+	0x1d, 0x03, 0x8c, 0x90, 0x4b, 0xba, 0x88, 0xd3, 0x20, 0xf5, 0x6e, 0xf6, 0x09, 0x77, 0x86, 0x1d,
+	0xcd, 0xa6, 0x83, 0x46, 0x9f, 0xf6, 0x69, 0x43, 0x98, 0x3b, 0xc3, 0x9e, 0xf8, 0x4b, 0x44, 0xc7,
+	0x5f, 0x09, 0x5c, 0xfd, 0xa7, 0x0a, 0x66, 0x0d, 0xb1, 0xc6, 0x43, 0x8e, 0x38, 0x86, 0x2a, 0xa8,
+	0xf6, 0xb1, 0x87, 0x19, 0x61, 0x16, 0x27, 0x03, 0x2c, 0xff, 0xf1, 0x54, 0x5d, 0x5a, 0xaf, 0x98,
+	0xb3, 0xa9, 0xf1, 0x2d, 0x32, 0xc0, 0x70, 0x11, 0x54, 0x98, 0x4b, 0xb9, 0xfc, 0x67, 0xe2, 0x13,
+	0x3f, 0x50, 0x07, 0x95, 0x1e, 0x0d, 0x0e, 0xe4, 0xbf, 0x62, 0xe3, 0x6c, 0x73, 0x55, 0x9b, 0xbc,
+	0x7d, 0xed, 0x55, 0x1a, 0x1c, 0x98, 0x02, 0x0a, 0xdf, 0x01, 0x8b, 0x2e, 0x8a, 0xb7, 0x9f, 0x6c,
+	0xcf, 0x72, 0x30, 0xea, 0xe2, 0x40, 0xfe, 0xb1, 0x26, 0x18, 0xd6, 0x0b, 0x06, 0xcc, 0x1d, 0x2d,
+	0xdb, 0xb0, 0x96, 0xa8, 0x35, 0xe2, 0x88, 0xfb, 0x22, 0xc0, 0x5c, 0x48, 0x58, 0x4a, 0x26, 0x78,
+	0x07, 0xcc, 0x26, 0x9c, 0x01, 0xa5, 0x9c, 0xc9, 0x3f, 0xd5, 0xea, 0xe7, 0xd6, 0xab, 0x86, 0x12,
+	0x85, 0xca, 0x0a, 0x63, 0xc7, 0x9b, 0x8c, 0x1c, 0xe3, 0x5d, 0xb5, 0x84, 0xd0, 0x62, 0x8b, 0x6a,
+	0x02, 0x61, 0x32, 0x63, 0x4b, 0x4c, 0x11, 0x17, 0x06, 0xa7, 0x14, 0x3f, 0x4f, 0xa4, 0x28, 0x21,
+	0x32, 0x0a, 0x61, 0x4a, 0x28, 0x4c, 0x30, 0xef, 0x10, 0xc6, 0x69, 0x40, 0x6c, 0xe4, 0xa6, 0x3c,
+	0xbf, 0x24, 0x3c, 0x57, 0xa3, 0x50, 0x51, 0x0b, 0x9e, 0x5b, 0x1b, 0x5b, 0x4d, 0xb5, 0x1e, 0xff,
+	0x0f, 0xd0, 0xe1, 0xae, 0xaa, 0xb7, 0xda, 0xed, 0x76, 0x53, 0x6f, 0xa9, 0x66, 0xad, 0x20, 0x48,
+	0x38, 0x5f, 0x01, 0x17, 0x30, 0x77, 0x74, 0xab, 0x8b, 0x38, 0x92, 0xbf, 0x58, 0x12, 0xa9, 0x52,
+	0xa6, 0xa4, 0xea, 0x1e, 0x77, 0xf4, 0x3d, 0xc4, 0x91, 0x79, 0x1e, 0xa7, 0x5f, 0xd0, 0x05, 0xb5,
+	0x3c, 0xdc, 0x1a, 0x51, 0x8e, 0x99, 0xfc, 0xe5, 0x52, 0xfd, 0xdc, 0x29, 0x48, 0x0c, 0x35, 0x0a,
+	0x95, 0xb5, 0x5c, 0xe2, 0xff, 0x58, 0xd2, 0xdd, 0x5f, 0xcc, 0x16, 0xda, 0x8f, 0x8d, 0x70, 0x13,
+	0xc0, 0x04, 0x87, 0x7d, 0xca, 0x08, 0xb7, 0x88, 0xd7, 0xc5, 0x87, 0xf2, 0x57, 0x4b, 0xe2, 0xdc,
+	0xcc, 0x0b, 0x6c, 0xe2, 0x79, 0x10, 0x3b, 0xe0, 0xbb, 0x00, 0xe4, 0xc7, 0x99, 0xc9, 0x9f, 0x29,
+	0x42, 0x57, 0x7d, 0x8a, 0xae, 0xfd, 0x0c, 0x69, 0xac, 0x44, 0xa1, 0xb2, 0x54, 0xe4, 0xee, 0xfa,
+	0xce, 0xce, 0x8b, 0xba, 0xde, 0x6a, 0xb6, 0xdb, 0xed, 0x96, 0x6a, 0x96, 0x18, 0xe1, 0x0d, 0x70,
+	0xbe, 0x83, 0x5c, 0xe4, 0xd9, 0x98, 0xc9, 0x9f, 0xc7, 0xec, 0x95, 0xc7, 0xc7, 0xe6, 0x68, 0xb8,
+	0x07, 0xaa, 0x01, 0xf2, 0xba, 0x88, 0x5a, 0x03, 0x72, 0x88, 0x99, 0xfc, 0xfe, 0x35, 0x51, 0xc5,
+	0x7a, 0x14, 0x2a, 0xab, 0x45, 0x15, 0xcb, 0x90, 0x34, 0x21, 0xb3, 0x89, 0xed, 0x8d, 0xd8, 0x04,
+	0x5f, 0x06, 0x17, 0x98, 0x8b, 0x98, 0x43, 0xbc, 0x3e, 0x93, 0xff, 0xd6, 0x84, 0x80, 0x2b, 0x51,
+	0xa8, 0x5c, 0x2e, 0x28, 0x72, 0x7f, 0x1a, 0x5f, 0x04, 0xc0, 0x4f, 0x25, 0xb0, 0xe2, 0x07, 0x78,
+	0x44, 0xe8, 0x90, 0x59, 0xd8, 0xa7, 0xb6, 0x63, 0x95, 0x5e, 0x0f, 0x26, 0xff, 0xda, 0x12, 0xf9,
+	0x7a, 0x61, 0xda, 0xcd, 0x7b, 0x13, 0x7b, 0x5d, 0xe2, 0xf5, 0xef, 0x14, 0x31, 0xc6, 0x66, 0x14,
+	0x2a, 0xcf, 0xe7, 0xbb, 0x7f, 0x0c, 0xb9, 0x36, 0x40, 0x87, 0xaa, 0x79, 0x39, 0x43, 0xdc, 0x8b,
+	0x01, 0x25, 0x22, 0x06, 0x3f, 0x91, 0xc0, 0xb2, 0x3d, 0x0c, 0x02, 0xec, 0xf1, 0x49, 0xda, 0x7e,
+	0x3b, 0xbb, 0xb6, 0x8d, 0x28, 0x54, 0xd6, 0x73, 0x6d, 0xd3, 0xb9, 0x13, 0x69, 0x72, 0x0a, 0x78,
+	0x54, 0x19, 0x03, 0xf0, 0xbd, 0x21, 0xe3, 0xa4, 0x47, 0x6c, 0x61, 0xb1, 0x3a, 0x84, 0x33, 0xf9,
+	0xeb, 0x9b, 0x75, 0x69, 0xbd, 0x6a, 0xdc, 0x8d, 0x42, 0xa5, 0x5a, 0x64, 0x5f, 0x57, 0xff, 0x0d,
+	0x95, 0x46, 0xe9, 0x05, 0xf5, 0x83, 0x23, 0x36, 0x40, 0x9c, 0xd8, 0x2e, 0xea, 0xb0, 0x46, 0x9f,
+	0x6e, 0x76, 0x08, 0xef, 0x11, 0xec, 0x76, 0x35, 0x83, 0xf0, 0x11, 0xb6, 0x39, 0x0d, 0xb6, 0xcd,
+	0x85, 0x31, 0x7e, 0x83, 0x70, 0x06, 0x7b, 0xe0, 0x4a, 0x9e, 0xcd, 0xd4, 0x8b, 0xbb, 0x96, 0xed,
+	0x60, 0xfb, 0xc0, 0xa7, 0xc4, 0xe3, 0xf2, 0x37, 0x37, 0xc5, 0xcd, 0x7d, 0x76, 0xca, 0xe1, 0xbe,
+	0x9b, 0x23, 0xcd, 0xbc, 0xe6, 0xaf, 0x65, 0x3c, 0x85, 0x13, 0x76, 0xc1, 0x6a, 0x96, 0x99, 0x89,
+	0xcb, 0x7c, 0x7b, 0xea, 0x65, 0xb2, 0xea, 0x4d, 0x5a, 0xe5, 0x6d, 0x70, 0xa9, 0x47, 0x3c, 0xe4,
+	0x92, 0xe3, 0x71, 0xf6, 0xef, 0x4e, 0xcd, 0xbe, 0x98, 0xc7, 0x17, 0x46, 0xf5, 0x23, 0x09, 0x54,
+	0xe2, 0x76, 0x00, 0x5f, 0x02, 0xf3, 0x79, 0xb6, 0x46, 0x38, 0x60, 0x84, 0x7a, 0xb2, 0x24, 0xea,
+	0x33, 0x3f, 0x5e, 0x9f, 0x6d, 0xd5, 0xac, 0x65, 0xc8, 0xfd, 0x04, 0x08, 0x77, 0x40, 0x2d, 0x4b,
+	0x41, 0x16, 0x3b, 0x33, 0x25, 0x76, 0x2e, 0x05, 0x66, 0xa1, 0x97, 0xc0, 0x13, 0xe2, 0x3c, 0xc9,
+	0xe7, 0xc4, 0x83, 0x94, 0xfc, 0xa8, 0x1f, 0x4a, 0xe0, 0x7c, 0x2c, 0x4b, 0xbc, 0x97, 0x13, 0xd8,
+	0xa5, 0x53, 0xb2, 0x3f, 0x00, 0x4b, 0x59, 0x2b, 0x2d, 0xde, 0x20, 0xd1, 0x05, 0x52, 0x81, 0x0b,
+	0x51, 0xa8, 0x5c, 0x2c, 0x28, 0xb6, 0x9a, 0xaa, 0xf9, 0x74, 0x1a, 0x91, 0x3f, 0x6e, 0x2c, 0x7e,
+	0xf5, 0xd5, 0x0f, 0x66, 0x00, 0x7c, 0xf4, 0x8a, 0xc0, 0x01, 0x98, 0x47, 0xfd, 0x7e, 0x80, 0xfb,
+	0xa5, 0x83, 0x9d, 0xa8, 0x33, 0xa2, 0x50, 0x99, 0xcb, 0x2f, 0x4f, 0xf3, 0xfa, 0xf6, 0x8d, 0xf8,
+	0x64, 0x6f, 0x9c, 0xf6, 0x64, 0xbb, 0x84, 0x71, 0xb3, 0x56, 0xe2, 0x16, 0x87, 0x7a, 0x17, 0x54,
+	0x44, 0xd7, 0x99, 0x11, 0x55, 0xbf, 0x3a, 0xa5, 0xea, 0x25, 0x81, 0xa2, 0xf7, 0x88, 0x18, 0x78,
+	0x0d, 0xd4, 0x88, 0x67, 0xbb, 0xc3, 0x38, 0x33, 0x56, 0x17, 0xbb, 0xe8, 0x28, 0x4d, 0xfa, 0x5c,
+	0x6e, 0xde, 0x8b, 0xad, 0xf0, 0x39, 0x30, 0xe7, 0x07, 0xd4, 0xa7, 0x0c, 0x07, 0x69, 0xbb, 0xa8,
+	0x08, 0xdc, 0xc5, 0xcc, 0x2a, 0x5a, 0x85, 0x7a, 0x0b, 0x2c, 0xe6, 0x39, 0x7a, 0x5d, 0xb4, 0xff,
+	0xb8, 0xe3, 0x14, 0x15, 0x95, 0x4a, 0x15, 0x85, 0x10, 0x54, 0x8a, 0xb4, 0x9b, 0xe2, 0x5b, 0xfd,
+	0x58, 0x02, 0xb5, 0xfb, 0x79, 0x6f, 0x35, 0x10, 0xb7, 0x1d, 0x78, 0x7b, 0x7c, 0x6a, 0x90, 0xce,
+	0x3e, 0x34, 0xdc, 0x1e, 0x1f, 0x1a, 0x66, 0xce, 0x3c, 0x33, 0xa8, 0x2d, 0x50, 0x15, 0x93, 0xd8,
+	0xc3, 0xe1, 0x60, 0x80, 0x82, 0xa3, 0x58, 0xbb, 0x18, 0xb6, 0xa4, 0xd2, 0xac, 0x35, 0x61, 0x3f,
+	0x46, 0xf5, 0xfb, 0x93, 0x35, 0xe9, 0x87, 0x93, 0x35, 0xe9, 0xf7, 0x93, 0x35, 0xa9, 0xf3, 0xa4,
+	0x98, 0xee, 0xb6, 0xfe, 0x1b, 0x00, 0xc9, 0xc4, 0xc7, 0x0c, 0xa6, 0x0a, 0x00, 0x00,
 }
 
 func (m *BeaconState) Marshal() (dAtA []byte, err error) {
@@ -967,6 +1027,47 @@ func (m *Fork) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ForkData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForkData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForkData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.GenesisValidatorsRoot) > 0 {
+		i -= len(m.GenesisValidatorsRoot)
+		copy(dAtA[i:], m.GenesisValidatorsRoot)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.GenesisValidatorsRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CurrentVersion) > 0 {
+		i -= len(m.CurrentVersion)
+		copy(dAtA[i:], m.CurrentVersion)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.CurrentVersion)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *PendingAttestation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1291,6 +1392,26 @@ func (m *Fork) Size() (n int) {
 	return n
 }
 
+func (m *ForkData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CurrentVersion)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.GenesisValidatorsRoot)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *PendingAttestation) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2303,6 +2424,128 @@ func (m *Fork) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ForkData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ForkData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ForkData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentVersion", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentVersion = append(m.CurrentVersion[:0], dAtA[iNdEx:postIndex]...)
+			if m.CurrentVersion == nil {
+				m.CurrentVersion = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisValidatorsRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GenesisValidatorsRoot = append(m.GenesisValidatorsRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.GenesisValidatorsRoot == nil {
+				m.GenesisValidatorsRoot = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *PendingAttestation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,566 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: proto/beacon/rpc/v1/block_body_provider.proto
+
+package ethereum_beacon_rpc_v1
+
+import (
+	context "context"
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	proto "github.com/gogo/protobuf/proto"
+	v1alpha1 "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type BlockBodyRequest struct {
+	Slot                 uint64   `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	ParentRoot           []byte   `protobuf:"bytes,2,opt,name=parent_root,json=parentRoot,proto3" json:"parent_root,omitempty"`
+	RandaoReveal         []byte   `protobuf:"bytes,3,opt,name=randao_reveal,json=randaoReveal,proto3" json:"randao_reveal,omitempty"`
+	Graffiti             []byte   `protobuf:"bytes,4,opt,name=graffiti,proto3" json:"graffiti,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BlockBodyRequest) Reset()         { *m = BlockBodyRequest{} }
+func (m *BlockBodyRequest) String() string { return proto.CompactTextString(m) }
+func (*BlockBodyRequest) ProtoMessage()    {}
+func (*BlockBodyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16890fa252da3b50, []int{0}
+}
+func (m *BlockBodyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockBodyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockBodyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockBodyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockBodyRequest.Merge(m, src)
+}
+func (m *BlockBodyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockBodyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockBodyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockBodyRequest proto.InternalMessageInfo
+
+func (m *BlockBodyRequest) GetSlot() uint64 {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+func (m *BlockBodyRequest) GetParentRoot() []byte {
+	if m != nil {
+		return m.ParentRoot
+	}
+	return nil
+}
+
+func (m *BlockBodyRequest) GetRandaoReveal() []byte {
+	if m != nil {
+		return m.RandaoReveal
+	}
+	return nil
+}
+
+func (m *BlockBodyRequest) GetGraffiti() []byte {
+	if m != nil {
+		return m.Graffiti
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*BlockBodyRequest)(nil), "ethereum.beacon.rpc.v1.BlockBodyRequest")
+}
+
+func init() {
+	proto.RegisterFile("proto/beacon/rpc/v1/block_body_provider.proto", fileDescriptor_16890fa252da3b50)
+}
+
+var fileDescriptor_16890fa252da3b50 = []byte{
+	// 269 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0x41, 0x4e, 0x84, 0x40,
+	0x10, 0x45, 0x6d, 0x25, 0xc6, 0xb4, 0x98, 0x68, 0x2f, 0x0c, 0x61, 0xc1, 0x4c, 0xc6, 0xc4, 0xb0,
+	0xb1, 0x09, 0x7a, 0x03, 0x36, 0x6e, 0x0d, 0x17, 0x20, 0x0d, 0xd4, 0x08, 0x11, 0xa9, 0xb6, 0xa6,
+	0x06, 0x33, 0x37, 0xf0, 0x68, 0x2e, 0x3d, 0x82, 0xe1, 0x24, 0xc6, 0x66, 0xc4, 0xc4, 0xb8, 0xeb,
+	0xfa, 0xfd, 0x7e, 0xa5, 0xfe, 0x97, 0x37, 0x96, 0x90, 0x31, 0x29, 0xc1, 0x54, 0xd8, 0x27, 0x64,
+	0xab, 0x64, 0x48, 0x93, 0xb2, 0xc3, 0xea, 0xa9, 0x28, 0xb1, 0xde, 0x15, 0x96, 0x70, 0x68, 0x6b,
+	0x20, 0xed, 0x38, 0x75, 0x09, 0xdc, 0x00, 0xc1, 0xf6, 0x59, 0x4f, 0x0e, 0x4d, 0xb6, 0xd2, 0x43,
+	0x1a, 0x2e, 0x80, 0x9b, 0x64, 0x48, 0x4d, 0x67, 0x1b, 0x93, 0xee, 0xb7, 0x15, 0x6e, 0xcd, 0x64,
+	0x5c, 0xbd, 0x09, 0x79, 0x9e, 0x7d, 0xcf, 0x19, 0xd6, 0xbb, 0x1c, 0x5e, 0xb6, 0xb0, 0x61, 0xa5,
+	0xa4, 0xb7, 0xe9, 0x90, 0x03, 0xb1, 0x14, 0xb1, 0x97, 0xbb, 0xb7, 0x5a, 0xc8, 0x53, 0x6b, 0x08,
+	0x7a, 0x2e, 0x08, 0x91, 0x83, 0xc3, 0xa5, 0x88, 0xfd, 0x5c, 0x4e, 0x52, 0x8e, 0xc8, 0xea, 0x4a,
+	0x9e, 0x91, 0xe9, 0x6b, 0x83, 0x05, 0xc1, 0x00, 0xa6, 0x0b, 0x8e, 0x1c, 0xe2, 0x4f, 0x62, 0xee,
+	0x34, 0x15, 0xca, 0x93, 0x47, 0x32, 0xeb, 0x75, 0xcb, 0x6d, 0xe0, 0xb9, 0xff, 0x79, 0xbe, 0x7d,
+	0x95, 0x17, 0xf3, 0x25, 0x0f, 0xfb, 0x78, 0xaa, 0x94, 0xfe, 0x3d, 0xf0, 0xac, 0xab, 0x58, 0xff,
+	0x9f, 0x54, 0xff, 0x0d, 0x11, 0x5e, 0xff, 0x92, 0xc0, 0x8d, 0xfe, 0x29, 0x41, 0x67, 0xce, 0x36,
+	0xe3, 0xab, 0x83, 0xcc, 0x7f, 0x1f, 0x23, 0xf1, 0x31, 0x46, 0xe2, 0x73, 0x8c, 0x44, 0x79, 0xec,
+	0x8a, 0xb9, 0xfb, 0x1a, 0x00, 0x42, 0x21, 0x40, 0xc9, 0x82, 0x01, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// BlockBodyProviderClient is the client API for BlockBodyProvider service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type BlockBodyProviderClient interface {
+	GetBlockBody(ctx context.Context, in *BlockBodyRequest, opts ...grpc.CallOption) (*v1alpha1.BeaconBlockBody, error)
+}
+
+type blockBodyProviderClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewBlockBodyProviderClient(cc *grpc.ClientConn) BlockBodyProviderClient {
+	return &blockBodyProviderClient{cc}
+}
+
+func (c *blockBodyProviderClient) GetBlockBody(ctx context.Context, in *BlockBodyRequest, opts ...grpc.CallOption) (*v1alpha1.BeaconBlockBody, error) {
+	out := new(v1alpha1.BeaconBlockBody)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BlockBodyProvider/GetBlockBody", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BlockBodyProviderServer is the server API for BlockBodyProvider service.
+type BlockBodyProviderServer interface {
+	GetBlockBody(context.Context, *BlockBodyRequest) (*v1alpha1.BeaconBlockBody, error)
+}
+
+// UnimplementedBlockBodyProviderServer can be embedded to have forward compatible implementations.
+type UnimplementedBlockBodyProviderServer struct {
+}
+
+func (*UnimplementedBlockBodyProviderServer) GetBlockBody(ctx context.Context, req *BlockBodyRequest) (*v1alpha1.BeaconBlockBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockBody not implemented")
+}
+
+func RegisterBlockBodyProviderServer(s *grpc.Server, srv BlockBodyProviderServer) {
+	s.RegisterService(&_BlockBodyProvider_serviceDesc, srv)
+}
+
+func _BlockBodyProvider_GetBlockBody_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockBodyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockBodyProviderServer).GetBlockBody(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BlockBodyProvider/GetBlockBody",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockBodyProviderServer).GetBlockBody(ctx, req.(*BlockBodyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _BlockBodyProvider_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.BlockBodyProvider",
+	HandlerType: (*BlockBodyProviderServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetBlockBody",
+			Handler:    _BlockBodyProvider_GetBlockBody_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/beacon/rpc/v1/block_body_provider.proto",
+}
+
+func (m *BlockBodyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockBodyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockBodyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Graffiti) > 0 {
+		i -= len(m.Graffiti)
+		copy(dAtA[i:], m.Graffiti)
+		i = encodeVarintBlockBodyProvider(dAtA, i, uint64(len(m.Graffiti)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RandaoReveal) > 0 {
+		i -= len(m.RandaoReveal)
+		copy(dAtA[i:], m.RandaoReveal)
+		i = encodeVarintBlockBodyProvider(dAtA, i, uint64(len(m.RandaoReveal)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ParentRoot) > 0 {
+		i -= len(m.ParentRoot)
+		copy(dAtA[i:], m.ParentRoot)
+		i = encodeVarintBlockBodyProvider(dAtA, i, uint64(len(m.ParentRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Slot != 0 {
+		i = encodeVarintBlockBodyProvider(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBlockBodyProvider(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBlockBodyProvider(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BlockBodyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Slot != 0 {
+		n += 1 + sovBlockBodyProvider(uint64(m.Slot))
+	}
+	l = len(m.ParentRoot)
+	if l > 0 {
+		n += 1 + l + sovBlockBodyProvider(uint64(l))
+	}
+	l = len(m.RandaoReveal)
+	if l > 0 {
+		n += 1 + l + sovBlockBodyProvider(uint64(l))
+	}
+	l = len(m.Graffiti)
+	if l > 0 {
+		n += 1 + l + sovBlockBodyProvider(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovBlockBodyProvider(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBlockBodyProvider(x uint64) (n int) {
+	return sovBlockBodyProvider(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BlockBodyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlockBodyProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockBodyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockBodyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlockBodyProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlockBodyProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlockBodyProvider
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlockBodyProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParentRoot = append(m.ParentRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.ParentRoot == nil {
+				m.ParentRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RandaoReveal", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlockBodyProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlockBodyProvider
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlockBodyProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RandaoReveal = append(m.RandaoReveal[:0], dAtA[iNdEx:postIndex]...)
+			if m.RandaoReveal == nil {
+				m.RandaoReveal = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Graffiti", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlockBodyProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlockBodyProvider
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlockBodyProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Graffiti = append(m.Graffiti[:0], dAtA[iNdEx:postIndex]...)
+			if m.Graffiti == nil {
+				m.Graffiti = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlockBodyProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlockBodyProvider
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBlockBodyProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipBlockBodyProvider(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowBlockBodyProvider
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBlockBodyProvider
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBlockBodyProvider
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthBlockBodyProvider
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupBlockBodyProvider
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthBlockBodyProvider
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthBlockBodyProvider        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowBlockBodyProvider          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupBlockBodyProvider = fmt.Errorf("proto: unexpected end of group")
+)
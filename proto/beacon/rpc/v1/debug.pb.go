@@ -0,0 +1,3323 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: proto/beacon/rpc/v1/debug.proto
+
+package ethereum_beacon_rpc_v1
+
+import (
+	context "context"
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type StateEncoding int32
+
+const (
+	StateEncoding_SSZ  StateEncoding = 0
+	StateEncoding_JSON StateEncoding = 1
+)
+
+var StateEncoding_name = map[int32]string{
+	0: "SSZ",
+	1: "JSON",
+}
+
+var StateEncoding_value = map[string]int32{
+	"SSZ":  0,
+	"JSON": 1,
+}
+
+func (x StateEncoding) String() string {
+	return proto.EnumName(StateEncoding_name, int32(x))
+}
+
+func (StateEncoding) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{0}
+}
+
+type ForkChoiceGraphFormat int32
+
+const (
+	ForkChoiceGraphFormat_NO_GRAPH ForkChoiceGraphFormat = 0
+	ForkChoiceGraphFormat_DOT      ForkChoiceGraphFormat = 1
+	ForkChoiceGraphFormat_MERMAID  ForkChoiceGraphFormat = 2
+)
+
+var ForkChoiceGraphFormat_name = map[int32]string{
+	0: "NO_GRAPH",
+	1: "DOT",
+	2: "MERMAID",
+}
+
+var ForkChoiceGraphFormat_value = map[string]int32{
+	"NO_GRAPH": 0,
+	"DOT":      1,
+	"MERMAID":  2,
+}
+
+func (x ForkChoiceGraphFormat) String() string {
+	return proto.EnumName(ForkChoiceGraphFormat_name, int32(x))
+}
+
+func (ForkChoiceGraphFormat) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{1}
+}
+
+type BeaconStateRequest struct {
+	// Types that are valid to be assigned to QueryFilter:
+	//	*BeaconStateRequest_Slot
+	//	*BeaconStateRequest_BlockRoot
+	//	*BeaconStateRequest_StateRoot
+	QueryFilter          isBeaconStateRequest_QueryFilter `protobuf_oneof:"query_filter"`
+	Encoding             StateEncoding                    `protobuf:"varint,4,opt,name=encoding,proto3,enum=ethereum.beacon.rpc.v1.StateEncoding" json:"encoding,omitempty"`
+	Fields               []string                         `protobuf:"bytes,5,rep,name=fields,proto3" json:"fields,omitempty"`
+	IncludeProofs        bool                             `protobuf:"varint,6,opt,name=include_proofs,json=includeProofs,proto3" json:"include_proofs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                         `json:"-"`
+	XXX_unrecognized     []byte                           `json:"-"`
+	XXX_sizecache        int32                            `json:"-"`
+}
+
+func (m *BeaconStateRequest) Reset()         { *m = BeaconStateRequest{} }
+func (m *BeaconStateRequest) String() string { return proto.CompactTextString(m) }
+func (*BeaconStateRequest) ProtoMessage()    {}
+func (*BeaconStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{0}
+}
+func (m *BeaconStateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BeaconStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BeaconStateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BeaconStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BeaconStateRequest.Merge(m, src)
+}
+func (m *BeaconStateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BeaconStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BeaconStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BeaconStateRequest proto.InternalMessageInfo
+
+type isBeaconStateRequest_QueryFilter interface {
+	isBeaconStateRequest_QueryFilter()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type BeaconStateRequest_Slot struct {
+	Slot uint64 `protobuf:"varint,1,opt,name=slot,proto3,oneof" json:"slot,omitempty"`
+}
+type BeaconStateRequest_BlockRoot struct {
+	BlockRoot []byte `protobuf:"bytes,2,opt,name=block_root,json=blockRoot,proto3,oneof" json:"block_root,omitempty"`
+}
+type BeaconStateRequest_StateRoot struct {
+	StateRoot []byte `protobuf:"bytes,3,opt,name=state_root,json=stateRoot,proto3,oneof" json:"state_root,omitempty"`
+}
+
+func (*BeaconStateRequest_Slot) isBeaconStateRequest_QueryFilter()      {}
+func (*BeaconStateRequest_BlockRoot) isBeaconStateRequest_QueryFilter() {}
+func (*BeaconStateRequest_StateRoot) isBeaconStateRequest_QueryFilter() {}
+
+func (m *BeaconStateRequest) GetQueryFilter() isBeaconStateRequest_QueryFilter {
+	if m != nil {
+		return m.QueryFilter
+	}
+	return nil
+}
+
+func (m *BeaconStateRequest) GetSlot() uint64 {
+	if x, ok := m.GetQueryFilter().(*BeaconStateRequest_Slot); ok {
+		return x.Slot
+	}
+	return 0
+}
+
+func (m *BeaconStateRequest) GetBlockRoot() []byte {
+	if x, ok := m.GetQueryFilter().(*BeaconStateRequest_BlockRoot); ok {
+		return x.BlockRoot
+	}
+	return nil
+}
+
+func (m *BeaconStateRequest) GetStateRoot() []byte {
+	if x, ok := m.GetQueryFilter().(*BeaconStateRequest_StateRoot); ok {
+		return x.StateRoot
+	}
+	return nil
+}
+
+func (m *BeaconStateRequest) GetEncoding() StateEncoding {
+	if m != nil {
+		return m.Encoding
+	}
+	return StateEncoding_SSZ
+}
+
+func (m *BeaconStateRequest) GetFields() []string {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+func (m *BeaconStateRequest) GetIncludeProofs() bool {
+	if m != nil {
+		return m.IncludeProofs
+	}
+	return false
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*BeaconStateRequest) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*BeaconStateRequest_Slot)(nil),
+		(*BeaconStateRequest_BlockRoot)(nil),
+		(*BeaconStateRequest_StateRoot)(nil),
+	}
+}
+
+type BeaconStateResponse struct {
+	Slot                 uint64              `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	StateRoot            []byte              `protobuf:"bytes,2,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
+	Encoded              []byte              `protobuf:"bytes,3,opt,name=encoded,proto3" json:"encoded,omitempty"`
+	Fields               []*BeaconStateField `protobuf:"bytes,4,rep,name=fields,proto3" json:"fields,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *BeaconStateResponse) Reset()         { *m = BeaconStateResponse{} }
+func (m *BeaconStateResponse) String() string { return proto.CompactTextString(m) }
+func (*BeaconStateResponse) ProtoMessage()    {}
+func (*BeaconStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{1}
+}
+func (m *BeaconStateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BeaconStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BeaconStateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BeaconStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BeaconStateResponse.Merge(m, src)
+}
+func (m *BeaconStateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *BeaconStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BeaconStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BeaconStateResponse proto.InternalMessageInfo
+
+func (m *BeaconStateResponse) GetSlot() uint64 {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+func (m *BeaconStateResponse) GetStateRoot() []byte {
+	if m != nil {
+		return m.StateRoot
+	}
+	return nil
+}
+
+func (m *BeaconStateResponse) GetEncoded() []byte {
+	if m != nil {
+		return m.Encoded
+	}
+	return nil
+}
+
+func (m *BeaconStateResponse) GetFields() []*BeaconStateField {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+type BeaconStateField struct {
+	Path                 string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Encoded              []byte   `protobuf:"bytes,2,opt,name=encoded,proto3" json:"encoded,omitempty"`
+	GeneralizedIndex     uint64   `protobuf:"varint,3,opt,name=generalized_index,json=generalizedIndex,proto3" json:"generalized_index,omitempty"`
+	Proof                [][]byte `protobuf:"bytes,4,rep,name=proof,proto3" json:"proof,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BeaconStateField) Reset()         { *m = BeaconStateField{} }
+func (m *BeaconStateField) String() string { return proto.CompactTextString(m) }
+func (*BeaconStateField) ProtoMessage()    {}
+func (*BeaconStateField) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{2}
+}
+func (m *BeaconStateField) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BeaconStateField) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BeaconStateField.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BeaconStateField) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BeaconStateField.Merge(m, src)
+}
+func (m *BeaconStateField) XXX_Size() int {
+	return m.Size()
+}
+func (m *BeaconStateField) XXX_DiscardUnknown() {
+	xxx_messageInfo_BeaconStateField.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BeaconStateField proto.InternalMessageInfo
+
+func (m *BeaconStateField) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *BeaconStateField) GetEncoded() []byte {
+	if m != nil {
+		return m.Encoded
+	}
+	return nil
+}
+
+func (m *BeaconStateField) GetGeneralizedIndex() uint64 {
+	if m != nil {
+		return m.GeneralizedIndex
+	}
+	return 0
+}
+
+func (m *BeaconStateField) GetProof() [][]byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+type BeaconStateChunk struct {
+	Slot                 uint64   `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	StateRoot            []byte   `protobuf:"bytes,2,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
+	TotalSize            uint64   `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	Offset               uint64   `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	Data                 []byte   `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BeaconStateChunk) Reset()         { *m = BeaconStateChunk{} }
+func (m *BeaconStateChunk) String() string { return proto.CompactTextString(m) }
+func (*BeaconStateChunk) ProtoMessage()    {}
+func (*BeaconStateChunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{3}
+}
+func (m *BeaconStateChunk) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BeaconStateChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BeaconStateChunk.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BeaconStateChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BeaconStateChunk.Merge(m, src)
+}
+func (m *BeaconStateChunk) XXX_Size() int {
+	return m.Size()
+}
+func (m *BeaconStateChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_BeaconStateChunk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BeaconStateChunk proto.InternalMessageInfo
+
+func (m *BeaconStateChunk) GetSlot() uint64 {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+func (m *BeaconStateChunk) GetStateRoot() []byte {
+	if m != nil {
+		return m.StateRoot
+	}
+	return nil
+}
+
+func (m *BeaconStateChunk) GetTotalSize() uint64 {
+	if m != nil {
+		return m.TotalSize
+	}
+	return 0
+}
+
+func (m *BeaconStateChunk) GetOffset() uint64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *BeaconStateChunk) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type ForkChoiceRequest struct {
+	GraphFormat          ForkChoiceGraphFormat `protobuf:"varint,1,opt,name=graph_format,json=graphFormat,proto3,enum=ethereum.beacon.rpc.v1.ForkChoiceGraphFormat" json:"graph_format,omitempty"`
+	ExcludeVotes         bool                  `protobuf:"varint,2,opt,name=exclude_votes,json=excludeVotes,proto3" json:"exclude_votes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ForkChoiceRequest) Reset()         { *m = ForkChoiceRequest{} }
+func (m *ForkChoiceRequest) String() string { return proto.CompactTextString(m) }
+func (*ForkChoiceRequest) ProtoMessage()    {}
+func (*ForkChoiceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{4}
+}
+func (m *ForkChoiceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForkChoiceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForkChoiceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ForkChoiceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForkChoiceRequest.Merge(m, src)
+}
+func (m *ForkChoiceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForkChoiceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForkChoiceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForkChoiceRequest proto.InternalMessageInfo
+
+func (m *ForkChoiceRequest) GetGraphFormat() ForkChoiceGraphFormat {
+	if m != nil {
+		return m.GraphFormat
+	}
+	return ForkChoiceGraphFormat_NO_GRAPH
+}
+
+func (m *ForkChoiceRequest) GetExcludeVotes() bool {
+	if m != nil {
+		return m.ExcludeVotes
+	}
+	return false
+}
+
+type ForkChoiceResponse struct {
+	JustifiedEpoch       uint64            `protobuf:"varint,1,opt,name=justified_epoch,json=justifiedEpoch,proto3" json:"justified_epoch,omitempty"`
+	FinalizedEpoch       uint64            `protobuf:"varint,2,opt,name=finalized_epoch,json=finalizedEpoch,proto3" json:"finalized_epoch,omitempty"`
+	Nodes                []*ForkChoiceNode `protobuf:"bytes,3,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	Votes                []*ForkChoiceVote `protobuf:"bytes,4,rep,name=votes,proto3" json:"votes,omitempty"`
+	Graph                string            `protobuf:"bytes,5,opt,name=graph,proto3" json:"graph,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ForkChoiceResponse) Reset()         { *m = ForkChoiceResponse{} }
+func (m *ForkChoiceResponse) String() string { return proto.CompactTextString(m) }
+func (*ForkChoiceResponse) ProtoMessage()    {}
+func (*ForkChoiceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{5}
+}
+func (m *ForkChoiceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForkChoiceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForkChoiceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ForkChoiceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForkChoiceResponse.Merge(m, src)
+}
+func (m *ForkChoiceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForkChoiceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForkChoiceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForkChoiceResponse proto.InternalMessageInfo
+
+func (m *ForkChoiceResponse) GetJustifiedEpoch() uint64 {
+	if m != nil {
+		return m.JustifiedEpoch
+	}
+	return 0
+}
+
+func (m *ForkChoiceResponse) GetFinalizedEpoch() uint64 {
+	if m != nil {
+		return m.FinalizedEpoch
+	}
+	return 0
+}
+
+func (m *ForkChoiceResponse) GetNodes() []*ForkChoiceNode {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+func (m *ForkChoiceResponse) GetVotes() []*ForkChoiceVote {
+	if m != nil {
+		return m.Votes
+	}
+	return nil
+}
+
+func (m *ForkChoiceResponse) GetGraph() string {
+	if m != nil {
+		return m.Graph
+	}
+	return ""
+}
+
+type ForkChoiceNode struct {
+	Slot                 uint64   `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	Root                 []byte   `protobuf:"bytes,2,opt,name=root,proto3" json:"root,omitempty"`
+	ParentRoot           []byte   `protobuf:"bytes,3,opt,name=parent_root,json=parentRoot,proto3" json:"parent_root,omitempty"`
+	JustifiedEpoch       uint64   `protobuf:"varint,4,opt,name=justified_epoch,json=justifiedEpoch,proto3" json:"justified_epoch,omitempty"`
+	FinalizedEpoch       uint64   `protobuf:"varint,5,opt,name=finalized_epoch,json=finalizedEpoch,proto3" json:"finalized_epoch,omitempty"`
+	Weight               uint64   `protobuf:"varint,6,opt,name=weight,proto3" json:"weight,omitempty"`
+	BestChild            []byte   `protobuf:"bytes,7,opt,name=best_child,json=bestChild,proto3" json:"best_child,omitempty"`
+	BestDescendant       []byte   `protobuf:"bytes,8,opt,name=best_descendant,json=bestDescendant,proto3" json:"best_descendant,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ForkChoiceNode) Reset()         { *m = ForkChoiceNode{} }
+func (m *ForkChoiceNode) String() string { return proto.CompactTextString(m) }
+func (*ForkChoiceNode) ProtoMessage()    {}
+func (*ForkChoiceNode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{6}
+}
+func (m *ForkChoiceNode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForkChoiceNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForkChoiceNode.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ForkChoiceNode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForkChoiceNode.Merge(m, src)
+}
+func (m *ForkChoiceNode) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForkChoiceNode) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForkChoiceNode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForkChoiceNode proto.InternalMessageInfo
+
+func (m *ForkChoiceNode) GetSlot() uint64 {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+func (m *ForkChoiceNode) GetRoot() []byte {
+	if m != nil {
+		return m.Root
+	}
+	return nil
+}
+
+func (m *ForkChoiceNode) GetParentRoot() []byte {
+	if m != nil {
+		return m.ParentRoot
+	}
+	return nil
+}
+
+func (m *ForkChoiceNode) GetJustifiedEpoch() uint64 {
+	if m != nil {
+		return m.JustifiedEpoch
+	}
+	return 0
+}
+
+func (m *ForkChoiceNode) GetFinalizedEpoch() uint64 {
+	if m != nil {
+		return m.FinalizedEpoch
+	}
+	return 0
+}
+
+func (m *ForkChoiceNode) GetWeight() uint64 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+func (m *ForkChoiceNode) GetBestChild() []byte {
+	if m != nil {
+		return m.BestChild
+	}
+	return nil
+}
+
+func (m *ForkChoiceNode) GetBestDescendant() []byte {
+	if m != nil {
+		return m.BestDescendant
+	}
+	return nil
+}
+
+type ForkChoiceVote struct {
+	ValidatorIndex       uint64   `protobuf:"varint,1,opt,name=validator_index,json=validatorIndex,proto3" json:"validator_index,omitempty"`
+	CurrentRoot          []byte   `protobuf:"bytes,2,opt,name=current_root,json=currentRoot,proto3" json:"current_root,omitempty"`
+	NextRoot             []byte   `protobuf:"bytes,3,opt,name=next_root,json=nextRoot,proto3" json:"next_root,omitempty"`
+	NextEpoch            uint64   `protobuf:"varint,4,opt,name=next_epoch,json=nextEpoch,proto3" json:"next_epoch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ForkChoiceVote) Reset()         { *m = ForkChoiceVote{} }
+func (m *ForkChoiceVote) String() string { return proto.CompactTextString(m) }
+func (*ForkChoiceVote) ProtoMessage()    {}
+func (*ForkChoiceVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{7}
+}
+func (m *ForkChoiceVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForkChoiceVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForkChoiceVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ForkChoiceVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForkChoiceVote.Merge(m, src)
+}
+func (m *ForkChoiceVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForkChoiceVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForkChoiceVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForkChoiceVote proto.InternalMessageInfo
+
+func (m *ForkChoiceVote) GetValidatorIndex() uint64 {
+	if m != nil {
+		return m.ValidatorIndex
+	}
+	return 0
+}
+
+func (m *ForkChoiceVote) GetCurrentRoot() []byte {
+	if m != nil {
+		return m.CurrentRoot
+	}
+	return nil
+}
+
+func (m *ForkChoiceVote) GetNextRoot() []byte {
+	if m != nil {
+		return m.NextRoot
+	}
+	return nil
+}
+
+func (m *ForkChoiceVote) GetNextEpoch() uint64 {
+	if m != nil {
+		return m.NextEpoch
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("ethereum.beacon.rpc.v1.StateEncoding", StateEncoding_name, StateEncoding_value)
+	proto.RegisterEnum("ethereum.beacon.rpc.v1.ForkChoiceGraphFormat", ForkChoiceGraphFormat_name, ForkChoiceGraphFormat_value)
+	proto.RegisterType((*BeaconStateRequest)(nil), "ethereum.beacon.rpc.v1.BeaconStateRequest")
+	proto.RegisterType((*BeaconStateResponse)(nil), "ethereum.beacon.rpc.v1.BeaconStateResponse")
+	proto.RegisterType((*BeaconStateField)(nil), "ethereum.beacon.rpc.v1.BeaconStateField")
+	proto.RegisterType((*BeaconStateChunk)(nil), "ethereum.beacon.rpc.v1.BeaconStateChunk")
+	proto.RegisterType((*ForkChoiceRequest)(nil), "ethereum.beacon.rpc.v1.ForkChoiceRequest")
+	proto.RegisterType((*ForkChoiceResponse)(nil), "ethereum.beacon.rpc.v1.ForkChoiceResponse")
+	proto.RegisterType((*ForkChoiceNode)(nil), "ethereum.beacon.rpc.v1.ForkChoiceNode")
+	proto.RegisterType((*ForkChoiceVote)(nil), "ethereum.beacon.rpc.v1.ForkChoiceVote")
+}
+
+func init() { proto.RegisterFile("proto/beacon/rpc/v1/debug.proto", fileDescriptor_851e5cb2de3d61dd) }
+
+var fileDescriptor_851e5cb2de3d61dd = []byte{
+	// 931 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x5f, 0x6f, 0xe3, 0xc4,
+	0x17, 0x8d, 0x13, 0xa7, 0x4d, 0x6e, 0xd2, 0xfc, 0xd2, 0xf9, 0x2d, 0x95, 0xd9, 0x65, 0xdb, 0xac,
+	0xd1, 0x2e, 0xa1, 0x2b, 0x12, 0x5a, 0x1e, 0xe1, 0x81, 0xfe, 0xef, 0x22, 0x6d, 0x5b, 0x4d, 0x10,
+	0x0f, 0xbc, 0x44, 0x8e, 0x7d, 0x13, 0x9b, 0xba, 0x1e, 0xaf, 0x3d, 0x09, 0xa5, 0x8f, 0xf0, 0x06,
+	0x08, 0x21, 0x21, 0x21, 0x3e, 0x00, 0x1f, 0x86, 0x47, 0x24, 0xbe, 0x00, 0xaa, 0xf8, 0x10, 0x3c,
+	0x21, 0x34, 0x77, 0x9c, 0xd4, 0x41, 0x59, 0x6d, 0xb4, 0x6f, 0x9e, 0x33, 0xe7, 0x8e, 0xcf, 0x39,
+	0xbe, 0x73, 0x13, 0xd8, 0x8a, 0x13, 0x21, 0x45, 0x77, 0x80, 0x8e, 0x2b, 0xa2, 0x6e, 0x12, 0xbb,
+	0xdd, 0xc9, 0x4e, 0xd7, 0xc3, 0xc1, 0x78, 0xd4, 0xa1, 0x1d, 0xb6, 0x81, 0xd2, 0xc7, 0x04, 0xc7,
+	0x57, 0x1d, 0xcd, 0xe9, 0x24, 0xb1, 0xdb, 0x99, 0xec, 0xdc, 0x7f, 0x6b, 0x24, 0xc4, 0x28, 0xc4,
+	0xae, 0x13, 0x07, 0x5d, 0x27, 0x8a, 0x84, 0x74, 0x64, 0x20, 0xa2, 0x54, 0x57, 0xd9, 0xff, 0x18,
+	0xc0, 0xf6, 0x89, 0xdf, 0x93, 0x8e, 0x44, 0x8e, 0x2f, 0xc6, 0x98, 0x4a, 0x76, 0x0f, 0xcc, 0x34,
+	0x14, 0xd2, 0x32, 0x5a, 0x46, 0xdb, 0x3c, 0x2d, 0x70, 0x5a, 0xb1, 0x2d, 0x80, 0x41, 0x28, 0xdc,
+	0xcb, 0x7e, 0x22, 0x84, 0xb4, 0x8a, 0x2d, 0xa3, 0x5d, 0x3f, 0x2d, 0xf0, 0x2a, 0x61, 0x5c, 0x68,
+	0x42, 0xaa, 0x8e, 0xd1, 0x84, 0xd2, 0x94, 0x40, 0x18, 0x11, 0xf6, 0xa0, 0x82, 0x91, 0x2b, 0xbc,
+	0x20, 0x1a, 0x59, 0x66, 0xcb, 0x68, 0x37, 0x76, 0x1f, 0x77, 0x16, 0xeb, 0xee, 0x90, 0x9e, 0xa3,
+	0x8c, 0xcc, 0x67, 0x65, 0x6c, 0x03, 0x56, 0x86, 0x01, 0x86, 0x5e, 0x6a, 0x95, 0x5b, 0xa5, 0x76,
+	0x95, 0x67, 0x2b, 0xf6, 0x18, 0x1a, 0x41, 0xe4, 0x86, 0x63, 0x0f, 0xfb, 0x71, 0x22, 0xc4, 0x30,
+	0xb5, 0x56, 0x5a, 0x46, 0xbb, 0xc2, 0xd7, 0x32, 0xf4, 0x82, 0xc0, 0xfd, 0x06, 0xd4, 0x5f, 0x8c,
+	0x31, 0xf9, 0xaa, 0x3f, 0x0c, 0x42, 0x89, 0x89, 0xfd, 0xab, 0x01, 0xff, 0x9f, 0x0b, 0x20, 0x8d,
+	0x45, 0x94, 0x22, 0x63, 0xf9, 0x04, 0x32, 0xff, 0x0f, 0xe7, 0xec, 0x91, 0xff, 0xbc, 0x39, 0x0b,
+	0x56, 0x49, 0x25, 0x7a, 0xda, 0x3a, 0x9f, 0x2e, 0xd9, 0xc7, 0x33, 0xcd, 0x66, 0xab, 0xd4, 0xae,
+	0xed, 0xb6, 0x5f, 0x66, 0x3a, 0xa7, 0xe4, 0x58, 0x15, 0x4c, 0xdd, 0xd9, 0xdf, 0x18, 0xd0, 0xfc,
+	0xef, 0xa6, 0xd2, 0x18, 0x3b, 0xd2, 0x27, 0x8d, 0x55, 0x4e, 0xcf, 0x79, 0x11, 0xc5, 0x79, 0x11,
+	0x4f, 0x61, 0x7d, 0x84, 0x11, 0x26, 0x4e, 0x18, 0xdc, 0xa0, 0xd7, 0x0f, 0x22, 0x0f, 0xaf, 0x49,
+	0xa8, 0xc9, 0x9b, 0xb9, 0x8d, 0x67, 0x0a, 0x67, 0xf7, 0xa0, 0x4c, 0x29, 0x92, 0xe0, 0x3a, 0xd7,
+	0x0b, 0xfb, 0xc7, 0x79, 0x15, 0x07, 0xfe, 0x38, 0xba, 0x7c, 0x9d, 0xa4, 0x1e, 0x02, 0x48, 0x21,
+	0x9d, 0xb0, 0x9f, 0x06, 0x37, 0x98, 0x69, 0xa8, 0x12, 0xd2, 0x0b, 0x6e, 0x50, 0x7d, 0x62, 0x31,
+	0x1c, 0xa6, 0x28, 0xa9, 0x47, 0x4c, 0x9e, 0xad, 0xd4, 0x9b, 0x3c, 0x47, 0x3a, 0x56, 0x99, 0xce,
+	0xa3, 0x67, 0xfb, 0x5b, 0x03, 0xd6, 0x8f, 0x45, 0x72, 0x79, 0xe0, 0x8b, 0xc0, 0x9d, 0xf5, 0xef,
+	0x05, 0xd4, 0x47, 0x89, 0x13, 0xfb, 0xfd, 0xa1, 0x48, 0xae, 0x1c, 0xad, 0xad, 0xb1, 0xfb, 0xde,
+	0xcb, 0x62, 0xbf, 0x3b, 0xe0, 0x44, 0x55, 0x1d, 0x53, 0x11, 0xaf, 0x8d, 0xee, 0x16, 0xec, 0x6d,
+	0x58, 0xc3, 0x6b, 0xdd, 0x5e, 0x13, 0x21, 0x31, 0x25, 0x53, 0x15, 0x5e, 0xcf, 0xc0, 0xcf, 0x14,
+	0x66, 0xff, 0x6d, 0x00, 0xcb, 0x8b, 0xc9, 0x7a, 0xe9, 0x1d, 0xf8, 0xdf, 0x17, 0xe3, 0x54, 0x06,
+	0xc3, 0x00, 0xbd, 0x3e, 0xc6, 0xc2, 0xf5, 0xb3, 0xb0, 0x1a, 0x33, 0xf8, 0x48, 0xa1, 0x8a, 0x38,
+	0x0c, 0xa2, 0xec, 0x03, 0x69, 0x62, 0x51, 0x13, 0x67, 0xb0, 0x26, 0x7e, 0x04, 0xe5, 0x48, 0x78,
+	0x98, 0x5a, 0x25, 0xea, 0xa7, 0x27, 0xaf, 0x36, 0x76, 0x26, 0x3c, 0xe4, 0xba, 0x48, 0x55, 0x6b,
+	0x0f, 0xe6, 0xb2, 0xd5, 0xca, 0x1e, 0xd7, 0x45, 0xaa, 0x35, 0x28, 0x18, 0xfa, 0x0c, 0x55, 0xae,
+	0x17, 0xf6, 0x77, 0x45, 0x68, 0xcc, 0xbf, 0x6d, 0x61, 0x63, 0x30, 0x30, 0x73, 0x2d, 0x41, 0xcf,
+	0x6c, 0x0b, 0x6a, 0xb1, 0x93, 0x60, 0x24, 0x73, 0x63, 0x83, 0x83, 0x86, 0xa8, 0x5d, 0x16, 0xe4,
+	0x67, 0x2e, 0x9b, 0x5f, 0x79, 0x61, 0x7e, 0x1b, 0xb0, 0xf2, 0x25, 0x06, 0x23, 0x5f, 0xd2, 0x90,
+	0x30, 0x79, 0xb6, 0x52, 0x8d, 0x39, 0xc0, 0x54, 0xf6, 0x5d, 0x3f, 0x08, 0x3d, 0x6b, 0x55, 0xf7,
+	0xad, 0x42, 0x0e, 0x14, 0xa0, 0xce, 0xa7, 0x6d, 0x0f, 0x53, 0x17, 0x23, 0xcf, 0x89, 0xa4, 0x55,
+	0x21, 0x4e, 0x43, 0xc1, 0x87, 0x33, 0xd4, 0xfe, 0xc5, 0x80, 0xc6, 0x7c, 0x7a, 0xaa, 0x76, 0xe2,
+	0x84, 0x81, 0xe7, 0x48, 0x91, 0x64, 0x97, 0x2f, 0x6b, 0x82, 0x19, 0xac, 0xaf, 0xde, 0x23, 0xa8,
+	0xbb, 0xe3, 0xe4, 0x2e, 0x0f, 0x1d, 0x55, 0x2d, 0xc3, 0x28, 0x90, 0x07, 0x50, 0x8d, 0xf0, 0x7a,
+	0x2e, 0xaf, 0x8a, 0x02, 0xa6, 0x97, 0x8b, 0x36, 0xf3, 0x41, 0x11, 0x9d, 0xac, 0x6f, 0xdb, 0xb0,
+	0x36, 0x37, 0x5a, 0xd9, 0x2a, 0x94, 0x7a, 0xbd, 0xcf, 0x9b, 0x05, 0x56, 0x01, 0xf3, 0x93, 0xde,
+	0xf9, 0x59, 0xd3, 0xd8, 0xfe, 0x10, 0xde, 0x58, 0x78, 0x25, 0x58, 0x1d, 0x2a, 0x67, 0xe7, 0xfd,
+	0x13, 0xbe, 0x77, 0x71, 0xda, 0x2c, 0xa8, 0xca, 0xc3, 0xf3, 0x4f, 0x9b, 0x06, 0xab, 0xc1, 0xea,
+	0xf3, 0x23, 0xfe, 0x7c, 0xef, 0xd9, 0x61, 0xb3, 0xb8, 0xfb, 0x73, 0x09, 0xca, 0x87, 0xea, 0x87,
+	0x89, 0x7d, 0x6f, 0x40, 0xe3, 0x04, 0x65, 0x6e, 0x62, 0xb0, 0xed, 0x25, 0x26, 0x5f, 0x76, 0x89,
+	0xef, 0x3f, 0x5d, 0x8a, 0xab, 0xef, 0x98, 0xfd, 0xe8, 0xeb, 0x3f, 0xfe, 0xfa, 0xa9, 0xf8, 0x80,
+	0xbd, 0xd9, 0x45, 0xe9, 0x77, 0x27, 0x3b, 0x4e, 0x18, 0xfb, 0x4e, 0xf6, 0x0b, 0xd9, 0xa5, 0xc9,
+	0xc3, 0xae, 0x60, 0xbd, 0x27, 0x13, 0x74, 0xae, 0x5e, 0x57, 0xd0, 0x32, 0x63, 0x9b, 0x66, 0xa2,
+	0x5d, 0x78, 0xdf, 0x60, 0x3f, 0x18, 0xb0, 0x76, 0x82, 0xf2, 0x2e, 0x48, 0xf6, 0xee, 0xab, 0x2f,
+	0xda, 0xf4, 0x55, 0xdb, 0xcb, 0x50, 0x33, 0xeb, 0x4f, 0xc8, 0x7a, 0x8b, 0x6d, 0x2e, 0xb2, 0x3e,
+	0x14, 0xc9, 0xa5, 0x4b, 0xfc, 0xfd, 0xfa, 0x6f, 0xb7, 0x9b, 0xc6, 0xef, 0xb7, 0x9b, 0xc6, 0x9f,
+	0xb7, 0x9b, 0xc6, 0x60, 0x85, 0xfe, 0x00, 0x7c, 0xf0, 0xef, 0x00, 0x55, 0x9b, 0xac, 0x96, 0x59,
+	0x08, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// DebugClient is the client API for Debug service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type DebugClient interface {
+	GetBeaconState(ctx context.Context, in *BeaconStateRequest, opts ...grpc.CallOption) (*BeaconStateResponse, error)
+	StreamBeaconState(ctx context.Context, in *BeaconStateRequest, opts ...grpc.CallOption) (Debug_StreamBeaconStateClient, error)
+	GetForkChoice(ctx context.Context, in *ForkChoiceRequest, opts ...grpc.CallOption) (*ForkChoiceResponse, error)
+}
+
+type debugClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewDebugClient(cc *grpc.ClientConn) DebugClient {
+	return &debugClient{cc}
+}
+
+func (c *debugClient) GetBeaconState(ctx context.Context, in *BeaconStateRequest, opts ...grpc.CallOption) (*BeaconStateResponse, error) {
+	out := new(BeaconStateResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetBeaconState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) StreamBeaconState(ctx context.Context, in *BeaconStateRequest, opts ...grpc.CallOption) (Debug_StreamBeaconStateClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Debug_serviceDesc.Streams[0], "/ethereum.beacon.rpc.v1.Debug/StreamBeaconState", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &debugStreamBeaconStateClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Debug_StreamBeaconStateClient interface {
+	Recv() (*BeaconStateChunk, error)
+	grpc.ClientStream
+}
+
+type debugStreamBeaconStateClient struct {
+	grpc.ClientStream
+}
+
+func (x *debugStreamBeaconStateClient) Recv() (*BeaconStateChunk, error) {
+	m := new(BeaconStateChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *debugClient) GetForkChoice(ctx context.Context, in *ForkChoiceRequest, opts ...grpc.CallOption) (*ForkChoiceResponse, error) {
+	out := new(ForkChoiceResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetForkChoice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DebugServer is the server API for Debug service.
+type DebugServer interface {
+	GetBeaconState(context.Context, *BeaconStateRequest) (*BeaconStateResponse, error)
+	StreamBeaconState(*BeaconStateRequest, Debug_StreamBeaconStateServer) error
+	GetForkChoice(context.Context, *ForkChoiceRequest) (*ForkChoiceResponse, error)
+}
+
+// UnimplementedDebugServer can be embedded to have forward compatible implementations.
+type UnimplementedDebugServer struct {
+}
+
+func (*UnimplementedDebugServer) GetBeaconState(ctx context.Context, req *BeaconStateRequest) (*BeaconStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBeaconState not implemented")
+}
+func (*UnimplementedDebugServer) StreamBeaconState(req *BeaconStateRequest, srv Debug_StreamBeaconStateServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamBeaconState not implemented")
+}
+func (*UnimplementedDebugServer) GetForkChoice(ctx context.Context, req *ForkChoiceRequest) (*ForkChoiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetForkChoice not implemented")
+}
+
+func RegisterDebugServer(s *grpc.Server, srv DebugServer) {
+	s.RegisterService(&_Debug_serviceDesc, srv)
+}
+
+func _Debug_GetBeaconState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BeaconStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).GetBeaconState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/GetBeaconState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).GetBeaconState(ctx, req.(*BeaconStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_StreamBeaconState_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BeaconStateRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DebugServer).StreamBeaconState(m, &debugStreamBeaconStateServer{stream})
+}
+
+type Debug_StreamBeaconStateServer interface {
+	Send(*BeaconStateChunk) error
+	grpc.ServerStream
+}
+
+type debugStreamBeaconStateServer struct {
+	grpc.ServerStream
+}
+
+func (x *debugStreamBeaconStateServer) Send(m *BeaconStateChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Debug_GetForkChoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForkChoiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).GetForkChoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/GetForkChoice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).GetForkChoice(ctx, req.(*ForkChoiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Debug_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.Debug",
+	HandlerType: (*DebugServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetBeaconState",
+			Handler:    _Debug_GetBeaconState_Handler,
+		},
+		{
+			MethodName: "GetForkChoice",
+			Handler:    _Debug_GetForkChoice_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamBeaconState",
+			Handler:       _Debug_StreamBeaconState_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/beacon/rpc/v1/debug.proto",
+}
+
+func (m *BeaconStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BeaconStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BeaconStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.IncludeProofs {
+		i--
+		if m.IncludeProofs {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Fields) > 0 {
+		for iNdEx := len(m.Fields) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Fields[iNdEx])
+			copy(dAtA[i:], m.Fields[iNdEx])
+			i = encodeVarintDebug(dAtA, i, uint64(len(m.Fields[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Encoding != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Encoding))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.QueryFilter != nil {
+		{
+			size := m.QueryFilter.Size()
+			i -= size
+			if _, err := m.QueryFilter.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BeaconStateRequest_Slot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BeaconStateRequest_Slot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarintDebug(dAtA, i, uint64(m.Slot))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+func (m *BeaconStateRequest_BlockRoot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BeaconStateRequest_BlockRoot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.BlockRoot != nil {
+		i -= len(m.BlockRoot)
+		copy(dAtA[i:], m.BlockRoot)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.BlockRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *BeaconStateRequest_StateRoot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BeaconStateRequest_StateRoot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.StateRoot != nil {
+		i -= len(m.StateRoot)
+		copy(dAtA[i:], m.StateRoot)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.StateRoot)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *BeaconStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BeaconStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BeaconStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Fields) > 0 {
+		for iNdEx := len(m.Fields) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fields[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDebug(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Encoded) > 0 {
+		i -= len(m.Encoded)
+		copy(dAtA[i:], m.Encoded)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.Encoded)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.StateRoot) > 0 {
+		i -= len(m.StateRoot)
+		copy(dAtA[i:], m.StateRoot)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.StateRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Slot != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BeaconStateField) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BeaconStateField) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BeaconStateField) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Proof) > 0 {
+		for iNdEx := len(m.Proof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Proof[iNdEx])
+			copy(dAtA[i:], m.Proof[iNdEx])
+			i = encodeVarintDebug(dAtA, i, uint64(len(m.Proof[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.GeneralizedIndex != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.GeneralizedIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Encoded) > 0 {
+		i -= len(m.Encoded)
+		copy(dAtA[i:], m.Encoded)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.Encoded)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BeaconStateChunk) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BeaconStateChunk) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BeaconStateChunk) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Offset != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TotalSize != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.TotalSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.StateRoot) > 0 {
+		i -= len(m.StateRoot)
+		copy(dAtA[i:], m.StateRoot)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.StateRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Slot != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ForkChoiceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForkChoiceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForkChoiceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ExcludeVotes {
+		i--
+		if m.ExcludeVotes {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.GraphFormat != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.GraphFormat))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ForkChoiceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForkChoiceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForkChoiceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Graph) > 0 {
+		i -= len(m.Graph)
+		copy(dAtA[i:], m.Graph)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.Graph)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Votes) > 0 {
+		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Votes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDebug(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Nodes) > 0 {
+		for iNdEx := len(m.Nodes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Nodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDebug(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.FinalizedEpoch != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.FinalizedEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.JustifiedEpoch != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.JustifiedEpoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ForkChoiceNode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForkChoiceNode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForkChoiceNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.BestDescendant) > 0 {
+		i -= len(m.BestDescendant)
+		copy(dAtA[i:], m.BestDescendant)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.BestDescendant)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.BestChild) > 0 {
+		i -= len(m.BestChild)
+		copy(dAtA[i:], m.BestChild)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.BestChild)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Weight != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Weight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.FinalizedEpoch != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.FinalizedEpoch))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.JustifiedEpoch != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.JustifiedEpoch))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ParentRoot) > 0 {
+		i -= len(m.ParentRoot)
+		copy(dAtA[i:], m.ParentRoot)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.ParentRoot)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Root) > 0 {
+		i -= len(m.Root)
+		copy(dAtA[i:], m.Root)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.Root)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Slot != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ForkChoiceVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForkChoiceVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForkChoiceVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.NextEpoch != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.NextEpoch))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.NextRoot) > 0 {
+		i -= len(m.NextRoot)
+		copy(dAtA[i:], m.NextRoot)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.NextRoot)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CurrentRoot) > 0 {
+		i -= len(m.CurrentRoot)
+		copy(dAtA[i:], m.CurrentRoot)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.CurrentRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ValidatorIndex != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.ValidatorIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDebug(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDebug(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BeaconStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.QueryFilter != nil {
+		n += m.QueryFilter.Size()
+	}
+	if m.Encoding != 0 {
+		n += 1 + sovDebug(uint64(m.Encoding))
+	}
+	if len(m.Fields) > 0 {
+		for _, s := range m.Fields {
+			l = len(s)
+			n += 1 + l + sovDebug(uint64(l))
+		}
+	}
+	if m.IncludeProofs {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BeaconStateRequest_Slot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovDebug(uint64(m.Slot))
+	return n
+}
+func (m *BeaconStateRequest_BlockRoot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockRoot != nil {
+		l = len(m.BlockRoot)
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	return n
+}
+func (m *BeaconStateRequest_StateRoot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StateRoot != nil {
+		l = len(m.StateRoot)
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	return n
+}
+func (m *BeaconStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Slot != 0 {
+		n += 1 + sovDebug(uint64(m.Slot))
+	}
+	l = len(m.StateRoot)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	l = len(m.Encoded)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if len(m.Fields) > 0 {
+		for _, e := range m.Fields {
+			l = e.Size()
+			n += 1 + l + sovDebug(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BeaconStateField) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	l = len(m.Encoded)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.GeneralizedIndex != 0 {
+		n += 1 + sovDebug(uint64(m.GeneralizedIndex))
+	}
+	if len(m.Proof) > 0 {
+		for _, b := range m.Proof {
+			l = len(b)
+			n += 1 + l + sovDebug(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BeaconStateChunk) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Slot != 0 {
+		n += 1 + sovDebug(uint64(m.Slot))
+	}
+	l = len(m.StateRoot)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.TotalSize != 0 {
+		n += 1 + sovDebug(uint64(m.TotalSize))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovDebug(uint64(m.Offset))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ForkChoiceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GraphFormat != 0 {
+		n += 1 + sovDebug(uint64(m.GraphFormat))
+	}
+	if m.ExcludeVotes {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ForkChoiceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JustifiedEpoch != 0 {
+		n += 1 + sovDebug(uint64(m.JustifiedEpoch))
+	}
+	if m.FinalizedEpoch != 0 {
+		n += 1 + sovDebug(uint64(m.FinalizedEpoch))
+	}
+	if len(m.Nodes) > 0 {
+		for _, e := range m.Nodes {
+			l = e.Size()
+			n += 1 + l + sovDebug(uint64(l))
+		}
+	}
+	if len(m.Votes) > 0 {
+		for _, e := range m.Votes {
+			l = e.Size()
+			n += 1 + l + sovDebug(uint64(l))
+		}
+	}
+	l = len(m.Graph)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ForkChoiceNode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Slot != 0 {
+		n += 1 + sovDebug(uint64(m.Slot))
+	}
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	l = len(m.ParentRoot)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.JustifiedEpoch != 0 {
+		n += 1 + sovDebug(uint64(m.JustifiedEpoch))
+	}
+	if m.FinalizedEpoch != 0 {
+		n += 1 + sovDebug(uint64(m.FinalizedEpoch))
+	}
+	if m.Weight != 0 {
+		n += 1 + sovDebug(uint64(m.Weight))
+	}
+	l = len(m.BestChild)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	l = len(m.BestDescendant)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ForkChoiceVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValidatorIndex != 0 {
+		n += 1 + sovDebug(uint64(m.ValidatorIndex))
+	}
+	l = len(m.CurrentRoot)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	l = len(m.NextRoot)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.NextEpoch != 0 {
+		n += 1 + sovDebug(uint64(m.NextEpoch))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovDebug(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDebug(x uint64) (n int) {
+	return sovDebug(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BeaconStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BeaconStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BeaconStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.QueryFilter = &BeaconStateRequest_Slot{v}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.QueryFilter = &BeaconStateRequest_BlockRoot{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.QueryFilter = &BeaconStateRequest_StateRoot{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Encoding", wireType)
+			}
+			m.Encoding = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Encoding |= StateEncoding(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fields = append(m.Fields, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeProofs", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeProofs = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BeaconStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BeaconStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BeaconStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateRoot = append(m.StateRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.StateRoot == nil {
+				m.StateRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Encoded", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Encoded = append(m.Encoded[:0], dAtA[iNdEx:postIndex]...)
+			if m.Encoded == nil {
+				m.Encoded = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fields = append(m.Fields, &BeaconStateField{})
+			if err := m.Fields[len(m.Fields)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BeaconStateField) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BeaconStateField: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BeaconStateField: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Encoded", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Encoded = append(m.Encoded[:0], dAtA[iNdEx:postIndex]...)
+			if m.Encoded == nil {
+				m.Encoded = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GeneralizedIndex", wireType)
+			}
+			m.GeneralizedIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GeneralizedIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof, make([]byte, postIndex-iNdEx))
+			copy(m.Proof[len(m.Proof)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BeaconStateChunk) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BeaconStateChunk: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BeaconStateChunk: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateRoot = append(m.StateRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.StateRoot == nil {
+				m.StateRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSize", wireType)
+			}
+			m.TotalSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ForkChoiceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ForkChoiceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ForkChoiceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GraphFormat", wireType)
+			}
+			m.GraphFormat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GraphFormat |= ForkChoiceGraphFormat(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeVotes", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ExcludeVotes = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ForkChoiceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ForkChoiceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ForkChoiceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JustifiedEpoch", wireType)
+			}
+			m.JustifiedEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JustifiedEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedEpoch", wireType)
+			}
+			m.FinalizedEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinalizedEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nodes = append(m.Nodes, &ForkChoiceNode{})
+			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Votes = append(m.Votes, &ForkChoiceVote{})
+			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Graph", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Graph = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ForkChoiceNode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ForkChoiceNode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ForkChoiceNode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Root = append(m.Root[:0], dAtA[iNdEx:postIndex]...)
+			if m.Root == nil {
+				m.Root = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParentRoot = append(m.ParentRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.ParentRoot == nil {
+				m.ParentRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JustifiedEpoch", wireType)
+			}
+			m.JustifiedEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JustifiedEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedEpoch", wireType)
+			}
+			m.FinalizedEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinalizedEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BestChild", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BestChild = append(m.BestChild[:0], dAtA[iNdEx:postIndex]...)
+			if m.BestChild == nil {
+				m.BestChild = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BestDescendant", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BestDescendant = append(m.BestDescendant[:0], dAtA[iNdEx:postIndex]...)
+			if m.BestDescendant == nil {
+				m.BestDescendant = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ForkChoiceVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ForkChoiceVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ForkChoiceVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorIndex", wireType)
+			}
+			m.ValidatorIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentRoot = append(m.CurrentRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.CurrentRoot == nil {
+				m.CurrentRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextRoot = append(m.NextRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextRoot == nil {
+				m.NextRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextEpoch", wireType)
+			}
+			m.NextEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDebug(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDebug
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDebug
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDebug
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDebug        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDebug          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDebug = fmt.Errorf("proto: unexpected end of group")
+)
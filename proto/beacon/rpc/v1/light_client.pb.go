@@ -0,0 +1,131 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: proto/beacon/rpc/v1/light_client.proto
+
+package ethereum_beacon_rpc_v1
+
+import (
+	context "context"
+	fmt "fmt"
+	math "math"
+
+	proto "github.com/gogo/protobuf/proto"
+	v1 "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+func init() {
+	proto.RegisterFile("proto/beacon/rpc/v1/light_client.proto", fileDescriptor_a6a419a4f3eb701d)
+}
+
+var fileDescriptor_a6a419a4f3eb701d = []byte{
+	// 227 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2b, 0x28, 0xca, 0x2f,
+	0xc9, 0xd7, 0x4f, 0x4a, 0x4d, 0x4c, 0xce, 0xcf, 0xd3, 0x2f, 0x2a, 0x48, 0xd6, 0x2f, 0x33, 0xd4,
+	0xcf, 0xc9, 0x4c, 0xcf, 0x28, 0x89, 0x4f, 0xce, 0xc9, 0x4c, 0xcd, 0x2b, 0xd1, 0x03, 0x2b, 0x10,
+	0x12, 0x4b, 0x2d, 0xc9, 0x48, 0x2d, 0x4a, 0x2d, 0xcd, 0xd5, 0x83, 0x28, 0xd5, 0x2b, 0x2a, 0x48,
+	0xd6, 0x2b, 0x33, 0x94, 0x92, 0x49, 0xcf, 0xcf, 0x4f, 0xcf, 0x49, 0xd5, 0x4f, 0x2c, 0xc8, 0xd4,
+	0x4f, 0xcc, 0xcb, 0xcb, 0x2f, 0x49, 0x2c, 0xc9, 0xcc, 0xcf, 0x2b, 0x86, 0xe8, 0x92, 0x52, 0x42,
+	0x31, 0xbd, 0xc0, 0xa8, 0x00, 0x64, 0x7a, 0x6e, 0x6a, 0x71, 0x71, 0x62, 0x7a, 0x2a, 0x54, 0x8d,
+	0xd1, 0x5a, 0x46, 0x2e, 0x6e, 0x1f, 0x90, 0x85, 0xce, 0x60, 0xfb, 0x84, 0xe6, 0x32, 0x72, 0x89,
+	0xb8, 0xa7, 0x96, 0x20, 0x09, 0x85, 0x16, 0xa4, 0x24, 0x96, 0xa4, 0x0a, 0x19, 0xe8, 0xa1, 0xbb,
+	0xa1, 0xc0, 0xa8, 0x40, 0xaf, 0xcc, 0x50, 0x0f, 0x43, 0x69, 0x50, 0x6a, 0x61, 0x69, 0x6a, 0x71,
+	0x89, 0x94, 0x26, 0xd1, 0x3a, 0x94, 0x34, 0x9a, 0x2e, 0x3f, 0x99, 0xcc, 0xa4, 0x24, 0xa4, 0xa0,
+	0x9f, 0x5a, 0x92, 0xa1, 0x5f, 0x66, 0x98, 0x98, 0x53, 0x90, 0x91, 0x08, 0x0d, 0x09, 0x48, 0x40,
+	0xe8, 0x97, 0x82, 0x55, 0x3a, 0xf1, 0x9c, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83,
+	0x47, 0x72, 0x8c, 0x49, 0x6c, 0x60, 0x4f, 0x18, 0x03, 0x06, 0x00, 0x66, 0x52, 0x5f, 0x9d, 0x48,
+	0x01, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// LightClientClient is the client API for LightClient service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type LightClientClient interface {
+	GetLightClientUpdate(ctx context.Context, in *v1.LightClientUpdateRequest, opts ...grpc.CallOption) (*v1.LightClientUpdate, error)
+}
+
+type lightClientClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewLightClientClient(cc *grpc.ClientConn) LightClientClient {
+	return &lightClientClient{cc}
+}
+
+func (c *lightClientClient) GetLightClientUpdate(ctx context.Context, in *v1.LightClientUpdateRequest, opts ...grpc.CallOption) (*v1.LightClientUpdate, error) {
+	out := new(v1.LightClientUpdate)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.LightClient/GetLightClientUpdate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LightClientServer is the server API for LightClient service.
+type LightClientServer interface {
+	GetLightClientUpdate(context.Context, *v1.LightClientUpdateRequest) (*v1.LightClientUpdate, error)
+}
+
+// UnimplementedLightClientServer can be embedded to have forward compatible implementations.
+type UnimplementedLightClientServer struct {
+}
+
+func (*UnimplementedLightClientServer) GetLightClientUpdate(ctx context.Context, req *v1.LightClientUpdateRequest) (*v1.LightClientUpdate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLightClientUpdate not implemented")
+}
+
+func RegisterLightClientServer(s *grpc.Server, srv LightClientServer) {
+	s.RegisterService(&_LightClient_serviceDesc, srv)
+}
+
+func _LightClient_GetLightClientUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.LightClientUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightClientServer).GetLightClientUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.LightClient/GetLightClientUpdate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightClientServer).GetLightClientUpdate(ctx, req.(*v1.LightClientUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _LightClient_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.LightClient",
+	HandlerType: (*LightClientServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetLightClientUpdate",
+			Handler:    _LightClient_GetLightClientUpdate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/beacon/rpc/v1/light_client.proto",
+}
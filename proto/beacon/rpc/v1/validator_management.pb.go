@@ -0,0 +1,2680 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: proto/beacon/rpc/v1/validator_management.proto
+
+package ethereum_beacon_rpc_v1
+
+import (
+	context "context"
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	proto "github.com/gogo/protobuf/proto"
+	types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type ListKeysResponse struct {
+	Keys                 []*ListKeysResponse_Key `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *ListKeysResponse) Reset()         { *m = ListKeysResponse{} }
+func (m *ListKeysResponse) String() string { return proto.CompactTextString(m) }
+func (*ListKeysResponse) ProtoMessage()    {}
+func (*ListKeysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f56ec6305ba18bfa, []int{0}
+}
+func (m *ListKeysResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListKeysResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListKeysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListKeysResponse.Merge(m, src)
+}
+func (m *ListKeysResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListKeysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListKeysResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListKeysResponse proto.InternalMessageInfo
+
+func (m *ListKeysResponse) GetKeys() []*ListKeysResponse_Key {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+type ListKeysResponse_Key struct {
+	PublicKey            []byte   `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Enabled              bool     `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Status               string   `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Index                uint64   `protobuf:"varint,4,opt,name=index,proto3" json:"index,omitempty"`
+	Balance              uint64   `protobuf:"varint,5,opt,name=balance,proto3" json:"balance,omitempty"`
+	AttesterSlot         uint64   `protobuf:"varint,6,opt,name=attester_slot,json=attesterSlot,proto3" json:"attester_slot,omitempty"`
+	CommitteeIndex       uint64   `protobuf:"varint,7,opt,name=committee_index,json=committeeIndex,proto3" json:"committee_index,omitempty"`
+	ProposerSlot         uint64   `protobuf:"varint,8,opt,name=proposer_slot,json=proposerSlot,proto3" json:"proposer_slot,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListKeysResponse_Key) Reset()         { *m = ListKeysResponse_Key{} }
+func (m *ListKeysResponse_Key) String() string { return proto.CompactTextString(m) }
+func (*ListKeysResponse_Key) ProtoMessage()    {}
+func (*ListKeysResponse_Key) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f56ec6305ba18bfa, []int{0, 0}
+}
+func (m *ListKeysResponse_Key) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListKeysResponse_Key) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListKeysResponse_Key.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListKeysResponse_Key) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListKeysResponse_Key.Merge(m, src)
+}
+func (m *ListKeysResponse_Key) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListKeysResponse_Key) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListKeysResponse_Key.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListKeysResponse_Key proto.InternalMessageInfo
+
+func (m *ListKeysResponse_Key) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *ListKeysResponse_Key) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *ListKeysResponse_Key) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *ListKeysResponse_Key) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *ListKeysResponse_Key) GetBalance() uint64 {
+	if m != nil {
+		return m.Balance
+	}
+	return 0
+}
+
+func (m *ListKeysResponse_Key) GetAttesterSlot() uint64 {
+	if m != nil {
+		return m.AttesterSlot
+	}
+	return 0
+}
+
+func (m *ListKeysResponse_Key) GetCommitteeIndex() uint64 {
+	if m != nil {
+		return m.CommitteeIndex
+	}
+	return 0
+}
+
+func (m *ListKeysResponse_Key) GetProposerSlot() uint64 {
+	if m != nil {
+		return m.ProposerSlot
+	}
+	return 0
+}
+
+type ImportKeystoreRequest struct {
+	Keystore             []byte   `protobuf:"bytes,1,opt,name=keystore,proto3" json:"keystore,omitempty"`
+	Password             string   `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportKeystoreRequest) Reset()         { *m = ImportKeystoreRequest{} }
+func (m *ImportKeystoreRequest) String() string { return proto.CompactTextString(m) }
+func (*ImportKeystoreRequest) ProtoMessage()    {}
+func (*ImportKeystoreRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f56ec6305ba18bfa, []int{1}
+}
+func (m *ImportKeystoreRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ImportKeystoreRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ImportKeystoreRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ImportKeystoreRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportKeystoreRequest.Merge(m, src)
+}
+func (m *ImportKeystoreRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ImportKeystoreRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportKeystoreRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportKeystoreRequest proto.InternalMessageInfo
+
+func (m *ImportKeystoreRequest) GetKeystore() []byte {
+	if m != nil {
+		return m.Keystore
+	}
+	return nil
+}
+
+func (m *ImportKeystoreRequest) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+type ImportKeystoreResponse struct {
+	PublicKey            []byte   `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportKeystoreResponse) Reset()         { *m = ImportKeystoreResponse{} }
+func (m *ImportKeystoreResponse) String() string { return proto.CompactTextString(m) }
+func (*ImportKeystoreResponse) ProtoMessage()    {}
+func (*ImportKeystoreResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f56ec6305ba18bfa, []int{2}
+}
+func (m *ImportKeystoreResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ImportKeystoreResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ImportKeystoreResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ImportKeystoreResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportKeystoreResponse.Merge(m, src)
+}
+func (m *ImportKeystoreResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ImportKeystoreResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportKeystoreResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportKeystoreResponse proto.InternalMessageInfo
+
+func (m *ImportKeystoreResponse) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+type DeleteKeyRequest struct {
+	PublicKey            []byte   `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteKeyRequest) Reset()         { *m = DeleteKeyRequest{} }
+func (m *DeleteKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteKeyRequest) ProtoMessage()    {}
+func (*DeleteKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f56ec6305ba18bfa, []int{3}
+}
+func (m *DeleteKeyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteKeyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeleteKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteKeyRequest.Merge(m, src)
+}
+func (m *DeleteKeyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteKeyRequest proto.InternalMessageInfo
+
+func (m *DeleteKeyRequest) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+type SetKeyEnabledRequest struct {
+	PublicKey            []byte   `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Enabled              bool     `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetKeyEnabledRequest) Reset()         { *m = SetKeyEnabledRequest{} }
+func (m *SetKeyEnabledRequest) String() string { return proto.CompactTextString(m) }
+func (*SetKeyEnabledRequest) ProtoMessage()    {}
+func (*SetKeyEnabledRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f56ec6305ba18bfa, []int{4}
+}
+func (m *SetKeyEnabledRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetKeyEnabledRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetKeyEnabledRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetKeyEnabledRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetKeyEnabledRequest.Merge(m, src)
+}
+func (m *SetKeyEnabledRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetKeyEnabledRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetKeyEnabledRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetKeyEnabledRequest proto.InternalMessageInfo
+
+func (m *SetKeyEnabledRequest) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *SetKeyEnabledRequest) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+type SigningHistoryRequest struct {
+	PublicKey            []byte   `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SigningHistoryRequest) Reset()         { *m = SigningHistoryRequest{} }
+func (m *SigningHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*SigningHistoryRequest) ProtoMessage()    {}
+func (*SigningHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f56ec6305ba18bfa, []int{5}
+}
+func (m *SigningHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SigningHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SigningHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SigningHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SigningHistoryRequest.Merge(m, src)
+}
+func (m *SigningHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SigningHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SigningHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SigningHistoryRequest proto.InternalMessageInfo
+
+func (m *SigningHistoryRequest) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+type SigningHistory struct {
+	ProposedEpochs         []uint64          `protobuf:"varint,1,rep,packed,name=proposed_epochs,json=proposedEpochs,proto3" json:"proposed_epochs,omitempty"`
+	AttestedTargetToSource map[uint64]uint64 `protobuf:"bytes,2,rep,name=attested_target_to_source,json=attestedTargetToSource,proto3" json:"attested_target_to_source,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral   struct{}          `json:"-"`
+	XXX_unrecognized       []byte            `json:"-"`
+	XXX_sizecache          int32             `json:"-"`
+}
+
+func (m *SigningHistory) Reset()         { *m = SigningHistory{} }
+func (m *SigningHistory) String() string { return proto.CompactTextString(m) }
+func (*SigningHistory) ProtoMessage()    {}
+func (*SigningHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f56ec6305ba18bfa, []int{6}
+}
+func (m *SigningHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SigningHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SigningHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SigningHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SigningHistory.Merge(m, src)
+}
+func (m *SigningHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *SigningHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_SigningHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SigningHistory proto.InternalMessageInfo
+
+func (m *SigningHistory) GetProposedEpochs() []uint64 {
+	if m != nil {
+		return m.ProposedEpochs
+	}
+	return nil
+}
+
+func (m *SigningHistory) GetAttestedTargetToSource() map[uint64]uint64 {
+	if m != nil {
+		return m.AttestedTargetToSource
+	}
+	return nil
+}
+
+type VoluntaryExitRequest struct {
+	PublicKey            []byte   `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VoluntaryExitRequest) Reset()         { *m = VoluntaryExitRequest{} }
+func (m *VoluntaryExitRequest) String() string { return proto.CompactTextString(m) }
+func (*VoluntaryExitRequest) ProtoMessage()    {}
+func (*VoluntaryExitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f56ec6305ba18bfa, []int{7}
+}
+func (m *VoluntaryExitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoluntaryExitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoluntaryExitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VoluntaryExitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoluntaryExitRequest.Merge(m, src)
+}
+func (m *VoluntaryExitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoluntaryExitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoluntaryExitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoluntaryExitRequest proto.InternalMessageInfo
+
+func (m *VoluntaryExitRequest) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ListKeysResponse)(nil), "ethereum.beacon.rpc.v1.ListKeysResponse")
+	proto.RegisterType((*ListKeysResponse_Key)(nil), "ethereum.beacon.rpc.v1.ListKeysResponse.Key")
+	proto.RegisterType((*ImportKeystoreRequest)(nil), "ethereum.beacon.rpc.v1.ImportKeystoreRequest")
+	proto.RegisterType((*ImportKeystoreResponse)(nil), "ethereum.beacon.rpc.v1.ImportKeystoreResponse")
+	proto.RegisterType((*DeleteKeyRequest)(nil), "ethereum.beacon.rpc.v1.DeleteKeyRequest")
+	proto.RegisterType((*SetKeyEnabledRequest)(nil), "ethereum.beacon.rpc.v1.SetKeyEnabledRequest")
+	proto.RegisterType((*SigningHistoryRequest)(nil), "ethereum.beacon.rpc.v1.SigningHistoryRequest")
+	proto.RegisterType((*SigningHistory)(nil), "ethereum.beacon.rpc.v1.SigningHistory")
+	proto.RegisterMapType((map[uint64]uint64)(nil), "ethereum.beacon.rpc.v1.SigningHistory.AttestedTargetToSourceEntry")
+	proto.RegisterType((*VoluntaryExitRequest)(nil), "ethereum.beacon.rpc.v1.VoluntaryExitRequest")
+}
+
+func init() {
+	proto.RegisterFile("proto/beacon/rpc/v1/validator_management.proto", fileDescriptor_f56ec6305ba18bfa)
+}
+
+var fileDescriptor_f56ec6305ba18bfa = []byte{
+	// 757 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x4d, 0x6f, 0xd3, 0x4c,
+	0x10, 0xd6, 0x26, 0x69, 0x9a, 0xec, 0xdb, 0xe4, 0x2d, 0x4b, 0x1a, 0x5c, 0x97, 0x86, 0xc8, 0xe5,
+	0x23, 0xaa, 0xa8, 0xad, 0x16, 0xf1, 0xa1, 0x9c, 0xa0, 0x22, 0x82, 0xaa, 0xa0, 0x4a, 0x4e, 0xd5,
+	0xab, 0xb5, 0x71, 0x86, 0xc4, 0xaa, 0xe3, 0x35, 0xf6, 0x3a, 0xd4, 0x12, 0x5c, 0xe8, 0x89, 0x0b,
+	0x17, 0x24, 0x7e, 0x13, 0xc7, 0x4a, 0xfc, 0x01, 0x54, 0xf1, 0x27, 0xb8, 0x21, 0xaf, 0xed, 0x48,
+	0x09, 0x71, 0x09, 0x37, 0xcf, 0x8c, 0xe7, 0x79, 0x66, 0x67, 0xe6, 0x19, 0xac, 0xba, 0x1e, 0xe3,
+	0x4c, 0xeb, 0x01, 0x35, 0x99, 0xa3, 0x79, 0xae, 0xa9, 0x8d, 0x77, 0xb5, 0x31, 0xb5, 0xad, 0x3e,
+	0xe5, 0xcc, 0x33, 0x46, 0xd4, 0xa1, 0x03, 0x18, 0x81, 0xc3, 0xe3, 0x1f, 0x49, 0x1d, 0xf8, 0x10,
+	0x3c, 0x08, 0x46, 0x6a, 0x9c, 0xa2, 0x7a, 0xae, 0xa9, 0x8e, 0x77, 0xe5, 0x9b, 0x03, 0xc6, 0x06,
+	0x36, 0x68, 0xd4, 0xb5, 0x34, 0xea, 0x38, 0x8c, 0x53, 0x6e, 0x31, 0xc7, 0x8f, 0xb3, 0xe4, 0x8d,
+	0x24, 0x2a, 0xac, 0x5e, 0xf0, 0x46, 0x83, 0x91, 0xcb, 0xc3, 0x38, 0xa8, 0x5c, 0xe4, 0xf0, 0xea,
+	0x2b, 0xcb, 0xe7, 0x87, 0x10, 0xfa, 0x3a, 0xf8, 0x2e, 0x73, 0x7c, 0x20, 0x4f, 0x71, 0xe1, 0x14,
+	0x42, 0x5f, 0x42, 0xcd, 0x7c, 0xeb, 0xbf, 0xbd, 0xfb, 0xea, 0x7c, 0x5a, 0x75, 0x36, 0x4f, 0x3d,
+	0x84, 0x50, 0x17, 0x99, 0xf2, 0x2f, 0x84, 0xf3, 0x87, 0x10, 0x92, 0x4d, 0x8c, 0xdd, 0xa0, 0x67,
+	0x5b, 0xa6, 0x71, 0x0a, 0xa1, 0x84, 0x9a, 0xa8, 0xb5, 0xa2, 0x97, 0x63, 0x4f, 0x14, 0x96, 0xf0,
+	0x32, 0x38, 0xb4, 0x67, 0x43, 0x5f, 0xca, 0x35, 0x51, 0xab, 0xa4, 0xa7, 0x26, 0xa9, 0xe3, 0xa2,
+	0xcf, 0x29, 0x0f, 0x7c, 0x29, 0xdf, 0x44, 0xad, 0xb2, 0x9e, 0x58, 0xa4, 0x86, 0x97, 0x2c, 0xa7,
+	0x0f, 0x67, 0x52, 0xa1, 0x89, 0x5a, 0x05, 0x3d, 0x36, 0x22, 0x9c, 0x1e, 0xb5, 0xa9, 0x63, 0x82,
+	0xb4, 0x24, 0xfc, 0xa9, 0x49, 0xb6, 0x70, 0x85, 0x72, 0x0e, 0x3e, 0x07, 0xcf, 0xf0, 0x6d, 0xc6,
+	0xa5, 0xa2, 0x88, 0xaf, 0xa4, 0xce, 0xae, 0xcd, 0x38, 0xb9, 0x87, 0xff, 0x37, 0xd9, 0x68, 0x64,
+	0x71, 0x0e, 0x60, 0xc4, 0xf0, 0xcb, 0xe2, 0xb7, 0xea, 0xc4, 0x7d, 0x20, 0x78, 0xb6, 0x70, 0xc5,
+	0xf5, 0x98, 0xcb, 0xfc, 0x14, 0xad, 0x14, 0xa3, 0xa5, 0xce, 0x08, 0x4d, 0x39, 0xc2, 0x6b, 0x07,
+	0x23, 0x97, 0x79, 0xa2, 0x37, 0x9c, 0x79, 0xa0, 0xc3, 0xdb, 0x00, 0x7c, 0x4e, 0x64, 0x5c, 0x3a,
+	0x4d, 0x5c, 0x49, 0x2b, 0x26, 0x76, 0x14, 0x73, 0xa9, 0xef, 0xbf, 0x63, 0x5e, 0xdc, 0x8a, 0xb2,
+	0x3e, 0xb1, 0x95, 0xc7, 0xb8, 0x3e, 0x0b, 0x98, 0x0c, 0xea, 0xea, 0xf6, 0x2a, 0xbb, 0x78, 0xf5,
+	0x39, 0xd8, 0xc0, 0x21, 0x1a, 0x4c, 0x52, 0xc4, 0x5f, 0x52, 0x8e, 0x70, 0xad, 0x0b, 0x11, 0x51,
+	0x27, 0x1e, 0xc4, 0x62, 0x69, 0xd9, 0x83, 0x54, 0x1e, 0xe1, 0xb5, 0xae, 0x35, 0x70, 0x2c, 0x67,
+	0xf0, 0xd2, 0x8a, 0x8a, 0x5f, 0xb4, 0x90, 0xf3, 0x1c, 0xae, 0x4e, 0x27, 0x46, 0x63, 0x4a, 0x1a,
+	0xdd, 0x37, 0xc0, 0x65, 0xe6, 0x30, 0xde, 0xd0, 0x82, 0x5e, 0x4d, 0xdd, 0x1d, 0xe1, 0x25, 0x1f,
+	0xf0, 0x7a, 0x32, 0xdf, 0xbe, 0xc1, 0xa9, 0x37, 0x00, 0x6e, 0x70, 0x66, 0xf8, 0x2c, 0xf0, 0x4c,
+	0x90, 0x72, 0x62, 0xa9, 0xf7, 0xb3, 0x96, 0x7a, 0x9a, 0x53, 0x7d, 0x96, 0xe0, 0x1c, 0x0b, 0x98,
+	0x63, 0xd6, 0x15, 0x20, 0x1d, 0x87, 0x7b, 0xa1, 0x5e, 0xa7, 0x73, 0x83, 0xf2, 0x01, 0xde, 0xb8,
+	0x22, 0x8d, 0xac, 0xe2, 0x7c, 0xfa, 0xe2, 0x82, 0x1e, 0x7d, 0x46, 0x4b, 0x3d, 0xa6, 0x76, 0x00,
+	0xa2, 0x77, 0x05, 0x3d, 0x36, 0xda, 0xb9, 0x27, 0x48, 0x79, 0x88, 0x6b, 0x27, 0xcc, 0x0e, 0x1c,
+	0x4e, 0xbd, 0xb0, 0x73, 0x66, 0xf1, 0xc5, 0x9a, 0xb7, 0xf7, 0xb5, 0x88, 0xaf, 0x9f, 0xa4, 0x77,
+	0xe4, 0xf5, 0xe4, 0x8c, 0x90, 0x21, 0x2e, 0xa5, 0xa2, 0x25, 0x75, 0x35, 0xbe, 0x0b, 0x6a, 0x7a,
+	0x17, 0xd4, 0x4e, 0x74, 0x17, 0xe4, 0xd6, 0xa2, 0x72, 0x57, 0xe4, 0x8f, 0xdf, 0x7f, 0x7e, 0xc9,
+	0xd5, 0x08, 0x99, 0x3a, 0x5b, 0x5a, 0xb4, 0xd3, 0xe4, 0x33, 0xc2, 0xd5, 0xe9, 0xa5, 0x25, 0x3b,
+	0x59, 0xc0, 0x73, 0xd5, 0x22, 0xab, 0x8b, 0xfe, 0x9e, 0x54, 0xb3, 0x29, 0xaa, 0xb9, 0xd1, 0x46,
+	0xdb, 0xca, 0xbc, 0x82, 0xce, 0x11, 0x2e, 0x4f, 0xc4, 0x40, 0x32, 0x1f, 0x39, 0xab, 0x17, 0xf9,
+	0xee, 0x62, 0x8b, 0xa2, 0xdc, 0x16, 0xf4, 0x0d, 0x65, 0xfd, 0x4f, 0x6e, 0xad, 0x2f, 0x40, 0xdb,
+	0x68, 0x9b, 0xbc, 0xc7, 0x95, 0x29, 0x79, 0x91, 0xcc, 0xe3, 0x3a, 0x4f, 0x85, 0x72, 0xc6, 0xcc,
+	0x94, 0x3b, 0x82, 0xfc, 0x96, 0x22, 0xcf, 0x21, 0x4f, 0x84, 0x18, 0xb1, 0x7f, 0x42, 0xf8, 0xda,
+	0x0b, 0xe0, 0x33, 0xb2, 0xda, 0x59, 0xec, 0x85, 0xff, 0xda, 0x90, 0x64, 0x1e, 0x64, 0x6d, 0xba,
+	0xa6, 0x61, 0xc2, 0xca, 0x71, 0x65, 0x6a, 0xb3, 0xb3, 0x3b, 0x31, 0x4f, 0x00, 0x99, 0x9d, 0x48,
+	0x58, 0x67, 0x57, 0x00, 0xce, 0x2c, 0xde, 0x46, 0xdb, 0xfb, 0x2b, 0xdf, 0x2e, 0x1b, 0xe8, 0xe2,
+	0xb2, 0x81, 0x7e, 0x5c, 0x36, 0x50, 0xaf, 0x28, 0x92, 0x1f, 0xfc, 0x1e, 0x00, 0xc3, 0x01, 0x09,
+	0xca, 0x88, 0x07, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ValidatorManagementClient is the client API for ValidatorManagement service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ValidatorManagementClient interface {
+	ListKeys(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ListKeysResponse, error)
+	ImportKeystore(ctx context.Context, in *ImportKeystoreRequest, opts ...grpc.CallOption) (*ImportKeystoreResponse, error)
+	DeleteKey(ctx context.Context, in *DeleteKeyRequest, opts ...grpc.CallOption) (*SigningHistory, error)
+	SetKeyEnabled(ctx context.Context, in *SetKeyEnabledRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	GetSigningHistory(ctx context.Context, in *SigningHistoryRequest, opts ...grpc.CallOption) (*SigningHistory, error)
+	VoluntaryExit(ctx context.Context, in *VoluntaryExitRequest, opts ...grpc.CallOption) (*types.Empty, error)
+}
+
+type validatorManagementClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewValidatorManagementClient(cc *grpc.ClientConn) ValidatorManagementClient {
+	return &validatorManagementClient{cc}
+}
+
+func (c *validatorManagementClient) ListKeys(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ListKeysResponse, error) {
+	out := new(ListKeysResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorManagement/ListKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *validatorManagementClient) ImportKeystore(ctx context.Context, in *ImportKeystoreRequest, opts ...grpc.CallOption) (*ImportKeystoreResponse, error) {
+	out := new(ImportKeystoreResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorManagement/ImportKeystore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *validatorManagementClient) DeleteKey(ctx context.Context, in *DeleteKeyRequest, opts ...grpc.CallOption) (*SigningHistory, error) {
+	out := new(SigningHistory)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorManagement/DeleteKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *validatorManagementClient) SetKeyEnabled(ctx context.Context, in *SetKeyEnabledRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorManagement/SetKeyEnabled", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *validatorManagementClient) GetSigningHistory(ctx context.Context, in *SigningHistoryRequest, opts ...grpc.CallOption) (*SigningHistory, error) {
+	out := new(SigningHistory)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorManagement/GetSigningHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *validatorManagementClient) VoluntaryExit(ctx context.Context, in *VoluntaryExitRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorManagement/VoluntaryExit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ValidatorManagementServer is the server API for ValidatorManagement service.
+type ValidatorManagementServer interface {
+	ListKeys(context.Context, *types.Empty) (*ListKeysResponse, error)
+	ImportKeystore(context.Context, *ImportKeystoreRequest) (*ImportKeystoreResponse, error)
+	DeleteKey(context.Context, *DeleteKeyRequest) (*SigningHistory, error)
+	SetKeyEnabled(context.Context, *SetKeyEnabledRequest) (*types.Empty, error)
+	GetSigningHistory(context.Context, *SigningHistoryRequest) (*SigningHistory, error)
+	VoluntaryExit(context.Context, *VoluntaryExitRequest) (*types.Empty, error)
+}
+
+// UnimplementedValidatorManagementServer can be embedded to have forward compatible implementations.
+type UnimplementedValidatorManagementServer struct {
+}
+
+func (*UnimplementedValidatorManagementServer) ListKeys(ctx context.Context, req *types.Empty) (*ListKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListKeys not implemented")
+}
+func (*UnimplementedValidatorManagementServer) ImportKeystore(ctx context.Context, req *ImportKeystoreRequest) (*ImportKeystoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportKeystore not implemented")
+}
+func (*UnimplementedValidatorManagementServer) DeleteKey(ctx context.Context, req *DeleteKeyRequest) (*SigningHistory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteKey not implemented")
+}
+func (*UnimplementedValidatorManagementServer) SetKeyEnabled(ctx context.Context, req *SetKeyEnabledRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetKeyEnabled not implemented")
+}
+func (*UnimplementedValidatorManagementServer) GetSigningHistory(ctx context.Context, req *SigningHistoryRequest) (*SigningHistory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSigningHistory not implemented")
+}
+func (*UnimplementedValidatorManagementServer) VoluntaryExit(ctx context.Context, req *VoluntaryExitRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoluntaryExit not implemented")
+}
+
+func RegisterValidatorManagementServer(s *grpc.Server, srv ValidatorManagementServer) {
+	s.RegisterService(&_ValidatorManagement_serviceDesc, srv)
+}
+
+func _ValidatorManagement_ListKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValidatorManagementServer).ListKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorManagement/ListKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorManagementServer).ListKeys(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ValidatorManagement_ImportKeystore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportKeystoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValidatorManagementServer).ImportKeystore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorManagement/ImportKeystore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorManagementServer).ImportKeystore(ctx, req.(*ImportKeystoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ValidatorManagement_DeleteKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValidatorManagementServer).DeleteKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorManagement/DeleteKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorManagementServer).DeleteKey(ctx, req.(*DeleteKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ValidatorManagement_SetKeyEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetKeyEnabledRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValidatorManagementServer).SetKeyEnabled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorManagement/SetKeyEnabled",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorManagementServer).SetKeyEnabled(ctx, req.(*SetKeyEnabledRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ValidatorManagement_GetSigningHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SigningHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValidatorManagementServer).GetSigningHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorManagement/GetSigningHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorManagementServer).GetSigningHistory(ctx, req.(*SigningHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ValidatorManagement_VoluntaryExit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoluntaryExitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValidatorManagementServer).VoluntaryExit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorManagement/VoluntaryExit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorManagementServer).VoluntaryExit(ctx, req.(*VoluntaryExitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ValidatorManagement_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.ValidatorManagement",
+	HandlerType: (*ValidatorManagementServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListKeys",
+			Handler:    _ValidatorManagement_ListKeys_Handler,
+		},
+		{
+			MethodName: "ImportKeystore",
+			Handler:    _ValidatorManagement_ImportKeystore_Handler,
+		},
+		{
+			MethodName: "DeleteKey",
+			Handler:    _ValidatorManagement_DeleteKey_Handler,
+		},
+		{
+			MethodName: "SetKeyEnabled",
+			Handler:    _ValidatorManagement_SetKeyEnabled_Handler,
+		},
+		{
+			MethodName: "GetSigningHistory",
+			Handler:    _ValidatorManagement_GetSigningHistory_Handler,
+		},
+		{
+			MethodName: "VoluntaryExit",
+			Handler:    _ValidatorManagement_VoluntaryExit_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/beacon/rpc/v1/validator_management.proto",
+}
+
+func (m *ListKeysResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListKeysResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListKeysResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Keys) > 0 {
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Keys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintValidatorManagement(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListKeysResponse_Key) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListKeysResponse_Key) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListKeysResponse_Key) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ProposerSlot != 0 {
+		i = encodeVarintValidatorManagement(dAtA, i, uint64(m.ProposerSlot))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.CommitteeIndex != 0 {
+		i = encodeVarintValidatorManagement(dAtA, i, uint64(m.CommitteeIndex))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.AttesterSlot != 0 {
+		i = encodeVarintValidatorManagement(dAtA, i, uint64(m.AttesterSlot))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Balance != 0 {
+		i = encodeVarintValidatorManagement(dAtA, i, uint64(m.Balance))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Index != 0 {
+		i = encodeVarintValidatorManagement(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintValidatorManagement(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintValidatorManagement(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ImportKeystoreRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImportKeystoreRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImportKeystoreRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Password) > 0 {
+		i -= len(m.Password)
+		copy(dAtA[i:], m.Password)
+		i = encodeVarintValidatorManagement(dAtA, i, uint64(len(m.Password)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Keystore) > 0 {
+		i -= len(m.Keystore)
+		copy(dAtA[i:], m.Keystore)
+		i = encodeVarintValidatorManagement(dAtA, i, uint64(len(m.Keystore)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ImportKeystoreResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImportKeystoreResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImportKeystoreResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintValidatorManagement(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteKeyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintValidatorManagement(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetKeyEnabledRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetKeyEnabledRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetKeyEnabledRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintValidatorManagement(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SigningHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SigningHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SigningHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintValidatorManagement(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SigningHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SigningHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SigningHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.AttestedTargetToSource) > 0 {
+		for k := range m.AttestedTargetToSource {
+			v := m.AttestedTargetToSource[k]
+			baseI := i
+			i = encodeVarintValidatorManagement(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarintValidatorManagement(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintValidatorManagement(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ProposedEpochs) > 0 {
+		dAtA2 := make([]byte, len(m.ProposedEpochs)*10)
+		var j1 int
+		for _, num := range m.ProposedEpochs {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintValidatorManagement(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VoluntaryExitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VoluntaryExitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoluntaryExitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintValidatorManagement(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintValidatorManagement(dAtA []byte, offset int, v uint64) int {
+	offset -= sovValidatorManagement(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ListKeysResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Keys) > 0 {
+		for _, e := range m.Keys {
+			l = e.Size()
+			n += 1 + l + sovValidatorManagement(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListKeysResponse_Key) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovValidatorManagement(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovValidatorManagement(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovValidatorManagement(uint64(m.Index))
+	}
+	if m.Balance != 0 {
+		n += 1 + sovValidatorManagement(uint64(m.Balance))
+	}
+	if m.AttesterSlot != 0 {
+		n += 1 + sovValidatorManagement(uint64(m.AttesterSlot))
+	}
+	if m.CommitteeIndex != 0 {
+		n += 1 + sovValidatorManagement(uint64(m.CommitteeIndex))
+	}
+	if m.ProposerSlot != 0 {
+		n += 1 + sovValidatorManagement(uint64(m.ProposerSlot))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ImportKeystoreRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Keystore)
+	if l > 0 {
+		n += 1 + l + sovValidatorManagement(uint64(l))
+	}
+	l = len(m.Password)
+	if l > 0 {
+		n += 1 + l + sovValidatorManagement(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ImportKeystoreResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovValidatorManagement(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteKeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovValidatorManagement(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SetKeyEnabledRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovValidatorManagement(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SigningHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovValidatorManagement(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SigningHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ProposedEpochs) > 0 {
+		l = 0
+		for _, e := range m.ProposedEpochs {
+			l += sovValidatorManagement(uint64(e))
+		}
+		n += 1 + sovValidatorManagement(uint64(l)) + l
+	}
+	if len(m.AttestedTargetToSource) > 0 {
+		for k, v := range m.AttestedTargetToSource {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovValidatorManagement(uint64(k)) + 1 + sovValidatorManagement(uint64(v))
+			n += mapEntrySize + 1 + sovValidatorManagement(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *VoluntaryExitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovValidatorManagement(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovValidatorManagement(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozValidatorManagement(x uint64) (n int) {
+	return sovValidatorManagement(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ListKeysResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowValidatorManagement
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListKeysResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListKeysResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorManagement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthValidatorManagement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidatorManagement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Keys = append(m.Keys, &ListKeysResponse_Key{})
+			if err := m.Keys[len(m.Keys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipValidatorManagement(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthValidatorManagement
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthValidatorManagement
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListKeysResponse_Key) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowValidatorManagement
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Key: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Key: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorManagement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthValidatorManagement
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidatorManagement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorManagement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorManagement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidatorManagement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidatorManagement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorManagement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			m.Balance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorManagement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Balance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttesterSlot", wireType)
+			}
+			m.AttesterSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorManagement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AttesterSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitteeIndex", wireType)
+			}
+			m.CommitteeIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorManagement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitteeIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerSlot", wireType)
+			}
+			m.ProposerSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorManagement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposerSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipValidatorManagement(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthValidatorManagement
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthValidatorManagement
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ImportKeystoreRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowValidatorManagement
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImportKeystoreRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImportKeystoreRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keystore", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorManagement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthValidatorManagement
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidatorManagement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Keystore = append(m.Keystore[:0], dAtA[iNdEx:postIndex]...)
+			if m.Keystore == nil {
+				m.Keystore = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorManagement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidatorManagement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidatorManagement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Password = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipValidatorManagement(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthValidatorManagement
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthValidatorManagement
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ImportKeystoreResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowValidatorManagement
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImportKeystoreResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImportKeystoreResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorManagement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthValidatorManagement
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidatorManagement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipValidatorManagement(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthValidatorManagement
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthValidatorManagement
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteKeyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowValidatorManagement
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteKeyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorManagement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthValidatorManagement
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidatorManagement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipValidatorManagement(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthValidatorManagement
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthValidatorManagement
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetKeyEnabledRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowValidatorManagement
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetKeyEnabledRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetKeyEnabledRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorManagement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthValidatorManagement
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidatorManagement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorManagement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipValidatorManagement(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthValidatorManagement
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthValidatorManagement
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SigningHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowValidatorManagement
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SigningHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SigningHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorManagement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthValidatorManagement
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidatorManagement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipValidatorManagement(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthValidatorManagement
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthValidatorManagement
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SigningHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowValidatorManagement
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SigningHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SigningHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowValidatorManagement
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ProposedEpochs = append(m.ProposedEpochs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowValidatorManagement
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthValidatorManagement
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthValidatorManagement
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ProposedEpochs) == 0 {
+					m.ProposedEpochs = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowValidatorManagement
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ProposedEpochs = append(m.ProposedEpochs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposedEpochs", wireType)
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestedTargetToSource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorManagement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthValidatorManagement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidatorManagement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AttestedTargetToSource == nil {
+				m.AttestedTargetToSource = make(map[uint64]uint64)
+			}
+			var mapkey uint64
+			var mapvalue uint64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowValidatorManagement
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowValidatorManagement
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowValidatorManagement
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipValidatorManagement(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthValidatorManagement
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.AttestedTargetToSource[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipValidatorManagement(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthValidatorManagement
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthValidatorManagement
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VoluntaryExitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowValidatorManagement
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VoluntaryExitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VoluntaryExitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorManagement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthValidatorManagement
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidatorManagement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipValidatorManagement(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthValidatorManagement
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthValidatorManagement
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipValidatorManagement(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowValidatorManagement
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowValidatorManagement
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowValidatorManagement
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthValidatorManagement
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupValidatorManagement
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthValidatorManagement
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthValidatorManagement        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowValidatorManagement          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupValidatorManagement = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,688 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: proto/beacon/rpc/v1/validator_subnets.proto
+
+package ethereum_beacon_rpc_v1
+
+import (
+	context "context"
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	proto "github.com/gogo/protobuf/proto"
+	types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type CommitteeSubnetsSubscribeRequest struct {
+	Slots                []uint64 `protobuf:"varint,1,rep,packed,name=slots,proto3" json:"slots,omitempty"`
+	CommitteeIds         []uint64 `protobuf:"varint,2,rep,packed,name=committee_ids,json=committeeIds,proto3" json:"committee_ids,omitempty"`
+	IsAggregator         []bool   `protobuf:"varint,3,rep,packed,name=is_aggregator,json=isAggregator,proto3" json:"is_aggregator,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CommitteeSubnetsSubscribeRequest) Reset()         { *m = CommitteeSubnetsSubscribeRequest{} }
+func (m *CommitteeSubnetsSubscribeRequest) String() string { return proto.CompactTextString(m) }
+func (*CommitteeSubnetsSubscribeRequest) ProtoMessage()    {}
+func (*CommitteeSubnetsSubscribeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b34d819747b17de, []int{0}
+}
+func (m *CommitteeSubnetsSubscribeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommitteeSubnetsSubscribeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommitteeSubnetsSubscribeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommitteeSubnetsSubscribeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitteeSubnetsSubscribeRequest.Merge(m, src)
+}
+func (m *CommitteeSubnetsSubscribeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommitteeSubnetsSubscribeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitteeSubnetsSubscribeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommitteeSubnetsSubscribeRequest proto.InternalMessageInfo
+
+func (m *CommitteeSubnetsSubscribeRequest) GetSlots() []uint64 {
+	if m != nil {
+		return m.Slots
+	}
+	return nil
+}
+
+func (m *CommitteeSubnetsSubscribeRequest) GetCommitteeIds() []uint64 {
+	if m != nil {
+		return m.CommitteeIds
+	}
+	return nil
+}
+
+func (m *CommitteeSubnetsSubscribeRequest) GetIsAggregator() []bool {
+	if m != nil {
+		return m.IsAggregator
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*CommitteeSubnetsSubscribeRequest)(nil), "ethereum.beacon.rpc.v1.CommitteeSubnetsSubscribeRequest")
+}
+
+func init() {
+	proto.RegisterFile("proto/beacon/rpc/v1/validator_subnets.proto", fileDescriptor_0b34d819747b17de)
+}
+
+var fileDescriptor_0b34d819747b17de = []byte{
+	// 316 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x51, 0xc1, 0x4a, 0xf3, 0x40,
+	0x10, 0x66, 0xdb, 0xff, 0x17, 0x59, 0x2a, 0x48, 0x90, 0x52, 0xab, 0x94, 0x52, 0x2f, 0x45, 0x71,
+	0x97, 0xda, 0x8b, 0x78, 0x53, 0xf1, 0xe0, 0xb5, 0x05, 0xaf, 0x65, 0x37, 0x1d, 0xd3, 0x85, 0x24,
+	0xbb, 0xee, 0x4e, 0x02, 0x5e, 0xfb, 0x0a, 0xbe, 0x81, 0x2f, 0xe1, 0x2b, 0x78, 0x14, 0x7c, 0x01,
+	0x09, 0x3e, 0x88, 0x34, 0x9b, 0x04, 0x11, 0xc1, 0xe3, 0xcc, 0xf7, 0xcd, 0xcc, 0xf7, 0x7d, 0x43,
+	0x4f, 0x8c, 0xd5, 0xa8, 0xb9, 0x04, 0x11, 0xea, 0x94, 0x5b, 0x13, 0xf2, 0x7c, 0xc2, 0x73, 0x11,
+	0xab, 0xa5, 0x40, 0x6d, 0x17, 0x2e, 0x93, 0x29, 0xa0, 0x63, 0x25, 0x2b, 0xe8, 0x02, 0xae, 0xc0,
+	0x42, 0x96, 0x30, 0xcf, 0x67, 0xd6, 0x84, 0x2c, 0x9f, 0xf4, 0x0f, 0x23, 0xad, 0xa3, 0x18, 0xb8,
+	0x30, 0x8a, 0x8b, 0x34, 0xd5, 0x28, 0x50, 0xe9, 0xb4, 0x9a, 0xea, 0x1f, 0x54, 0x68, 0x59, 0xc9,
+	0xec, 0x9e, 0x43, 0x62, 0xf0, 0xd1, 0x83, 0xa3, 0x35, 0xa1, 0xc3, 0x6b, 0x9d, 0x24, 0x0a, 0x11,
+	0x60, 0xee, 0xaf, 0xcd, 0x33, 0xe9, 0x42, 0xab, 0x24, 0xcc, 0xe0, 0x21, 0x03, 0x87, 0xc1, 0x1e,
+	0xfd, 0xef, 0x62, 0x8d, 0xae, 0x47, 0x86, 0xed, 0xf1, 0xbf, 0x99, 0x2f, 0x82, 0x23, 0xba, 0x13,
+	0xd6, 0x93, 0x0b, 0xb5, 0x74, 0xbd, 0x56, 0x89, 0x76, 0x9a, 0xe6, 0xed, 0xb2, 0x24, 0x29, 0xb7,
+	0x10, 0x51, 0x64, 0x21, 0xda, 0x38, 0xea, 0xb5, 0x87, 0xed, 0xf1, 0xf6, 0xac, 0xa3, 0xdc, 0x65,
+	0xd3, 0x3b, 0x7b, 0x21, 0x74, 0xf7, 0xae, 0xf6, 0x5c, 0x89, 0x08, 0x9e, 0x09, 0xdd, 0x6f, 0x94,
+	0xfc, 0x94, 0x18, 0x9c, 0xb3, 0xdf, 0xb3, 0x60, 0x7f, 0x99, 0xe9, 0x77, 0x99, 0xcf, 0x83, 0xd5,
+	0x79, 0xb0, 0x9b, 0x4d, 0x1e, 0xa3, 0xe9, 0xfa, 0xfd, 0xf3, 0xa9, 0x75, 0x3a, 0x1a, 0x73, 0xc0,
+	0x15, 0xcf, 0x27, 0x22, 0x36, 0x2b, 0xf1, 0xed, 0x17, 0xdc, 0xff, 0x82, 0xbb, 0x7a, 0xe1, 0x05,
+	0x39, 0xbe, 0xea, 0xbc, 0x16, 0x03, 0xf2, 0x56, 0x0c, 0xc8, 0x47, 0x31, 0x20, 0x72, 0xab, 0x5c,
+	0x39, 0xfd, 0x1a, 0x00, 0xb4, 0x52, 0x1c, 0x96, 0xd5, 0x01, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ValidatorSubnetsClient is the client API for ValidatorSubnets service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ValidatorSubnetsClient interface {
+	SubscribeCommitteeSubnets(ctx context.Context, in *CommitteeSubnetsSubscribeRequest, opts ...grpc.CallOption) (*types.Empty, error)
+}
+
+type validatorSubnetsClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewValidatorSubnetsClient(cc *grpc.ClientConn) ValidatorSubnetsClient {
+	return &validatorSubnetsClient{cc}
+}
+
+func (c *validatorSubnetsClient) SubscribeCommitteeSubnets(ctx context.Context, in *CommitteeSubnetsSubscribeRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorSubnets/SubscribeCommitteeSubnets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ValidatorSubnetsServer is the server API for ValidatorSubnets service.
+type ValidatorSubnetsServer interface {
+	SubscribeCommitteeSubnets(context.Context, *CommitteeSubnetsSubscribeRequest) (*types.Empty, error)
+}
+
+// UnimplementedValidatorSubnetsServer can be embedded to have forward compatible implementations.
+type UnimplementedValidatorSubnetsServer struct {
+}
+
+func (*UnimplementedValidatorSubnetsServer) SubscribeCommitteeSubnets(ctx context.Context, req *CommitteeSubnetsSubscribeRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubscribeCommitteeSubnets not implemented")
+}
+
+func RegisterValidatorSubnetsServer(s *grpc.Server, srv ValidatorSubnetsServer) {
+	s.RegisterService(&_ValidatorSubnets_serviceDesc, srv)
+}
+
+func _ValidatorSubnets_SubscribeCommitteeSubnets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitteeSubnetsSubscribeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValidatorSubnetsServer).SubscribeCommitteeSubnets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorSubnets/SubscribeCommitteeSubnets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorSubnetsServer).SubscribeCommitteeSubnets(ctx, req.(*CommitteeSubnetsSubscribeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ValidatorSubnets_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.ValidatorSubnets",
+	HandlerType: (*ValidatorSubnetsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SubscribeCommitteeSubnets",
+			Handler:    _ValidatorSubnets_SubscribeCommitteeSubnets_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/beacon/rpc/v1/validator_subnets.proto",
+}
+
+func (m *CommitteeSubnetsSubscribeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitteeSubnetsSubscribeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommitteeSubnetsSubscribeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.IsAggregator) > 0 {
+		for iNdEx := len(m.IsAggregator) - 1; iNdEx >= 0; iNdEx-- {
+			i--
+			if m.IsAggregator[iNdEx] {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+		}
+		i = encodeVarintValidatorSubnets(dAtA, i, uint64(len(m.IsAggregator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CommitteeIds) > 0 {
+		dAtA2 := make([]byte, len(m.CommitteeIds)*10)
+		var j1 int
+		for _, num := range m.CommitteeIds {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintValidatorSubnets(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Slots) > 0 {
+		dAtA4 := make([]byte, len(m.Slots)*10)
+		var j3 int
+		for _, num := range m.Slots {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintValidatorSubnets(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintValidatorSubnets(dAtA []byte, offset int, v uint64) int {
+	offset -= sovValidatorSubnets(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CommitteeSubnetsSubscribeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Slots) > 0 {
+		l = 0
+		for _, e := range m.Slots {
+			l += sovValidatorSubnets(uint64(e))
+		}
+		n += 1 + sovValidatorSubnets(uint64(l)) + l
+	}
+	if len(m.CommitteeIds) > 0 {
+		l = 0
+		for _, e := range m.CommitteeIds {
+			l += sovValidatorSubnets(uint64(e))
+		}
+		n += 1 + sovValidatorSubnets(uint64(l)) + l
+	}
+	if len(m.IsAggregator) > 0 {
+		n += 1 + sovValidatorSubnets(uint64(len(m.IsAggregator))) + len(m.IsAggregator)*1
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovValidatorSubnets(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozValidatorSubnets(x uint64) (n int) {
+	return sovValidatorSubnets(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CommitteeSubnetsSubscribeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowValidatorSubnets
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitteeSubnetsSubscribeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitteeSubnetsSubscribeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowValidatorSubnets
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Slots = append(m.Slots, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowValidatorSubnets
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthValidatorSubnets
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthValidatorSubnets
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Slots) == 0 {
+					m.Slots = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowValidatorSubnets
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Slots = append(m.Slots, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slots", wireType)
+			}
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowValidatorSubnets
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.CommitteeIds = append(m.CommitteeIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowValidatorSubnets
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthValidatorSubnets
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthValidatorSubnets
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.CommitteeIds) == 0 {
+					m.CommitteeIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowValidatorSubnets
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.CommitteeIds = append(m.CommitteeIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitteeIds", wireType)
+			}
+		case 3:
+			if wireType == 0 {
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowValidatorSubnets
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.IsAggregator = append(m.IsAggregator, bool(v != 0))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowValidatorSubnets
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthValidatorSubnets
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthValidatorSubnets
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen
+				if elementCount != 0 && len(m.IsAggregator) == 0 {
+					m.IsAggregator = make([]bool, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowValidatorSubnets
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.IsAggregator = append(m.IsAggregator, bool(v != 0))
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsAggregator", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipValidatorSubnets(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthValidatorSubnets
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthValidatorSubnets
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipValidatorSubnets(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowValidatorSubnets
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowValidatorSubnets
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowValidatorSubnets
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthValidatorSubnets
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupValidatorSubnets
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthValidatorSubnets
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthValidatorSubnets        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowValidatorSubnets          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupValidatorSubnets = fmt.Errorf("proto: unexpected end of group")
+)
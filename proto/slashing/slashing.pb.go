@@ -234,49 +234,144 @@ func (m *AttestationHistory) GetLatestEpochWritten() uint64 {
 	return 0
 }
 
+type AttestationInclusion struct {
+	Slot                 uint64   `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	DataRoot             []byte   `protobuf:"bytes,2,opt,name=data_root,json=dataRoot,proto3" json:"data_root,omitempty"`
+	InclusionSlot        uint64   `protobuf:"varint,3,opt,name=inclusion_slot,json=inclusionSlot,proto3" json:"inclusion_slot,omitempty"`
+	InclusionDistance    uint64   `protobuf:"varint,4,opt,name=inclusion_distance,json=inclusionDistance,proto3" json:"inclusion_distance,omitempty"`
+	CorrectHead          bool     `protobuf:"varint,5,opt,name=correct_head,json=correctHead,proto3" json:"correct_head,omitempty"`
+	CorrectTarget        bool     `protobuf:"varint,6,opt,name=correct_target,json=correctTarget,proto3" json:"correct_target,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AttestationInclusion) Reset()         { *m = AttestationInclusion{} }
+func (m *AttestationInclusion) String() string { return proto.CompactTextString(m) }
+func (*AttestationInclusion) ProtoMessage()    {}
+func (*AttestationInclusion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da7e95107d0081b4, []int{4}
+}
+func (m *AttestationInclusion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttestationInclusion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttestationInclusion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AttestationInclusion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttestationInclusion.Merge(m, src)
+}
+func (m *AttestationInclusion) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttestationInclusion) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttestationInclusion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttestationInclusion proto.InternalMessageInfo
+
+func (m *AttestationInclusion) GetSlot() uint64 {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+func (m *AttestationInclusion) GetDataRoot() []byte {
+	if m != nil {
+		return m.DataRoot
+	}
+	return nil
+}
+
+func (m *AttestationInclusion) GetInclusionSlot() uint64 {
+	if m != nil {
+		return m.InclusionSlot
+	}
+	return 0
+}
+
+func (m *AttestationInclusion) GetInclusionDistance() uint64 {
+	if m != nil {
+		return m.InclusionDistance
+	}
+	return 0
+}
+
+func (m *AttestationInclusion) GetCorrectHead() bool {
+	if m != nil {
+		return m.CorrectHead
+	}
+	return false
+}
+
+func (m *AttestationInclusion) GetCorrectTarget() bool {
+	if m != nil {
+		return m.CorrectTarget
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*ProposerSlashingResponse)(nil), "ethereum.slashing.ProposerSlashingResponse")
 	proto.RegisterType((*AttesterSlashingResponse)(nil), "ethereum.slashing.AttesterSlashingResponse")
 	proto.RegisterType((*ProposalHistory)(nil), "ethereum.slashing.ProposalHistory")
 	proto.RegisterType((*AttestationHistory)(nil), "ethereum.slashing.AttestationHistory")
 	proto.RegisterMapType((map[uint64]uint64)(nil), "ethereum.slashing.AttestationHistory.TargetToSourceEntry")
+	proto.RegisterType((*AttestationInclusion)(nil), "ethereum.slashing.AttestationInclusion")
 }
 
 func init() { proto.RegisterFile("proto/slashing/slashing.proto", fileDescriptor_da7e95107d0081b4) }
 
 var fileDescriptor_da7e95107d0081b4 = []byte{
-	// 483 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0xd5, 0xb6, 0x05, 0xc4, 0x52, 0x41, 0xba, 0x54, 0x28, 0x8a, 0x44, 0x1a, 0xe5, 0x42, 0x10,
-	0x74, 0xdd, 0x96, 0x0b, 0x70, 0xab, 0xa5, 0x4a, 0xed, 0x09, 0xe4, 0x44, 0xe2, 0x68, 0xad, 0xed,
-	0xa9, 0xbd, 0xea, 0xc6, 0xbb, 0xda, 0x1d, 0x17, 0xf2, 0x1f, 0x7c, 0x14, 0x47, 0xbe, 0x00, 0xa1,
-	0x9c, 0x11, 0x1f, 0xc0, 0x09, 0x79, 0xed, 0x54, 0xa1, 0x49, 0x24, 0x7a, 0x9b, 0x99, 0x37, 0xf3,
-	0xde, 0xf3, 0x78, 0x87, 0x3e, 0x37, 0x56, 0xa3, 0x0e, 0x9c, 0x12, 0xae, 0x90, 0x65, 0x7e, 0x13,
-	0x70, 0x5f, 0x67, 0x7b, 0x80, 0x05, 0x58, 0xa8, 0xa6, 0x7c, 0x01, 0xf4, 0x0e, 0x00, 0x8b, 0xe0,
-	0xfa, 0x58, 0x28, 0x53, 0x88, 0xe3, 0x20, 0x01, 0x91, 0xea, 0x32, 0x4e, 0x94, 0x4e, 0xaf, 0x9a,
-	0x99, 0xde, 0x61, 0x2e, 0xb1, 0xa8, 0x12, 0x9e, 0xea, 0x69, 0x90, 0xeb, 0x5c, 0x07, 0xbe, 0x9c,
-	0x54, 0x97, 0x3e, 0x6b, 0xf4, 0xea, 0xa8, 0x69, 0x1f, 0x1a, 0xda, 0xfd, 0x68, 0xb5, 0xd1, 0x0e,
-	0xec, 0xb8, 0xd5, 0x88, 0xc0, 0x19, 0x5d, 0x3a, 0x60, 0x13, 0xba, 0x67, 0x5a, 0x2c, 0x5e, 0x18,
-	0xe8, 0x92, 0xc1, 0xf6, 0xe8, 0xd1, 0xc9, 0x0b, 0x7e, 0x63, 0x0d, 0xb0, 0xe0, 0x0b, 0x43, 0x7c,
-	0x85, 0xab, 0x63, 0x6e, 0x55, 0x6a, 0xc5, 0x53, 0x44, 0x70, 0xb8, 0x5e, 0x51, 0xb4, 0xd8, 0xff,
-	0x2a, 0xae, 0x70, 0x75, 0xc4, 0xad, 0xca, 0xf0, 0x2b, 0xa1, 0x4f, 0x1a, 0x63, 0x42, 0x9d, 0x4b,
-	0x87, 0xda, 0xce, 0xd8, 0x07, 0x4a, 0xc1, 0xe8, 0xb4, 0x88, 0x13, 0x89, 0xae, 0x4b, 0x06, 0x64,
-	0xb4, 0x1b, 0x1e, 0xfd, 0xf9, 0x71, 0xf0, 0x7a, 0x69, 0x7d, 0xc6, 0xce, 0xdc, 0x54, 0xa0, 0x4c,
-	0x95, 0x48, 0x5c, 0x90, 0xeb, 0xc3, 0x44, 0xe2, 0xa5, 0x04, 0x95, 0xf1, 0x50, 0xa2, 0x92, 0x0e,
-	0xa3, 0x87, 0x9e, 0x23, 0x94, 0xe8, 0xd8, 0x11, 0xdd, 0x57, 0xa2, 0x16, 0x8e, 0x1b, 0xde, 0xcf,
-	0x56, 0x22, 0x42, 0xd9, 0xdd, 0x1a, 0x90, 0xd1, 0x4e, 0xc4, 0x1a, 0xec, 0xac, 0x86, 0x3e, 0x35,
-	0xc8, 0xf0, 0x37, 0xa1, 0xac, 0x71, 0x2f, 0x50, 0xea, 0x72, 0xe1, 0x2c, 0xa5, 0x1d, 0x14, 0x36,
-	0x07, 0x8c, 0x51, 0xc7, 0x4e, 0x57, 0x36, 0x85, 0x76, 0x05, 0xef, 0xf8, 0xca, 0x7b, 0xe0, 0xab,
-	0x04, 0x7c, 0xe2, 0xa7, 0x27, 0x7a, 0xec, 0x67, 0xcf, 0x4a, 0xb4, 0xb3, 0xe8, 0x31, 0xfe, 0x53,
-	0xbc, 0xbb, 0xdb, 0xde, 0x29, 0x7d, 0xba, 0x86, 0x98, 0x75, 0xe8, 0xf6, 0x15, 0xcc, 0xfc, 0x02,
-	0x77, 0xa2, 0x3a, 0x64, 0xfb, 0xf4, 0xde, 0xb5, 0x50, 0x15, 0xb4, 0x5c, 0x4d, 0xf2, 0x7e, 0xeb,
-	0x2d, 0x39, 0xf9, 0x45, 0xe8, 0x03, 0xff, 0x53, 0xc0, 0x32, 0x43, 0x9f, 0x5d, 0x38, 0x9f, 0x88,
-	0x44, 0xc1, 0xd2, 0x57, 0xb0, 0x97, 0x1b, 0x7e, 0xf4, 0x45, 0x99, 0xc1, 0x17, 0xc8, 0x96, 0x5a,
-	0x7b, 0xaf, 0x36, 0x2e, 0x64, 0xcd, 0xdb, 0xd2, 0xb4, 0xb3, 0xa4, 0x18, 0xd6, 0x27, 0xc3, 0xf8,
-	0x06, 0xad, 0xb1, 0xcc, 0x4b, 0xc8, 0x42, 0x7f, 0x5d, 0xbe, 0xf3, 0x1c, 0x44, 0x06, 0x76, 0xad,
-	0xe0, 0xa6, 0xf3, 0x09, 0x77, 0xbf, 0xcd, 0xfb, 0xe4, 0xfb, 0xbc, 0x4f, 0x7e, 0xce, 0xfb, 0x24,
-	0xb9, 0xef, 0xef, 0xed, 0xcd, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x11, 0xe3, 0x4b, 0x92, 0xf3,
-	0x03, 0x00, 0x00,
+	// 593 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0xd5, 0xb6, 0x69, 0x69, 0xb7, 0x69, 0x49, 0x97, 0x0a, 0x59, 0x41, 0xb4, 0x21, 0x12, 0x22,
+	0x08, 0xea, 0xb4, 0xe5, 0x02, 0xdc, 0x1a, 0x51, 0xa9, 0x3d, 0x81, 0x9c, 0x4a, 0x1c, 0xad, 0xb5,
+	0x3d, 0xb5, 0x57, 0x75, 0x3d, 0xd6, 0xee, 0xa4, 0x90, 0xff, 0xe0, 0xa3, 0x38, 0xf2, 0x05, 0x08,
+	0xf5, 0xc2, 0x05, 0xf1, 0x01, 0x9c, 0x90, 0xd7, 0x76, 0x08, 0x4d, 0x22, 0xc1, 0x6d, 0xf7, 0xbd,
+	0x99, 0xf7, 0x9e, 0x27, 0x9b, 0xe1, 0x0f, 0x73, 0x8d, 0x84, 0x7d, 0x93, 0x4a, 0x93, 0xa8, 0x2c,
+	0x9e, 0x1c, 0x5c, 0x8b, 0x8b, 0x6d, 0xa0, 0x04, 0x34, 0x8c, 0xae, 0xdc, 0x9a, 0x68, 0xef, 0x01,
+	0x25, 0xfd, 0xeb, 0x43, 0x99, 0xe6, 0x89, 0x3c, 0xec, 0x07, 0x20, 0x43, 0xcc, 0xfc, 0x20, 0xc5,
+	0xf0, 0xb2, 0xec, 0x69, 0xef, 0xc7, 0x8a, 0x92, 0x51, 0xe0, 0x86, 0x78, 0xd5, 0x8f, 0x31, 0xc6,
+	0xbe, 0x85, 0x83, 0xd1, 0x85, 0xbd, 0x95, 0x7e, 0xc5, 0xa9, 0x2c, 0xef, 0xe6, 0xdc, 0x79, 0xa7,
+	0x31, 0x47, 0x03, 0x7a, 0x58, 0x79, 0x78, 0x60, 0x72, 0xcc, 0x0c, 0x88, 0x73, 0xbe, 0x9d, 0x57,
+	0x9c, 0x5f, 0x07, 0x70, 0x58, 0x67, 0xb9, 0xb7, 0x71, 0xf4, 0xc4, 0x9d, 0x44, 0x03, 0x4a, 0xdc,
+	0x3a, 0x90, 0x3b, 0xa3, 0xd5, 0xca, 0x6f, 0x21, 0x85, 0xe3, 0x31, 0x11, 0x18, 0x9a, 0xef, 0x28,
+	0x2b, 0xee, 0x5f, 0x1d, 0x67, 0xb4, 0x5a, 0xf2, 0x16, 0xd2, 0xfd, 0xc4, 0xf8, 0xdd, 0x32, 0x98,
+	0x4c, 0x4f, 0x95, 0x21, 0xd4, 0x63, 0xf1, 0x96, 0x73, 0xc8, 0x31, 0x4c, 0xfc, 0x40, 0x91, 0x71,
+	0x58, 0x87, 0xf5, 0x9a, 0x83, 0x83, 0x5f, 0x5f, 0xf7, 0x9e, 0x4f, 0x8d, 0x2f, 0xd7, 0x63, 0x73,
+	0x25, 0x49, 0x85, 0xa9, 0x0c, 0x4c, 0x3f, 0xc6, 0xfd, 0x40, 0xd1, 0x85, 0x82, 0x34, 0x72, 0x07,
+	0x8a, 0x52, 0x65, 0xc8, 0x5b, 0xb7, 0x1a, 0x03, 0x45, 0x46, 0x1c, 0xf0, 0x9d, 0x54, 0x16, 0xc6,
+	0x7e, 0xa9, 0xfb, 0x41, 0x2b, 0x22, 0xc8, 0x9c, 0xa5, 0x0e, 0xeb, 0x35, 0x3c, 0x51, 0x72, 0x27,
+	0x05, 0xf5, 0xbe, 0x64, 0xba, 0x3f, 0x19, 0x17, 0x65, 0x7a, 0x49, 0x0a, 0xb3, 0x3a, 0x59, 0xc8,
+	0x5b, 0x24, 0x75, 0x0c, 0xe4, 0x13, 0xfa, 0x06, 0x47, 0x3a, 0x84, 0x6a, 0x04, 0xaf, 0xdc, 0x99,
+	0xf7, 0xe0, 0xce, 0x0a, 0xb8, 0xe7, 0xb6, 0xfb, 0x1c, 0x87, 0xb6, 0xf7, 0x24, 0x23, 0x3d, 0xf6,
+	0xb6, 0xe8, 0x2f, 0xf0, 0xff, 0xd3, 0xb6, 0x8f, 0xf9, 0xbd, 0x39, 0xc2, 0xa2, 0xc5, 0x97, 0x2f,
+	0x61, 0x6c, 0x07, 0xd8, 0xf0, 0x8a, 0xa3, 0xd8, 0xe1, 0x2b, 0xd7, 0x32, 0x1d, 0x41, 0xa5, 0x55,
+	0x5e, 0x5e, 0x2f, 0xbd, 0x64, 0xdd, 0xef, 0x8c, 0xef, 0x4c, 0xe5, 0x3d, 0xcb, 0xc2, 0x74, 0x64,
+	0x14, 0x66, 0x42, 0xf0, 0x86, 0x49, 0x91, 0x2a, 0x15, 0x7b, 0x16, 0x0f, 0xf8, 0x7a, 0x24, 0x49,
+	0xfa, 0x1a, 0x91, 0xac, 0x54, 0xd3, 0x5b, 0x2b, 0x00, 0x0f, 0x91, 0xc4, 0x63, 0xbe, 0xa5, 0xea,
+	0x6e, 0xdf, 0xb6, 0x2e, 0xdb, 0xd6, 0xcd, 0x09, 0x3a, 0x2c, 0x34, 0xf6, 0xb9, 0xf8, 0x53, 0x16,
+	0x29, 0x43, 0x32, 0x0b, 0xc1, 0x69, 0xd8, 0xd2, 0xed, 0x09, 0xf3, 0xa6, 0x22, 0xc4, 0x23, 0xde,
+	0x0c, 0x51, 0x6b, 0x08, 0xc9, 0x4f, 0x40, 0x46, 0xce, 0x4a, 0x87, 0xf5, 0xd6, 0xbc, 0x8d, 0x0a,
+	0x3b, 0x05, 0x19, 0x15, 0xc6, 0x75, 0x49, 0x39, 0x51, 0x67, 0xd5, 0x16, 0x6d, 0x56, 0x68, 0x39,
+	0xa2, 0xa3, 0x1f, 0x8c, 0xdf, 0xb1, 0xcf, 0x0f, 0xb4, 0xc8, 0xf9, 0xfd, 0x33, 0x63, 0x2f, 0x32,
+	0x48, 0x61, 0xea, 0xfb, 0xc5, 0xd3, 0x05, 0x4f, 0xfa, 0x2c, 0x8b, 0xe0, 0x23, 0x44, 0x53, 0xa5,
+	0xed, 0x67, 0x0b, 0x7f, 0xfa, 0x39, 0xff, 0x22, 0xe4, 0xad, 0x29, 0xc7, 0x41, 0xb1, 0x1c, 0x84,
+	0xbb, 0xc0, 0x6b, 0xa8, 0xe2, 0x0c, 0xa2, 0x81, 0xdd, 0x23, 0xb6, 0xb2, 0xf8, 0x4c, 0xd0, 0x73,
+	0x0d, 0x17, 0x2d, 0x8a, 0x41, 0xf3, 0xf3, 0xcd, 0x2e, 0xfb, 0x72, 0xb3, 0xcb, 0xbe, 0xdd, 0xec,
+	0xb2, 0x60, 0xd5, 0x6e, 0x96, 0x17, 0xbf, 0x07, 0x00, 0x59, 0x45, 0x34, 0x1a, 0xdd, 0x04, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -563,6 +658,75 @@ func (m *AttestationHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AttestationInclusion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttestationInclusion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttestationInclusion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.CorrectTarget {
+		i--
+		if m.CorrectTarget {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.CorrectHead {
+		i--
+		if m.CorrectHead {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.InclusionDistance != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.InclusionDistance))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.InclusionSlot != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.InclusionSlot))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.DataRoot) > 0 {
+		i -= len(m.DataRoot)
+		copy(dAtA[i:], m.DataRoot)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.DataRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Slot != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintSlashing(dAtA []byte, offset int, v uint64) int {
 	offset -= sovSlashing(v)
 	base := offset
@@ -652,6 +816,37 @@ func (m *AttestationHistory) Size() (n int) {
 	return n
 }
 
+func (m *AttestationInclusion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Slot != 0 {
+		n += 1 + sovSlashing(uint64(m.Slot))
+	}
+	l = len(m.DataRoot)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	if m.InclusionSlot != 0 {
+		n += 1 + sovSlashing(uint64(m.InclusionSlot))
+	}
+	if m.InclusionDistance != 0 {
+		n += 1 + sovSlashing(uint64(m.InclusionDistance))
+	}
+	if m.CorrectHead {
+		n += 2
+	}
+	if m.CorrectTarget {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovSlashing(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1113,6 +1308,191 @@ func (m *AttestationHistory) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AttestationInclusion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttestationInclusion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttestationInclusion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataRoot = append(m.DataRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.DataRoot == nil {
+				m.DataRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InclusionSlot", wireType)
+			}
+			m.InclusionSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InclusionSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InclusionDistance", wireType)
+			}
+			m.InclusionDistance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InclusionDistance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorrectHead", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CorrectHead = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorrectTarget", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CorrectTarget = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipSlashing(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,90 @@
+package benchutil_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	beaconstate "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/shared/benchutil"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+)
+
+var runAmount = 25
+
+func fullBlockSignatureSet(b *testing.B) *bls.SignatureSet {
+	benchutil.SetBenchmarkConfig()
+	beaconState, err := benchutil.PreGenState1Epoch()
+	if err != nil {
+		b.Fatal(err)
+	}
+	block, err := benchutil.PreGenFullBlock()
+	if err != nil {
+		b.Fatal(err)
+	}
+	beaconState, err = state.ProcessSlots(context.Background(), beaconState, block.Block.Slot)
+	if err != nil {
+		b.Fatal(err)
+	}
+	set, err := blocks.BlockSignatureSet(context.Background(), beaconState, block)
+	if err != nil {
+		b.Fatal(err)
+	}
+	return set
+}
+
+func BenchmarkVerifyBlockSignatures_Individually(b *testing.B) {
+	set := fullBlockSignatureSet(b)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for j, sig := range set.Signatures {
+			if !sig.Verify(set.Messages[j][:], set.PublicKeys[j], set.Domains[j]) {
+				b.Fatalf("could not verify %s", set.Descriptions[j])
+			}
+		}
+	}
+}
+
+func BenchmarkVerifyBlockSignatures_Batched(b *testing.B) {
+	set := fullBlockSignatureSet(b)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := set.Verify(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkExecuteStateTransition_FullBlockBatchVerify(b *testing.B) {
+	benchutil.SetBenchmarkConfig()
+	resetCfg := featureconfig.Get()
+	cfg := *resetCfg
+	cfg.EnableBatchBlockVerify = true
+	featureconfig.Init(&cfg)
+	defer featureconfig.Init(resetCfg)
+
+	beaconState, err := benchutil.PreGenState1Epoch()
+	if err != nil {
+		b.Fatal(err)
+	}
+	cleanStates := make([]*beaconstate.BeaconState, runAmount)
+	for i := 0; i < runAmount; i++ {
+		cleanStates[i] = beaconState.Copy()
+	}
+	block, err := benchutil.PreGenFullBlock()
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.N = runAmount
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := state.ExecuteStateTransition(context.Background(), cleanStates[i], block); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,141 @@
+package bls
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+
+	bls12 "github.com/herumi/bls-eth-go-binary/bls"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+)
+
+// SignatureSet refers to the defined set of signatures, their respective public keys,
+// messages and domains. Each index i across the slices refers to a single signature
+// which can be verified with PublicKeys[i] over Messages[i] with Domains[i].
+type SignatureSet struct {
+	Signatures   []*Signature
+	PublicKeys   []*PublicKey
+	Messages     [][32]byte
+	Domains      []uint64
+	Descriptions []string
+}
+
+// NewSet constructs an empty signature set object.
+func NewSet() *SignatureSet {
+	return &SignatureSet{
+		Signatures:   []*Signature{},
+		PublicKeys:   []*PublicKey{},
+		Messages:     [][32]byte{},
+		Domains:      []uint64{},
+		Descriptions: []string{},
+	}
+}
+
+// Add appends a single signature with its public key, message, domain and a
+// human readable description of what was signed to the set.
+func (s *SignatureSet) Add(sig *Signature, pub *PublicKey, msg [32]byte, domain uint64, description string) {
+	s.Signatures = append(s.Signatures, sig)
+	s.PublicKeys = append(s.PublicKeys, pub)
+	s.Messages = append(s.Messages, msg)
+	s.Domains = append(s.Domains, domain)
+	s.Descriptions = append(s.Descriptions, description)
+}
+
+// Join merges the provided signature set into the current one and returns it.
+func (s *SignatureSet) Join(set *SignatureSet) *SignatureSet {
+	s.Signatures = append(s.Signatures, set.Signatures...)
+	s.PublicKeys = append(s.PublicKeys, set.PublicKeys...)
+	s.Messages = append(s.Messages, set.Messages...)
+	s.Domains = append(s.Domains, set.Domains...)
+	s.Descriptions = append(s.Descriptions, set.Descriptions...)
+	return s
+}
+
+// Verify checks all the signatures in the set with a single randomized batch
+// verification. If the batch fails, the signatures are verified one by one so
+// that the returned error describes the first invalid signature of the set.
+func (s *SignatureSet) Verify() error {
+	if featureconfig.Get().SkipBLSVerify {
+		return nil
+	}
+	valid, err := VerifyMultipleSignatures(s.Signatures, s.PublicKeys, s.Messages, s.Domains)
+	if err != nil {
+		return err
+	}
+	if valid {
+		return nil
+	}
+	for i, sig := range s.Signatures {
+		if !sig.Verify(s.Messages[i][:], s.PublicKeys[i], s.Domains[i]) {
+			return errors.Errorf("could not verify %s", s.Descriptions[i])
+		}
+	}
+	// Valid signatures always pass the batch verification, so this is not
+	// expected to be reached.
+	return errors.New("batch signature verification failed")
+}
+
+// VerifyMultipleSignatures verifies a non-singular set of signatures and their respective
+// public keys, messages and domains in one go. Every signature and public key is multiplied
+// by a random non-zero 64-bit scalar before aggregating, so that invalid signatures can not
+// cancel each other out in the aggregate:
+//
+//	e(sum(r_i * sig_i), G1) == prod(e(H(msg_i, domain_i), r_i * pub_i))
+func VerifyMultipleSignatures(sigs []*Signature, pubKeys []*PublicKey, msgs [][32]byte, domains []uint64) (bool, error) {
+	if featureconfig.Get().SkipBLSVerify {
+		return true, nil
+	}
+	size := len(sigs)
+	if size != len(pubKeys) || size != len(msgs) || size != len(domains) {
+		return false, errors.Errorf(
+			"provided signatures, public keys, messages and domains have differing lengths: %d, %d, %d, %d",
+			size, len(pubKeys), len(msgs), len(domains),
+		)
+	}
+	if size == 0 {
+		return false, errors.New("no signatures provided")
+	}
+
+	var aggregated bls12.G2
+	aggregated.Clear()
+	rawKeys := make([]bls12.PublicKey, size)
+	hashWithDomains := make([]byte, 0, size*concatMsgDomainSize)
+	for i := 0; i < size; i++ {
+		if sigs[i] == nil || sigs[i].s == nil || pubKeys[i] == nil || pubKeys[i].p == nil {
+			return false, errors.Errorf("nil signature or public key at index %d", i)
+		}
+		r, err := randomScalar()
+		if err != nil {
+			return false, errors.Wrap(err, "could not generate random scalar")
+		}
+		var scaledSig bls12.G2
+		bls12.G2Mul(&scaledSig, bls12.CastFromSign(sigs[i].s), r)
+		bls12.G2Add(&aggregated, &aggregated, &scaledSig)
+
+		var scaledKey bls12.G1
+		bls12.G1Mul(&scaledKey, bls12.CastFromPublicKey(pubKeys[i].p), r)
+		rawKeys[i] = *bls12.CastToPublicKey(&scaledKey)
+
+		hashWithDomains = append(hashWithDomains, concatMsgAndDomain(msgs[i][:], domains[i])...)
+	}
+	return bls12.CastToSign(&aggregated).VerifyAggregateHashWithDomain(rawKeys, hashWithDomains), nil
+}
+
+// randomScalar returns a random non-zero 64-bit scalar used to weight each signature
+// of a batch verification.
+func randomScalar() (*bls12.Fr, error) {
+	b := make([]byte, 8)
+	for {
+		if _, err := rand.Read(b); err != nil {
+			return nil, err
+		}
+		if binary.LittleEndian.Uint64(b) != 0 {
+			break
+		}
+	}
+	r := &bls12.Fr{}
+	if err := r.SetLittleEndian(b); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
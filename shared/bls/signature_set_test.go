@@ -0,0 +1,77 @@
+package bls_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/bls"
+)
+
+func signatureSet(size int) *bls.SignatureSet {
+	set := bls.NewSet()
+	for i := 0; i < size; i++ {
+		msg := [32]byte{'h', 'e', 'l', 'l', 'o', byte(i)}
+		priv := bls.RandKey()
+		domain := uint64(i % 3)
+		set.Add(priv.Sign(msg[:], domain), priv.PublicKey(), msg, domain, fmt.Sprintf("signature %d", i))
+	}
+	return set
+}
+
+func TestVerifyMultipleSignatures(t *testing.T) {
+	set := signatureSet(64)
+	valid, err := bls.VerifyMultipleSignatures(set.Signatures, set.PublicKeys, set.Messages, set.Domains)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !valid {
+		t.Error("Signatures did not verify")
+	}
+}
+
+func TestVerifyMultipleSignatures_InvalidSignature(t *testing.T) {
+	set := signatureSet(16)
+	set.Signatures[3], set.Signatures[4] = set.Signatures[4], set.Signatures[3]
+	valid, err := bls.VerifyMultipleSignatures(set.Signatures, set.PublicKeys, set.Messages, set.Domains)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if valid {
+		t.Error("Expected swapped signatures to fail batch verification")
+	}
+}
+
+func TestVerifyMultipleSignatures_LengthMismatch(t *testing.T) {
+	set := signatureSet(4)
+	if _, err := bls.VerifyMultipleSignatures(set.Signatures, set.PublicKeys[1:], set.Messages, set.Domains); err == nil {
+		t.Error("Expected error with differing input lengths")
+	}
+	if _, err := bls.VerifyMultipleSignatures(nil, nil, nil, nil); err == nil {
+		t.Error("Expected error with empty input")
+	}
+}
+
+func TestSignatureSet_Join(t *testing.T) {
+	set := signatureSet(3)
+	joined := signatureSet(5).Join(set)
+	if len(joined.Signatures) != 8 || len(joined.PublicKeys) != 8 || len(joined.Messages) != 8 ||
+		len(joined.Domains) != 8 || len(joined.Descriptions) != 8 {
+		t.Errorf("Expected 8 signatures in joined set, received %d", len(joined.Signatures))
+	}
+	if err := joined.Verify(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSignatureSet_VerifyReportsInvalidSignature(t *testing.T) {
+	set := signatureSet(10)
+	set.Messages[7] = [32]byte{'b', 'a', 'd'}
+	err := set.Verify()
+	if err == nil {
+		t.Fatal("Expected invalid signature set to fail verification")
+	}
+	if !strings.Contains(err.Error(), "signature 7") {
+		t.Errorf("Expected error to describe the invalid signature, received %v", err)
+	}
+}
@@ -168,4 +168,15 @@ var (
 		Name:  "config-file",
 		Usage: "The filepath to a yaml file with flag values",
 	}
+	// ChainConfigFileFlag specifies the filepath to load the chain config values from.
+	ChainConfigFileFlag = &cli.StringFlag{
+		Name:  "chain-config-file",
+		Usage: "The path to a YAML file with chain config values, applied over the preset chosen by the other flags",
+	}
+	// SlotTimingFractionsFlag specifies the fractions of the slot after which a late step of a validator duty is logged.
+	SlotTimingFractionsFlag = &cli.StringFlag{
+		Name:  "slot-timing-fractions",
+		Usage: "Comma-separated fractions of the slot after which a step of a validator duty is logged as late",
+		Value: "0.33,0.66,1",
+	}
 )
@@ -6,7 +6,9 @@ import (
 	"os"
 	"strings"
 
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/sirupsen/logrus"
+	"gopkg.in/urfave/cli.v2"
 )
 
 var log = logrus.WithField("prefix", "node")
@@ -42,3 +44,16 @@ func ConfirmAction(actionText string, deniedText string) (bool, error) {
 
 	return confirmed, nil
 }
+
+// LoadChainConfigFile overrides the beacon chain config with the chain config file given by
+// --chain-config-file, if any.
+func LoadChainConfigFile(ctx *cli.Context) error {
+	if !ctx.IsSet(ChainConfigFileFlag.Name) {
+		return nil
+	}
+	if err := params.LoadChainConfigFile(ctx.String(ChainConfigFileFlag.Name)); err != nil {
+		return err
+	}
+	log.WithField("config", params.BeaconConfig().ConfigName).Info("Loaded chain config file")
+	return nil
+}
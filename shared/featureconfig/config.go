@@ -52,6 +52,9 @@ type Flags struct {
 	EnableFieldTrie                            bool // EnableFieldTrie enables the state from using field specific tries when computing the root.
 	EnableBlockHTR                             bool // EnableBlockHTR enables custom hashing of our beacon blocks.
 	NoInitSyncBatchSaveBlocks                  bool // NoInitSyncBatchSaveBlocks disables batch save blocks mode during initial syncing.
+	EnableStateDiffStorage                     bool // EnableStateDiffStorage saves cold archived states as diffs against full snapshots.
+	EnableBatchBlockVerify                     bool // EnableBatchBlockVerify verifies all signatures of a block in a single batch during the state transition.
+	EnableProposerBoost                        bool // EnableProposerBoost boosts the fork choice weight of timely blocks during their slot.
 	// DisableForkChoice disables using LMD-GHOST fork choice to update
 	// the head of the chain based on attestations and instead accepts any valid received block
 	// as the chain head. UNSAFE, use with caution.
@@ -191,6 +194,18 @@ func ConfigureBeaconChain(ctx *cli.Context) {
 		log.Warn("Disabling init sync batch save blocks mode")
 		cfg.NoInitSyncBatchSaveBlocks = true
 	}
+	if ctx.Bool(enableStateDiffStorage.Name) {
+		log.Warn("Enabling experimental state diff storage for cold states")
+		cfg.EnableStateDiffStorage = true
+	}
+	if ctx.Bool(enableBatchBlockVerify.Name) {
+		log.Warn("Enabling batch verification of block signatures")
+		cfg.EnableBatchBlockVerify = true
+	}
+	if ctx.Bool(enableProposerBoost.Name) {
+		log.Warn("Enabling proposer boost in fork choice")
+		cfg.EnableProposerBoost = true
+	}
 	Init(cfg)
 }
 
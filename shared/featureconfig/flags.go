@@ -129,6 +129,21 @@ var (
 		Name:  "disable-init-sync-queue",
 		Usage: "Disables concurrent fetching and processing of blocks on initial sync.",
 	}
+	enableStateDiffStorage = &cli.BoolFlag{
+		Name: "enable-state-diff-storage",
+		Usage: "Saves cold archived states as diffs against the previous full snapshot instead of full states. " +
+			"This significantly reduces disk usage of archive nodes with small archive point intervals",
+	}
+	enableBatchBlockVerify = &cli.BoolFlag{
+		Name: "enable-batch-block-verify",
+		Usage: "Verifies all the signatures of a block in a single randomized batch verification " +
+			"instead of one by one, which speeds up block processing",
+	}
+	enableProposerBoost = &cli.BoolFlag{
+		Name: "enable-proposer-boost",
+		Usage: "Boosts the fork choice weight of a block received in the first interval of its slot until the " +
+			"end of the slot, which prevents late blocks from reorging timely ones",
+	}
 	enableFieldTrie = &cli.BoolFlag{
 		Name:  "enable-state-field-trie",
 		Usage: "Enables the usage of state field tries to compute the state root",
@@ -345,6 +360,9 @@ var BeaconChainFlags = append(deprecatedFlags, []cli.Flag{
 	enableFieldTrie,
 	enableCustomBlockHTR,
 	disableInitSyncBatchSaveBlocks,
+	enableStateDiffStorage,
+	enableBatchBlockVerify,
+	enableProposerBoost,
 }...)
 
 // E2EBeaconChainFlags contains a list of the beacon chain feature flags to be tested in E2E.
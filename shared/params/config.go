@@ -10,6 +10,8 @@ import (
 
 // BeaconChainConfig contains constant configs for node to participate in beacon chain.
 type BeaconChainConfig struct {
+	ConfigName string `yaml:"CONFIG_NAME"` // ConfigName is the name of the preset or file the config was loaded from.
+
 	// Constants (non-configurable)
 	FarFutureEpoch           uint64 `yaml:"FAR_FUTURE_EPOCH"`            // FarFutureEpoch represents a epoch extremely far away in the future used as the default penalization slot for validators.
 	BaseRewardsPerEpoch      uint64 `yaml:"BASE_REWARDS_PER_EPOCH"`      // BaseRewardsPerEpoch is used to calculate the per epoch rewards.
@@ -17,15 +19,19 @@ type BeaconChainConfig struct {
 	MinGenesisDelay          uint64 `yaml:"MIN_GENESIS_DELAY"`           // Minimum number of seconds to delay starting the ETH2 genesis. Must be at least 1 second.
 
 	// Misc constants.
-	TargetCommitteeSize            uint64 `yaml:"TARGET_COMMITTEE_SIZE"`        // TargetCommitteeSize is the number of validators in a committee when the chain is healthy.
-	MaxValidatorsPerCommittee      uint64 `yaml:"MAX_VALIDATORS_PER_COMMITTEE"` // MaxValidatorsPerCommittee defines the upper bound of the size of a committee.
-	MaxCommitteesPerSlot           uint64 // MaxCommitteesPerSlot defines the max amount of committee in a single slot.
+	TargetCommitteeSize            uint64 `yaml:"TARGET_COMMITTEE_SIZE"`              // TargetCommitteeSize is the number of validators in a committee when the chain is healthy.
+	MaxValidatorsPerCommittee      uint64 `yaml:"MAX_VALIDATORS_PER_COMMITTEE"`       // MaxValidatorsPerCommittee defines the upper bound of the size of a committee.
+	MaxCommitteesPerSlot           uint64 `yaml:"MAX_COMMITTEES_PER_SLOT"`            // MaxCommitteesPerSlot defines the max amount of committee in a single slot.
 	MinPerEpochChurnLimit          uint64 `yaml:"MIN_PER_EPOCH_CHURN_LIMIT"`          // MinPerEpochChurnLimit is the minimum amount of churn allotted for validator rotations.
 	ChurnLimitQuotient             uint64 `yaml:"CHURN_LIMIT_QUOTIENT"`               // ChurnLimitQuotient is used to determine the limit of how many validators can rotate per epoch.
 	ShuffleRoundCount              uint64 `yaml:"SHUFFLE_ROUND_COUNT"`                // ShuffleRoundCount is used for retrieving the permuted index.
 	MinGenesisActiveValidatorCount uint64 `yaml:"MIN_GENESIS_ACTIVE_VALIDATOR_COUNT"` // MinGenesisActiveValidatorCount defines how many validator deposits needed to kick off beacon chain.
 	MinGenesisTime                 uint64 `yaml:"MIN_GENESIS_TIME"`                   // MinGenesisTime is the time that needed to pass before kicking off beacon chain.
-	TargetAggregatorsPerCommittee  uint64 // TargetAggregatorsPerCommittee defines the number of aggregators inside one committee.
+	TargetAggregatorsPerCommittee  uint64 `yaml:"TARGET_AGGREGATORS_PER_COMMITTEE"`   // TargetAggregatorsPerCommittee defines the number of aggregators inside one committee.
+
+	// Networking constants.
+	RandomSubnetsPerValidator         uint64 // RandomSubnetsPerValidator defines the number of long-lived attestation subnets a beacon node subscribes to for each of its validators.
+	EpochsPerRandomSubnetSubscription uint64 // EpochsPerRandomSubnetSubscription defines the number of epochs a beacon node stays subscribed to a long-lived attestation subnet.
 
 	// Gwei value constants.
 	MinDepositAmount          uint64 `yaml:"MIN_DEPOSIT_AMOUNT"`          // MinDepositAmount is the maximal amount of Gwei a validator can send to the deposit contract at once.
@@ -48,8 +54,11 @@ type BeaconChainConfig struct {
 	MinValidatorWithdrawabilityDelay uint64 `yaml:"MIN_VALIDATOR_WITHDRAWABILITY_DELAY"` // MinValidatorWithdrawabilityDelay is the shortest amount of time a validator has to wait to withdraw.
 	PersistentCommitteePeriod        uint64 `yaml:"PERSISTENT_COMMITTEE_PERIOD"`         // PersistentCommitteePeriod is the minimum amount of epochs a validator must participate before exiting.
 	MinEpochsToInactivityPenalty     uint64 `yaml:"MIN_EPOCHS_TO_INACTIVITY_PENALTY"`    // MinEpochsToInactivityPenalty defines the minimum amount of epochs since finality to begin penalizing inactivity.
-	Eth1FollowDistance               uint64 // Eth1FollowDistance is the number of eth1.0 blocks to wait before considering a new deposit for voting. This only applies after the chain as been started.
-	SafeSlotsToUpdateJustified       uint64 // SafeSlotsToUpdateJustified is the minimal slots needed to update justified check point.
+	Eth1FollowDistance               uint64 `yaml:"ETH1_FOLLOW_DISTANCE"`                // Eth1FollowDistance is the number of eth1.0 blocks to wait before considering a new deposit for voting. This only applies after the chain as been started.
+	SecondsPerETH1Block              uint64 `yaml:"SECONDS_PER_ETH1_BLOCK"`              // SecondsPerETH1Block is the approximate time between two eth1.0 blocks, used to select the blocks to vote for.
+	SafeSlotsToUpdateJustified       uint64 `yaml:"SAFE_SLOTS_TO_UPDATE_JUSTIFIED"`      // SafeSlotsToUpdateJustified is the minimal slots needed to update justified check point.
+	IntervalsPerSlot                 uint64 `yaml:"INTERVALS_PER_SLOT"`                  // IntervalsPerSlot is the number of intervals of a slot, a block received in the first interval of its slot being timely.
+	ProposerScoreBoost               uint64 `yaml:"PROPOSER_SCORE_BOOST"`                // ProposerScoreBoost is the percentage of the committee weight a timely block is boosted by in fork choice.
 	AttestationPropagationSlotRange  uint64 // AttestationPropagationSlotRange is the maximum number of slots during which an attestation can be propagated.
 
 	// State list lengths
@@ -78,23 +87,25 @@ type BeaconChainConfig struct {
 	DomainBeaconAttester [4]byte `yaml:"DOMAIN_ATTESTATION"`     // DomainBeaconAttester defines the BLS signature domain for attestation verification.
 	DomainDeposit        [4]byte `yaml:"DOMAIN_DEPOSIT"`         // DomainDeposit defines the BLS signature domain for deposit verification.
 	DomainVoluntaryExit  [4]byte `yaml:"DOMAIN_VOLUNTARY_EXIT"`  // DomainVoluntaryExit defines the BLS signature domain for exit verification.
+	DomainSyncCommittee  [4]byte `yaml:"DOMAIN_SYNC_COMMITTEE"`  // DomainSyncCommittee defines the BLS signature domain for light client update verification.
 
 	// Prysm constants.
-	GweiPerEth                uint64        // GweiPerEth is the amount of gwei corresponding to 1 eth.
-	LogBlockDelay             int64         // Number of blocks to wait from the current head before processing logs from the deposit contract.
-	BLSSecretKeyLength        int           // BLSSecretKeyLength defines the expected length of BLS secret keys in bytes.
-	BLSPubkeyLength           int           // BLSPubkeyLength defines the expected length of BLS public keys in bytes.
-	BLSSignatureLength        int           // BLSSignatureLength defines the expected length of BLS signatures in bytes.
-	DefaultBufferSize         int           // DefaultBufferSize for channels across the Prysm repository.
-	ValidatorPrivkeyFileName  string        // ValidatorPrivKeyFileName specifies the string name of a validator private key file.
-	WithdrawalPrivkeyFileName string        // WithdrawalPrivKeyFileName specifies the string name of a withdrawal private key file.
-	RPCSyncCheck              time.Duration // Number of seconds to query the sync service, to find out if the node is synced or not.
-	GoerliBlockTime           uint64        // GoerliBlockTime is the number of seconds on avg a Goerli block is created.
-	GenesisForkVersion        []byte        `yaml:"GENESIS_FORK_VERSION"` // GenesisForkVersion is used to track fork version between state transitions.
-	EmptySignature            [96]byte      // EmptySignature is used to represent a zeroed out BLS Signature.
-	DefaultPageSize           int           // DefaultPageSize defines the default page size for RPC server request.
-	MaxPeersToSync            int           // MaxPeersToSync describes the limit for number of peers in round robin sync.
-	SlotsPerArchivedPoint     uint64        // SlotsPerArchivedPoint defines the number of slots per one archived point.
+	GweiPerEth                uint64            // GweiPerEth is the amount of gwei corresponding to 1 eth.
+	LogBlockDelay             int64             // Number of blocks to wait from the current head before processing logs from the deposit contract.
+	BLSSecretKeyLength        int               // BLSSecretKeyLength defines the expected length of BLS secret keys in bytes.
+	BLSPubkeyLength           int               // BLSPubkeyLength defines the expected length of BLS public keys in bytes.
+	BLSSignatureLength        int               // BLSSignatureLength defines the expected length of BLS signatures in bytes.
+	DefaultBufferSize         int               // DefaultBufferSize for channels across the Prysm repository.
+	ValidatorPrivkeyFileName  string            // ValidatorPrivKeyFileName specifies the string name of a validator private key file.
+	WithdrawalPrivkeyFileName string            // WithdrawalPrivKeyFileName specifies the string name of a withdrawal private key file.
+	RPCSyncCheck              time.Duration     // Number of seconds to query the sync service, to find out if the node is synced or not.
+	GoerliBlockTime           uint64            // GoerliBlockTime is the number of seconds on avg a Goerli block is created.
+	GenesisForkVersion        []byte            `yaml:"GENESIS_FORK_VERSION"` // GenesisForkVersion is used to track fork version between state transitions.
+	ForkVersionSchedule       map[uint64][]byte // ForkVersionSchedule maps the epochs of scheduled forks to the fork versions they activate.
+	EmptySignature            [96]byte          // EmptySignature is used to represent a zeroed out BLS Signature.
+	DefaultPageSize           int               // DefaultPageSize defines the default page size for RPC server request.
+	MaxPeersToSync            int               // MaxPeersToSync describes the limit for number of peers in round robin sync.
+	SlotsPerArchivedPoint     uint64            // SlotsPerArchivedPoint defines the number of slots per one archived point.
 
 	// Slasher constants.
 	WeakSubjectivityPeriod    uint64 // WeakSubjectivityPeriod defines the time period expressed in number of epochs were proof of stake network should validate block headers and attestations for slashable events.
@@ -102,6 +113,8 @@ type BeaconChainConfig struct {
 }
 
 var defaultBeaconConfig = &BeaconChainConfig{
+	ConfigName: "mainnet",
+
 	// Constants (Non-configurable)
 	FarFutureEpoch:           1<<64 - 1,
 	BaseRewardsPerEpoch:      4,
@@ -119,6 +132,10 @@ var defaultBeaconConfig = &BeaconChainConfig{
 	MinGenesisTime:                 0, // Zero until a proper time is decided.
 	TargetAggregatorsPerCommittee:  16,
 
+	// Networking constants.
+	RandomSubnetsPerValidator:         1,
+	EpochsPerRandomSubnetSubscription: 256,
+
 	// Gwei value constants.
 	MinDepositAmount:          1 * 1e9,
 	MaxEffectiveBalance:       32 * 1e9,
@@ -141,8 +158,11 @@ var defaultBeaconConfig = &BeaconChainConfig{
 	PersistentCommitteePeriod:        2048,
 	MinEpochsToInactivityPenalty:     4,
 	Eth1FollowDistance:               1024,
+	SecondsPerETH1Block:              14,
 	SafeSlotsToUpdateJustified:       8,
 	AttestationPropagationSlotRange:  32,
+	IntervalsPerSlot:                 3,
+	ProposerScoreBoost:               40,
 
 	// State list length constants.
 	EpochsPerHistoricalVector: 65536,
@@ -170,6 +190,7 @@ var defaultBeaconConfig = &BeaconChainConfig{
 	DomainRandao:         bytesutil.ToBytes4(bytesutil.Bytes4(2)),
 	DomainDeposit:        bytesutil.ToBytes4(bytesutil.Bytes4(3)),
 	DomainVoluntaryExit:  bytesutil.ToBytes4(bytesutil.Bytes4(4)),
+	DomainSyncCommittee:  bytesutil.ToBytes4(bytesutil.Bytes4(7)),
 
 	// Prysm constants.
 	GweiPerEth:                1000000000,
@@ -210,6 +231,7 @@ func MainnetConfig() *BeaconChainConfig {
 // mainnet deposit values.
 func DemoBeaconConfig() *BeaconChainConfig {
 	demoConfig := *MainnetConfig()
+	demoConfig.ConfigName = "demo"
 
 	demoConfig.MinDepositAmount /= 10
 	demoConfig.MaxEffectiveBalance /= 10
@@ -227,6 +249,7 @@ func DemoBeaconConfig() *BeaconChainConfig {
 // MinimalSpecConfig retrieves the minimal config used in spec tests.
 func MinimalSpecConfig() *BeaconChainConfig {
 	minimalConfig := *defaultBeaconConfig
+	minimalConfig.ConfigName = "minimal"
 	// Misc
 	minimalConfig.MaxCommitteesPerSlot = 4
 	minimalConfig.TargetCommitteeSize = 4
@@ -260,6 +283,7 @@ func MinimalSpecConfig() *BeaconChainConfig {
 	minimalConfig.PersistentCommitteePeriod = 2048
 	minimalConfig.MinEpochsToInactivityPenalty = 4
 	minimalConfig.Eth1FollowDistance = 16
+	minimalConfig.SecondsPerETH1Block = 14
 	minimalConfig.SafeSlotsToUpdateJustified = 2
 
 	// State vector lengths
@@ -288,6 +312,7 @@ func MinimalSpecConfig() *BeaconChainConfig {
 	minimalConfig.DomainRandao = bytesutil.ToBytes4(bytesutil.Bytes4(2))
 	minimalConfig.DomainDeposit = bytesutil.ToBytes4(bytesutil.Bytes4(3))
 	minimalConfig.DomainVoluntaryExit = bytesutil.ToBytes4(bytesutil.Bytes4(4))
+	minimalConfig.DomainSyncCommittee = bytesutil.ToBytes4(bytesutil.Bytes4(7))
 
 	minimalConfig.DepositContractTreeDepth = 32
 	minimalConfig.FarFutureEpoch = 1<<64 - 1
@@ -0,0 +1,200 @@
+package params
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// LoadChainConfigFile loads a chain config from a YAML file over the config in use, which
+// is the preset chosen by the flags, and uses it from then on. The file may hold any subset
+// of the values of the config, in the format of the configs of the eth2 specification.
+func LoadChainConfigFile(chainConfigFileName string) error {
+	// #nosec G304
+	yamlFile, err := ioutil.ReadFile(chainConfigFileName)
+	if err != nil {
+		return fmt.Errorf("could not read chain config file: %v", err)
+	}
+	conf, err := UnmarshalConfig(yamlFile, BeaconConfig())
+	if err != nil {
+		return fmt.Errorf("could not load chain config file %s: %v", chainConfigFileName, err)
+	}
+	if conf.ConfigName == BeaconConfig().ConfigName {
+		conf.ConfigName = strings.TrimSuffix(filepath.Base(chainConfigFileName), filepath.Ext(chainConfigFileName))
+	}
+	OverrideBeaconConfig(conf)
+	return nil
+}
+
+// UnmarshalConfig returns a copy of the preset with the values of the YAML config applied,
+// after checking that the resulting config is consistent. Keys which are not part of the
+// config are ignored, and byte values may be written as 0x-prefixed hex strings.
+func UnmarshalConfig(yamlFile []byte, preset *BeaconChainConfig) (*BeaconChainConfig, error) {
+	doc, err := decodeHexValues(yamlFile)
+	if err != nil {
+		return nil, err
+	}
+	conf := *preset
+	if err := yaml.Unmarshal(doc, &conf); err != nil {
+		return nil, err
+	}
+	if err := conf.Validate(); err != nil {
+		return nil, err
+	}
+	return &conf, nil
+}
+
+// MarshalConfig encodes the values of the config which can be loaded from a YAML file, in
+// the format UnmarshalConfig reads them.
+func MarshalConfig(c *BeaconChainConfig) ([]byte, error) {
+	var doc yaml.MapSlice
+	val := reflect.ValueOf(c).Elem()
+	for i := 0; i < val.NumField(); i++ {
+		tag := val.Type().Field(i).Tag.Get("yaml")
+		if tag == "" {
+			continue
+		}
+		field := val.Field(i)
+		value := field.Interface()
+		if isByteField(field.Type()) {
+			value = fmt.Sprintf("%#x", value)
+		}
+		doc = append(doc, yaml.MapItem{Key: tag, Value: value})
+	}
+	return yaml.Marshal(doc)
+}
+
+// Validate checks that the values of the config are consistent with each other, and that
+// the ones used as divisors or as lengths of merkleized lists are valid.
+func (c *BeaconChainConfig) Validate() error {
+	nonZero := []struct {
+		name  string
+		value uint64
+	}{
+		{"SECONDS_PER_SLOT", c.SecondsPerSlot},
+		{"SLOTS_PER_EPOCH", c.SlotsPerEpoch},
+		{"TARGET_COMMITTEE_SIZE", c.TargetCommitteeSize},
+		{"MAX_COMMITTEES_PER_SLOT", c.MaxCommitteesPerSlot},
+		{"MAX_VALIDATORS_PER_COMMITTEE", c.MaxValidatorsPerCommittee},
+		{"TARGET_AGGREGATORS_PER_COMMITTEE", c.TargetAggregatorsPerCommittee},
+		{"CHURN_LIMIT_QUOTIENT", c.ChurnLimitQuotient},
+		{"SLOTS_PER_ETH1_VOTING_PERIOD", c.SlotsPerEth1VotingPeriod},
+		{"SECONDS_PER_ETH1_BLOCK", c.SecondsPerETH1Block},
+		{"MAX_EFFECTIVE_BALANCE", c.MaxEffectiveBalance},
+		{"EFFECTIVE_BALANCE_INCREMENT", c.EffectiveBalanceIncrement},
+		{"BASE_REWARD_FACTOR", c.BaseRewardFactor},
+		{"WHISTLEBLOWER_REWARD_QUOTIENT", c.WhistleBlowerRewardQuotient},
+		{"PROPOSER_REWARD_QUOTIENT", c.ProposerRewardQuotient},
+		{"INACTIVITY_PENALTY_QUOTIENT", c.InactivityPenaltyQuotient},
+		{"MIN_SLASHING_PENALTY_QUOTIENT", c.MinSlashingPenaltyQuotient},
+	}
+	for _, v := range nonZero {
+		if v.value == 0 {
+			return fmt.Errorf("%s must be greater than 0", v.name)
+		}
+	}
+	// The lengths of the vectors and lists of the beacon state are merkleized as is.
+	powersOfTwo := []struct {
+		name  string
+		value uint64
+	}{
+		{"SLOTS_PER_HISTORICAL_ROOT", c.SlotsPerHistoricalRoot},
+		{"EPOCHS_PER_HISTORICAL_VECTOR", c.EpochsPerHistoricalVector},
+		{"EPOCHS_PER_SLASHINGS_VECTOR", c.EpochsPerSlashingsVector},
+		{"HISTORICAL_ROOTS_LIMIT", c.HistoricalRootsLimit},
+		{"VALIDATOR_REGISTRY_LIMIT", c.ValidatorRegistryLimit},
+	}
+	for _, v := range powersOfTwo {
+		if v.value == 0 || v.value&(v.value-1) != 0 {
+			return fmt.Errorf("%s must be a power of two, received %d", v.name, v.value)
+		}
+	}
+	if c.SlotsPerHistoricalRoot%c.SlotsPerEpoch != 0 {
+		return fmt.Errorf("SLOTS_PER_HISTORICAL_ROOT %d must be a multiple of SLOTS_PER_EPOCH %d", c.SlotsPerHistoricalRoot, c.SlotsPerEpoch)
+	}
+	if c.MinSeedLookahead > c.MaxSeedLookahead {
+		return fmt.Errorf("MIN_SEED_LOOKAHEAD %d must not exceed MAX_SEED_LOOKAHEAD %d", c.MinSeedLookahead, c.MaxSeedLookahead)
+	}
+	if c.TargetCommitteeSize > c.MaxValidatorsPerCommittee {
+		return fmt.Errorf("TARGET_COMMITTEE_SIZE %d must not exceed MAX_VALIDATORS_PER_COMMITTEE %d", c.TargetCommitteeSize, c.MaxValidatorsPerCommittee)
+	}
+	if c.MaxEffectiveBalance%c.EffectiveBalanceIncrement != 0 {
+		return fmt.Errorf("MAX_EFFECTIVE_BALANCE %d must be a multiple of EFFECTIVE_BALANCE_INCREMENT %d", c.MaxEffectiveBalance, c.EffectiveBalanceIncrement)
+	}
+	if c.MinDepositAmount > c.MaxEffectiveBalance {
+		return fmt.Errorf("MIN_DEPOSIT_AMOUNT %d must not exceed MAX_EFFECTIVE_BALANCE %d", c.MinDepositAmount, c.MaxEffectiveBalance)
+	}
+	if c.EjectionBalance > c.MaxEffectiveBalance {
+		return fmt.Errorf("EJECTION_BALANCE %d must not exceed MAX_EFFECTIVE_BALANCE %d", c.EjectionBalance, c.MaxEffectiveBalance)
+	}
+	if len(c.GenesisForkVersion) != 4 {
+		return fmt.Errorf("GENESIS_FORK_VERSION must be 4 bytes long, received %d bytes", len(c.GenesisForkVersion))
+	}
+	return nil
+}
+
+// decodeHexValues rewrites the 0x-prefixed hex values of the byte fields of the config as
+// sequences of bytes, which the YAML decoder can set the fields with.
+func decodeHexValues(yamlFile []byte) ([]byte, error) {
+	// Unquoted hex values are resolved as integers, so they are read as strings first to
+	// keep their leading zeros.
+	var raw map[string]scalar
+	if err := yaml.Unmarshal(yamlFile, &raw); err != nil {
+		return nil, err
+	}
+	var doc yaml.MapSlice
+	if err := yaml.Unmarshal(yamlFile, &doc); err != nil {
+		return nil, err
+	}
+	byteFields := make(map[string]bool)
+	t := reflect.TypeOf(BeaconChainConfig{})
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if tag := f.Tag.Get("yaml"); tag != "" && isByteField(f.Type) {
+			byteFields[tag] = true
+		}
+	}
+	for i, item := range doc {
+		key, ok := item.Key.(string)
+		if !ok || !byteFields[key] {
+			continue
+		}
+		value := string(raw[key])
+		if !strings.HasPrefix(value, "0x") {
+			continue
+		}
+		b, err := hex.DecodeString(strings.TrimPrefix(value, "0x"))
+		if err != nil {
+			return nil, fmt.Errorf("%s is not a valid hex value: %v", key, err)
+		}
+		seq := make([]int, len(b))
+		for j := range b {
+			seq[j] = int(b[j])
+		}
+		doc[i].Value = seq
+	}
+	return yaml.Marshal(doc)
+}
+
+func isByteField(t reflect.Type) bool {
+	kind := t.Kind()
+	return (kind == reflect.Array || kind == reflect.Slice) && t.Elem().Kind() == reflect.Uint8
+}
+
+// scalar reads the text of scalar YAML values, and ignores the other values.
+type scalar string
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (s *scalar) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var text string
+	if err := unmarshal(&text); err != nil {
+		return nil
+	}
+	*s = scalar(text)
+	return nil
+}
@@ -0,0 +1,154 @@
+package params_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func TestUnmarshalConfig_PartialConfig(t *testing.T) {
+	yamlFile := []byte(`
+SECONDS_PER_SLOT: 3
+SLOTS_PER_EPOCH: 4
+MIN_GENESIS_TIME: 1587000000
+DOMAIN_RANDAO: 0x0a000000
+GENESIS_FORK_VERSION: 0x00000102
+DOMAIN_SELECTION_PROOF: 0x05000000
+`)
+	conf, err := params.UnmarshalConfig(yamlFile, params.MinimalSpecConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if conf.SecondsPerSlot != 3 || conf.SlotsPerEpoch != 4 || conf.MinGenesisTime != 1587000000 {
+		t.Errorf("Values of the file were not applied: %d seconds per slot, %d slots per epoch, min genesis time %d",
+			conf.SecondsPerSlot, conf.SlotsPerEpoch, conf.MinGenesisTime)
+	}
+	if conf.DomainRandao != [4]byte{10, 0, 0, 0} {
+		t.Errorf("Wanted randao domain %#x, received %#x", [4]byte{10, 0, 0, 0}, conf.DomainRandao)
+	}
+	if !bytes.Equal(conf.GenesisForkVersion, []byte{0, 0, 1, 2}) {
+		t.Errorf("Wanted genesis fork version %#x, received %#x", []byte{0, 0, 1, 2}, conf.GenesisForkVersion)
+	}
+	// The values missing from the file are the ones of the preset.
+	if conf.TargetCommitteeSize != params.MinimalSpecConfig().TargetCommitteeSize {
+		t.Errorf("Wanted target committee size %d of the preset, received %d", params.MinimalSpecConfig().TargetCommitteeSize, conf.TargetCommitteeSize)
+	}
+	if params.MinimalSpecConfig().SecondsPerSlot == 3 {
+		t.Error("Preset was modified")
+	}
+}
+
+func TestUnmarshalConfig_InvalidConfig(t *testing.T) {
+	tests := []struct {
+		name     string
+		yamlFile string
+		err      string
+	}{
+		{
+			name:     "zero slots per epoch",
+			yamlFile: "SLOTS_PER_EPOCH: 0",
+			err:      "SLOTS_PER_EPOCH must be greater than 0",
+		},
+		{
+			name:     "non power of two vector length",
+			yamlFile: "EPOCHS_PER_HISTORICAL_VECTOR: 100",
+			err:      "EPOCHS_PER_HISTORICAL_VECTOR must be a power of two",
+		},
+		{
+			name:     "slots per epoch not dividing historical roots",
+			yamlFile: "SLOTS_PER_EPOCH: 6",
+			err:      "must be a multiple of SLOTS_PER_EPOCH",
+		},
+		{
+			name:     "inconsistent seed lookahead",
+			yamlFile: "MIN_SEED_LOOKAHEAD: 5\nMAX_SEED_LOOKAHEAD: 4",
+			err:      "MIN_SEED_LOOKAHEAD 5 must not exceed MAX_SEED_LOOKAHEAD 4",
+		},
+		{
+			name:     "short domain",
+			yamlFile: "DOMAIN_RANDAO: 0x0a00",
+			err:      "invalid array",
+		},
+		{
+			name:     "invalid hex",
+			yamlFile: "DOMAIN_RANDAO: 0x0z000000",
+			err:      "DOMAIN_RANDAO is not a valid hex value",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := params.UnmarshalConfig([]byte(tt.yamlFile), params.MainnetConfig())
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Errorf("Expected error containing %q, received %v", tt.err, err)
+			}
+		})
+	}
+}
+
+func TestMarshalConfig_RoundTrip(t *testing.T) {
+	conf := params.MinimalSpecConfig()
+	conf.ConfigName = "devnet"
+	conf.SecondsPerSlot = 2
+	conf.DomainRandao = [4]byte{0, 0, 0, 9}
+	conf.GenesisForkVersion = []byte{0, 0, 0, 1}
+	yamlFile, err := params.MarshalConfig(conf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(yamlFile), "DOMAIN_RANDAO: \"0x00000009\"") {
+		t.Errorf("Expected byte values to be encoded in hex, received:\n%s", yamlFile)
+	}
+
+	// The file is loaded over a different preset to check that it holds every value.
+	loaded, err := params.UnmarshalConfig(yamlFile, params.MainnetConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(loaded, conf) {
+		t.Errorf("Wanted %v, received %v", conf, loaded)
+	}
+}
+
+func TestLoadChainConfigFile(t *testing.T) {
+	defer params.UseMainnetConfig()
+	dir, err := ioutil.TempDir("", "chainconfig")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	fileName := filepath.Join(dir, "devnet.yaml")
+	if err := ioutil.WriteFile(fileName, []byte("SECONDS_PER_SLOT: 2\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	params.UseMinimalConfig()
+	if err := params.LoadChainConfigFile(fileName); err != nil {
+		t.Fatal(err)
+	}
+	conf := params.BeaconConfig()
+	if conf.SecondsPerSlot != 2 {
+		t.Errorf("Wanted 2 seconds per slot, received %d", conf.SecondsPerSlot)
+	}
+	if conf.SlotsPerEpoch != params.MinimalSpecConfig().SlotsPerEpoch {
+		t.Errorf("Expected the file to be loaded over the minimal preset, received %d slots per epoch", conf.SlotsPerEpoch)
+	}
+	if conf.ConfigName != "devnet" {
+		t.Errorf("Expected config to be named after the file, received %s", conf.ConfigName)
+	}
+
+	if err := ioutil.WriteFile(fileName, []byte("SLOTS_PER_EPOCH: 0\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := params.LoadChainConfigFile(fileName); err == nil {
+		t.Error("Expected invalid config to be rejected")
+	}
+	if params.BeaconConfig() != conf {
+		t.Error("Expected config in use to be kept when the file is invalid")
+	}
+}
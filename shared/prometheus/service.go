@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"reflect"
 	"runtime/debug"
 	"runtime/pprof"
+	"sort"
 	"strings"
 	"time"
 
@@ -55,24 +57,45 @@ func NewPrometheusService(addr string, svcRegistry *shared.ServiceRegistry, addi
 func (s *Service) healthzHandler(w http.ResponseWriter, _ *http.Request) {
 	// Call all services in the registry.
 	// if any are not OK, write 500
-	// print the statuses of all services.
+	// print the statuses and readiness of all services, then the readiness of the node.
 
 	statuses := s.svcRegistry.Statuses()
+	readiness := s.svcRegistry.Readiness()
+	kinds := make([]reflect.Type, 0, len(statuses))
+	for k := range statuses {
+		kinds = append(kinds, k)
+	}
+	sort.Slice(kinds, func(i, j int) bool {
+		return kinds[i].String() < kinds[j].String()
+	})
+
 	hasError := false
+	numReady := 0
 	var buf bytes.Buffer
-	for k, v := range statuses {
+	for _, k := range kinds {
 		var status string
-		if v == nil {
+		if v := statuses[k]; v == nil {
 			status = "OK"
 		} else {
 			hasError = true
 			status = "ERROR " + v.Error()
 		}
+		if v := readiness[k]; v == nil {
+			numReady++
+			status += ", ready"
+		} else {
+			status += ", not ready: " + v.Error()
+		}
 
 		if _, err := buf.WriteString(fmt.Sprintf("%s: %s\n", k, status)); err != nil {
 			hasError = true
 		}
 	}
+	if _, err := buf.WriteString(fmt.Sprintf(
+		"ready: %t (%d/%d services ready)\n", numReady == len(kinds), numReady, len(kinds),
+	)); err != nil {
+		hasError = true
+	}
 
 	// Write status header
 	if hasError {
@@ -75,9 +75,30 @@ func TestHealthz(t *testing.T) {
 	}
 
 	body := rr.Body.String()
-	if !strings.Contains(body, "*prometheus.mockService: OK") {
+	if !strings.Contains(body, "*prometheus.mockService: OK, not ready: not started") {
 		t.Errorf("Expected body to contain mockService status, but got %v", body)
 	}
+	if !strings.Contains(body, "ready: false (0/1 services ready)") {
+		t.Errorf("Expected body to contain node readiness, but got %v", body)
+	}
+
+	if err := registry.StartAll(); err != nil {
+		t.Fatal(err)
+	}
+	defer registry.StopAll()
+	// Give the registry time to start the service.
+	time.Sleep(100 * time.Millisecond)
+
+	rr = httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+
+	body = rr.Body.String()
+	if !strings.Contains(body, "*prometheus.mockService: OK, ready") {
+		t.Errorf("Expected body to contain mockService readiness, but got %v", body)
+	}
+	if !strings.Contains(body, "ready: true (1/1 services ready)") {
+		t.Errorf("Expected body to contain node readiness, but got %v", body)
+	}
 
 	m.status = errors.New("something really bad has happened")
 
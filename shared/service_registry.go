@@ -1,18 +1,36 @@
 package shared
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"reflect"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/sirupsen/logrus"
 )
 
 var log = logrus.WithField("prefix", "registry")
 
+const (
+	// defaultStopTimeout is the time given to each service to stop before the
+	// registry gives up on it and moves on to the next one.
+	defaultStopTimeout = 10 * time.Second
+	// readyPollInterval is how often the readiness of the dependencies of a
+	// service is checked while it waits to be started.
+	readyPollInterval = 100 * time.Millisecond
+	// readyWarnInterval is how often a service still waiting on its dependencies
+	// is logged.
+	readyWarnInterval = 30 * time.Second
+)
+
 // Service is a struct that can be registered into a ServiceRegistry for
 // easy dependency management.
 type Service interface {
-	// Start spawns any goroutines required by the service.
+	// Start spawns any goroutines required by the service. It must not block, as
+	// the services are started one at a time.
 	Start()
 	// Stop terminates all goroutines belonging to the service,
 	// blocking until they are all terminated.
@@ -21,39 +39,188 @@ type Service interface {
 	Status() error
 }
 
+// DependentService is a service which depends on other services of the registry.
+// It is started once all of its dependencies are ready and stopped before them.
+type DependentService interface {
+	Service
+	// Dependencies returns the types of the services this service depends on,
+	// such as reflect.TypeOf(&powchain.Service{}).
+	Dependencies() []reflect.Type
+}
+
+// ReadyService is a service which reports when it is ready to be used by the
+// services depending on it. Services without a readiness probe are considered
+// ready as soon as their Start method returns.
+type ReadyService interface {
+	Service
+	// Ready returns an error until the service is ready.
+	Ready() error
+}
+
+// DependencyTypes returns the types of the given services, for the Dependencies method of
+// a DependentService. Services which are not set are skipped.
+func DependencyTypes(services ...interface{}) []reflect.Type {
+	types := make([]reflect.Type, 0, len(services))
+	for _, service := range services {
+		if service == nil {
+			continue
+		}
+		if v := reflect.ValueOf(service); v.Kind() == reflect.Ptr && v.IsNil() {
+			continue
+		}
+		types = append(types, reflect.TypeOf(service))
+	}
+	return types
+}
+
 // ServiceRegistry provides a useful pattern for managing services.
 // It allows for ease of dependency management and ensures services
 // dependent on others use the same references in memory.
 type ServiceRegistry struct {
 	services     map[reflect.Type]Service // map of types to services.
 	serviceTypes []reflect.Type           // keep an ordered slice of registered service types.
+	stopTimeout  time.Duration            // time given to each service to stop.
+
+	lock      sync.RWMutex
+	started   map[reflect.Type]bool // services whose Start method returned.
+	cancel    context.CancelFunc    // cancels the services still waiting to be started.
+	startDone chan struct{}         // closed once no more services are being started.
 }
 
 // NewServiceRegistry starts a registry instance for convenience
 func NewServiceRegistry() *ServiceRegistry {
 	return &ServiceRegistry{
-		services: make(map[reflect.Type]Service),
+		services:    make(map[reflect.Type]Service),
+		stopTimeout: defaultStopTimeout,
 	}
 }
 
-// StartAll initialized each service in order of registration.
-func (s *ServiceRegistry) StartAll() {
-	log.Debugf("Starting %d services: %v", len(s.serviceTypes), s.serviceTypes)
-	for _, kind := range s.serviceTypes {
-		log.Debugf("Starting service type %v", kind)
-		go s.services[kind].Start()
+// StartAll initializes the services one at a time in the background, each
+// service after its dependencies and in order of registration otherwise. It
+// returns an error without starting any service if a dependency is not
+// registered or the dependencies form a cycle. A service is started once its
+// dependencies have returned from Start and pass their readiness probe.
+func (s *ServiceRegistry) StartAll() error {
+	order, err := s.startOrder()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	s.lock.Lock()
+	s.started = make(map[reflect.Type]bool, len(order))
+	s.cancel = cancel
+	s.startDone = done
+	s.lock.Unlock()
+
+	log.Debugf("Starting %d services: %v", len(order), order)
+	go func() {
+		defer close(done)
+		for _, kind := range order {
+			if !s.start(ctx, kind) {
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+// start waits for the dependencies of the service to be ready and starts it. The
+// service is marked as started once its Start method returns. It returns false if
+// the registry was stopped before the service could be started.
+func (s *ServiceRegistry) start(ctx context.Context, kind reflect.Type) bool {
+	deps := s.dependencies(kind)
+	if len(deps) > 0 {
+		ticker := time.NewTicker(readyPollInterval)
+		defer ticker.Stop()
+		waitingSince := time.Now()
+		lastWarning := waitingSince
+		for {
+			notReady := make([]reflect.Type, 0, len(deps))
+			for _, dep := range deps {
+				if err := s.ready(dep); err != nil {
+					notReady = append(notReady, dep)
+				}
+			}
+			if len(notReady) == 0 {
+				break
+			}
+			if time.Since(lastWarning) >= readyWarnInterval {
+				lastWarning = time.Now()
+				log.WithFields(logrus.Fields{
+					"service":      kind,
+					"dependencies": notReady,
+					"waited":       time.Since(waitingSince).Round(time.Second),
+				}).Warn("Waiting for dependencies to be ready")
+			}
+			select {
+			case <-ctx.Done():
+				log.WithField("service", kind).Debug("Registry stopped before the service was started")
+				return false
+			case <-ticker.C:
+			}
+		}
+	}
+	if ctx.Err() != nil {
+		return false
 	}
+
+	log.Debugf("Starting service type %v", kind)
+	s.services[kind].Start()
+	s.lock.Lock()
+	s.started[kind] = true
+	s.lock.Unlock()
+	return true
 }
 
-// StopAll ends every service in reverse order of registration, logging a
-// panic if any of them fail to stop.
+// StopAll ends every started service in reverse order of startup, so that no
+// service is stopped before the services depending on it. The service being
+// started, if any, is waited for. Each service is given a deadline to stop,
+// after which it is left behind. A panic is logged if any of them fail to stop.
 func (s *ServiceRegistry) StopAll() {
-	for i := len(s.serviceTypes) - 1; i >= 0; i-- {
-		kind := s.serviceTypes[i]
-		service := s.services[kind]
-		if err := service.Stop(); err != nil {
+	s.lock.Lock()
+	if s.cancel != nil {
+		s.cancel()
+	}
+	done := s.startDone
+	s.lock.Unlock()
+	if done != nil {
+		<-done
+	}
+	s.lock.RLock()
+	started := s.started
+	s.lock.RUnlock()
+
+	order, err := s.startOrder()
+	if err != nil {
+		order = s.serviceTypes
+	}
+	for i := len(order) - 1; i >= 0; i-- {
+		kind := order[i]
+		if started != nil && !started[kind] {
+			continue
+		}
+		s.stop(kind)
+	}
+}
+
+// stop calls the Stop method of a service, waiting at most the stop timeout for it to return.
+func (s *ServiceRegistry) stop(kind reflect.Type) {
+	timeout := s.stopTimeout
+	if timeout == 0 {
+		timeout = defaultStopTimeout
+	}
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- s.services[kind].Stop()
+	}()
+	select {
+	case err := <-errChan:
+		if err != nil {
 			log.Panicf("Could not stop the following service: %v, %v", kind, err)
 		}
+	case <-time.After(timeout):
+		log.WithField("service", kind).Errorf("Service did not stop within %v, moving on", timeout)
 	}
 }
 
@@ -67,6 +234,93 @@ func (s *ServiceRegistry) Statuses() map[reflect.Type]error {
 	return m
 }
 
+// Readiness returns a map of Service type -> error. The error is nil if the
+// service was started and its readiness probe, if any, passes.
+func (s *ServiceRegistry) Readiness() map[reflect.Type]error {
+	m := make(map[reflect.Type]error)
+	for _, kind := range s.serviceTypes {
+		m[kind] = s.ready(kind)
+	}
+	return m
+}
+
+func (s *ServiceRegistry) ready(kind reflect.Type) error {
+	s.lock.RLock()
+	started := s.started[kind]
+	s.lock.RUnlock()
+	if !started {
+		return errors.New("not started")
+	}
+	if service, ok := s.services[kind].(ReadyService); ok {
+		return service.Ready()
+	}
+	return nil
+}
+
+func (s *ServiceRegistry) dependencies(kind reflect.Type) []reflect.Type {
+	if service, ok := s.services[kind].(DependentService); ok {
+		return service.Dependencies()
+	}
+	return nil
+}
+
+// startOrder sorts the registered services so that every service comes after
+// its dependencies, keeping the order of registration otherwise.
+func (s *ServiceRegistry) startOrder() ([]reflect.Type, error) {
+	for _, kind := range s.serviceTypes {
+		for _, dep := range s.dependencies(kind) {
+			if _, ok := s.services[dep]; !ok {
+				return nil, fmt.Errorf("service %v depends on unregistered service %v", kind, dep)
+			}
+		}
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make(map[reflect.Type]int, len(s.serviceTypes))
+	order := make([]reflect.Type, 0, len(s.serviceTypes))
+	var path []reflect.Type
+	var visit func(kind reflect.Type) error
+	visit = func(kind reflect.Type) error {
+		switch state[kind] {
+		case visited:
+			return nil
+		case visiting:
+			cycle := make([]string, 0, len(path)+1)
+			for i := range path {
+				if path[i] == kind {
+					for _, k := range path[i:] {
+						cycle = append(cycle, k.String())
+					}
+					break
+				}
+			}
+			cycle = append(cycle, kind.String())
+			return fmt.Errorf("dependency cycle between services: %s", strings.Join(cycle, " -> "))
+		}
+		state[kind] = visiting
+		path = append(path, kind)
+		for _, dep := range s.dependencies(kind) {
+			if err := visit(dep); err != nil {
+				return err
+			}
+		}
+		path = path[:len(path)-1]
+		state[kind] = visited
+		order = append(order, kind)
+		return nil
+	}
+	for _, kind := range s.serviceTypes {
+		if err := visit(kind); err != nil {
+			return nil, err
+		}
+	}
+	return order, nil
+}
+
 // RegisterService appends a service constructor function to the service
 // registry.
 func (s *ServiceRegistry) RegisterService(service Service) error {
@@ -3,7 +3,10 @@ package shared
 import (
 	"errors"
 	"reflect"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 )
 
 type mockService struct {
@@ -35,6 +38,89 @@ func (s *secondMockService) Status() error {
 	return s.status
 }
 
+// eventLog records the order services are started and stopped in.
+type eventLog struct {
+	lock   sync.Mutex
+	events []string
+}
+
+func (l *eventLog) add(event string) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.events = append(l.events, event)
+}
+
+func (l *eventLog) get() []string {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	return append([]string{}, l.events...)
+}
+
+// recordingService is embedded in distinct types, since the registry holds one
+// service per type.
+type recordingService struct {
+	name     string
+	log      *eventLog
+	deps     []reflect.Type
+	lock     sync.Mutex
+	readyErr error
+	stopped  chan struct{} // if set, Stop blocks until it is closed.
+}
+
+func (r *recordingService) Start() {
+	r.log.add("start " + r.name)
+}
+
+func (r *recordingService) Stop() error {
+	if r.stopped != nil {
+		<-r.stopped
+	}
+	r.log.add("stop " + r.name)
+	return nil
+}
+
+func (r *recordingService) Status() error {
+	return nil
+}
+
+func (r *recordingService) Dependencies() []reflect.Type {
+	return r.deps
+}
+
+func (r *recordingService) Ready() error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.readyErr
+}
+
+func (r *recordingService) setReady(err error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.readyErr = err
+}
+
+type firstService struct{ recordingService }
+type secondService struct{ recordingService }
+type thirdService struct{ recordingService }
+
+var (
+	firstServiceType  = reflect.TypeOf(&firstService{})
+	secondServiceType = reflect.TypeOf(&secondService{})
+	thirdServiceType  = reflect.TypeOf(&thirdService{})
+)
+
+func waitForEvents(t *testing.T, l *eventLog, n int) []string {
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		if events := l.get(); len(events) >= n {
+			return events
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("Expected %d events, received %v", n, l.get())
+	return nil
+}
+
 func TestRegisterService_Twice(t *testing.T) {
 	registry := &ServiceRegistry{
 		services: make(map[reflect.Type]Service),
@@ -142,3 +228,191 @@ func TestServiceStatus_OK(t *testing.T) {
 		t.Errorf("Received unexpected status for %T = %v", s, sStatus)
 	}
 }
+
+func TestStartAll_DependencyOrder(t *testing.T) {
+	registry := NewServiceRegistry()
+	l := &eventLog{}
+	// Registered in reverse order of their dependencies.
+	services := []Service{
+		&thirdService{recordingService{name: "third", log: l, deps: []reflect.Type{secondServiceType}}},
+		&secondService{recordingService{name: "second", log: l, deps: []reflect.Type{firstServiceType}}},
+		&firstService{recordingService{name: "first", log: l}},
+	}
+	for _, service := range services {
+		if err := registry.RegisterService(service); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := registry.StartAll(); err != nil {
+		t.Fatal(err)
+	}
+	events := waitForEvents(t, l, 3)
+	want := []string{"start first", "start second", "start third"}
+	if !reflect.DeepEqual(events, want) {
+		t.Errorf("Wanted start order %v, received %v", want, events)
+	}
+
+	registry.StopAll()
+	events = l.get()[3:]
+	want = []string{"stop third", "stop second", "stop first"}
+	if !reflect.DeepEqual(events, want) {
+		t.Errorf("Wanted stop order %v, received %v", want, events)
+	}
+}
+
+func TestStartAll_WaitsForReadiness(t *testing.T) {
+	registry := NewServiceRegistry()
+	l := &eventLog{}
+	first := &firstService{recordingService{name: "first", log: l, readyErr: errors.New("syncing")}}
+	second := &secondService{recordingService{name: "second", log: l, deps: []reflect.Type{firstServiceType}}}
+	for _, service := range []Service{first, second} {
+		if err := registry.RegisterService(service); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := registry.StartAll(); err != nil {
+		t.Fatal(err)
+	}
+	waitForEvents(t, l, 1)
+	time.Sleep(3 * readyPollInterval)
+	if events := l.get(); len(events) != 1 {
+		t.Fatalf("Expected only the first service to be started, received %v", events)
+	}
+	readiness := registry.Readiness()
+	if err := readiness[firstServiceType]; err == nil || err.Error() != "syncing" {
+		t.Errorf("Expected first service not to be ready, received %v", err)
+	}
+	if err := readiness[secondServiceType]; err == nil {
+		t.Error("Expected second service not to be ready before it is started")
+	}
+
+	first.setReady(nil)
+	events := waitForEvents(t, l, 2)
+	if events[1] != "start second" {
+		t.Errorf("Expected second service to be started, received %v", events)
+	}
+	for kind, err := range registry.Readiness() {
+		if err != nil {
+			t.Errorf("Expected %v to be ready, received %v", kind, err)
+		}
+	}
+}
+
+func TestStartAll_InvalidDependencies(t *testing.T) {
+	tests := []struct {
+		name     string
+		services []Service
+		wantErr  string
+	}{
+		{
+			name: "unregistered dependency",
+			services: []Service{
+				&firstService{recordingService{deps: []reflect.Type{secondServiceType}}},
+			},
+			wantErr: "service *shared.firstService depends on unregistered service *shared.secondService",
+		},
+		{
+			name: "cycle",
+			services: []Service{
+				&firstService{recordingService{deps: []reflect.Type{secondServiceType}}},
+				&secondService{recordingService{deps: []reflect.Type{thirdServiceType}}},
+				&thirdService{recordingService{deps: []reflect.Type{firstServiceType}}},
+			},
+			wantErr: "dependency cycle between services: *shared.firstService -> *shared.secondService -> *shared.thirdService -> *shared.firstService",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			registry := NewServiceRegistry()
+			for _, service := range tt.services {
+				if err := registry.RegisterService(service); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if err := registry.StartAll(); err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Expected error %q, received %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestStopAll_Timeout(t *testing.T) {
+	registry := NewServiceRegistry()
+	registry.stopTimeout = 50 * time.Millisecond
+	l := &eventLog{}
+	hung := make(chan struct{})
+	defer close(hung)
+	services := []Service{
+		&firstService{recordingService{name: "first", log: l}},
+		&secondService{recordingService{name: "second", log: l, stopped: hung}},
+		&thirdService{recordingService{name: "third", log: l}},
+	}
+	for _, service := range services {
+		if err := registry.RegisterService(service); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := registry.StartAll(); err != nil {
+		t.Fatal(err)
+	}
+	waitForEvents(t, l, 3)
+
+	registry.StopAll()
+	events := l.get()[3:]
+	want := []string{"stop third", "stop first"}
+	if !reflect.DeepEqual(events, want) {
+		t.Errorf("Expected the hung service to be skipped, wanted %v, received %v", want, events)
+	}
+}
+
+func TestDependencyTypes_SkipsUnsetServices(t *testing.T) {
+	var unset *secondMockService
+	var unsetInterface Service
+	got := DependencyTypes(&mockService{}, unset, unsetInterface)
+	want := []reflect.Type{reflect.TypeOf(&mockService{})}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Wanted dependencies %v, received %v", want, got)
+	}
+}
+
+// blockingService records its start once its Start method is released.
+type blockingService struct {
+	recordingService
+	release chan struct{}
+}
+
+func (b *blockingService) Start() {
+	<-b.release
+	b.log.add("start " + b.name)
+}
+
+func TestStartAll_OneAtATime(t *testing.T) {
+	registry := NewServiceRegistry()
+	l := &eventLog{}
+	first := &blockingService{recordingService{name: "first", log: l}, make(chan struct{})}
+	second := &secondService{recordingService{name: "second", log: l}}
+	third := &thirdService{recordingService{name: "third", log: l, deps: []reflect.Type{reflect.TypeOf(first)}}}
+	for _, service := range []Service{first, second, third} {
+		if err := registry.RegisterService(service); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := registry.StartAll(); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(3 * readyPollInterval)
+	if events := l.get(); len(events) != 0 {
+		t.Fatalf("Expected no service to start before the first one returned from Start, received %v", events)
+	}
+	if err := registry.Readiness()[reflect.TypeOf(first)]; err == nil {
+		t.Error("Expected the first service not to be ready while it is starting")
+	}
+
+	close(first.release)
+	events := waitForEvents(t, l, 3)
+	want := []string{"start first", "start second", "start third"}
+	if !reflect.DeepEqual(events, want) {
+		t.Errorf("Wanted start order %v, received %v", want, events)
+	}
+	registry.StopAll()
+}
@@ -0,0 +1,76 @@
+package slottiming
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// Metadata keys carrying the timer of a duty from the validator client to the beacon node.
+const (
+	dutyIDKey    = "x-duty-id"
+	dutyKey      = "x-duty"
+	slotKey      = "x-duty-slot"
+	slotStartKey = "x-duty-slot-start"
+)
+
+// UnaryClientInterceptor sends the timer of the duty in the context of a request along with it.
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if t := FromContext(ctx); t != nil {
+			ctx = metadata.AppendToOutgoingContext(
+				ctx,
+				dutyIDKey, t.ID,
+				dutyKey, t.Duty,
+				slotKey, strconv.FormatUint(t.Slot, 10),
+				slotStartKey, strconv.FormatInt(t.SlotStart.UnixNano(), 10),
+			)
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// UnaryServerInterceptor adds the timer of the duty sent along with a request to its context.
+// The start of the slot is taken from the client, as both compute it from the same genesis time.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if t := timerFromMetadata(ctx); t != nil {
+			ctx = NewContext(ctx, t)
+		}
+		return handler(ctx, req)
+	}
+}
+
+func timerFromMetadata(ctx context.Context) *Timer {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil
+	}
+	value := func(key string) string {
+		if values := md.Get(key); len(values) > 0 {
+			return values[0]
+		}
+		return ""
+	}
+	id := value(dutyIDKey)
+	if id == "" {
+		return nil
+	}
+	slot, err := strconv.ParseUint(value(slotKey), 10, 64)
+	if err != nil {
+		return nil
+	}
+	slotStart, err := strconv.ParseInt(value(slotStartKey), 10, 64)
+	if err != nil {
+		return nil
+	}
+	return &Timer{
+		ID:        id,
+		Duty:      knownDuty(value(dutyKey)),
+		Slot:      slot,
+		SlotStart: time.Unix(0, slotStart),
+	}
+}
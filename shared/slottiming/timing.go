@@ -0,0 +1,171 @@
+// Package slottiming records when the steps of a validator duty complete, relative to the
+// start of the slot of the duty. The timer of a duty travels in its context, and from the
+// validator client to the beacon node in gRPC metadata, so that the steps of both
+// processes are recorded and logged against the same duty.
+package slottiming
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/roughtime"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+var log = logrus.WithField("prefix", "slottiming")
+
+var stepSlotOffset = promauto.NewHistogramVec(
+	prometheus.HistogramOpts{
+		Name:    "duty_step_slot_offset_seconds",
+		Help:    "Time since the start of the slot at which a step of a validator duty completed",
+		Buckets: []float64{0.25, 0.5, 1, 2, 3, 4, 6, 8, 12, 16, 24},
+	},
+	[]string{"duty", "step"},
+)
+
+// Duties timed by the validator client.
+const (
+	DutyUpdateDuties = "update_duties"
+	DutyProposal     = "proposal"
+	DutyAttestation  = "attestation"
+	// DutyUnknown is the duty of timers received with a duty which is not one of the above.
+	DutyUnknown = "unknown"
+)
+
+// knownDuty returns the duty if it is one of the duties timed by the validator client, and
+// DutyUnknown otherwise. The duty is used as a metric label, so the values it takes are
+// bounded regardless of what the client sends.
+func knownDuty(duty string) string {
+	switch duty {
+	case DutyUpdateDuties, DutyProposal, DutyAttestation:
+		return duty
+	default:
+		return DutyUnknown
+	}
+}
+
+var (
+	fractionsLock sync.RWMutex
+	fractions     = []float64{1.0 / 3, 2.0 / 3, 1}
+)
+
+// SetFractions sets the fractions of the slot after which a step of a duty is logged as
+// late. Every fraction is logged once per duty, by the first step completing after it.
+func SetFractions(f []float64) {
+	sorted := append([]float64{}, f...)
+	sort.Float64s(sorted)
+	fractionsLock.Lock()
+	defer fractionsLock.Unlock()
+	fractions = sorted
+}
+
+// ParseFractions parses a comma-separated list of positive fractions of the slot.
+func ParseFractions(s string) ([]float64, error) {
+	var f []float64
+	for _, field := range strings.Split(s, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		fraction, err := strconv.ParseFloat(field, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not parse slot fraction %q", field)
+		}
+		if fraction <= 0 {
+			return nil, fmt.Errorf("slot fraction must be positive, received %v", fraction)
+		}
+		f = append(f, fraction)
+	}
+	return f, nil
+}
+
+// Timer records the steps of one duty of a validator.
+type Timer struct {
+	ID        string
+	Duty      string
+	Slot      uint64
+	SlotStart time.Time
+
+	lock    sync.Mutex
+	crossed int // number of fractions of the slot crossed by the steps so far.
+}
+
+// New starts the timing of a duty at the given slot, identified by a random ID.
+func New(duty string, slot uint64, slotStart time.Time) *Timer {
+	id := make([]byte, 8)
+	if _, err := rand.Read(id); err != nil {
+		log.WithError(err).Debug("Could not generate duty ID")
+	}
+	return &Timer{
+		ID:        fmt.Sprintf("%x", id),
+		Duty:      duty,
+		Slot:      slot,
+		SlotStart: slotStart,
+	}
+}
+
+type timerKey struct{}
+
+// NewContext returns a copy of the context carrying the timer.
+func NewContext(ctx context.Context, t *Timer) context.Context {
+	return context.WithValue(ctx, timerKey{}, t)
+}
+
+// FromContext returns the timer of the context, or nil if there is none.
+func FromContext(ctx context.Context) *Timer {
+	t, _ := ctx.Value(timerKey{}).(*Timer)
+	return t
+}
+
+// Step records the completion of a step of the duty timed in the context, if any.
+func Step(ctx context.Context, step string) {
+	FromContext(ctx).Step(ctx, step)
+}
+
+// Step records the completion of a step of the duty. The time since the start of the slot
+// is observed in a histogram, annotated on the span of the context and logged when it
+// crosses one of the configured fractions of the slot.
+func (t *Timer) Step(ctx context.Context, step string) {
+	if t == nil {
+		return
+	}
+	offset := roughtime.Since(t.SlotStart)
+	stepSlotOffset.WithLabelValues(t.Duty, step).Observe(offset.Seconds())
+	trace.FromContext(ctx).Annotate([]trace.Attribute{
+		trace.StringAttribute("dutyID", t.ID),
+		trace.Int64Attribute("slotOffsetMs", int64(offset/time.Millisecond)),
+	}, step)
+
+	log := log.WithFields(logrus.Fields{
+		"duty":       t.Duty,
+		"dutyID":     t.ID,
+		"slot":       t.Slot,
+		"step":       step,
+		"slotOffset": offset,
+	})
+	log.Trace("Duty step completed")
+
+	slotDuration := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+	fractionsLock.RLock()
+	defer fractionsLock.RUnlock()
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	crossed := t.crossed
+	for t.crossed < len(fractions) && offset >= time.Duration(fractions[t.crossed]*float64(slotDuration)) {
+		t.crossed++
+	}
+	if t.crossed > crossed {
+		log.WithField("slotFraction", fractions[t.crossed-1]).Warn("Duty step completed late in the slot")
+	}
+}
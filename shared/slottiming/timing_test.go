@@ -0,0 +1,126 @@
+package slottiming
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestParseFractions(t *testing.T) {
+	f, err := ParseFractions("0.5, 0.25,1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []float64{0.5, 0.25, 1}; !reflect.DeepEqual(f, want) {
+		t.Errorf("Wanted %v, received %v", want, f)
+	}
+	if _, err := ParseFractions("0.5,half"); err == nil {
+		t.Error("Expected error parsing invalid fraction")
+	}
+	if _, err := ParseFractions("0"); err == nil {
+		t.Error("Expected error parsing zero fraction")
+	}
+}
+
+func TestStep_LogsCrossedFractions(t *testing.T) {
+	hook := logTest.NewGlobal()
+	defer SetFractions([]float64{1.0 / 3, 2.0 / 3, 1})
+	SetFractions([]float64{0.5, 0.25})
+	slotDuration := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+
+	// Before the first fraction of the slot.
+	timer := New(DutyProposal, 10, time.Now())
+	ctx := NewContext(context.Background(), timer)
+	Step(ctx, "randaoSigned")
+	testutil.AssertLogsDoNotContain(t, hook, "Duty step completed late in the slot")
+
+	// Past both fractions, logged once.
+	timer.SlotStart = time.Now().Add(-slotDuration * 3 / 4)
+	Step(ctx, "blockReceived")
+	Step(ctx, "blockSigned")
+	entries := 0
+	for _, entry := range hook.AllEntries() {
+		if entry.Message == "Duty step completed late in the slot" {
+			entries++
+			if entry.Data["step"] != "blockReceived" || entry.Data["slotFraction"] != 0.5 {
+				t.Errorf("Unexpected late step log %v", entry.Data)
+			}
+		}
+	}
+	if entries != 1 {
+		t.Errorf("Expected 1 late step log, received %d", entries)
+	}
+
+	// Steps of a context without a timer are ignored.
+	Step(context.Background(), "blockSigned")
+}
+
+func TestInterceptors_PassTimer(t *testing.T) {
+	timer := New(DutyAttestation, 42, time.Unix(1587000000, 0))
+	ctx := NewContext(context.Background(), timer)
+
+	var md metadata.MD
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		md, _ = metadata.FromOutgoingContext(ctx)
+		return nil
+	}
+	if err := UnaryClientInterceptor()(ctx, "/method", nil, nil, nil, invoker); err != nil {
+		t.Fatal(err)
+	}
+
+	var received *Timer
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		received = FromContext(ctx)
+		return nil, nil
+	}
+	serverCtx := metadata.NewIncomingContext(context.Background(), md)
+	if _, err := UnaryServerInterceptor()(serverCtx, nil, &grpc.UnaryServerInfo{}, handler); err != nil {
+		t.Fatal(err)
+	}
+	if received == nil {
+		t.Fatal("Expected timer in the context of the server")
+	}
+	if received.ID != timer.ID || received.Duty != timer.Duty || received.Slot != timer.Slot || !received.SlotStart.Equal(timer.SlotStart) {
+		t.Errorf("Wanted timer %+v, received %+v", timer, received)
+	}
+
+	// Requests without a timer have none on the server either.
+	received = nil
+	if _, err := UnaryServerInterceptor()(context.Background(), nil, &grpc.UnaryServerInfo{}, handler); err != nil {
+		t.Fatal(err)
+	}
+	if received != nil {
+		t.Errorf("Expected no timer, received %+v", received)
+	}
+}
+
+func TestInterceptors_UnknownDuty(t *testing.T) {
+	md := metadata.Pairs(
+		dutyIDKey, "abc",
+		dutyKey, "made_up_duty",
+		slotKey, "42",
+		slotStartKey, "1587000000000000000",
+	)
+	var received *Timer
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		received = FromContext(ctx)
+		return nil, nil
+	}
+	serverCtx := metadata.NewIncomingContext(context.Background(), md)
+	if _, err := UnaryServerInterceptor()(serverCtx, nil, &grpc.UnaryServerInfo{}, handler); err != nil {
+		t.Fatal(err)
+	}
+	if received == nil {
+		t.Fatal("Expected timer in the context of the server")
+	}
+	if received.Duty != DutyUnknown {
+		t.Errorf("Expected duty %q, received %q", DutyUnknown, received.Duty)
+	}
+}
@@ -0,0 +1,39 @@
+package slotutil
+
+import (
+	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/roughtime"
+)
+
+// Clock is the source of time of the slot tickers and time checks of the beacon node
+// services. The services use the roughtime synchronized clock, unless they are given
+// another clock, such as the clock of a simulated network.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+	// After returns a channel receiving the current time once the duration has elapsed.
+	After(d time.Duration) <-chan time.Time
+}
+
+// ClockOrDefault returns the given clock, or the roughtime clock if none is given. Services
+// read their clock through it, as the clock is optional in their config.
+func ClockOrDefault(c Clock) Clock {
+	if c == nil {
+		return RoughClock{}
+	}
+	return c
+}
+
+// RoughClock is the roughtime synchronized system clock.
+type RoughClock struct{}
+
+// Now returns the roughtime synchronized current time.
+func (RoughClock) Now() time.Time {
+	return roughtime.Now()
+}
+
+// After waits for the duration to elapse on the system clock.
+func (RoughClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
@@ -50,6 +50,26 @@ func GetSlotTicker(genesisTime time.Time, secondsPerSlot uint64) *SlotTicker {
 	return ticker
 }
 
+// GetSlotTickerWithClock is the constructor for a SlotTicker following the time of the
+// given clock.
+func GetSlotTickerWithClock(clock Clock, genesisTime time.Time, secondsPerSlot uint64) *SlotTicker {
+	if genesisTime.Unix() == 0 {
+		panic("zero genesis time")
+	}
+	ticker := &SlotTicker{
+		c:    make(chan uint64),
+		done: make(chan struct{}),
+	}
+	since := func(t time.Time) time.Duration {
+		return clock.Now().Sub(t)
+	}
+	until := func(t time.Time) time.Duration {
+		return t.Sub(clock.Now())
+	}
+	ticker.start(genesisTime, secondsPerSlot, since, until, clock.After)
+	return ticker
+}
+
 func (s *SlotTicker) start(
 	genesisTime time.Time,
 	secondsPerSlot uint64,
@@ -1,7 +1,10 @@
 package trieutil
 
 import (
+	"bytes"
+	"errors"
 	"math"
+	"math/bits"
 
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
@@ -153,3 +156,54 @@ func GeneralizedIndexChild(index int, rightSide bool) int {
 func GeneralizedIndexParent(index int) int {
 	return index / 2
 }
+
+// MerkleRootFromBranch returns the root of a Merkle tree computed from a leaf, its Merkle branch
+// from the leaf up and the generalized index of the leaf in the tree.
+//
+// Spec pseudocode definition:
+//   def calculate_merkle_root(leaf: Bytes32, proof: Sequence[Bytes32], index: GeneralizedIndex) -> Root:
+//    assert len(proof) == get_generalized_index_length(index)
+//    for i, h in enumerate(proof):
+//        if get_generalized_index_bit(index, i):
+//            leaf = hash(h + leaf)
+//        else:
+//            leaf = hash(leaf + h)
+//    return leaf
+func MerkleRootFromBranch(leaf []byte, branch [][]byte, index uint64) ([32]byte, error) {
+	if index == 0 {
+		return [32]byte{}, errors.New("invalid generalized index 0")
+	}
+	// The generalized index length is computed from the bit length of the index
+	// instead of GeneralizedIndexLength to avoid floating point rounding on deep trees.
+	if len(branch) != bits.Len64(index)-1 {
+		return [32]byte{}, errors.New("branch length does not match generalized index length")
+	}
+	node := make([]byte, 32)
+	copy(node, leaf)
+	for i, h := range branch {
+		var parent [32]byte
+		if GeneralizedIndexBit(index, uint64(i)) {
+			parent = hashutil.Hash(append(append([]byte{}, h...), node...))
+		} else {
+			parent = hashutil.Hash(append(node, h...))
+		}
+		node = parent[:]
+	}
+	var root [32]byte
+	copy(root[:], node)
+	return root, nil
+}
+
+// VerifyMerkleProof verifies the Merkle branch of a leaf at the given generalized index
+// against the root of a Merkle tree.
+//
+// Spec pseudocode definition:
+//   def verify_merkle_proof(leaf: Bytes32, proof: Sequence[Bytes32], index: GeneralizedIndex, root: Root) -> bool:
+//    return calculate_merkle_root(leaf, proof, index) == root
+func VerifyMerkleProof(root []byte, leaf []byte, branch [][]byte, index uint64) bool {
+	computed, err := MerkleRootFromBranch(leaf, branch, index)
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(root, computed[:])
+}
@@ -4,6 +4,7 @@ import (
 	"math/rand"
 	"testing"
 
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/trieutil"
 )
 
@@ -173,6 +174,41 @@ func TestGeneralizedIndexParent(t *testing.T) {
 	}
 }
 
+func TestVerifyMerkleProof(t *testing.T) {
+	leaves := [][]byte{{'a'}, {'b'}, {'c'}, {'d'}}
+	for i := range leaves {
+		leaf := make([]byte, 32)
+		copy(leaf, leaves[i])
+		leaves[i] = leaf
+	}
+	hashPair := func(a []byte, b []byte) []byte {
+		h := hashutil.Hash(append(append([]byte{}, a...), b...))
+		return h[:]
+	}
+	left := hashPair(leaves[0], leaves[1])
+	right := hashPair(leaves[2], leaves[3])
+	root := hashPair(left, right)
+
+	// Leaf "c" is at generalized index 6, its branch is the leaf "d" and the left subtree root.
+	branch := [][]byte{leaves[3], left}
+	if !trieutil.VerifyMerkleProof(root, leaves[2], branch, 6) {
+		t.Error("Expected valid proof of leaf at generalized index 6")
+	}
+	if trieutil.VerifyMerkleProof(root, leaves[2], branch, 7) {
+		t.Error("Expected invalid proof at wrong generalized index")
+	}
+	if trieutil.VerifyMerkleProof(root, leaves[2], branch, 12) {
+		t.Error("Expected invalid proof for mismatched branch length")
+	}
+	if trieutil.VerifyMerkleProof(root, leaves[0], branch, 6) {
+		t.Error("Expected invalid proof of wrong leaf")
+	}
+	// The left subtree root is at generalized index 2.
+	if !trieutil.VerifyMerkleProof(root, left, [][]byte{right}, 2) {
+		t.Error("Expected valid proof of subtree root at generalized index 2")
+	}
+}
+
 func BenchmarkMerkleTree_Generate(b *testing.B) {
 	leaves := make([][]byte, 1<<20)
 	for i := 0; i < len(leaves); i++ {
@@ -7,12 +7,14 @@ package beaconclient
 
 import (
 	"context"
+	"sync"
 
 	middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_opentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared"
 	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/slasher/cache"
 	"github.com/prysmaticlabs/prysm/slasher/db"
@@ -22,6 +24,8 @@ import (
 	"google.golang.org/grpc/credentials"
 )
 
+var _ = shared.ReadyService(&Service{})
+
 var log = logrus.WithField("prefix", "beaconclient")
 
 // Notifier defines a struct which exposes event feeds regarding beacon blocks,
@@ -58,6 +62,8 @@ type Service struct {
 	receivedAttestationsBuffer  chan *ethpb.IndexedAttestation
 	collectedAttestationsBuffer chan []*ethpb.IndexedAttestation
 	publicKeyCache              *cache.PublicKeyCache
+	ready                       bool
+	readyLock                   sync.RWMutex
 }
 
 // Config options for the beaconclient service.
@@ -133,6 +139,17 @@ func (bs *Service) Status() error {
 	return nil
 }
 
+// Ready returns an error until the service is connected to the beacon node and the beacon
+// node is synced.
+func (bs *Service) Ready() error {
+	bs.readyLock.RLock()
+	defer bs.readyLock.RUnlock()
+	if !bs.ready {
+		return errors.New("beacon node not synced")
+	}
+	return nil
+}
+
 // Start the main runtime of the beaconclient service, initializing
 // a gRPC client connection with a beacon node, listening for
 // streamed blocks/attestations, and submitting slashing operations
@@ -172,8 +189,18 @@ func (bs *Service) Start() {
 	bs.beaconClient = ethpb.NewBeaconChainClient(bs.conn)
 	bs.nodeClient = ethpb.NewNodeClient(bs.conn)
 
+	// The beacon node may take a while to sync, it is waited for in the background.
+	go bs.run()
+}
+
+// run waits for the beacon node to be synced before streaming its blocks and attestations
+// to the other services of the slasher.
+func (bs *Service) run() {
 	// We poll for the sync status of the beacon node until it is fully synced.
 	bs.querySyncStatus(bs.ctx)
+	bs.readyLock.Lock()
+	bs.ready = true
+	bs.readyLock.Unlock()
 
 	// We notify other services in slasher that the beacon client is ready
 	// and the connection is active.
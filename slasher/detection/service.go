@@ -2,8 +2,10 @@ package detection
 
 import (
 	"context"
+	"reflect"
 
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared"
 	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/sliceutil"
 	"github.com/prysmaticlabs/prysm/slasher/beaconclient"
@@ -16,6 +18,8 @@ import (
 	"go.opencensus.io/trace"
 )
 
+var _ = shared.DependentService(&Service{})
+
 var log = logrus.WithField("prefix", "detection")
 
 // Service struct for the detection service of the slasher.
@@ -76,14 +80,16 @@ func (ds *Service) Status() error {
 	return nil
 }
 
+// Dependencies of the detection service, which is started once the beacon client is
+// connected to a synced beacon node.
+func (ds *Service) Dependencies() []reflect.Type {
+	return shared.DependencyTypes(ds.beaconClient)
+}
+
 // Start the detection service runtime.
 func (ds *Service) Start() {
-	// We wait for the gRPC beacon client to be ready and the beacon node
-	// to be fully synced before proceeding.
-	ch := make(chan bool)
-	sub := ds.notifier.ClientReadyFeed().Subscribe(ch)
-	<-ch
-	sub.Unsubscribe()
+	// The service is only started once the gRPC beacon client is ready and
+	// the beacon node is fully synced, as it depends on the beacon client.
 
 	// The detection service runs detection on all historical
 	// chain data since genesis.
@@ -15,6 +15,7 @@ import (
 	"github.com/sirupsen/logrus"
 	prefixed "github.com/x-cray/logrus-prefixed-formatter"
 	"gopkg.in/urfave/cli.v2"
+	"gopkg.in/urfave/cli.v2/altsrc"
 )
 
 var log = logrus.WithField("prefix", "main")
@@ -47,6 +48,8 @@ var appFlags = []cli.Flag{
 	cmd.LogFormat,
 	cmd.ClearDB,
 	cmd.ForceClearDB,
+	cmd.ConfigFileFlag,
+	cmd.ChainConfigFileFlag,
 	debug.PProfFlag,
 	debug.PProfAddrFlag,
 	debug.PProfPortFlag,
@@ -61,6 +64,10 @@ var appFlags = []cli.Flag{
 	flags.BeaconRPCProviderFlag,
 }
 
+func init() {
+	appFlags = cmd.WrapFlags(appFlags)
+}
+
 func main() {
 	app := cli.App{}
 	app.Name = "hash slinging slasher"
@@ -69,6 +76,13 @@ func main() {
 	app.Flags = appFlags
 	app.Action = startSlasher
 	app.Before = func(ctx *cli.Context) error {
+		// Load any flags from file, if specified.
+		if ctx.IsSet(cmd.ConfigFileFlag.Name) {
+			if err := altsrc.InitInputSourceWithContext(appFlags, altsrc.NewYamlSourceFromFlagFunc(cmd.ConfigFileFlag.Name))(ctx); err != nil {
+				return err
+			}
+		}
+
 		format := ctx.String(cmd.LogFormat.Name)
 		switch format {
 		case "text":
@@ -55,6 +55,9 @@ func NewSlasherNode(ctx *cli.Context) (*SlasherNode, error) {
 	); err != nil {
 		return nil, err
 	}
+	if err := cmd.LoadChainConfigFile(ctx); err != nil {
+		return nil, err
+	}
 	registry := shared.NewServiceRegistry()
 
 	slasher := &SlasherNode{
@@ -90,7 +93,9 @@ func NewSlasherNode(ctx *cli.Context) (*SlasherNode, error) {
 // Start the slasher and kick off every registered service.
 func (s *SlasherNode) Start() {
 	s.lock.Lock()
-	s.services.StartAll()
+	if err := s.services.StartAll(); err != nil {
+		log.WithError(err).Fatal("Could not start services")
+	}
 	s.lock.Unlock()
 
 	stop := s.stop
@@ -4,12 +4,14 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"reflect"
 
 	middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	recovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
 	grpc_opentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
 	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
+	"github.com/prysmaticlabs/prysm/shared"
 	"github.com/prysmaticlabs/prysm/shared/traceutil"
 	"github.com/prysmaticlabs/prysm/slasher/db"
 	"github.com/prysmaticlabs/prysm/slasher/detection"
@@ -20,6 +22,8 @@ import (
 	"google.golang.org/grpc/reflection"
 )
 
+var _ = shared.DependentService(&Service{})
+
 // Service defines a server implementation of the gRPC Slasher service,
 // providing RPC endpoints for retrieving slashing proofs for malicious validators.
 type Service struct {
@@ -139,3 +143,8 @@ func (s *Service) Status() error {
 	}
 	return nil
 }
+
+// Dependencies of the slasher RPC server, which checks slashings with the detection service.
+func (s *Service) Dependencies() []reflect.Type {
+	return shared.DependencyTypes(s.detector)
+}
@@ -55,6 +55,8 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.LogFileName,
 			cmd.ForceClearDB,
 			cmd.ClearDB,
+			cmd.ConfigFileFlag,
+			cmd.ChainConfigFileFlag,
 		},
 	},
 	{
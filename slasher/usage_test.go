@@ -32,7 +32,7 @@ func TestAllFlagsExistInHelp(t *testing.T) {
 
 func doesFlagExist(flag cli.Flag, flags []cli.Flag) bool {
 	for _, f := range flags {
-		if f == flag {
+		if f.String() == flag.String() {
 			return true
 		}
 	}
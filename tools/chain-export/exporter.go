@@ -0,0 +1,278 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	"github.com/prysmaticlabs/prysm/shared/attestationutil"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/sliceutil"
+)
+
+// exporter walks the blocks and archived data of a beacon chain database and writes them
+// to the exported tables, one epoch at a time.
+type exporter struct {
+	db         db.ReadOnlyDatabase
+	tables     *tables
+	validators []*ethpb.Validator
+	// The active validator indices, attester seed and beacon committees by slot and
+	// committee index are cached for the epoch of the last looked up committee.
+	committeesEpoch uint64
+	activeIndices   []uint64
+	attesterSeed    [32]byte
+	committees      map[[2]uint64][]uint64
+}
+
+// export writes the chain data from the start slot to the end slot, both included. The
+// progress is committed after every epoch.
+func (e *exporter) export(ctx context.Context, startSlot uint64, endSlot uint64) error {
+	for epoch := helpers.SlotToEpoch(startSlot); epoch <= helpers.SlotToEpoch(endSlot); epoch++ {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		from := helpers.StartSlot(epoch)
+		if from < startSlot {
+			from = startSlot
+		}
+		to := helpers.StartSlot(epoch+1) - 1
+		if to > endSlot {
+			to = endSlot
+		}
+		if err := e.exportSlots(ctx, from, to); err != nil {
+			return errors.Wrapf(err, "could not export slots %d to %d", from, to)
+		}
+		// Balances are archived at the end of each epoch.
+		if to == helpers.StartSlot(epoch+1)-1 {
+			if err := e.exportBalances(ctx, epoch); err != nil {
+				return errors.Wrapf(err, "could not export balances of epoch %d", epoch)
+			}
+		}
+		if err := e.tables.commit(to + 1); err != nil {
+			return errors.Wrap(err, "could not commit progress")
+		}
+		log.WithField("epoch", epoch).Info("Exported epoch")
+	}
+	return nil
+}
+
+func (e *exporter) exportSlots(ctx context.Context, from uint64, to uint64) error {
+	blks, err := e.db.Blocks(ctx, filters.NewFilter().SetStartSlot(from).SetEndSlot(to))
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve blocks")
+	}
+	roots := make([][32]byte, len(blks))
+	for i, blk := range blks {
+		roots[i], err = ssz.HashTreeRoot(blk.Block)
+		if err != nil {
+			return errors.Wrap(err, "could not compute block root")
+		}
+	}
+	// Blocks are exported in slot order. Blocks of forks are exported as well, the
+	// parent root column allows to filter the canonical chain.
+	idx := make([]int, len(blks))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.Slice(idx, func(a, b int) bool {
+		return blks[idx[a]].Block.Slot < blks[idx[b]].Block.Slot
+	})
+	for _, i := range idx {
+		if err := e.exportBlock(ctx, blks[i].Block, roots[i]); err != nil {
+			return errors.Wrapf(err, "could not export block %#x", roots[i])
+		}
+	}
+	return nil
+}
+
+func (e *exporter) exportBlock(ctx context.Context, blk *ethpb.BeaconBlock, root [32]byte) error {
+	body := blk.Body
+	blockSlot := strconv.FormatUint(blk.Slot, 10)
+	blockRoot := hexString(root[:])
+	eth1Data := body.Eth1Data
+	if eth1Data == nil {
+		eth1Data = &ethpb.Eth1Data{}
+	}
+	if err := e.tables.write(
+		"blocks",
+		blockSlot,
+		blockRoot,
+		hexString(blk.ParentRoot),
+		hexString(blk.StateRoot),
+		hexString(eth1Data.DepositRoot),
+		strconv.FormatUint(eth1Data.DepositCount, 10),
+		hexString(eth1Data.BlockHash),
+		hexString(body.Graffiti),
+		strconv.Itoa(len(body.Attestations)),
+		strconv.Itoa(len(body.Deposits)),
+		strconv.Itoa(len(body.VoluntaryExits)),
+		strconv.Itoa(len(body.ProposerSlashings)),
+		strconv.Itoa(len(body.AttesterSlashings)),
+	); err != nil {
+		return err
+	}
+
+	for i, att := range body.Attestations {
+		committee, err := e.committee(ctx, att.Data.Slot, att.Data.CommitteeIndex)
+		if err != nil {
+			return errors.Wrapf(err, "could not compute committee of attestation %d in block at slot %d", i, blk.Slot)
+		}
+		indices := attestationutil.AttestingIndices(att.AggregationBits, committee)
+		for _, index := range indices {
+			if err := e.tables.write(
+				"attestations",
+				blockSlot,
+				blockRoot,
+				strconv.Itoa(i),
+				strconv.FormatUint(att.Data.Slot, 10),
+				strconv.FormatUint(att.Data.CommitteeIndex, 10),
+				hexString(att.Data.BeaconBlockRoot),
+				strconv.FormatUint(att.Data.Source.Epoch, 10),
+				hexString(att.Data.Source.Root),
+				strconv.FormatUint(att.Data.Target.Epoch, 10),
+				hexString(att.Data.Target.Root),
+				strconv.FormatUint(index, 10),
+			); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, deposit := range body.Deposits {
+		if err := e.tables.write(
+			"deposits",
+			blockSlot,
+			blockRoot,
+			hexString(deposit.Data.PublicKey),
+			hexString(deposit.Data.WithdrawalCredentials),
+			strconv.FormatUint(deposit.Data.Amount, 10),
+		); err != nil {
+			return err
+		}
+	}
+
+	for _, exit := range body.VoluntaryExits {
+		if err := e.tables.write(
+			"voluntary_exits",
+			blockSlot,
+			blockRoot,
+			strconv.FormatUint(exit.Exit.Epoch, 10),
+			strconv.FormatUint(exit.Exit.ValidatorIndex, 10),
+		); err != nil {
+			return err
+		}
+	}
+
+	for _, slashing := range body.ProposerSlashings {
+		root1, err := ssz.HashTreeRoot(slashing.Header_1.Header)
+		if err != nil {
+			return errors.Wrap(err, "could not compute header root")
+		}
+		root2, err := ssz.HashTreeRoot(slashing.Header_2.Header)
+		if err != nil {
+			return errors.Wrap(err, "could not compute header root")
+		}
+		if err := e.tables.write(
+			"proposer_slashings",
+			blockSlot,
+			blockRoot,
+			strconv.FormatUint(slashing.ProposerIndex, 10),
+			strconv.FormatUint(slashing.Header_1.Header.Slot, 10),
+			hexString(root1[:]),
+			hexString(root2[:]),
+		); err != nil {
+			return err
+		}
+	}
+
+	for _, slashing := range body.AttesterSlashings {
+		att1, att2 := slashing.Attestation_1, slashing.Attestation_2
+		slashed := sliceutil.IntersectionUint64(att1.AttestingIndices, att2.AttestingIndices)
+		for _, index := range slashed {
+			if err := e.tables.write(
+				"attester_slashings",
+				blockSlot,
+				blockRoot,
+				strconv.FormatUint(index, 10),
+				strconv.FormatUint(att1.Data.Target.Epoch, 10),
+				strconv.FormatUint(att2.Data.Target.Epoch, 10),
+			); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (e *exporter) exportBalances(ctx context.Context, epoch uint64) error {
+	balances, err := e.db.ArchivedBalances(ctx, epoch)
+	if err != nil {
+		return err
+	}
+	if balances == nil {
+		log.WithField("epoch", epoch).Debug("No archived balances")
+		return nil
+	}
+	epochStr := strconv.FormatUint(epoch, 10)
+	for i, balance := range balances {
+		if err := e.tables.write(
+			"balances",
+			epochStr,
+			strconv.Itoa(i),
+			strconv.FormatUint(balance, 10),
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// This returns the beacon committee of a slot and committee index, computed from the archived
+// attester seed of the epoch. The validator registry of the head state is used to determine
+// the active validators of past epochs, as the activation and exit epochs of a validator do
+// not change once they are set.
+func (e *exporter) committee(ctx context.Context, slot uint64, committeeIndex uint64) ([]uint64, error) {
+	epoch := helpers.SlotToEpoch(slot)
+	if e.committees == nil || e.committeesEpoch != epoch {
+		info, err := e.db.ArchivedCommitteeInfo(ctx, epoch)
+		if err != nil {
+			return nil, err
+		}
+		if info == nil {
+			return nil, fmt.Errorf(
+				"no archived committee info for epoch %d, perhaps --archive in the beacon node is disabled",
+				epoch,
+			)
+		}
+		e.activeIndices = e.activeIndices[:0]
+		for i, v := range e.validators {
+			if helpers.IsActiveValidator(v, epoch) {
+				e.activeIndices = append(e.activeIndices, uint64(i))
+			}
+		}
+		e.attesterSeed = bytesutil.ToBytes32(info.AttesterSeed)
+		e.committees = make(map[[2]uint64][]uint64)
+		e.committeesEpoch = epoch
+	}
+	if committee, ok := e.committees[[2]uint64{slot, committeeIndex}]; ok {
+		return committee, nil
+	}
+
+	committee, err := helpers.BeaconCommittee(e.activeIndices, e.attesterSeed, slot, committeeIndex)
+	if err != nil {
+		return nil, err
+	}
+	e.committees[[2]uint64{slot, committeeIndex}] = committee
+	return committee, nil
+}
+
+func hexString(b []byte) string {
+	return fmt.Sprintf("%#x", b)
+}
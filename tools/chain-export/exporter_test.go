@@ -0,0 +1,229 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+func attestationData(slot uint64, committeeIndex uint64, targetEpoch uint64) *ethpb.AttestationData {
+	return &ethpb.AttestationData{
+		Slot:            slot,
+		CommitteeIndex:  committeeIndex,
+		BeaconBlockRoot: make([]byte, 32),
+		Source:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+		Target:          &ethpb.Checkpoint{Epoch: targetEpoch, Root: make([]byte, 32)},
+	}
+}
+
+// exportEpoch saves the given block to a fixture database, along with the archived committee
+// info and balances of epoch 0, and exports epoch 0 to a new output directory.
+func exportEpoch(t *testing.T, beaconDB db.Database, blk *ethpb.BeaconBlock, balances []uint64) string {
+	ctx := context.Background()
+	st, _ := testutil.DeterministicGenesisState(t, 256)
+	attesterSeed, err := helpers.Seed(st, 0, params.BeaconConfig().DomainBeaconAttester)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := beaconDB.SaveArchivedCommitteeInfo(ctx, 0, &pbp2p.ArchivedCommitteeInfo{
+		AttesterSeed: attesterSeed[:],
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if balances != nil {
+		if err := beaconDB.SaveArchivedBalances(ctx, 0, balances); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := beaconDB.SaveBlock(ctx, &ethpb.SignedBeaconBlock{Block: blk}); err != nil {
+		t.Fatal(err)
+	}
+
+	dir, err := ioutil.TempDir("", "chain-export")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tbls, _, err := openTables(dir, "csv", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tbls.close()
+	e := &exporter{db: beaconDB, tables: tbls, validators: st.Validators()}
+	if err := e.export(ctx, 0, params.BeaconConfig().SlotsPerEpoch-1); err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+// readTable returns the rows of an exported table, without its header.
+func readTable(t *testing.T, dir string, name string) [][]string {
+	f, err := os.Open(filepath.Join(dir, name+".csv"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	rows, err := csv.NewReader(f).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) == 0 || !reflect.DeepEqual(rows[0], tableColumns[name]) {
+		t.Fatalf("Expected the header of table %s, received %v", name, rows)
+	}
+	return rows[1:]
+}
+
+// column returns the values of a column of the given rows.
+func column(rows [][]string, name string, col string) []string {
+	var values []string
+	for i, c := range tableColumns[name] {
+		if c != col {
+			continue
+		}
+		for _, row := range rows {
+			values = append(values, row[i])
+		}
+	}
+	return values
+}
+
+func TestExport_AttestationIndices(t *testing.T) {
+	beaconDB := dbTest.SetupDB(t)
+	defer dbTest.TeardownDB(t, beaconDB)
+
+	st, _ := testutil.DeterministicGenesisState(t, 256)
+	committee, err := helpers.BeaconCommitteeFromState(st, 1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(committee) < 6 {
+		t.Fatalf("Expected a committee of at least 6 validators, received %v", committee)
+	}
+	bits := bitfield.NewBitlist(uint64(len(committee)))
+	bits.SetBitAt(1, true)
+	bits.SetBitAt(5, true)
+	blk := &ethpb.BeaconBlock{
+		Slot:       2,
+		ParentRoot: make([]byte, 32),
+		StateRoot:  make([]byte, 32),
+		Body: &ethpb.BeaconBlockBody{
+			Attestations: []*ethpb.Attestation{{
+				AggregationBits: bits,
+				Data:            attestationData(1, 0, 0),
+				Signature:       make([]byte, 96),
+			}},
+		},
+	}
+	dir := exportEpoch(t, beaconDB, blk, nil)
+	defer os.RemoveAll(dir)
+
+	rows := readTable(t, dir, "attestations")
+	want := []string{strconv.FormatUint(committee[1], 10), strconv.FormatUint(committee[5], 10)}
+	if got := column(rows, "attestations", "validator_index"); !reflect.DeepEqual(got, want) {
+		t.Errorf("Wanted attesting validators %v, received %v", want, got)
+	}
+	if got := column(rows, "attestations", "block_slot"); !reflect.DeepEqual(got, []string{"2", "2"}) {
+		t.Errorf("Wanted attestations included at slot 2, received %v", got)
+	}
+	if blocks := readTable(t, dir, "blocks"); len(blocks) != 1 {
+		t.Errorf("Wanted 1 exported block, received %d", len(blocks))
+	}
+}
+
+func TestExport_Balances(t *testing.T) {
+	beaconDB := dbTest.SetupDB(t)
+	defer dbTest.TeardownDB(t, beaconDB)
+
+	blk := &ethpb.BeaconBlock{Slot: 1, ParentRoot: make([]byte, 32), StateRoot: make([]byte, 32), Body: &ethpb.BeaconBlockBody{}}
+	dir := exportEpoch(t, beaconDB, blk, []uint64{32e9, 31e9, 33e9})
+	defer os.RemoveAll(dir)
+
+	want := [][]string{
+		{"0", "0", "32000000000"},
+		{"0", "1", "31000000000"},
+		{"0", "2", "33000000000"},
+	}
+	if got := readTable(t, dir, "balances"); !reflect.DeepEqual(got, want) {
+		t.Errorf("Wanted balances %v, received %v", want, got)
+	}
+}
+
+func TestExport_Slashings(t *testing.T) {
+	beaconDB := dbTest.SetupDB(t)
+	defer dbTest.TeardownDB(t, beaconDB)
+
+	header := func(root byte) *ethpb.SignedBeaconBlockHeader {
+		return &ethpb.SignedBeaconBlockHeader{
+			Header: &ethpb.BeaconBlockHeader{
+				Slot:       3,
+				ParentRoot: make([]byte, 32),
+				StateRoot:  []byte{root, 31: 0},
+				BodyRoot:   make([]byte, 32),
+			},
+			Signature: make([]byte, 96),
+		}
+	}
+	blk := &ethpb.BeaconBlock{
+		Slot:       4,
+		ParentRoot: make([]byte, 32),
+		StateRoot:  make([]byte, 32),
+		Body: &ethpb.BeaconBlockBody{
+			ProposerSlashings: []*ethpb.ProposerSlashing{{
+				ProposerIndex: 7,
+				Header_1:      header(1),
+				Header_2:      header(2),
+			}},
+			AttesterSlashings: []*ethpb.AttesterSlashing{{
+				Attestation_1: &ethpb.IndexedAttestation{
+					AttestingIndices: []uint64{1, 2, 3},
+					Data:             attestationData(0, 0, 0),
+					Signature:        make([]byte, 96),
+				},
+				Attestation_2: &ethpb.IndexedAttestation{
+					AttestingIndices: []uint64{2, 3, 4},
+					Data:             attestationData(0, 0, 1),
+					Signature:        make([]byte, 96),
+				},
+			}},
+		},
+	}
+	dir := exportEpoch(t, beaconDB, blk, nil)
+	defer os.RemoveAll(dir)
+
+	proposerSlashings := readTable(t, dir, "proposer_slashings")
+	if len(proposerSlashings) != 1 {
+		t.Fatalf("Wanted 1 proposer slashing, received %v", proposerSlashings)
+	}
+	if got := column(proposerSlashings, "proposer_slashings", "proposer_index"); got[0] != "7" {
+		t.Errorf("Wanted proposer 7 to be slashed, received %v", got)
+	}
+	roots := append(
+		column(proposerSlashings, "proposer_slashings", "header_1_root"),
+		column(proposerSlashings, "proposer_slashings", "header_2_root")...,
+	)
+	if roots[0] == roots[1] {
+		t.Errorf("Wanted the roots of the distinct slashed headers, received %v", roots)
+	}
+
+	// Only the validators attesting to both attestations are slashed.
+	want := [][]string{
+		{"4", column(proposerSlashings, "proposer_slashings", "block_root")[0], "2", "0", "1"},
+		{"4", column(proposerSlashings, "proposer_slashings", "block_root")[0], "3", "0", "1"},
+	}
+	if got := readTable(t, dir, "attester_slashings"); !reflect.DeepEqual(got, want) {
+		t.Errorf("Wanted attester slashings %v, received %v", want, got)
+	}
+}
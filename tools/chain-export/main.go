@@ -0,0 +1,134 @@
+/**
+ * Chain data exporter
+ *
+ * Given a beacon node data directory and a slot range, this tool exports blocks, attestations,
+ * deposits, voluntary exits, slashings and per epoch validator balances to CSV or Parquet tables
+ * for analytics. Attestation aggregation bits are expanded to one row per attesting validator,
+ * using the committee information archived by beacon nodes running with --archive.
+ *
+ * The export progress is recorded in the output directory after every epoch, running the
+ * tool again with the same output directory resumes the export where it stopped. A resumed export
+ * keeps the start slot and format it was started with.
+ *
+ * Example: chain-export --datadir /tmp/beacon --out /tmp/export --start-slot 0 --end-slot 6400
+ */
+package main
+
+import (
+	"context"
+	"flag"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	datadir       = flag.String("datadir", "", "Path to the beacon node data directory.")
+	outDir        = flag.String("out", "", "Path to the output directory of the exported tables.")
+	startSlot     = flag.Uint64("start-slot", 0, "First slot to export.")
+	endSlot       = flag.Uint64("end-slot", 0, "Last slot to export, defaults to the head slot.")
+	format        = flag.String("format", "csv", "Output format of the exported tables, csv or parquet.")
+	minimalConfig = flag.Bool("minimal-config", false, "Use the minimal beacon chain config.")
+)
+
+var log = logrus.WithField("prefix", "chain_export")
+
+func main() {
+	flag.Parse()
+	if *datadir == "" || *outDir == "" {
+		log.Fatal("Both --datadir and --out are required")
+	}
+	if *format != "csv" && *format != "parquet" {
+		log.Fatalf("Unsupported output format %s, wanted csv or parquet", *format)
+	}
+	if *minimalConfig {
+		params.UseMinimalConfig()
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sigc := make(chan os.Signal, 1)
+	signal.Notify(sigc, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		<-sigc
+		log.Info("Interrupted, stopping after the current epoch")
+		cancel()
+	}()
+
+	beaconDB, err := db.NewDB(*datadir, cache.NewStateSummaryCache())
+	if err != nil {
+		log.WithError(err).Fatal("Could not open database")
+	}
+	defer func() {
+		if err := beaconDB.Close(); err != nil {
+			log.WithError(err).Error("Could not close database")
+		}
+	}()
+
+	headState, err := beaconDB.HeadState(ctx)
+	if err != nil {
+		log.WithError(err).Fatal("Could not retrieve head state")
+	}
+	if headState == nil {
+		log.Fatal("No head state in database")
+	}
+
+	t, p, err := openTables(*outDir, *format, *startSlot)
+	if err != nil {
+		log.WithError(err).Fatal("Could not open output tables")
+	}
+	defer t.close()
+
+	from := *startSlot
+	if p != nil {
+		// A resumed export continues from its recorded progress, a different start slot
+		// would leave a gap or duplicate rows in the tables.
+		if startSlotSet() && *startSlot != p.StartSlot {
+			log.Fatalf(
+				"The export in %s was started at slot %d, use a new output directory to export from slot %d",
+				*outDir,
+				p.StartSlot,
+				*startSlot,
+			)
+		}
+		log.WithField("slot", p.NextSlot).Info("Resuming export")
+		from = p.NextSlot
+	}
+	to := *endSlot
+	if to == 0 || to > headState.Slot() {
+		to = headState.Slot()
+	}
+	if from > to {
+		log.WithField("slot", to).Info("Export is complete")
+		return
+	}
+
+	e := &exporter{
+		db:         beaconDB,
+		tables:     t,
+		validators: headState.Validators(),
+	}
+	if err := e.export(ctx, from, to); err != nil {
+		log.WithError(err).Fatal("Could not export chain data")
+	}
+	log.WithFields(logrus.Fields{
+		"startSlot": from,
+		"endSlot":   to,
+	}).Info("Export is complete")
+}
+
+// This returns whether the start slot flag was given on the command line.
+func startSlotSet() bool {
+	set := false
+	flag.Visit(func(f *flag.Flag) {
+		if f.Name == "start-slot" {
+			set = true
+		}
+	})
+	return set
+}
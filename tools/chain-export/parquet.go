@@ -0,0 +1,209 @@
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"math"
+
+	"github.com/pkg/errors"
+)
+
+const parquetMagic = "PAR1"
+
+// Thrift compact protocol field types.
+const (
+	thriftI32    = 5
+	thriftI64    = 6
+	thriftBinary = 8
+	thriftList   = 9
+	thriftStruct = 12
+)
+
+// Parquet format enum values, see
+// https://github.com/apache/parquet-format/blob/master/src/main/thrift/parquet.thrift
+const (
+	parquetByteArray     = 6
+	parquetRequired      = 0
+	parquetUTF8          = 0
+	parquetPlain         = 0
+	parquetRLE           = 3
+	parquetUncompressed  = 0
+	parquetDataPage      = 0
+	parquetFormatVersion = 1
+)
+
+// writeParquet writes the rows of a table as a Parquet file with a single row group. All the
+// columns are required UTF8 strings holding the same values as the CSV tables, written with
+// the plain encoding in one uncompressed data page per column.
+func writeParquet(w io.Writer, columns []string, rows [][]string) error {
+	buf := &bytes.Buffer{}
+	buf.WriteString(parquetMagic)
+
+	offsets := make([]int64, len(columns))
+	sizes := make([]int64, len(columns))
+	var lenBuf [4]byte
+	for i := range columns {
+		page := &bytes.Buffer{}
+		for _, row := range rows {
+			if len(row) != len(columns) {
+				return errors.Errorf("wanted %d values in row, received %d", len(columns), len(row))
+			}
+			binary.LittleEndian.PutUint32(lenBuf[:], uint32(len(row[i])))
+			page.Write(lenBuf[:])
+			page.WriteString(row[i])
+		}
+		if page.Len() > math.MaxInt32 {
+			return errors.Errorf("column %s is too large for a single data page", columns[i])
+		}
+
+		header := &thriftWriter{}
+		header.i32(1, parquetDataPage)
+		header.i32(2, int32(page.Len()))
+		header.i32(3, int32(page.Len()))
+		header.beginStruct(5)
+		header.i32(1, int32(len(rows)))
+		header.i32(2, parquetPlain)
+		header.i32(3, parquetRLE)
+		header.i32(4, parquetRLE)
+		header.endStruct()
+		header.endStruct()
+
+		offsets[i] = int64(buf.Len())
+		sizes[i] = int64(header.buf.Len() + page.Len())
+		buf.Write(header.buf.Bytes())
+		buf.Write(page.Bytes())
+	}
+
+	footer := &thriftWriter{}
+	footer.i32(1, parquetFormatVersion)
+	footer.beginList(2, thriftStruct, len(columns)+1)
+	footer.beginListStruct()
+	footer.binary(4, "schema")
+	footer.i32(5, int32(len(columns)))
+	footer.endStruct()
+	for _, name := range columns {
+		footer.beginListStruct()
+		footer.i32(1, parquetByteArray)
+		footer.i32(3, parquetRequired)
+		footer.binary(4, name)
+		footer.i32(6, parquetUTF8)
+		footer.endStruct()
+	}
+	footer.i64(3, int64(len(rows)))
+	footer.beginList(4, thriftStruct, 1)
+	footer.beginListStruct()
+	footer.beginList(1, thriftStruct, len(columns))
+	var totalSize int64
+	for i, name := range columns {
+		totalSize += sizes[i]
+		footer.beginListStruct()
+		footer.i64(2, offsets[i])
+		footer.beginStruct(3)
+		footer.i32(1, parquetByteArray)
+		footer.beginList(2, thriftI32, 2)
+		footer.listI32(parquetPlain)
+		footer.listI32(parquetRLE)
+		footer.beginList(3, thriftBinary, 1)
+		footer.listBinary(name)
+		footer.i32(4, parquetUncompressed)
+		footer.i64(5, int64(len(rows)))
+		footer.i64(6, sizes[i])
+		footer.i64(7, sizes[i])
+		footer.i64(9, offsets[i])
+		footer.endStruct()
+		footer.endStruct()
+	}
+	footer.i64(2, totalSize)
+	footer.i64(3, int64(len(rows)))
+	footer.endStruct()
+	footer.binary(6, "prysm chain-export")
+	footer.endStruct()
+
+	buf.Write(footer.buf.Bytes())
+	binary.LittleEndian.PutUint32(lenBuf[:], uint32(footer.buf.Len()))
+	buf.Write(lenBuf[:])
+	buf.WriteString(parquetMagic)
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// thriftWriter encodes the Parquet metadata structures with the Thrift compact protocol. The
+// last field id of each open struct is kept, as field headers encode the delta to it.
+type thriftWriter struct {
+	buf       bytes.Buffer
+	lastField int16
+	parents   []int16
+}
+
+func (t *thriftWriter) fieldHeader(id int16, typ byte) {
+	if delta := id - t.lastField; delta > 0 && delta <= 15 {
+		t.buf.WriteByte(byte(delta)<<4 | typ)
+	} else {
+		t.buf.WriteByte(typ)
+		t.varint(uint64(int64(id)<<1 ^ int64(id)>>63))
+	}
+	t.lastField = id
+}
+
+func (t *thriftWriter) varint(v uint64) {
+	var b [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(b[:], v)
+	t.buf.Write(b[:n])
+}
+
+func (t *thriftWriter) i32(id int16, v int32) {
+	t.fieldHeader(id, thriftI32)
+	t.listI32(v)
+}
+
+func (t *thriftWriter) i64(id int16, v int64) {
+	t.fieldHeader(id, thriftI64)
+	t.varint(uint64(v<<1 ^ v>>63))
+}
+
+func (t *thriftWriter) binary(id int16, v string) {
+	t.fieldHeader(id, thriftBinary)
+	t.listBinary(v)
+}
+
+func (t *thriftWriter) beginStruct(id int16) {
+	t.fieldHeader(id, thriftStruct)
+	t.beginListStruct()
+}
+
+func (t *thriftWriter) beginList(id int16, elemType byte, size int) {
+	t.fieldHeader(id, thriftList)
+	if size < 15 {
+		t.buf.WriteByte(byte(size)<<4 | elemType)
+		return
+	}
+	t.buf.WriteByte(0xf0 | elemType)
+	t.varint(uint64(size))
+}
+
+// beginListStruct starts a struct element of a list, or a struct of which the field header
+// is already written.
+func (t *thriftWriter) beginListStruct() {
+	t.parents = append(t.parents, t.lastField)
+	t.lastField = 0
+}
+
+// endStruct writes the stop field of the current struct. It ends the top level struct when
+// no other struct is open.
+func (t *thriftWriter) endStruct() {
+	t.buf.WriteByte(0)
+	if len(t.parents) > 0 {
+		t.lastField = t.parents[len(t.parents)-1]
+		t.parents = t.parents[:len(t.parents)-1]
+	}
+}
+
+func (t *thriftWriter) listI32(v int32) {
+	t.varint(uint64(uint32(v<<1 ^ v>>31)))
+}
+
+func (t *thriftWriter) listBinary(v string) {
+	t.varint(uint64(len(v)))
+	t.buf.WriteString(v)
+}
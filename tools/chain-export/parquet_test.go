@@ -0,0 +1,145 @@
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"reflect"
+	"testing"
+)
+
+// thriftReader decodes Thrift compact protocol structs into maps of field id to value, with
+// integers decoded as int64, binaries as strings, lists as slices and structs as maps.
+type thriftReader struct {
+	t *testing.T
+	r *bytes.Reader
+}
+
+func (tr *thriftReader) varint() uint64 {
+	v, err := binary.ReadUvarint(tr.r)
+	if err != nil {
+		tr.t.Fatal(err)
+	}
+	return v
+}
+
+func (tr *thriftReader) byte() byte {
+	b, err := tr.r.ReadByte()
+	if err != nil {
+		tr.t.Fatal(err)
+	}
+	return b
+}
+
+func (tr *thriftReader) value(typ byte) interface{} {
+	switch typ {
+	case thriftI32, thriftI64:
+		v := tr.varint()
+		return int64(v>>1) ^ -int64(v&1)
+	case thriftBinary:
+		b := make([]byte, tr.varint())
+		if _, err := tr.r.Read(b); err != nil {
+			tr.t.Fatal(err)
+		}
+		return string(b)
+	case thriftList:
+		header := tr.byte()
+		size := uint64(header >> 4)
+		if size == 15 {
+			size = tr.varint()
+		}
+		list := make([]interface{}, size)
+		for i := range list {
+			list[i] = tr.value(header & 0x0f)
+		}
+		return list
+	case thriftStruct:
+		s := make(map[int16]interface{})
+		var id int16
+		for {
+			header := tr.byte()
+			if header == 0 {
+				return s
+			}
+			if delta := int16(header >> 4); delta != 0 {
+				id += delta
+			} else {
+				v := tr.varint()
+				id = int16(v>>1) ^ -int16(v&1)
+			}
+			s[id] = tr.value(header & 0x0f)
+		}
+	}
+	tr.t.Fatalf("Unexpected thrift type %d", typ)
+	return nil
+}
+
+func TestWriteParquet(t *testing.T) {
+	columns := []string{"block_slot", "block_root", "epoch", "validator_index"}
+	rows := [][]string{
+		{"1", "0xaa", "0", "2"},
+		{"40", "0xcc", "1", "4"},
+	}
+	buf := &bytes.Buffer{}
+	if err := writeParquet(buf, columns, rows); err != nil {
+		t.Fatal(err)
+	}
+	file := buf.Bytes()
+	if string(file[:4]) != parquetMagic || string(file[len(file)-4:]) != parquetMagic {
+		t.Fatal("Expected the file to start and end with the parquet magic")
+	}
+	footerLen := int(binary.LittleEndian.Uint32(file[len(file)-8:]))
+	footer := file[len(file)-8-footerLen : len(file)-8]
+	meta := (&thriftReader{t: t, r: bytes.NewReader(footer)}).value(thriftStruct).(map[int16]interface{})
+
+	if meta[3] != int64(len(rows)) {
+		t.Errorf("Wanted %d rows, received %v", len(rows), meta[3])
+	}
+	schema := meta[2].([]interface{})
+	if len(schema) != len(columns)+1 || schema[0].(map[int16]interface{})[5] != int64(len(columns)) {
+		t.Fatalf("Unexpected schema %v", schema)
+	}
+	rowGroups := meta[4].([]interface{})
+	if len(rowGroups) != 1 {
+		t.Fatalf("Wanted 1 row group, received %d", len(rowGroups))
+	}
+	chunks := rowGroups[0].(map[int16]interface{})[1].([]interface{})
+	for i, name := range columns {
+		if schema[i+1].(map[int16]interface{})[4] != name {
+			t.Errorf("Wanted column %s, received %v", name, schema[i+1])
+		}
+		chunkMeta := chunks[i].(map[int16]interface{})[3].(map[int16]interface{})
+		if path := chunkMeta[3].([]interface{}); len(path) != 1 || path[0] != name {
+			t.Errorf("Wanted column path %s, received %v", name, path)
+		}
+
+		// Read the values of the data page of the column chunk.
+		r := bytes.NewReader(file[chunkMeta[9].(int64):])
+		pageHeader := (&thriftReader{t: t, r: r}).value(thriftStruct).(map[int16]interface{})
+		if pageHeader[5].(map[int16]interface{})[1] != int64(len(rows)) {
+			t.Errorf("Wanted %d values in data page, received %v", len(rows), pageHeader[5])
+		}
+		page := make([]byte, pageHeader[3].(int64))
+		if _, err := r.Read(page); err != nil {
+			t.Fatal(err)
+		}
+		var values []string
+		for len(page) > 0 {
+			n := binary.LittleEndian.Uint32(page)
+			values = append(values, string(page[4:4+n]))
+			page = page[4+n:]
+		}
+		var want []string
+		for _, row := range rows {
+			want = append(want, row[i])
+		}
+		if !reflect.DeepEqual(values, want) {
+			t.Errorf("Wanted values %v for column %s, received %v", want, name, values)
+		}
+	}
+}
+
+func TestWriteParquet_WrongRowLength(t *testing.T) {
+	if err := writeParquet(&bytes.Buffer{}, []string{"epoch", "balance"}, [][]string{{"1"}}); err == nil {
+		t.Error("Expected an error for a row with a missing value")
+	}
+}
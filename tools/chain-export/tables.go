@@ -0,0 +1,306 @@
+package main
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+)
+
+const progressFileName = "progress.json"
+
+// tableColumns defines the exported tables and their columns.
+var tableColumns = map[string][]string{
+	"blocks": {
+		"slot", "root", "parent_root", "state_root", "eth1_deposit_root", "eth1_deposit_count",
+		"eth1_block_hash", "graffiti", "attestations", "deposits", "voluntary_exits",
+		"proposer_slashings", "attester_slashings",
+	},
+	"attestations": {
+		"block_slot", "block_root", "position", "slot", "committee_index", "beacon_block_root",
+		"source_epoch", "source_root", "target_epoch", "target_root", "validator_index",
+	},
+	"deposits": {
+		"block_slot", "block_root", "pubkey", "withdrawal_credentials", "amount",
+	},
+	"voluntary_exits": {
+		"block_slot", "block_root", "epoch", "validator_index",
+	},
+	"proposer_slashings": {
+		"block_slot", "block_root", "proposer_index", "slot", "header_1_root", "header_2_root",
+	},
+	"attester_slashings": {
+		"block_slot", "block_root", "validator_index", "attestation_1_target_epoch", "attestation_2_target_epoch",
+	},
+	"balances": {
+		"epoch", "validator_index", "balance",
+	},
+}
+
+// progress records how far an export went. The sizes of the tables are recorded along with
+// the next slot to export, so rows written after the last recorded progress can be discarded
+// when resuming. The size of a CSV table is the size of its file, and the size of a Parquet
+// table is its number of part files.
+type progress struct {
+	StartSlot uint64           `json:"start_slot"`
+	NextSlot  uint64           `json:"next_slot"`
+	Format    string           `json:"format"`
+	Sizes     map[string]int64 `json:"sizes"`
+}
+
+// table writes the rows of one exported table.
+type table interface {
+	write(row []string) error
+	// commit writes the rows to disk and returns the size of the table.
+	commit() (int64, error)
+	close() error
+}
+
+// tables holds the exported tables.
+type tables struct {
+	dir       string
+	format    string
+	startSlot uint64
+	tables    map[string]table
+}
+
+// openTables opens the tables of the output directory in the given format, csv or parquet,
+// and returns them along with the recorded progress of a previous export, if any. Tables are
+// truncated to their recorded size so that a resumed export does not duplicate rows. The
+// start slot is recorded in the progress of a new export.
+func openTables(dir string, format string, startSlot uint64) (*tables, *progress, error) {
+	if format != "csv" && format != "parquet" {
+		return nil, nil, errors.Errorf("unsupported output format %s", format)
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, nil, errors.Wrap(err, "could not create output directory")
+	}
+	p, err := readProgress(dir)
+	if err != nil {
+		return nil, nil, err
+	}
+	if p != nil {
+		if p.Format != format {
+			return nil, nil, errors.Errorf("output directory holds a %s export, it cannot be resumed as %s", p.Format, format)
+		}
+		startSlot = p.StartSlot
+	}
+
+	t := &tables{
+		dir:       dir,
+		format:    format,
+		startSlot: startSlot,
+		tables:    make(map[string]table),
+	}
+	for name, columns := range tableColumns {
+		var size int64
+		if p != nil {
+			size = p.Sizes[name]
+		}
+		var tbl table
+		if format == "parquet" {
+			tbl, err = openParquetTable(filepath.Join(dir, name), columns, size)
+		} else {
+			tbl, err = openCSVTable(filepath.Join(dir, name+".csv"), columns, size)
+		}
+		if err != nil {
+			t.close()
+			return nil, nil, err
+		}
+		t.tables[name] = tbl
+	}
+	return t, p, nil
+}
+
+// write appends a row to a table.
+func (t *tables) write(name string, row ...string) error {
+	return t.tables[name].write(row)
+}
+
+// commit writes the tables to disk and records the progress of the export.
+func (t *tables) commit(nextSlot uint64) error {
+	p := &progress{
+		StartSlot: t.startSlot,
+		NextSlot:  nextSlot,
+		Format:    t.format,
+		Sizes:     make(map[string]int64),
+	}
+	for name, tbl := range t.tables {
+		size, err := tbl.commit()
+		if err != nil {
+			return errors.Wrapf(err, "could not write %s", name)
+		}
+		p.Sizes[name] = size
+	}
+	enc, err := json.Marshal(p)
+	if err != nil {
+		return err
+	}
+	// The progress file is replaced atomically so that an interrupted commit leaves the
+	// previous progress intact.
+	tmp := filepath.Join(t.dir, progressFileName+".tmp")
+	if err := ioutil.WriteFile(tmp, enc, 0600); err != nil {
+		return errors.Wrap(err, "could not write progress")
+	}
+	return os.Rename(tmp, filepath.Join(t.dir, progressFileName))
+}
+
+func (t *tables) close() {
+	for name, tbl := range t.tables {
+		if err := tbl.close(); err != nil {
+			log.WithError(err).Errorf("Could not close %s", name)
+		}
+	}
+}
+
+// csvTable writes a table to a CSV file.
+type csvTable struct {
+	f *os.File
+	w *csv.Writer
+}
+
+func openCSVTable(path string, columns []string, size int64) (*csvTable, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR, 0600)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not open %s", path)
+	}
+	if err := f.Truncate(size); err != nil {
+		f.Close()
+		return nil, errors.Wrapf(err, "could not truncate %s", path)
+	}
+	if _, err := f.Seek(size, 0); err != nil {
+		f.Close()
+		return nil, errors.Wrapf(err, "could not seek %s", path)
+	}
+	c := &csvTable{f: f, w: csv.NewWriter(f)}
+	if size == 0 {
+		if err := c.w.Write(columns); err != nil {
+			f.Close()
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+func (c *csvTable) write(row []string) error {
+	return c.w.Write(row)
+}
+
+func (c *csvTable) commit() (int64, error) {
+	c.w.Flush()
+	if err := c.w.Error(); err != nil {
+		return 0, err
+	}
+	if err := c.f.Sync(); err != nil {
+		return 0, errors.Wrap(err, "could not sync")
+	}
+	return c.f.Seek(0, 1)
+}
+
+func (c *csvTable) close() error {
+	return c.f.Close()
+}
+
+// parquetTable writes a table to a directory of Parquet files, one part file per commit, as
+// a Parquet file cannot be appended to once its footer is written. The rows are kept in
+// memory until they are committed.
+type parquetTable struct {
+	dir     string
+	columns []string
+	rows    [][]string
+	parts   int64
+}
+
+// openParquetTable opens the directory of a Parquet table, removing the part files written
+// after the recorded number of parts.
+func openParquetTable(dir string, columns []string, parts int64) (*parquetTable, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, errors.Wrapf(err, "could not create %s", dir)
+	}
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not read %s", dir)
+	}
+	for _, file := range files {
+		var part int64
+		_, err := fmt.Sscanf(file.Name(), "part-%d.parquet", &part)
+		if err == nil && part < parts && file.Name() == partFileName(part) {
+			continue
+		}
+		if err := os.Remove(filepath.Join(dir, file.Name())); err != nil {
+			return nil, errors.Wrapf(err, "could not remove %s", file.Name())
+		}
+	}
+	return &parquetTable{dir: dir, columns: columns, parts: parts}, nil
+}
+
+func (p *parquetTable) write(row []string) error {
+	if len(row) != len(p.columns) {
+		return errors.Errorf("wanted %d values in row, received %d", len(p.columns), len(row))
+	}
+	p.rows = append(p.rows, row)
+	return nil
+}
+
+func (p *parquetTable) commit() (int64, error) {
+	if len(p.rows) == 0 {
+		return p.parts, nil
+	}
+	path := filepath.Join(p.dir, partFileName(p.parts))
+	tmp := path + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return 0, errors.Wrapf(err, "could not open %s", tmp)
+	}
+	w := bufio.NewWriter(f)
+	if err := writeParquet(w, p.columns, p.rows); err != nil {
+		f.Close()
+		return 0, err
+	}
+	if err := w.Flush(); err != nil {
+		f.Close()
+		return 0, err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return 0, errors.Wrap(err, "could not sync")
+	}
+	if err := f.Close(); err != nil {
+		return 0, err
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return 0, err
+	}
+	p.rows = nil
+	p.parts++
+	return p.parts, nil
+}
+
+func (p *parquetTable) close() error {
+	return nil
+}
+
+func partFileName(part int64) string {
+	return fmt.Sprintf("part-%05d.parquet", part)
+}
+
+// This reads the progress of a previous export, nil is returned if there is none.
+func readProgress(dir string) (*progress, error) {
+	enc, err := ioutil.ReadFile(filepath.Join(dir, progressFileName))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read progress")
+	}
+	p := &progress{}
+	if err := json.Unmarshal(enc, p); err != nil {
+		return nil, errors.Wrap(err, "could not decode progress")
+	}
+	return p, nil
+}
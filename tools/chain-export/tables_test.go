@@ -0,0 +1,147 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestTables_Resume(t *testing.T) {
+	dir, err := ioutil.TempDir("", "chain-export")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	tbls, p, err := openTables(dir, "csv", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p != nil {
+		t.Fatal("Expected no progress for a new export")
+	}
+	if err := tbls.write("voluntary_exits", "1", "0xaa", "0", "2"); err != nil {
+		t.Fatal(err)
+	}
+	if err := tbls.commit(32); err != nil {
+		t.Fatal(err)
+	}
+	// Rows written after the last commit are discarded when resuming.
+	if err := tbls.write("voluntary_exits", "33", "0xbb", "1", "3"); err != nil {
+		t.Fatal(err)
+	}
+	tbls.tables["voluntary_exits"].(*csvTable).w.Flush()
+	tbls.close()
+
+	tbls, p, err = openTables(dir, "csv", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p == nil || p.NextSlot != 32 || p.StartSlot != 1 {
+		t.Fatalf("Expected to resume from slot 32 an export started at slot 1, received %v", p)
+	}
+	if err := tbls.write("voluntary_exits", "40", "0xcc", "1", "4"); err != nil {
+		t.Fatal(err)
+	}
+	if err := tbls.commit(64); err != nil {
+		t.Fatal(err)
+	}
+	tbls.close()
+
+	enc, err := ioutil.ReadFile(filepath.Join(dir, "voluntary_exits.csv"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "block_slot,block_root,epoch,validator_index\n1,0xaa,0,2\n40,0xcc,1,4\n"
+	if string(enc) != want {
+		t.Errorf("Wanted table %q, received %q", want, string(enc))
+	}
+}
+
+func TestTables_ResumeParquet(t *testing.T) {
+	dir, err := ioutil.TempDir("", "chain-export")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	tbls, _, err := openTables(dir, "parquet", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := tbls.write("voluntary_exits", "1", "0xaa", "0", "2"); err != nil {
+		t.Fatal(err)
+	}
+	if err := tbls.commit(32); err != nil {
+		t.Fatal(err)
+	}
+	// A part file written after the last commit is discarded when resuming.
+	if err := tbls.write("voluntary_exits", "33", "0xbb", "1", "3"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tbls.tables["voluntary_exits"].commit(); err != nil {
+		t.Fatal(err)
+	}
+	tbls.close()
+
+	tbls, p, err := openTables(dir, "parquet", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p == nil || p.NextSlot != 32 {
+		t.Fatalf("Expected to resume from slot 32, received %v", p)
+	}
+	if err := tbls.write("voluntary_exits", "40", "0xcc", "1", "4"); err != nil {
+		t.Fatal(err)
+	}
+	if err := tbls.commit(64); err != nil {
+		t.Fatal(err)
+	}
+	tbls.close()
+
+	files, err := ioutil.ReadDir(filepath.Join(dir, "voluntary_exits"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 2 || files[0].Name() != "part-00000.parquet" || files[1].Name() != "part-00001.parquet" {
+		t.Fatalf("Unexpected part files %v", files)
+	}
+	enc, err := ioutil.ReadFile(filepath.Join(dir, "voluntary_exits", "part-00001.parquet"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Contains(enc, []byte("0xcc")) || bytes.Contains(enc, []byte("0xbb")) {
+		t.Error("Expected the last part file to hold the rows written after resuming")
+	}
+	// Tables without rows have no part files.
+	files, err = ioutil.ReadDir(filepath.Join(dir, "balances"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 0 {
+		t.Errorf("Expected no part files for an empty table, received %v", files)
+	}
+}
+
+func TestTables_ResumeOtherFormat(t *testing.T) {
+	dir, err := ioutil.TempDir("", "chain-export")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	tbls, _, err := openTables(dir, "csv", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := tbls.commit(32); err != nil {
+		t.Fatal(err)
+	}
+	tbls.close()
+
+	if _, _, err := openTables(dir, "parquet", 0); err == nil {
+		t.Error("Expected an error when resuming a csv export as parquet")
+	}
+}
@@ -0,0 +1,69 @@
+/**
+ * Devnet
+ *
+ * Generates the files of a local network of beacon nodes, validator clients and slashers:
+ * the genesis state of the interop validators, their keys split across the validator
+ * clients, the p2p keys and ENRs of the beacon nodes, and a config file for every node.
+ *
+ * Usage: Run devnet --help for flag options.
+ */
+package main
+
+import (
+	"flag"
+	"path/filepath"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	outputDir       = flag.String("output-dir", "", "Directory to write the network to")
+	numValidators   = flag.Uint64("num-validators", 0, "Number of interop validators in the genesis state")
+	numNodes        = flag.Uint64("num-nodes", 1, "Number of beacon nodes, each with a validator client and a slasher")
+	genesisTime     = flag.Uint64("genesis-time", 0, "Unix timestamp used as the genesis time (defaults to now)")
+	preset          = flag.String("config", "minimal", "Chain config preset of the network: minimal, demo or mainnet")
+	chainConfigFile = flag.String("chain-config-file", "", "YAML file with chain config values applied over the preset")
+	hostIP          = flag.String("host-ip", "127.0.0.1", "IPv4 address the beacon nodes are reachable at")
+	portOffset      = flag.Int("port-offset", 0, "Offset added to the ports of every node, to run several networks side by side")
+	seed            = flag.String("seed", "", "Seed the p2p keys of the beacon nodes are derived from")
+
+	log = logrus.WithField("prefix", "devnet")
+)
+
+func main() {
+	flag.Parse()
+	if *outputDir == "" {
+		log.Fatal("Expected --output-dir to have been provided")
+	}
+	if *numValidators == 0 {
+		log.Fatal("Expected --num-validators to have been provided, received 0")
+	}
+	if *genesisTime == 0 {
+		*genesisTime = uint64(time.Now().Unix())
+		log.WithField("genesisTime", *genesisTime).Info("No --genesis-time specified, defaulting to now")
+	}
+
+	if err := generateNetwork(&networkConfig{
+		OutputDir:       *outputDir,
+		NumValidators:   *numValidators,
+		NumNodes:        *numNodes,
+		GenesisTime:     *genesisTime,
+		Preset:          *preset,
+		ChainConfigFile: *chainConfigFile,
+		HostIP:          *hostIP,
+		PortOffset:      *portOffset,
+		Seed:            *seed,
+	}); err != nil {
+		log.WithError(err).Fatal("Could not generate network")
+	}
+	dir, err := filepath.Abs(*outputDir)
+	if err != nil {
+		dir = *outputDir
+	}
+	log.WithField("dir", dir).Infof(
+		"Generated network of %d nodes. Start each node with --config-file=%s",
+		*numNodes,
+		filepath.Join(dir, "<node>", configFileName),
+	)
+}
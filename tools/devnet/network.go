@@ -0,0 +1,345 @@
+package main
+
+import (
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/shared/interop"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	genesisStateFileName = "genesis.ssz"
+	chainConfigFileName  = "chain-config.yaml"
+	bootnodesFileName    = "bootnodes.txt"
+	configFileName       = "config.yaml"
+	p2pKeyFileName       = "p2p-key"
+	keysFileName         = "keys.json"
+)
+
+// Ports of the first node of the network. The ports of the other nodes follow them.
+const (
+	beaconRPCPort           = 4000
+	beaconGatewayPort       = 3500
+	beaconTCPPort           = 13000
+	beaconUDPPort           = 12000
+	beaconMonitoringPort    = 8080
+	validatorMonitoringPort = 8180
+	slasherRPCPort          = 5000
+	slasherMonitoringPort   = 8280
+)
+
+// networkConfig defines the network to generate.
+type networkConfig struct {
+	OutputDir     string
+	NumValidators uint64
+	NumNodes      uint64
+	GenesisTime   uint64
+	// Preset is the name of the chain config preset, minimal, demo or mainnet.
+	Preset string
+	// ChainConfigFile is an optional YAML chain config applied over the preset.
+	ChainConfigFile string
+	HostIP          string
+	PortOffset      int
+	// Seed derives the p2p keys of the nodes, so that different networks can be generated
+	// from the same validator set.
+	Seed string
+}
+
+// unencryptedKeysContainer is the key file read by the unencrypted key manager of the
+// validator client.
+type unencryptedKeysContainer struct {
+	Keys []*unencryptedKeys `json:"keys"`
+}
+
+type unencryptedKeys struct {
+	ValidatorKey  []byte `json:"validator_key"`
+	WithdrawalKey []byte `json:"withdrawal_key"`
+}
+
+// beaconNode is a beacon node of the network, along with the p2p identity it is started with.
+type beaconNode struct {
+	index  uint64
+	dir    string
+	p2pKey crypto.PrivKey
+	enr    string
+}
+
+// generateNetwork writes the genesis state, chain config, keys and config files of every
+// node of the network to the output directory. The same config generates the same network.
+func generateNetwork(cfg *networkConfig) error {
+	if cfg.NumNodes == 0 {
+		return errors.New("network must have at least one node")
+	}
+	if cfg.NumValidators < cfg.NumNodes {
+		return fmt.Errorf("cannot split %d validators across %d nodes", cfg.NumValidators, cfg.NumNodes)
+	}
+	if net.ParseIP(cfg.HostIP).To4() == nil {
+		return fmt.Errorf("invalid ipv4 address %s", cfg.HostIP)
+	}
+	outputDir, err := filepath.Abs(cfg.OutputDir)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(outputDir, 0700); err != nil {
+		return err
+	}
+
+	chainConfig, err := loadChainConfig(cfg.Preset, cfg.ChainConfigFile)
+	if err != nil {
+		return err
+	}
+	params.OverrideBeaconConfig(chainConfig)
+	enc, err := params.MarshalConfig(chainConfig)
+	if err != nil {
+		return errors.Wrap(err, "could not marshal chain config")
+	}
+	if err := ioutil.WriteFile(filepath.Join(outputDir, chainConfigFileName), enc, 0644); err != nil {
+		return err
+	}
+
+	genesisState, _, err := interop.GenerateGenesisState(cfg.GenesisTime, cfg.NumValidators)
+	if err != nil {
+		return errors.Wrap(err, "could not generate genesis state")
+	}
+	enc, err = ssz.Marshal(genesisState)
+	if err != nil {
+		return errors.Wrap(err, "could not ssz marshal genesis state")
+	}
+	if err := ioutil.WriteFile(filepath.Join(outputDir, genesisStateFileName), enc, 0644); err != nil {
+		return err
+	}
+
+	nodes := make([]*beaconNode, cfg.NumNodes)
+	enrs := make([]string, cfg.NumNodes)
+	for i := range nodes {
+		node, err := newBeaconNode(cfg, outputDir, uint64(i))
+		if err != nil {
+			return err
+		}
+		nodes[i] = node
+		enrs[i] = node.enr
+	}
+	if err := ioutil.WriteFile(filepath.Join(outputDir, bootnodesFileName), []byte(strings.Join(enrs, "\n")+"\n"), 0644); err != nil {
+		return err
+	}
+
+	var startIndex uint64
+	for _, node := range nodes {
+		if err := writeBeaconNode(cfg, outputDir, node, nodes); err != nil {
+			return err
+		}
+		// The validators which do not split evenly go to the first validator clients.
+		numKeys := cfg.NumValidators / cfg.NumNodes
+		if node.index < cfg.NumValidators%cfg.NumNodes {
+			numKeys++
+		}
+		if err := writeValidator(cfg, outputDir, node.index, startIndex, numKeys); err != nil {
+			return err
+		}
+		startIndex += numKeys
+		if err := writeSlasher(cfg, outputDir, node.index); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// loadChainConfig returns the config of the preset, with the values of the chain config
+// file applied.
+func loadChainConfig(preset string, chainConfigFile string) (*params.BeaconChainConfig, error) {
+	var conf *params.BeaconChainConfig
+	switch preset {
+	case "minimal":
+		conf = params.MinimalSpecConfig()
+	case "demo":
+		conf = params.DemoBeaconConfig()
+	case "mainnet":
+		conf = params.MainnetConfig()
+	default:
+		return nil, fmt.Errorf("unknown config preset %s", preset)
+	}
+	if chainConfigFile == "" {
+		return conf, nil
+	}
+	// #nosec G304
+	yamlFile, err := ioutil.ReadFile(chainConfigFile)
+	if err != nil {
+		return nil, err
+	}
+	return params.UnmarshalConfig(yamlFile, conf)
+}
+
+// newBeaconNode derives the p2p key of the beacon node from the seed, and computes the ENR
+// the other nodes find it with.
+func newBeaconNode(cfg *networkConfig, outputDir string, index uint64) (*beaconNode, error) {
+	h := sha256.New()
+	if _, err := h.Write([]byte(cfg.Seed)); err != nil {
+		return nil, err
+	}
+	b := make([]byte, 8)
+	binary.LittleEndian.PutUint64(b, index)
+	if _, err := h.Write(b); err != nil {
+		return nil, err
+	}
+	p2pKey, err := crypto.UnmarshalSecp256k1PrivateKey(h.Sum(nil))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not derive p2p key")
+	}
+	ecdsaKey := (*ecdsa.PrivateKey)((*btcec.PrivateKey)(p2pKey.(*crypto.Secp256k1PrivateKey)))
+
+	db, err := enode.OpenDB("")
+	if err != nil {
+		return nil, errors.Wrap(err, "could not open node database")
+	}
+	defer db.Close()
+	localNode := enode.NewLocalNode(db, ecdsaKey)
+	localNode.Set(enr.IP(net.ParseIP(cfg.HostIP)))
+	localNode.Set(enr.UDP(beaconUDPPort + cfg.PortOffset + int(index)))
+	localNode.Set(enr.TCP(beaconTCPPort + cfg.PortOffset + int(index)))
+
+	return &beaconNode{
+		index:  index,
+		dir:    filepath.Join(outputDir, fmt.Sprintf("beacon-node-%d", index)),
+		p2pKey: p2pKey,
+		enr:    localNode.Node().String(),
+	}, nil
+}
+
+// writeBeaconNode writes the p2p key and config file of the beacon node, which starts from
+// the genesis state and discovers the other beacon nodes through their ENRs.
+func writeBeaconNode(cfg *networkConfig, outputDir string, node *beaconNode, nodes []*beaconNode) error {
+	if err := os.MkdirAll(node.dir, 0700); err != nil {
+		return err
+	}
+	rawKey, err := node.p2pKey.Raw()
+	if err != nil {
+		return err
+	}
+	keyFile := filepath.Join(node.dir, p2pKeyFileName)
+	if err := ioutil.WriteFile(keyFile, []byte(hex.EncodeToString(rawKey)), 0600); err != nil {
+		return err
+	}
+	var bootnodes []string
+	for _, n := range nodes {
+		if n != node {
+			bootnodes = append(bootnodes, n.enr)
+		}
+	}
+	i := cfg.PortOffset + int(node.index)
+	flags := yaml.MapSlice{
+		{Key: "datadir", Value: filepath.Join(node.dir, "data")},
+		{Key: "interop-genesis-state", Value: filepath.Join(outputDir, genesisStateFileName)},
+		{Key: "p2p-priv-key", Value: keyFile},
+		{Key: "p2p-host-ip", Value: cfg.HostIP},
+		{Key: "p2p-tcp-port", Value: beaconTCPPort + i},
+		{Key: "p2p-udp-port", Value: beaconUDPPort + i},
+		{Key: "enable-discv5", Value: true},
+		{Key: "bootstrap-node", Value: strings.Join(bootnodes, ",")},
+		{Key: "min-sync-peers", Value: len(bootnodes)},
+		{Key: "rpc-port", Value: beaconRPCPort + i},
+		{Key: "grpc-gateway-port", Value: beaconGatewayPort + i},
+		{Key: "monitoring-port", Value: beaconMonitoringPort + i},
+	}
+	flags = append(flags, presetFlags(cfg.Preset, outputDir)...)
+	return writeConfigFile(filepath.Join(node.dir, configFileName), flags)
+}
+
+// writeValidator writes the keys and config file of the validator client of a beacon node,
+// which validates with the interop keys from the start index.
+func writeValidator(cfg *networkConfig, outputDir string, index uint64, startIndex uint64, numKeys uint64) error {
+	dir := filepath.Join(outputDir, fmt.Sprintf("validator-%d", index))
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+	sks, _, err := interop.DeterministicallyGenerateKeys(startIndex, numKeys)
+	if err != nil {
+		return errors.Wrap(err, "could not generate validator keys")
+	}
+	ctnr := &unencryptedKeysContainer{
+		Keys: make([]*unencryptedKeys, len(sks)),
+	}
+	for i, sk := range sks {
+		ctnr.Keys[i] = &unencryptedKeys{
+			ValidatorKey:  sk.Marshal(),
+			WithdrawalKey: sk.Marshal(),
+		}
+	}
+	enc, err := json.Marshal(ctnr)
+	if err != nil {
+		return err
+	}
+	keysFile := filepath.Join(dir, keysFileName)
+	if err := ioutil.WriteFile(keysFile, enc, 0600); err != nil {
+		return err
+	}
+	opts, err := json.Marshal(map[string]string{"path": keysFile})
+	if err != nil {
+		return err
+	}
+	i := cfg.PortOffset + int(index)
+	flags := yaml.MapSlice{
+		{Key: "datadir", Value: filepath.Join(dir, "data")},
+		{Key: "beacon-rpc-provider", Value: fmt.Sprintf("127.0.0.1:%d", beaconRPCPort+i)},
+		{Key: "keymanager", Value: "unencrypted"},
+		{Key: "keymanageropts", Value: string(opts)},
+		{Key: "monitoring-port", Value: validatorMonitoringPort + i},
+	}
+	flags = append(flags, presetFlags(cfg.Preset, outputDir)...)
+	return writeConfigFile(filepath.Join(dir, configFileName), flags)
+}
+
+// writeSlasher writes the config file of the slasher of a beacon node. The slasher has no
+// preset flags, so it relies on the chain config file alone.
+func writeSlasher(cfg *networkConfig, outputDir string, index uint64) error {
+	dir := filepath.Join(outputDir, fmt.Sprintf("slasher-%d", index))
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+	i := cfg.PortOffset + int(index)
+	flags := yaml.MapSlice{
+		{Key: "datadir", Value: filepath.Join(dir, "data")},
+		{Key: "beacon-rpc-provider", Value: fmt.Sprintf("127.0.0.1:%d", beaconRPCPort+i)},
+		{Key: "rpc-port", Value: slasherRPCPort + i},
+		{Key: "monitoring-port", Value: slasherMonitoringPort + i},
+		{Key: "chain-config-file", Value: filepath.Join(outputDir, chainConfigFileName)},
+	}
+	return writeConfigFile(filepath.Join(dir, configFileName), flags)
+}
+
+// presetFlags select the preset of the beacon node and validator client, and the chain
+// config file which holds the values of the network over it.
+func presetFlags(preset string, outputDir string) yaml.MapSlice {
+	var flags yaml.MapSlice
+	switch preset {
+	case "minimal":
+		flags = append(flags, yaml.MapItem{Key: "minimal-config", Value: true})
+	case "mainnet":
+		flags = append(flags, yaml.MapItem{Key: "no-custom-config", Value: true})
+	}
+	return append(flags, yaml.MapItem{Key: "chain-config-file", Value: filepath.Join(outputDir, chainConfigFileName)})
+}
+
+func writeConfigFile(fileName string, flags yaml.MapSlice) error {
+	enc, err := yaml.Marshal(flags)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(fileName, enc, 0644)
+}
@@ -0,0 +1,132 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/prysmaticlabs/go-ssz"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"gopkg.in/yaml.v2"
+)
+
+func TestGenerateNetwork(t *testing.T) {
+	defer params.UseMainnetConfig()
+	dir, err := ioutil.TempDir("", "devnet")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	cfg := &networkConfig{
+		OutputDir:     dir,
+		NumValidators: 5,
+		NumNodes:      2,
+		GenesisTime:   1587000000,
+		Preset:        "minimal",
+		HostIP:        "127.0.0.1",
+		Seed:          "test",
+	}
+	if err := generateNetwork(cfg); err != nil {
+		t.Fatal(err)
+	}
+
+	enc, err := ioutil.ReadFile(filepath.Join(dir, genesisStateFileName))
+	if err != nil {
+		t.Fatal(err)
+	}
+	genesisState := &pb.BeaconState{}
+	if err := ssz.Unmarshal(enc, genesisState); err != nil {
+		t.Fatal(err)
+	}
+	if len(genesisState.Validators) != 5 || genesisState.GenesisTime != 1587000000 {
+		t.Errorf("Unexpected genesis state with %d validators at %d", len(genesisState.Validators), genesisState.GenesisTime)
+	}
+
+	enc, err = ioutil.ReadFile(filepath.Join(dir, bootnodesFileName))
+	if err != nil {
+		t.Fatal(err)
+	}
+	enrs := strings.Fields(string(enc))
+	if len(enrs) != 2 {
+		t.Fatalf("Expected 2 bootnodes, received %d", len(enrs))
+	}
+	for _, record := range enrs {
+		if _, err := enode.Parse(enode.ValidSchemes, record); err != nil {
+			t.Errorf("Invalid ENR %s: %v", record, err)
+		}
+	}
+
+	var beaconFlags map[string]interface{}
+	readConfigFile(t, filepath.Join(dir, "beacon-node-0", configFileName), &beaconFlags)
+	if beaconFlags["bootstrap-node"] != enrs[1] {
+		t.Errorf("Expected first beacon node to bootstrap from the second one, received %v", beaconFlags["bootstrap-node"])
+	}
+	if beaconFlags["minimal-config"] != true {
+		t.Error("Expected beacon node to use the minimal preset")
+	}
+
+	// The validators are split across the validator clients in order.
+	for i, wantKeys := range []int{3, 2} {
+		enc, err := ioutil.ReadFile(filepath.Join(dir, fmt.Sprintf("validator-%d", i), keysFileName))
+		if err != nil {
+			t.Fatal(err)
+		}
+		ctnr := &unencryptedKeysContainer{}
+		if err := json.Unmarshal(enc, ctnr); err != nil {
+			t.Fatal(err)
+		}
+		if len(ctnr.Keys) != wantKeys {
+			t.Errorf("Expected %d keys for validator client %d, received %d", wantKeys, i, len(ctnr.Keys))
+		}
+	}
+
+	var slasherFlags map[string]interface{}
+	readConfigFile(t, filepath.Join(dir, "slasher-1", configFileName), &slasherFlags)
+	if slasherFlags["chain-config-file"] != filepath.Join(dir, chainConfigFileName) {
+		t.Errorf("Expected slasher to load the chain config file, received %v", slasherFlags["chain-config-file"])
+	}
+
+	// The p2p keys are derived from the seed.
+	firstKey, err := ioutil.ReadFile(filepath.Join(dir, "beacon-node-0", p2pKeyFileName))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := generateNetwork(cfg); err != nil {
+		t.Fatal(err)
+	}
+	secondKey, err := ioutil.ReadFile(filepath.Join(dir, "beacon-node-0", p2pKeyFileName))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(firstKey) != string(secondKey) {
+		t.Error("Expected the same p2p key to be generated from the same seed")
+	}
+}
+
+func TestGenerateNetwork_TooFewValidators(t *testing.T) {
+	err := generateNetwork(&networkConfig{
+		NumValidators: 1,
+		NumNodes:      2,
+		Preset:        "minimal",
+		HostIP:        "127.0.0.1",
+	})
+	if err == nil || !strings.Contains(err.Error(), "cannot split") {
+		t.Errorf("Expected error splitting validators, received %v", err)
+	}
+}
+
+func readConfigFile(t *testing.T, fileName string, flags interface{}) {
+	enc, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := yaml.Unmarshal(enc, flags); err != nil {
+		t.Fatal(err)
+	}
+}
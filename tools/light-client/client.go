@@ -0,0 +1,100 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/lightclient"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateutil"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// client follows the finalized header of the beacon chain from a trusted block root, using
+// the light client updates served by several beacon nodes.
+//
+// An update is only valid if at least minParticipants members of the sync committee signed
+// its attested header, and if it proves that its finalized header descends from the current
+// trusted block of the client. The client only accepts a finalized header once a quorum of
+// beacon nodes served valid updates for it.
+type client struct {
+	providers       map[string]pbrpc.LightClientClient
+	quorum          int
+	syncCommittee   *lightclient.SyncCommittee
+	minParticipants uint64
+	trustedRoot     [32]byte
+	trustedSlot     uint64
+}
+
+// finalizedSlot returns the slot of the current trusted block of the client.
+func (c *client) finalizedSlot() uint64 {
+	return c.trustedSlot
+}
+
+// sync requests updates from all the providers and advances the finalized header of the
+// client to the most recent finalized header agreed on by a quorum of providers. It returns
+// whether the finalized header was advanced.
+func (c *client) sync(ctx context.Context) (bool, error) {
+	req := &pb.LightClientUpdateRequest{FinalizedSlot: c.finalizedSlot()}
+	votes := make(map[[32]byte]int)
+	headers := make(map[[32]byte]*ethpb.BeaconBlockHeader)
+	for endpt, provider := range c.providers {
+		log := log.WithField("endpoint", endpt)
+		update, err := provider.GetLightClientUpdate(ctx, req)
+		if status.Code(err) == codes.NotFound {
+			log.Debug("No new light client update")
+			continue
+		}
+		if err != nil {
+			log.WithError(err).Warn("Could not request light client update")
+			continue
+		}
+		if err := lightclient.VerifyUpdate(update, c.syncCommittee, c.minParticipants); err != nil {
+			log.WithError(err).Warn("Received invalid light client update")
+			continue
+		}
+		if err := lightclient.VerifyAncestry(update, c.trustedSlot, c.trustedRoot); err != nil {
+			log.WithError(err).Warn("Received light client update not descending from the trusted block")
+			continue
+		}
+		root, err := stateutil.BlockHeaderRoot(update.FinalizedHeader)
+		if err != nil {
+			return false, err
+		}
+		votes[root]++
+		headers[root] = update.FinalizedHeader
+	}
+
+	var best *ethpb.BeaconBlockHeader
+	var bestRoot [32]byte
+	for root, count := range votes {
+		header := headers[root]
+		if count < c.quorum || header.Slot <= c.finalizedSlot() {
+			continue
+		}
+		if best == nil || header.Slot > best.Slot {
+			best = header
+			bestRoot = root
+		}
+	}
+	if best == nil {
+		if len(votes) > 1 {
+			log.WithField("candidates", len(votes)).Warn("Beacon nodes disagree on the finalized header")
+		}
+		return false, nil
+	}
+
+	c.trustedRoot = bestRoot
+	c.trustedSlot = best.Slot
+	log.WithFields(logrus.Fields{
+		"slot":      best.Slot,
+		"root":      fmt.Sprintf("%#x", bestRoot),
+		"stateRoot": fmt.Sprintf("%#x", best.StateRoot),
+		"votes":     votes[bestRoot],
+	}).Info("New finalized header")
+	return true, nil
+}
@@ -0,0 +1,237 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/lightclient"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateutil"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type mockProvider struct {
+	update *pb.LightClientUpdate
+}
+
+func (m *mockProvider) GetLightClientUpdate(
+	_ context.Context, req *pb.LightClientUpdateRequest, _ ...grpc.CallOption,
+) (*pb.LightClientUpdate, error) {
+	if m.update == nil || m.update.FinalizedHeader.Slot <= req.FinalizedSlot {
+		return nil, status.Error(codes.NotFound, "no update")
+	}
+	return m.update, nil
+}
+
+var testKeys = []*bls.SecretKey{bls.RandKey(), bls.RandKey(), bls.RandKey()}
+
+var testCommittee = &lightclient.SyncCommittee{
+	PublicKeys: []*bls.PublicKey{testKeys[0].PublicKey(), testKeys[1].PublicKey(), testKeys[2].PublicKey()},
+}
+
+// This builds a valid update finalizing a header at the given slot, the attested state having
+// the given root as block root of the trusted slot. The update is signed by the first two
+// members of the test sync committee.
+func testUpdate(t *testing.T, finalizedSlot uint64, trustedSlot uint64, trustedRoot [32]byte) *pb.LightClientUpdate {
+	ctx := context.Background()
+	finalizedHeader := &ethpb.BeaconBlockHeader{
+		Slot:       finalizedSlot,
+		ParentRoot: make([]byte, 32),
+		StateRoot:  make([]byte, 32),
+		BodyRoot:   make([]byte, 32),
+	}
+	finalizedRoot, err := stateutil.BlockHeaderRoot(finalizedHeader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	finalizedEpoch := finalizedSlot / params.BeaconConfig().SlotsPerEpoch
+
+	attestedState, _ := testutil.DeterministicGenesisState(t, 16)
+	if err := attestedState.SetSlot(finalizedSlot + 64); err != nil {
+		t.Fatal(err)
+	}
+	if err := attestedState.SetFinalizedCheckpoint(&ethpb.Checkpoint{Epoch: finalizedEpoch, Root: finalizedRoot[:]}); err != nil {
+		t.Fatal(err)
+	}
+	trustedIndex := trustedSlot % params.BeaconConfig().SlotsPerHistoricalRoot
+	if err := attestedState.UpdateBlockRootAtIndex(trustedIndex, trustedRoot); err != nil {
+		t.Fatal(err)
+	}
+	finalityProof, err := attestedState.FieldProof(ctx, "finalized_checkpoint")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ancestryProof, err := attestedState.ElementProof(ctx, "block_roots", trustedIndex)
+	if err != nil {
+		t.Fatal(err)
+	}
+	epochRoot := stateutil.Uint64Root(finalizedEpoch)
+	update := &pb.LightClientUpdate{
+		AttestedHeader: &ethpb.BeaconBlockHeader{
+			Slot:       finalizedSlot + 64,
+			ParentRoot: make([]byte, 32),
+			StateRoot:  finalityProof.StateRoot[:],
+			BodyRoot:   make([]byte, 32),
+		},
+		FinalizedHeader: finalizedHeader,
+		FinalityBranch:  append([][]byte{epochRoot[:]}, finalityProof.Branch...),
+		AncestryBranch:  ancestryProof.Branch,
+	}
+	for _, key := range testKeys[:2] {
+		if err := testCommittee.SignUpdate(update, key); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return update
+}
+
+var trustedRoot = [32]byte{'t'}
+
+func TestClient_Sync(t *testing.T) {
+	update := testUpdate(t, 32, 0, trustedRoot)
+	c := &client{
+		providers: map[string]pbrpc.LightClientClient{
+			"a": &mockProvider{update: update},
+			"b": &mockProvider{update: update},
+		},
+		quorum:          2,
+		syncCommittee:   testCommittee,
+		minParticipants: 2,
+		trustedRoot:     trustedRoot,
+	}
+	advanced, err := c.sync(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !advanced || c.finalizedSlot() != 32 {
+		t.Errorf("Expected finalized slot 32, received %d", c.finalizedSlot())
+	}
+	finalizedRoot, err := stateutil.BlockHeaderRoot(update.FinalizedHeader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.trustedRoot != finalizedRoot {
+		t.Errorf("Expected the finalized header to be trusted, received root %#x", c.trustedRoot)
+	}
+
+	// The same update does not advance the client again.
+	advanced, err = c.sync(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if advanced {
+		t.Error("Expected the client not to advance on a known update")
+	}
+}
+
+func TestClient_Sync_NoQuorum(t *testing.T) {
+	c := &client{
+		providers: map[string]pbrpc.LightClientClient{
+			"a": &mockProvider{update: testUpdate(t, 32, 0, trustedRoot)},
+			"b": &mockProvider{update: testUpdate(t, 64, 0, trustedRoot)},
+		},
+		quorum:          2,
+		syncCommittee:   testCommittee,
+		minParticipants: 2,
+		trustedRoot:     trustedRoot,
+	}
+	advanced, err := c.sync(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if advanced || c.finalizedSlot() != 0 {
+		t.Error("Expected the client not to advance without a quorum")
+	}
+}
+
+func TestClient_Sync_InvalidUpdate(t *testing.T) {
+	invalid := testUpdate(t, 32, 0, trustedRoot)
+	invalid.FinalityBranch[0] = make([]byte, 32)
+	c := &client{
+		providers: map[string]pbrpc.LightClientClient{
+			"a": &mockProvider{update: testUpdate(t, 32, 0, trustedRoot)},
+			"b": &mockProvider{update: invalid},
+		},
+		quorum:          2,
+		syncCommittee:   testCommittee,
+		minParticipants: 2,
+		trustedRoot:     trustedRoot,
+	}
+	advanced, err := c.sync(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if advanced {
+		t.Error("Expected invalid updates not to count towards the quorum")
+	}
+}
+
+func TestClient_Sync_NotEnoughSignatures(t *testing.T) {
+	update := testUpdate(t, 32, 0, trustedRoot)
+	update.SyncCommitteeBits = nil
+	update.SyncCommitteeSignature = nil
+	if err := testCommittee.SignUpdate(update, testKeys[2]); err != nil {
+		t.Fatal(err)
+	}
+	c := &client{
+		providers: map[string]pbrpc.LightClientClient{
+			"a": &mockProvider{update: update},
+			"b": &mockProvider{update: update},
+		},
+		quorum:          2,
+		syncCommittee:   testCommittee,
+		minParticipants: 2,
+		trustedRoot:     trustedRoot,
+	}
+	advanced, err := c.sync(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if advanced {
+		t.Error("Expected updates signed by too few sync committee members to be rejected")
+	}
+}
+
+func TestClient_Sync_ConflictingChain(t *testing.T) {
+	// The beacon nodes agree on a chain which does not include the trusted block.
+	conflicting := testUpdate(t, 64, 16, [32]byte{'c'})
+	c := &client{
+		providers: map[string]pbrpc.LightClientClient{
+			"a": &mockProvider{update: conflicting},
+			"b": &mockProvider{update: conflicting},
+		},
+		quorum:          2,
+		syncCommittee:   testCommittee,
+		minParticipants: 2,
+		trustedRoot:     trustedRoot,
+		trustedSlot:     16,
+	}
+	if err := lightclient.VerifyUpdate(conflicting, testCommittee, 2); err != nil {
+		t.Fatalf("Expected the conflicting update to have a valid finality branch: %v", err)
+	}
+	advanced, err := c.sync(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if advanced || c.trustedRoot != trustedRoot || c.finalizedSlot() != 16 {
+		t.Error("Expected the client to reject a chain not descending from the trusted block")
+	}
+
+	// An update of the chain of the trusted block is accepted.
+	c.providers["a"] = &mockProvider{update: testUpdate(t, 64, 16, trustedRoot)}
+	c.providers["b"] = c.providers["a"]
+	advanced, err = c.sync(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !advanced || c.finalizedSlot() != 64 {
+		t.Errorf("Expected finalized slot 64, received %d", c.finalizedSlot())
+	}
+}
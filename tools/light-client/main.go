@@ -0,0 +1,98 @@
+/**
+ * Light client
+ *
+ * A reference light client which follows the finalized header of the beacon chain from a
+ * trusted block root, such as a recent finalized checkpoint root. It polls the light client
+ * updates served over gRPC by beacon nodes running with --light-client-server, verifies their
+ * sync committee signatures, finality and ancestry proofs and accepts a finalized header once
+ * a quorum of beacon nodes agree on it.
+ *
+ * Example: light-client --trusted-root 0x... --trusted-slot 320 --sync-committee committee.txt --min-participants 2 --endpoint 127.0.0.1:4000 --endpoint 127.0.0.1:4001 --quorum 2
+ */
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"flag"
+	"strings"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/lightclient"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+)
+
+var log = logrus.WithField("prefix", "light_client")
+
+type endpoint []string
+
+func (e *endpoint) String() string {
+	return "gRPC endpoints"
+}
+
+func (e *endpoint) Set(value string) error {
+	*e = append(*e, value)
+	return nil
+}
+
+func main() {
+	var endpts endpoint
+	flag.Var(&endpts, "endpoint", "Specify gRPC end points of beacon nodes serving light client updates")
+	quorum := flag.Int("quorum", 0, "Number of beacon nodes which must agree on a finalized header, defaults to all end points")
+	trustedRoot := flag.String("trusted-root", "", "Hex encoded root of the trusted block to follow finality from, such as a recent finalized checkpoint root")
+	trustedSlot := flag.Uint64("trusted-slot", 0, "Slot of the trusted block")
+	syncCommitteeFile := flag.String("sync-committee", "", "File holding the hex encoded public keys of the sync committee signing the updates, one per line")
+	minParticipants := flag.Uint64("min-participants", 1, "Number of sync committee members which must sign an update")
+	minimalConfig := flag.Bool("minimal-config", false, "Use the minimal beacon chain config")
+	flag.Parse()
+
+	if len(endpts) == 0 {
+		log.Fatal("At least one end point is required")
+	}
+	root, err := hex.DecodeString(strings.TrimPrefix(*trustedRoot, "0x"))
+	if err != nil || len(root) != 32 {
+		log.Fatal("A 32 byte hex encoded trusted block root is required")
+	}
+	if *syncCommitteeFile == "" {
+		log.Fatal("A sync committee file is required")
+	}
+	committee, err := lightclient.LoadSyncCommittee(*syncCommitteeFile)
+	if err != nil {
+		log.Fatalf("Could not load sync committee: %v", err)
+	}
+	if *quorum <= 0 || *quorum > len(endpts) {
+		*quorum = len(endpts)
+	}
+	if *minimalConfig {
+		params.UseMinimalConfig()
+	}
+
+	c := &client{
+		providers:       make(map[string]pbrpc.LightClientClient),
+		quorum:          *quorum,
+		syncCommittee:   committee,
+		minParticipants: *minParticipants,
+		trustedRoot:     bytesutil.ToBytes32(root),
+		trustedSlot:     *trustedSlot,
+	}
+	for _, endpt := range endpts {
+		conn, err := grpc.Dial(endpt, grpc.WithInsecure())
+		if err != nil {
+			log.Fatalf("fail to dial: %v", err)
+		}
+		c.providers[endpt] = pbrpc.NewLightClientClient(conn)
+	}
+
+	// New updates can only be served once per epoch, when the chain finalizes a new checkpoint.
+	ticker := time.NewTicker(time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second)
+	defer ticker.Stop()
+	for range ticker.C {
+		if _, err := c.sync(context.Background()); err != nil {
+			log.WithError(err).Error("Could not sync light client")
+		}
+	}
+}
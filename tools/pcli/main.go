@@ -0,0 +1,232 @@
+/**
+ * Prysm command line interface for debugging the state transition
+ *
+ * The state-transition command applies one or more SSZ encoded signed blocks to an SSZ encoded
+ * pre-state and writes the post-state. When a block fails to apply, the block is replayed one
+ * operation at a time to report the failing operation along with the changes made by the previous
+ * operations of the block to the state fields it uses.
+ *
+ * Example: pcli state-transition --pre-state-path pre.ssz --block-path block_1.ssz --block-path block_2.ssz \
+ *              --post-state-path post.ssz
+ *
+ * The pretty command prints any SSZ encoded beacon object as JSON or YAML.
+ *
+ * Example: pcli pretty --type signed_block --format yaml block_1.ssz
+ */
+package main
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/version"
+	"github.com/sirupsen/logrus"
+	prefixed "github.com/x-cray/logrus-prefixed-formatter"
+	"gopkg.in/urfave/cli.v2"
+)
+
+var log = logrus.WithField("prefix", "pcli")
+
+var minimalConfigFlag = &cli.BoolFlag{
+	Name:  "minimal-config",
+	Usage: "Use the minimal beacon chain config",
+}
+
+func main() {
+	customFormatter := new(prefixed.TextFormatter)
+	customFormatter.TimestampFormat = "2006-01-02 15:04:05"
+	customFormatter.FullTimestamp = true
+	logrus.SetFormatter(customFormatter)
+
+	app := cli.App{}
+	app.Name = "pcli"
+	app.Usage = "A command line utility to run the beacon state transition and inspect SSZ beacon objects"
+	app.Version = version.GetVersion()
+	app.Commands = []*cli.Command{
+		{
+			Name:  "state-transition",
+			Usage: "Applies signed blocks to a pre-state and writes the post-state",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "pre-state-path",
+					Usage: "Path to the SSZ encoded pre-state",
+				},
+				&cli.StringSliceFlag{
+					Name:  "block-path",
+					Usage: "Path to a SSZ encoded signed block, blocks are applied in the order they are given",
+				},
+				&cli.Uint64Flag{
+					Name:  "slot",
+					Usage: "Process empty slots up to this slot after applying the blocks. Without blocks, only the slots are processed",
+				},
+				&cli.StringFlag{
+					Name:  "post-state-path",
+					Usage: "Path to write the SSZ encoded post-state to",
+				},
+				&cli.StringFlag{
+					Name:  "expected-post-state-path",
+					Usage: "Path to an SSZ encoded post-state to compare the resulting post-state against",
+				},
+				minimalConfigFlag,
+			},
+			Action: stateTransition,
+		},
+		{
+			Name:      "pretty",
+			Usage:     "Prints a SSZ encoded beacon object as JSON or YAML",
+			ArgsUsage: "<ssz file>",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "type",
+					Usage: fmt.Sprintf("Type of the SSZ encoded object, one of %v", sszTypeNames()),
+				},
+				&cli.StringFlag{
+					Name:  "format",
+					Usage: "Output format, json or yaml",
+					Value: "json",
+				},
+				minimalConfigFlag,
+			},
+			Action: pretty,
+		},
+	}
+
+	if err := app.Run(os.Args); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func stateTransition(cliCtx *cli.Context) error {
+	if cliCtx.Bool(minimalConfigFlag.Name) {
+		params.UseMinimalConfig()
+	}
+	if !cliCtx.IsSet("pre-state-path") {
+		return errors.New("--pre-state-path is required")
+	}
+	ctx := context.Background()
+	st, err := readState(cliCtx.String("pre-state-path"))
+	if err != nil {
+		return err
+	}
+	for _, path := range cliCtx.StringSlice("block-path") {
+		blk := &ethpb.SignedBeaconBlock{}
+		if err := readSSZ(path, blk); err != nil {
+			return err
+		}
+		if blk.Block == nil {
+			return fmt.Errorf("block in %s is nil", path)
+		}
+		postState, err := state.ExecuteStateTransition(ctx, st.Copy(), blk)
+		if err != nil {
+			reportFailure(ctx, st, blk, path, err)
+			return fmt.Errorf("block in %s failed to apply", path)
+		}
+		log.WithField("slot", blk.Block.Slot).Infof("Applied block %s", path)
+		st = postState
+	}
+	if cliCtx.IsSet("slot") {
+		st, err = state.ProcessSlots(ctx, st, cliCtx.Uint64("slot"))
+		if err != nil {
+			return errors.Wrap(err, "could not process slots")
+		}
+		log.WithField("slot", st.Slot()).Info("Processed slots")
+	}
+
+	root, err := st.HashTreeRoot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not compute post-state root")
+	}
+	log.WithField("slot", st.Slot()).Infof("Post-state root %#x", root)
+
+	if outPath := cliCtx.String("post-state-path"); outPath != "" {
+		enc, err := ssz.Marshal(st.InnerStateUnsafe())
+		if err != nil {
+			return errors.Wrap(err, "could not marshal post-state")
+		}
+		if err := ioutil.WriteFile(outPath, enc, 0644); err != nil {
+			return errors.Wrap(err, "could not write post-state")
+		}
+		log.Infof("Wrote post-state to %s", outPath)
+	}
+
+	if expectedPath := cliCtx.String("expected-post-state-path"); expectedPath != "" {
+		expected := &pb.BeaconState{}
+		if err := readSSZ(expectedPath, expected); err != nil {
+			return err
+		}
+		if diff := stateFieldsDiff(expected, st.InnerStateUnsafe(), nil); diff != "" {
+			fmt.Println(diff)
+			return errors.New("post-state does not match the expected post-state")
+		}
+		log.Info("Post-state matches the expected post-state")
+	}
+	return nil
+}
+
+// reportFailure prints the operation the block failed at, with the changes the previous
+// operations of the block made to the state fields used by that operation.
+func reportFailure(ctx context.Context, preState *stateTrie.BeaconState, blk *ethpb.SignedBeaconBlock, path string, err error) {
+	log.WithError(err).Errorf("State transition failed for block %s at slot %d", path, blk.Block.Slot)
+	failure := diagnoseBlock(ctx, preState, blk)
+	if failure == nil {
+		log.Error("Every block operation applied, the block fails the operation lengths or state root checks")
+		return
+	}
+	log.WithError(failure.err).Errorf("Failed operation: %s", failure.operation)
+	if failure.diff == "" {
+		log.Info("The relevant state fields were not changed by the previous operations of the block")
+		return
+	}
+	fmt.Printf("Changes to the relevant state fields by the previous operations of the block:\n%s\n", failure.diff)
+}
+
+func pretty(cliCtx *cli.Context) error {
+	if cliCtx.Bool(minimalConfigFlag.Name) {
+		params.UseMinimalConfig()
+	}
+	if cliCtx.NArg() != 1 {
+		return errors.New("expected the path of a single SSZ file")
+	}
+	data, err := ioutil.ReadFile(cliCtx.Args().First())
+	if err != nil {
+		return errors.Wrap(err, "could not read file")
+	}
+	obj, err := decodeSSZ(cliCtx.String("type"), data)
+	if err != nil {
+		return err
+	}
+	out, err := prettyPrint(obj, cliCtx.String("format"))
+	if err != nil {
+		return err
+	}
+	fmt.Print(string(out))
+	return nil
+}
+
+func readState(path string) (*stateTrie.BeaconState, error) {
+	st := &pb.BeaconState{}
+	if err := readSSZ(path, st); err != nil {
+		return nil, err
+	}
+	return stateTrie.InitializeFromProto(st)
+}
+
+func readSSZ(path string, obj interface{}) error {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return errors.Wrapf(err, "could not read %s", path)
+	}
+	if err := ssz.Unmarshal(data, obj); err != nil {
+		return errors.Wrapf(err, "could not unmarshal %s", path)
+	}
+	return nil
+}
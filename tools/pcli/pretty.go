@@ -0,0 +1,80 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/ghodss/yaml"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+// sszTypes maps the names accepted by the pretty command to the beacon objects they decode into.
+var sszTypes = map[string]func() proto.Message{
+	"attestation":           func() proto.Message { return &ethpb.Attestation{} },
+	"attestation_data":      func() proto.Message { return &ethpb.AttestationData{} },
+	"attester_slashing":     func() proto.Message { return &ethpb.AttesterSlashing{} },
+	"block":                 func() proto.Message { return &ethpb.BeaconBlock{} },
+	"block_body":            func() proto.Message { return &ethpb.BeaconBlockBody{} },
+	"block_header":          func() proto.Message { return &ethpb.BeaconBlockHeader{} },
+	"checkpoint":            func() proto.Message { return &ethpb.Checkpoint{} },
+	"deposit":               func() proto.Message { return &ethpb.Deposit{} },
+	"deposit_data":          func() proto.Message { return &ethpb.Deposit_Data{} },
+	"eth1_data":             func() proto.Message { return &ethpb.Eth1Data{} },
+	"fork":                  func() proto.Message { return &pb.Fork{} },
+	"historical_batch":      func() proto.Message { return &pb.HistoricalBatch{} },
+	"indexed_attestation":   func() proto.Message { return &ethpb.IndexedAttestation{} },
+	"pending_attestation":   func() proto.Message { return &pb.PendingAttestation{} },
+	"proposer_slashing":     func() proto.Message { return &ethpb.ProposerSlashing{} },
+	"signed_block":          func() proto.Message { return &ethpb.SignedBeaconBlock{} },
+	"signed_block_header":   func() proto.Message { return &ethpb.SignedBeaconBlockHeader{} },
+	"signed_voluntary_exit": func() proto.Message { return &ethpb.SignedVoluntaryExit{} },
+	"state":                 func() proto.Message { return &pb.BeaconState{} },
+	"validator":             func() proto.Message { return &ethpb.Validator{} },
+	"voluntary_exit":        func() proto.Message { return &ethpb.VoluntaryExit{} },
+}
+
+// sszTypeNames returns the sorted names of the supported SSZ types.
+func sszTypeNames() []string {
+	names := make([]string, 0, len(sszTypes))
+	for name := range sszTypes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// decodeSSZ decodes SSZ encoded data into a new object of the named type.
+func decodeSSZ(typeName string, data []byte) (proto.Message, error) {
+	newObj, ok := sszTypes[typeName]
+	if !ok {
+		return nil, fmt.Errorf("unknown ssz type %q, expected one of %v", typeName, sszTypeNames())
+	}
+	obj := newObj()
+	if err := ssz.Unmarshal(data, obj); err != nil {
+		return nil, errors.Wrapf(err, "could not unmarshal ssz data into %s", typeName)
+	}
+	return obj, nil
+}
+
+// prettyPrint encodes a beacon object as indented JSON or as YAML.
+func prettyPrint(obj proto.Message, format string) ([]byte, error) {
+	m := &jsonpb.Marshaler{Indent: "  ", OrigName: true, EmitDefaults: true}
+	buf := new(bytes.Buffer)
+	if err := m.Marshal(buf, obj); err != nil {
+		return nil, errors.Wrap(err, "could not marshal object to json")
+	}
+	switch format {
+	case "json":
+		return append(buf.Bytes(), '\n'), nil
+	case "yaml":
+		return yaml.JSONToYAML(buf.Bytes())
+	default:
+		return nil, fmt.Errorf("unsupported output format %q, expected json or yaml", format)
+	}
+}
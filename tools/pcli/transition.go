@@ -0,0 +1,146 @@
+package main
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"gopkg.in/d4l3k/messagediff.v1"
+)
+
+// blockOperation is a single step of the per block state transition, along with the
+// beacon state fields it reads and writes.
+type blockOperation struct {
+	name   string
+	fields []string
+	apply  func(context.Context, *stateTrie.BeaconState, *ethpb.SignedBeaconBlock) (*stateTrie.BeaconState, error)
+}
+
+// blockOperations lists the steps of state.ProcessBlock in the order they are applied.
+var blockOperations = []blockOperation{
+	{
+		name:   "block header",
+		fields: []string{"Slot", "LatestBlockHeader", "Validators"},
+		apply: func(_ context.Context, s *stateTrie.BeaconState, b *ethpb.SignedBeaconBlock) (*stateTrie.BeaconState, error) {
+			return blocks.ProcessBlockHeader(s, b)
+		},
+	},
+	{
+		name:   "randao",
+		fields: []string{"Slot", "RandaoMixes", "Validators"},
+		apply: func(_ context.Context, s *stateTrie.BeaconState, b *ethpb.SignedBeaconBlock) (*stateTrie.BeaconState, error) {
+			return blocks.ProcessRandao(s, b.Block.Body)
+		},
+	},
+	{
+		name:   "eth1 data",
+		fields: []string{"Eth1Data", "Eth1DataVotes"},
+		apply: func(_ context.Context, s *stateTrie.BeaconState, b *ethpb.SignedBeaconBlock) (*stateTrie.BeaconState, error) {
+			return blocks.ProcessEth1DataInBlock(s, b.Block)
+		},
+	},
+	{
+		name:   "proposer slashings",
+		fields: []string{"Validators", "Balances", "Slashings"},
+		apply: func(ctx context.Context, s *stateTrie.BeaconState, b *ethpb.SignedBeaconBlock) (*stateTrie.BeaconState, error) {
+			return blocks.ProcessProposerSlashings(ctx, s, b.Block.Body)
+		},
+	},
+	{
+		name:   "attester slashings",
+		fields: []string{"Validators", "Balances", "Slashings"},
+		apply: func(ctx context.Context, s *stateTrie.BeaconState, b *ethpb.SignedBeaconBlock) (*stateTrie.BeaconState, error) {
+			return blocks.ProcessAttesterSlashings(ctx, s, b.Block.Body)
+		},
+	},
+	{
+		name: "attestations",
+		fields: []string{
+			"CurrentJustifiedCheckpoint",
+			"PreviousJustifiedCheckpoint",
+			"CurrentEpochAttestations",
+			"PreviousEpochAttestations",
+		},
+		apply: func(ctx context.Context, s *stateTrie.BeaconState, b *ethpb.SignedBeaconBlock) (*stateTrie.BeaconState, error) {
+			return blocks.ProcessAttestations(ctx, s, b.Block.Body)
+		},
+	},
+	{
+		name:   "deposits",
+		fields: []string{"Eth1Data", "Eth1DepositIndex", "Validators", "Balances"},
+		apply: func(ctx context.Context, s *stateTrie.BeaconState, b *ethpb.SignedBeaconBlock) (*stateTrie.BeaconState, error) {
+			return blocks.ProcessDeposits(ctx, s, b.Block.Body)
+		},
+	},
+	{
+		name:   "voluntary exits",
+		fields: []string{"Validators"},
+		apply: func(ctx context.Context, s *stateTrie.BeaconState, b *ethpb.SignedBeaconBlock) (*stateTrie.BeaconState, error) {
+			return blocks.ProcessVoluntaryExits(ctx, s, b.Block.Body)
+		},
+	},
+}
+
+// transitionFailure describes the step of the state transition a block failed at.
+type transitionFailure struct {
+	operation string
+	err       error
+	// diff holds the changes made by the previous operations of the block to the
+	// state fields used by the failing operation.
+	diff string
+}
+
+// diagnoseBlock replays the state transition of the block one operation at a time on a copy
+// of the pre-state, and returns the operation the block fails at. It returns nil when every
+// operation succeeds, in which case the failure lies in the operation lengths or state root checks.
+func diagnoseBlock(ctx context.Context, preState *stateTrie.BeaconState, signed *ethpb.SignedBeaconBlock) *transitionFailure {
+	if signed == nil || signed.Block == nil || signed.Block.Body == nil {
+		return &transitionFailure{operation: "block", err: errors.New("nil block")}
+	}
+	st, err := state.ProcessSlots(ctx, preState.Copy(), signed.Block.Slot)
+	if err != nil {
+		return &transitionFailure{operation: "process slots", err: err}
+	}
+	start := st.Copy()
+	for _, op := range blockOperations {
+		before := st.Copy()
+		st, err = op.apply(ctx, st, signed)
+		if err != nil {
+			return &transitionFailure{
+				operation: op.name,
+				err:       err,
+				diff:      stateFieldsDiff(start.InnerStateUnsafe(), before.InnerStateUnsafe(), op.fields),
+			}
+		}
+	}
+	return nil
+}
+
+// stateFieldsDiff returns a readable diff of the given beacon state fields between two states.
+// An empty fields list compares the entire states.
+func stateFieldsDiff(a, b *pb.BeaconState, fields []string) string {
+	if len(fields) > 0 {
+		a, b = selectFields(a, fields), selectFields(b, fields)
+	}
+	diff, equal := messagediff.PrettyDiff(a, b)
+	if equal {
+		return ""
+	}
+	return diff
+}
+
+// selectFields copies the given top level fields of the state into an otherwise empty state.
+func selectFields(st *pb.BeaconState, fields []string) *pb.BeaconState {
+	selected := &pb.BeaconState{}
+	src := reflect.ValueOf(st).Elem()
+	dst := reflect.ValueOf(selected).Elem()
+	for _, f := range fields {
+		dst.FieldByName(f).Set(src.FieldByName(f))
+	}
+	return selected
+}
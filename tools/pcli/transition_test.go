@@ -0,0 +1,113 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+func TestDiagnoseBlock_ValidBlock(t *testing.T) {
+	beaconState, privKeys := testutil.DeterministicGenesisState(t, 64)
+	blk, err := testutil.GenerateFullBlock(beaconState, privKeys, testutil.DefaultBlockGenConfig(), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if failure := diagnoseBlock(context.Background(), beaconState, blk); failure != nil {
+		t.Errorf("Expected valid block to apply, failed at %s: %v", failure.operation, failure.err)
+	}
+}
+
+func TestDiagnoseBlock_ReportsFailingOperation(t *testing.T) {
+	beaconState, privKeys := testutil.DeterministicGenesisState(t, 64)
+	blk, err := testutil.GenerateFullBlock(beaconState, privKeys, testutil.DefaultBlockGenConfig(), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	blk.Block.Body.Attestations[0].Data.Source.Epoch = 1
+
+	// The block signature no longer matches the modified block.
+	failure := diagnoseBlock(context.Background(), beaconState, blk)
+	if failure == nil || failure.operation != "block header" {
+		t.Fatalf("Expected failure at block header, received %v", failure)
+	}
+
+	signBlock(t, beaconState, blk, privKeys)
+	failure = diagnoseBlock(context.Background(), beaconState, blk)
+	if failure == nil || failure.operation != "attestations" {
+		t.Fatalf("Expected failure at attestations, received %v", failure)
+	}
+	if !strings.Contains(failure.err.Error(), "expected source epoch") {
+		t.Errorf("Unexpected error %v", failure.err)
+	}
+}
+
+func signBlock(t *testing.T, beaconState *stateTrie.BeaconState, blk *ethpb.SignedBeaconBlock, privKeys []*bls.SecretKey) {
+	st := beaconState.Copy()
+	if err := st.SetSlot(blk.Block.Slot); err != nil {
+		t.Fatal(err)
+	}
+	proposerIdx, err := helpers.BeaconProposerIndex(st)
+	if err != nil {
+		t.Fatal(err)
+	}
+	domain, err := helpers.Domain(st.Fork(), helpers.CurrentEpoch(st), params.BeaconConfig().DomainBeaconProposer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	root, err := ssz.HashTreeRoot(blk.Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	blk.Signature = privKeys[proposerIdx].Sign(root[:], domain).Marshal()
+}
+
+func TestStateFieldsDiff(t *testing.T) {
+	beaconState, _ := testutil.DeterministicGenesisState(t, 8)
+	a := beaconState.CloneInnerState()
+	b := beaconState.CloneInnerState()
+	if diff := stateFieldsDiff(a, b, nil); diff != "" {
+		t.Errorf("Expected no diff between equal states, received %s", diff)
+	}
+	b.Slot = 5
+	b.Balances[3] = 1
+	diff := stateFieldsDiff(a, b, []string{"Balances"})
+	if !strings.Contains(diff, "Balances[3]") {
+		t.Errorf("Expected balance diff, received %s", diff)
+	}
+	if strings.Contains(diff, "Slot") {
+		t.Errorf("Expected diff to only contain selected fields, received %s", diff)
+	}
+}
+
+func TestPrettyPrint(t *testing.T) {
+	checkpoint := &ethpb.Checkpoint{Epoch: 7, Root: make([]byte, 32)}
+	enc, err := ssz.Marshal(checkpoint)
+	if err != nil {
+		t.Fatal(err)
+	}
+	obj, err := decodeSSZ("checkpoint", enc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := prettyPrint(obj, "yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(out), `epoch: "7"`) {
+		t.Errorf("Unexpected yaml output %s", out)
+	}
+	if _, err := decodeSSZ("unknown", enc); err == nil {
+		t.Error("Expected error decoding unknown type")
+	}
+	if _, err := prettyPrint(obj, "xml"); err == nil {
+		t.Error("Expected error with unsupported format")
+	}
+}
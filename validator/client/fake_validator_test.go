@@ -17,6 +17,7 @@ type fakeValidator struct {
 	NextSlotCalled                   bool
 	CanonicalHeadSlotCalled          bool
 	UpdateDutiesCalled               bool
+	ReloadKeysCalled                 bool
 	RoleAtCalled                     bool
 	AttestToBlockHeadCalled          bool
 	ProposeBlockCalled               bool
@@ -66,6 +67,11 @@ func (fv *fakeValidator) NextSlot() <-chan uint64 {
 	return fv.NextSlotRet
 }
 
+func (fv *fakeValidator) ReloadKeys(_ context.Context) error {
+	fv.ReloadKeysCalled = true
+	return nil
+}
+
 func (fv *fakeValidator) UpdateDuties(_ context.Context, slot uint64) error {
 	fv.UpdateDutiesCalled = true
 	fv.UpdateDutiesArg1 = slot
@@ -99,4 +105,8 @@ func (fv *fakeValidator) SubmitAggregateAndProof(_ context.Context, slot uint64,
 
 func (fv *fakeValidator) LogAttestationsSubmitted() {}
 
+func (fv *fakeValidator) CheckAttestationInclusions(_ context.Context, _ uint64) error {
+	return nil
+}
+
 func (fv *fakeValidator) UpdateDomainDataCaches(context.Context, uint64) {}
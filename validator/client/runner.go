@@ -25,12 +25,14 @@ type Validator interface {
 	NextSlot() <-chan uint64
 	SlotDeadline(slot uint64) time.Time
 	LogValidatorGainsAndLosses(ctx context.Context, slot uint64) error
+	ReloadKeys(ctx context.Context) error
 	UpdateDuties(ctx context.Context, slot uint64) error
 	RolesAt(ctx context.Context, slot uint64) (map[[48]byte][]pb.ValidatorRole, error) // validator pubKey -> roles
 	SubmitAttestation(ctx context.Context, slot uint64, pubKey [48]byte)
 	ProposeBlock(ctx context.Context, slot uint64, pubKey [48]byte)
 	SubmitAggregateAndProof(ctx context.Context, slot uint64, pubKey [48]byte)
 	LogAttestationsSubmitted()
+	CheckAttestationInclusions(ctx context.Context, slot uint64) error
 	UpdateDomainDataCaches(ctx context.Context, slot uint64)
 }
 
@@ -80,6 +82,20 @@ func run(ctx context.Context, v Validator) {
 				log.WithError(err).Error("Could not report validator's rewards/penalties")
 			}
 
+			// Check the inclusion of the attestations submitted in the previous slots, in the
+			// background so that the duties of the slot are not delayed.
+			go func() {
+				if err := v.CheckAttestationInclusions(slotCtx, slot); err != nil {
+					log.WithError(err).Error("Could not check the inclusion of submitted attestations")
+				}
+			}()
+
+			// Pick up the keys added to or removed from the key source, the duties are
+			// refreshed below if they changed.
+			if err := v.ReloadKeys(ctx); err != nil {
+				log.WithError(err).Error("Could not reload validating keys")
+			}
+
 			// Keep trying to update assignments if they are nil or if we are past an
 			// epoch transition in the beacon node's state.
 			if err := v.UpdateDuties(ctx, slot); err != nil {
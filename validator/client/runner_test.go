@@ -64,6 +64,25 @@ func TestUpdateDuties_NextSlot(t *testing.T) {
 	}
 }
 
+func TestReloadKeys_NextSlot(t *testing.T) {
+	v := &fakeValidator{}
+	ctx, cancel := context.WithCancel(context.Background())
+
+	ticker := make(chan uint64)
+	v.NextSlotRet = ticker
+	go func() {
+		ticker <- 55
+
+		cancel()
+	}()
+
+	run(ctx, v)
+
+	if !v.ReloadKeysCalled {
+		t.Error("Expected ReloadKeys() to be called")
+	}
+}
+
 func TestUpdateDuties_HandlesError(t *testing.T) {
 	hook := logTest.NewGlobal()
 	v := &fakeValidator{}
@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"strings"
+	"sync"
 
 	"github.com/dgraph-io/ristretto"
 	middleware "github.com/grpc-ecosystem/go-grpc-middleware"
@@ -11,7 +12,10 @@ import (
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/slottiming"
 	"github.com/prysmaticlabs/prysm/validator/db"
+	"github.com/prysmaticlabs/prysm/validator/graffiti"
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/plugin/ocgrpc"
@@ -29,11 +33,15 @@ type ValidatorService struct {
 	cancel               context.CancelFunc
 	validator            Validator
 	graffiti             []byte
+	graffitiSource       *graffiti.Source
+	lock                 sync.RWMutex
 	conn                 *grpc.ClientConn
+	db                   *db.Store
 	endpoint             string
 	withCert             string
 	dataDir              string
 	keyManager           keymanager.KeyManager
+	disabledKeys         *disabledKeys
 	logValidatorBalances bool
 	emitAccountMetrics   bool
 	maxCallRecvMsgSize   int
@@ -47,6 +55,7 @@ type Config struct {
 	DataDir                    string
 	CertFlag                   string
 	GraffitiFlag               string
+	GraffitiFileFlag           string
 	KeyManager                 keymanager.KeyManager
 	LogValidatorBalances       bool
 	EmitAccountMetrics         bool
@@ -58,6 +67,14 @@ type Config struct {
 // NewValidatorService creates a new validator service for the service
 // registry.
 func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, error) {
+	var graffitiSource *graffiti.Source
+	if cfg.GraffitiFileFlag != "" {
+		var err error
+		graffitiSource, err = graffiti.NewSource(cfg.GraffitiFileFlag, []byte(cfg.GraffitiFlag))
+		if err != nil {
+			return nil, errors.Wrap(err, "could not load graffiti file")
+		}
+	}
 	ctx, cancel := context.WithCancel(ctx)
 	return &ValidatorService{
 		ctx:                  ctx,
@@ -66,7 +83,9 @@ func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, e
 		withCert:             cfg.CertFlag,
 		dataDir:              cfg.DataDir,
 		graffiti:             []byte(cfg.GraffitiFlag),
+		graffitiSource:       graffitiSource,
 		keyManager:           cfg.KeyManager,
+		disabledKeys:         newDisabledKeys(),
 		logValidatorBalances: cfg.LogValidatorBalances,
 		emitAccountMetrics:   cfg.EmitAccountMetrics,
 		maxCallRecvMsgSize:   cfg.GrpcMaxCallRecvMsgSizeFlag,
@@ -129,6 +148,7 @@ func (v *ValidatorService) Start() {
 			grpc_prometheus.UnaryClientInterceptor,
 			grpc_retry.UnaryClientInterceptor(),
 			logDebugRequestInfoUnaryInterceptor,
+			slottiming.UnaryClientInterceptor(),
 		)),
 	}
 	conn, err := grpc.DialContext(v.ctx, v.endpoint, opts...)
@@ -150,7 +170,23 @@ func (v *ValidatorService) Start() {
 		return
 	}
 
+	validatingKeys := make(map[[48]byte]bool, len(pubkeys))
+	for _, pubKey := range pubkeys {
+		validatingKeys[pubKey] = true
+	}
+	validatingKeysGauge.Set(float64(len(pubkeys)))
+
+	v.lock.Lock()
+	// Keys disabled before the database was opened are recorded in it, and the keys disabled
+	// in previous runs are disabled again.
+	if err := v.disabledKeys.sync(v.ctx, valDB); err != nil {
+		v.lock.Unlock()
+		log.Errorf("Could not load disabled keys: %v", err)
+		return
+	}
 	v.conn = conn
+	v.db = valDB
+	v.lock.Unlock()
 	cache, err := ristretto.NewCache(&ristretto.Config{
 		NumCounters: 1280, // number of keys to track.
 		MaxCost:     128,  // maximum cost of cache, 1 item = 1 cost.
@@ -162,15 +198,21 @@ func (v *ValidatorService) Start() {
 
 	v.validator = &validator{
 		db:                   valDB,
-		validatorClient:      ethpb.NewBeaconNodeValidatorClient(v.conn),
-		beaconClient:         ethpb.NewBeaconChainClient(v.conn),
-		node:                 ethpb.NewNodeClient(v.conn),
+		validatorClient:      ethpb.NewBeaconNodeValidatorClient(conn),
+		subnetsClient:        pb.NewValidatorSubnetsClient(conn),
+		beaconClient:         ethpb.NewBeaconChainClient(conn),
+		node:                 ethpb.NewNodeClient(conn),
 		keyManager:           v.keyManager,
+		validatingKeys:       validatingKeys,
+		disabledKeys:         v.disabledKeys,
 		graffiti:             v.graffiti,
+		graffitiSource:       v.graffitiSource,
 		logValidatorBalances: v.logValidatorBalances,
 		emitAccountMetrics:   v.emitAccountMetrics,
 		prevBalance:          make(map[[48]byte]uint64),
 		attLogs:              make(map[[32]byte]*attSubmitted),
+		pendingAtts:          make(map[[32]byte][]*pendingAtt),
+		inclusionBlocks:      make(map[[32]byte]*inclusionBlock),
 		domainDataCache:      cache,
 	}
 	go run(v.ctx, v.validator)
@@ -180,8 +222,60 @@ func (v *ValidatorService) Start() {
 func (v *ValidatorService) Stop() error {
 	v.cancel()
 	log.Info("Stopping service")
-	if v.conn != nil {
-		return v.conn.Close()
+	if conn := v.BeaconNodeConn(); conn != nil {
+		return conn.Close()
+	}
+	return nil
+}
+
+// KeyManager returns the key manager of the validator client.
+func (v *ValidatorService) KeyManager() keymanager.KeyManager {
+	return v.keyManager
+}
+
+// DB returns the slashing protection database of the validator client, which is nil until
+// the service started.
+func (v *ValidatorService) DB() *db.Store {
+	v.lock.RLock()
+	defer v.lock.RUnlock()
+	return v.db
+}
+
+// BeaconNodeConn returns the connection to the beacon node, which is nil until the service
+// started.
+func (v *ValidatorService) BeaconNodeConn() *grpc.ClientConn {
+	v.lock.RLock()
+	defer v.lock.RUnlock()
+	return v.conn
+}
+
+// SetKeyEnabled enables or disables the duties of the key. The change is applied at the
+// start of the next slot, and is recorded in the database so that it is kept when the
+// validator client restarts.
+func (v *ValidatorService) SetKeyEnabled(ctx context.Context, pubKey [48]byte, enabled bool) error {
+	v.lock.RLock()
+	defer v.lock.RUnlock()
+	if v.db != nil {
+		if err := v.db.SetKeyDisabled(ctx, pubKey[:], !enabled); err != nil {
+			return errors.Wrap(err, "could not record disabled key")
+		}
+	}
+	v.disabledKeys.set(pubKey, !enabled)
+	return nil
+}
+
+// KeyEnabled returns whether the duties of the key are performed.
+func (v *ValidatorService) KeyEnabled(pubKey [48]byte) bool {
+	return !v.disabledKeys.contains(pubKey)
+}
+
+// Ready returns an error until the service is connected to the beacon node and has
+// opened its database.
+func (v *ValidatorService) Ready() error {
+	v.lock.RLock()
+	defer v.lock.RUnlock()
+	if v.conn == nil || v.db == nil {
+		return errors.New("not connected to beacon RPC")
 	}
 	return nil
 }
@@ -190,7 +284,7 @@ func (v *ValidatorService) Stop() error {
 //
 // WIP - not done.
 func (v *ValidatorService) Status() error {
-	if v.conn == nil {
+	if v.BeaconNodeConn() == nil {
 		return errors.New("no connection to beacon RPC")
 	}
 	return nil
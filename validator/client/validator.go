@@ -25,8 +25,10 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/slottiming"
 	"github.com/prysmaticlabs/prysm/shared/slotutil"
 	"github.com/prysmaticlabs/prysm/validator/db"
+	"github.com/prysmaticlabs/prysm/validator/graffiti"
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
@@ -38,15 +40,23 @@ type validator struct {
 	db                   *db.Store
 	duties               *ethpb.DutiesResponse
 	validatorClient      ethpb.BeaconNodeValidatorClient
+	subnetsClient        pb.ValidatorSubnetsClient
 	beaconClient         ethpb.BeaconChainClient
 	graffiti             []byte
+	graffitiSource       *graffiti.Source
 	node                 ethpb.NodeClient
 	keyManager           keymanager.KeyManager
+	validatingKeys       map[[48]byte]bool
+	disabledKeys         *disabledKeys
 	prevBalance          map[[48]byte]uint64
 	logValidatorBalances bool
 	emitAccountMetrics   bool
 	attLogs              map[[32]byte]*attSubmitted
 	attLogsLock          sync.Mutex
+	pendingAtts          map[[32]byte][]*pendingAtt
+	pendingAttsLock      sync.Mutex
+	inclusionBlocks      map[[32]byte]*inclusionBlock
+	inclusionLock        sync.Mutex
 	domainDataLock       sync.Mutex
 	domainDataCache      *ristretto.Cache
 }
@@ -110,16 +120,31 @@ func (v *validator) WaitForChainStart(ctx context.Context) error {
 func (v *validator) WaitForActivation(ctx context.Context) error {
 	ctx, span := trace.StartSpan(ctx, "validator.WaitForActivation")
 	defer span.End()
-	validatingKeys, err := v.keyManager.FetchValidatingKeys()
+	validatingKeys, err := v.fetchValidatingKeys()
 	if err != nil {
 		return errors.Wrap(err, "could not fetch validating keys")
 	}
+	validatorActivatedRecords, err := v.waitForActivation(ctx, validatingKeys)
+	if err != nil {
+		return err
+	}
+	for _, pubKey := range validatorActivatedRecords {
+		log.WithField("pubKey", fmt.Sprintf("%#x", bytesutil.Trunc(pubKey[:]))).Info("Validator activated")
+	}
+	v.ticker = slotutil.GetSlotTicker(time.Unix(int64(v.genesisTime), 0), params.BeaconConfig().SecondsPerSlot)
+
+	return nil
+}
+
+// waitForActivation logs the statuses of the validators with the given keys until at least
+// one of them is active, and returns the keys of the active validators.
+func (v *validator) waitForActivation(ctx context.Context, validatingKeys [][48]byte) ([][]byte, error) {
 	req := &ethpb.ValidatorActivationRequest{
 		PublicKeys: bytesutil.FromBytes48Array(validatingKeys),
 	}
 	stream, err := v.validatorClient.WaitForActivation(ctx, req)
 	if err != nil {
-		return errors.Wrap(err, "could not setup validator WaitForActivation streaming client")
+		return nil, errors.Wrap(err, "could not setup validator WaitForActivation streaming client")
 	}
 	var validatorActivatedRecords [][]byte
 	for {
@@ -130,10 +155,10 @@ func (v *validator) WaitForActivation(ctx context.Context) error {
 		}
 		// If context is canceled we stop the loop.
 		if ctx.Err() == context.Canceled {
-			return errors.Wrap(ctx.Err(), "context has been canceled so shutting down the loop")
+			return nil, errors.Wrap(ctx.Err(), "context has been canceled so shutting down the loop")
 		}
 		if err != nil {
-			return errors.Wrap(err, "could not receive validator activation from stream")
+			return nil, errors.Wrap(err, "could not receive validator activation from stream")
 		}
 		activatedKeys := v.checkAndLogValidatorStatus(res.Statuses)
 
@@ -142,12 +167,7 @@ func (v *validator) WaitForActivation(ctx context.Context) error {
 			break
 		}
 	}
-	for _, pubKey := range validatorActivatedRecords {
-		log.WithField("pubKey", fmt.Sprintf("%#x", bytesutil.Trunc(pubKey[:]))).Info("Validator activated")
-	}
-	v.ticker = slotutil.GetSlotTicker(time.Unix(int64(v.genesisTime), 0), params.BeaconConfig().SecondsPerSlot)
-
-	return nil
+	return validatorActivatedRecords, nil
 }
 
 // WaitForSync checks whether the beacon node has sync to the latest head
@@ -248,6 +268,13 @@ func (v *validator) SlotDeadline(slot uint64) time.Time {
 	return time.Unix(int64(v.genesisTime), 0 /*ns*/).Add(time.Duration(secs) * time.Second)
 }
 
+// startDutyTimer returns a copy of the context timing a duty of the validator at the given
+// slot, relative to the start of the slot.
+func (v *validator) startDutyTimer(ctx context.Context, duty string, slot uint64) context.Context {
+	slotStart := slotutil.SlotStartTime(v.genesisTime, slot)
+	return slottiming.NewContext(ctx, slottiming.New(duty, slot, slotStart))
+}
+
 // UpdateDuties checks the slot number to determine if the validator's
 // list of upcoming assignments needs to be updated. For example, at the
 // beginning of a new epoch.
@@ -261,8 +288,9 @@ func (v *validator) UpdateDuties(ctx context.Context, slot uint64) error {
 	defer cancel()
 	ctx, span := trace.StartSpan(ctx, "validator.UpdateAssignments")
 	defer span.End()
+	ctx = v.startDutyTimer(ctx, slottiming.DutyUpdateDuties, slot)
 
-	validatingKeys, err := v.keyManager.FetchValidatingKeys()
+	validatingKeys, err := v.fetchValidatingKeys()
 	if err != nil {
 		return err
 	}
@@ -280,7 +308,15 @@ func (v *validator) UpdateDuties(ctx context.Context, slot uint64) error {
 		return err
 	}
 
+	slottiming.Step(ctx, "dutiesReceived")
+
 	v.duties = resp
+	// The node joins the attestation subnets of the duties ahead of time. Failing to announce
+	// them does not prevent the validator from performing its duties.
+	if err := v.subscribeToSubnets(ctx, resp); err != nil {
+		log.WithError(err).Error("Could not subscribe to attestation subnets")
+	}
+	slottiming.Step(ctx, "subnetsSubscribed")
 	// Only log the full assignments output on epoch start to be less verbose.
 	// Also log out on first launch so the user doesn't have to wait a whole epoch to see their assignments.
 	if slot%params.BeaconConfig().SlotsPerEpoch == 0 || firstDutiesReceived {
@@ -312,6 +348,34 @@ func (v *validator) UpdateDuties(ctx context.Context, slot uint64) error {
 	return nil
 }
 
+// subscribeToSubnets announces the attester duties of the active and exiting validators to
+// the beacon node, along with whether the validator is an aggregator of its committee, so that
+// the node joins the attestation subnets of the duties before their slot.
+func (v *validator) subscribeToSubnets(ctx context.Context, res *ethpb.DutiesResponse) error {
+	req := &pb.CommitteeSubnetsSubscribeRequest{}
+	for _, duty := range res.Duties {
+		if duty == nil {
+			continue
+		}
+		// Exiting validators keep attesting until their exit epoch.
+		if duty.Status != ethpb.ValidatorStatus_ACTIVE && duty.Status != ethpb.ValidatorStatus_EXITING {
+			continue
+		}
+		aggregator, err := v.isAggregator(ctx, duty.Committee, duty.AttesterSlot, bytesutil.ToBytes48(duty.PublicKey))
+		if err != nil {
+			return errors.Wrap(err, "could not check if a validator is an aggregator")
+		}
+		req.Slots = append(req.Slots, duty.AttesterSlot)
+		req.CommitteeIds = append(req.CommitteeIds, duty.CommitteeIndex)
+		req.IsAggregator = append(req.IsAggregator, aggregator)
+	}
+	if len(req.Slots) == 0 {
+		return nil
+	}
+	_, err := v.subnetsClient.SubscribeCommitteeSubnets(ctx, req)
+	return err
+}
+
 // RolesAt slot returns the validator roles at the given slot. Returns nil if the
 // validator is known to not have a roles at the at slot. Returns UNKNOWN if the
 // validator assignments are unknown. Otherwise returns a valid ValidatorRole map.
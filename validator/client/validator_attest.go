@@ -17,6 +17,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/slottiming"
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
@@ -52,6 +53,7 @@ var (
 func (v *validator) SubmitAttestation(ctx context.Context, slot uint64, pubKey [48]byte) {
 	ctx, span := trace.StartSpan(ctx, "validator.SubmitAttestation")
 	defer span.End()
+	ctx = v.startDutyTimer(ctx, slottiming.DutyAttestation, slot)
 	span.AddAttributes(trace.StringAttribute("validator", fmt.Sprintf("%#x", pubKey)))
 
 	fmtKey := fmt.Sprintf("%#x", pubKey[:])
@@ -77,6 +79,7 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot uint64, pubKey [
 		}
 		return
 	}
+	slottiming.Step(ctx, "attestationDataReceived")
 
 	if featureconfig.Get().ProtectAttester {
 		history, err := v.db.AttestationHistory(ctx, pubKey[:])
@@ -107,6 +110,7 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot uint64, pubKey [
 		}
 		return
 	}
+	slottiming.Step(ctx, "attestationSigned")
 
 	var indexInCommittee uint64
 	var found bool
@@ -141,6 +145,7 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot uint64, pubKey [
 		}
 		return
 	}
+	slottiming.Step(ctx, "attestationSubmitted")
 
 	if featureconfig.Get().ProtectAttester {
 		history, err := v.db.AttestationHistory(ctx, pubKey[:])
@@ -169,6 +174,10 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot uint64, pubKey [
 		return
 	}
 
+	if err := v.trackAttestation(pubKey, attestation, indexInCommittee); err != nil {
+		log.WithError(err).Error("Could not track attestation inclusion")
+	}
+
 	if v.emitAccountMetrics {
 		validatorAttestSuccessVec.WithLabelValues(fmtKey).Inc()
 	}
@@ -0,0 +1,328 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+var (
+	validatorInclusionDistanceGaugeVec = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "validator",
+			Name:      "attestation_inclusion_distance",
+			Help:      "inclusion distance of the latest included attestation.",
+		},
+		[]string{
+			// validator pubkey
+			"pubkey",
+		},
+	)
+	validatorMissedAttestationsVec = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "validator",
+			Name:      "missed_attestations",
+			Help:      "submitted attestations which were not included in the canonical chain.",
+		},
+		[]string{
+			// validator pubkey
+			"pubkey",
+		},
+	)
+	validatorLateAttestationsVec = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "validator",
+			Name:      "late_attestations",
+			Help:      "submitted attestations which were included later than the minimum inclusion delay.",
+		},
+		[]string{
+			// validator pubkey
+			"pubkey",
+		},
+	)
+	validatorWrongHeadAttestationsVec = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "validator",
+			Name:      "wrong_head_attestations",
+			Help:      "submitted attestations which did not vote for the canonical head.",
+		},
+		[]string{
+			// validator pubkey
+			"pubkey",
+		},
+	)
+	validatorWrongTargetAttestationsVec = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "validator",
+			Name:      "wrong_target_attestations",
+			Help:      "submitted attestations which did not vote for the canonical target.",
+		},
+		[]string{
+			// validator pubkey
+			"pubkey",
+		},
+	)
+)
+
+// pendingAtt is an attestation submitted by a validator of the client, tracked until it is included
+// in the canonical chain or its inclusion window has passed.
+type pendingAtt struct {
+	pubKey           [48]byte
+	data             *ethpb.AttestationData
+	indexInCommittee uint64
+}
+
+// inclusionBlock is a block of the canonical chain along with the data roots of its attestations.
+type inclusionBlock struct {
+	block    *ethpb.BeaconBlock
+	attRoots [][32]byte
+}
+
+func newInclusionBlock(block *ethpb.BeaconBlock) (*inclusionBlock, error) {
+	attRoots := make([][32]byte, len(block.Body.Attestations))
+	for i, att := range block.Body.Attestations {
+		root, err := ssz.HashTreeRoot(att.Data)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not hash attestation data")
+		}
+		attRoots[i] = root
+	}
+	return &inclusionBlock{block: block, attRoots: attRoots}, nil
+}
+
+// trackAttestation tracks the inclusion of the attestation submitted by the validator of the given
+// public key, the index in committee being the aggregation bit of the validator.
+func (v *validator) trackAttestation(pubKey [48]byte, att *ethpb.Attestation, indexInCommittee uint64) error {
+	root, err := ssz.HashTreeRoot(att.Data)
+	if err != nil {
+		return err
+	}
+
+	v.pendingAttsLock.Lock()
+	defer v.pendingAttsLock.Unlock()
+	v.pendingAtts[root] = append(v.pendingAtts[root], &pendingAtt{
+		pubKey:           pubKey,
+		data:             att.Data,
+		indexInCommittee: indexInCommittee,
+	})
+	return nil
+}
+
+// CheckAttestationInclusions looks for the attestations submitted by the validators of the client in
+// the canonical chain of the beacon node. Once an attestation is included, or its inclusion window
+// has passed and its canonical head and target are known, its inclusion distance and whether it voted
+// for the canonical head and target are logged, reported in the metrics and saved in the validator DB.
+func (v *validator) CheckAttestationInclusions(ctx context.Context, slot uint64) error {
+	ctx, span := trace.StartSpan(ctx, "validator.CheckAttestationInclusions")
+	defer span.End()
+
+	v.inclusionLock.Lock()
+	defer v.inclusionLock.Unlock()
+
+	v.pendingAttsLock.Lock()
+	pending := v.pendingAtts
+	v.pendingAtts = make(map[[32]byte][]*pendingAtt)
+	v.pendingAttsLock.Unlock()
+	if len(pending) == 0 {
+		return nil
+	}
+
+	// The blocks are needed from the start of the earliest target epoch, to know the canonical
+	// target roots.
+	lowestSlot := slot
+	for _, atts := range pending {
+		for _, att := range atts {
+			if s := helpers.StartSlot(att.data.Target.Epoch); s < lowestSlot {
+				lowestSlot = s
+			}
+		}
+	}
+	blocks, err := v.canonicalBlocks(ctx, lowestSlot)
+	if err != nil {
+		v.restorePendingAtts(pending)
+		return err
+	}
+
+	undecided := make(map[[32]byte][]*pendingAtt)
+	for dataRoot, atts := range pending {
+		for _, att := range atts {
+			inclusionSlot := attInclusionSlot(blocks, dataRoot, att)
+			headRoot, headKnown := canonicalRoot(blocks, att.data.Slot)
+			targetRoot, targetKnown := canonicalRoot(blocks, helpers.StartSlot(att.data.Target.Epoch))
+			if inclusionSlot == 0 && slot <= att.data.Slot+params.BeaconConfig().SlotsPerEpoch {
+				// The attestation can still be included.
+				undecided[dataRoot] = append(undecided[dataRoot], att)
+				continue
+			}
+			if !headKnown || !targetKnown {
+				// No canonical block was seen after the attestation, the votes cannot be judged
+				// until the chain moves on.
+				undecided[dataRoot] = append(undecided[dataRoot], att)
+				continue
+			}
+
+			inclusion := &slashpb.AttestationInclusion{
+				Slot:          att.data.Slot,
+				DataRoot:      dataRoot[:],
+				InclusionSlot: inclusionSlot,
+			}
+			if inclusionSlot != 0 {
+				inclusion.InclusionDistance = inclusionSlot - att.data.Slot
+			}
+			inclusion.CorrectHead = bytes.Equal(att.data.BeaconBlockRoot, headRoot[:])
+			inclusion.CorrectTarget = bytes.Equal(att.data.Target.Root, targetRoot[:])
+			v.recordAttestationInclusion(ctx, att.pubKey, inclusion)
+		}
+	}
+	v.restorePendingAtts(undecided)
+
+	// Keep the blocks of an extra epoch, the walk of the canonical chain ending on the block
+	// preceding the lowest slot.
+	for root, blk := range v.inclusionBlocks {
+		if blk.block.Slot+params.BeaconConfig().SlotsPerEpoch < lowestSlot {
+			delete(v.inclusionBlocks, root)
+		}
+	}
+	return nil
+}
+
+// restorePendingAtts tracks again the given attestations, along with the attestations submitted
+// since they were taken from the pending attestations.
+func (v *validator) restorePendingAtts(atts map[[32]byte][]*pendingAtt) {
+	v.pendingAttsLock.Lock()
+	defer v.pendingAttsLock.Unlock()
+	for root, a := range atts {
+		v.pendingAtts[root] = append(a, v.pendingAtts[root]...)
+	}
+}
+
+// canonicalBlocks returns the blocks of the canonical chain of the beacon node from the given slot,
+// in ascending slot order. The blocks are requested by walking back from the head of the chain, and
+// cached so that only the blocks which were not seen yet are requested.
+func (v *validator) canonicalBlocks(ctx context.Context, fromSlot uint64) ([]*inclusionBlock, error) {
+	head, err := v.beaconClient.GetChainHead(ctx, &ptypes.Empty{})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get chain head")
+	}
+
+	var blocks []*inclusionBlock
+	root := bytesutil.ToBytes32(head.HeadBlockRoot)
+	for {
+		blk, ok := v.inclusionBlocks[root]
+		if !ok {
+			res, err := v.beaconClient.ListBlocks(ctx, &ethpb.ListBlocksRequest{
+				QueryFilter: &ethpb.ListBlocksRequest_Root{Root: root[:]},
+			})
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not get block %#x", bytesutil.Trunc(root[:]))
+			}
+			// The walk ends on the genesis block, whose parent root is unknown to the beacon node.
+			if len(res.BlockContainers) == 0 || res.BlockContainers[0].Block == nil {
+				break
+			}
+			blk, err = newInclusionBlock(res.BlockContainers[0].Block.Block)
+			if err != nil {
+				return nil, err
+			}
+			v.inclusionBlocks[root] = blk
+		}
+		if blk.block.Slot < fromSlot {
+			break
+		}
+		blocks = append(blocks, blk)
+		root = bytesutil.ToBytes32(blk.block.ParentRoot)
+	}
+
+	for i, j := 0, len(blocks)-1; i < j; i, j = i+1, j-1 {
+		blocks[i], blocks[j] = blocks[j], blocks[i]
+	}
+	return blocks, nil
+}
+
+// attInclusionSlot returns the slot of the first canonical block including the attestation, or 0 if
+// it was not included yet.
+func attInclusionSlot(blocks []*inclusionBlock, dataRoot [32]byte, att *pendingAtt) uint64 {
+	for _, blk := range blocks {
+		if blk.block.Slot < att.data.Slot+params.BeaconConfig().MinAttestationInclusionDelay {
+			continue
+		}
+		for i, a := range blk.block.Body.Attestations {
+			if blk.attRoots[i] == dataRoot && a.AggregationBits.BitAt(att.indexInCommittee) {
+				return blk.block.Slot
+			}
+		}
+	}
+	return 0
+}
+
+// canonicalRoot returns the root of the canonical block at the given slot, which is the parent of the
+// first canonical block after the slot. It is unknown until such a block is known.
+func canonicalRoot(blocks []*inclusionBlock, slot uint64) ([32]byte, bool) {
+	for _, blk := range blocks {
+		if blk.block.Slot > slot {
+			return bytesutil.ToBytes32(blk.block.ParentRoot), true
+		}
+	}
+	return [32]byte{}, false
+}
+
+// recordAttestationInclusion logs the inclusion of an attestation of the validator of the given
+// public key, reports it in the metrics and saves it in the validator DB.
+func (v *validator) recordAttestationInclusion(ctx context.Context, pubKey [48]byte, inclusion *slashpb.AttestationInclusion) {
+	fmtKey := fmt.Sprintf("%#x", pubKey[:])
+	log := log.WithFields(logrus.Fields{
+		"pubKey":        fmt.Sprintf("%#x", bytesutil.Trunc(pubKey[:])),
+		"slot":          inclusion.Slot,
+		"correctHead":   inclusion.CorrectHead,
+		"correctTarget": inclusion.CorrectTarget,
+	})
+
+	late := inclusion.InclusionDistance > params.BeaconConfig().MinAttestationInclusionDelay
+	if v.emitAccountMetrics {
+		if inclusion.InclusionSlot == 0 {
+			validatorMissedAttestationsVec.WithLabelValues(fmtKey).Inc()
+		} else {
+			validatorInclusionDistanceGaugeVec.WithLabelValues(fmtKey).Set(float64(inclusion.InclusionDistance))
+		}
+		if late {
+			validatorLateAttestationsVec.WithLabelValues(fmtKey).Inc()
+		}
+		if !inclusion.CorrectHead {
+			validatorWrongHeadAttestationsVec.WithLabelValues(fmtKey).Inc()
+		}
+		if !inclusion.CorrectTarget {
+			validatorWrongTargetAttestationsVec.WithLabelValues(fmtKey).Inc()
+		}
+	}
+
+	if inclusion.InclusionSlot == 0 {
+		log.Warn("Attestation was not included in the canonical chain")
+	} else {
+		log = log.WithFields(logrus.Fields{
+			"inclusionSlot":     inclusion.InclusionSlot,
+			"inclusionDistance": inclusion.InclusionDistance,
+		})
+		if late || !inclusion.CorrectHead || !inclusion.CorrectTarget {
+			log.Warn("Attestation was included late or with an incorrect vote")
+		} else {
+			log.Info("Attestation included")
+		}
+	}
+
+	if err := v.db.SaveAttestationInclusion(ctx, pubKey[:], inclusion); err != nil {
+		log.WithError(err).Error("Could not save attestation inclusion to DB")
+	}
+}
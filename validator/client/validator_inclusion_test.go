@@ -0,0 +1,206 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/mock"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+// mockChain sets up the beacon chain client of the validator to serve the given blocks by root, the
+// head being the block of the given root.
+func mockChain(t *testing.T, v *validator, head [32]byte, blocks map[[32]byte]*ethpb.BeaconBlock) func() {
+	ctrl := gomock.NewController(t)
+	client := mock.NewMockBeaconChainClient(ctrl)
+	v.beaconClient = client
+	client.EXPECT().GetChainHead(
+		gomock.Any(), // ctx
+		gomock.Any(),
+	).Return(&ethpb.ChainHead{HeadBlockRoot: head[:]}, nil).AnyTimes()
+	client.EXPECT().ListBlocks(
+		gomock.Any(), // ctx
+		gomock.Any(),
+	).DoAndReturn(func(_ context.Context, req *ethpb.ListBlocksRequest) (*ethpb.ListBlocksResponse, error) {
+		blk, ok := blocks[bytesutil.ToBytes32(req.GetRoot())]
+		if !ok {
+			return &ethpb.ListBlocksResponse{}, nil
+		}
+		return &ethpb.ListBlocksResponse{
+			BlockContainers: []*ethpb.BeaconBlockContainer{{
+				Block:     &ethpb.SignedBeaconBlock{Block: blk},
+				BlockRoot: req.GetRoot(),
+			}},
+		}, nil
+	}).AnyTimes()
+	return ctrl.Finish
+}
+
+// inclusionChain returns a chain from the start of epoch 1, the attestation of slot start+1 voting
+// for the block of this slot being included in the block of the given slot.
+func inclusionChain(inclusionSlot uint64) (*ethpb.Attestation, [32]byte, map[[32]byte]*ethpb.BeaconBlock) {
+	start := params.BeaconConfig().SlotsPerEpoch
+	roots := [][32]byte{{'t'}, {'h'}, {'i'}}
+	att := &ethpb.Attestation{
+		Data: &ethpb.AttestationData{
+			Slot:            start + 1,
+			CommitteeIndex:  2,
+			BeaconBlockRoot: roots[1][:],
+			Source:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+			Target:          &ethpb.Checkpoint{Epoch: 1, Root: roots[0][:]},
+		},
+		AggregationBits: bitfield.Bitlist{0x0a},
+	}
+	blocks := map[[32]byte]*ethpb.BeaconBlock{
+		roots[0]: {Slot: start, ParentRoot: make([]byte, 32), Body: &ethpb.BeaconBlockBody{}},
+		roots[1]: {Slot: start + 1, ParentRoot: roots[0][:], Body: &ethpb.BeaconBlockBody{}},
+		roots[2]: {Slot: inclusionSlot, ParentRoot: roots[1][:], Body: &ethpb.BeaconBlockBody{
+			Attestations: []*ethpb.Attestation{att},
+		}},
+	}
+	return att, roots[2], blocks
+}
+
+func TestCheckAttestationInclusions_Included(t *testing.T) {
+	hook := logTest.NewGlobal()
+	validator, _, finish := setup(t)
+	defer finish()
+	att, head, blocks := inclusionChain(params.BeaconConfig().SlotsPerEpoch + 2)
+	defer mockChain(t, validator, head, blocks)()
+
+	if err := validator.trackAttestation(validatorPubKey, att, 1); err != nil {
+		t.Fatal(err)
+	}
+	if err := validator.CheckAttestationInclusions(context.Background(), att.Data.Slot+2); err != nil {
+		t.Fatal(err)
+	}
+	testutil.AssertLogsContain(t, hook, "Attestation included")
+	if len(validator.pendingAtts) != 0 {
+		t.Errorf("Expected the attestation to no longer be tracked, received %v", validator.pendingAtts)
+	}
+
+	inclusion, err := validator.db.AttestationInclusion(context.Background(), validatorPubKey[:], att.Data.Slot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if inclusion == nil {
+		t.Fatal("Expected the attestation inclusion to be saved")
+	}
+	if inclusion.InclusionSlot != att.Data.Slot+1 || inclusion.InclusionDistance != 1 {
+		t.Errorf("Expected inclusion at distance 1, received slot %d and distance %d", inclusion.InclusionSlot, inclusion.InclusionDistance)
+	}
+	if !inclusion.CorrectHead || !inclusion.CorrectTarget {
+		t.Errorf("Expected correct head and target votes, received %v", inclusion)
+	}
+}
+
+func TestCheckAttestationInclusions_LateWrongHead(t *testing.T) {
+	hook := logTest.NewGlobal()
+	validator, _, finish := setup(t)
+	defer finish()
+	att, head, blocks := inclusionChain(params.BeaconConfig().SlotsPerEpoch + 4)
+	// The validator voted for the parent of the block of its slot.
+	att.Data.BeaconBlockRoot = att.Data.Target.Root
+	defer mockChain(t, validator, head, blocks)()
+
+	if err := validator.trackAttestation(validatorPubKey, att, 1); err != nil {
+		t.Fatal(err)
+	}
+	if err := validator.CheckAttestationInclusions(context.Background(), att.Data.Slot+4); err != nil {
+		t.Fatal(err)
+	}
+	testutil.AssertLogsContain(t, hook, "Attestation was included late or with an incorrect vote")
+
+	inclusion, err := validator.db.AttestationInclusion(context.Background(), validatorPubKey[:], att.Data.Slot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if inclusion == nil {
+		t.Fatal("Expected the attestation inclusion to be saved")
+	}
+	if inclusion.InclusionDistance != 3 {
+		t.Errorf("Expected inclusion distance 3, received %d", inclusion.InclusionDistance)
+	}
+	if inclusion.CorrectHead || !inclusion.CorrectTarget {
+		t.Errorf("Expected an incorrect head vote only, received %v", inclusion)
+	}
+}
+
+func TestCheckAttestationInclusions_Missed(t *testing.T) {
+	hook := logTest.NewGlobal()
+	validator, _, finish := setup(t)
+	defer finish()
+	att, head, blocks := inclusionChain(params.BeaconConfig().SlotsPerEpoch + 2)
+	defer mockChain(t, validator, head, blocks)()
+
+	// The aggregation bit of the validator is not set in the included attestation.
+	if err := validator.trackAttestation(validatorPubKey, att, 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := validator.CheckAttestationInclusions(context.Background(), att.Data.Slot+2); err != nil {
+		t.Fatal(err)
+	}
+	inclusion, err := validator.db.AttestationInclusion(context.Background(), validatorPubKey[:], att.Data.Slot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if inclusion != nil {
+		t.Fatalf("Expected no inclusion within the inclusion window, received %v", inclusion)
+	}
+	if len(validator.pendingAtts) != 1 {
+		t.Fatalf("Expected the attestation to still be tracked, received %v", validator.pendingAtts)
+	}
+
+	if err := validator.CheckAttestationInclusions(context.Background(), att.Data.Slot+params.BeaconConfig().SlotsPerEpoch+1); err != nil {
+		t.Fatal(err)
+	}
+	testutil.AssertLogsContain(t, hook, "Attestation was not included in the canonical chain")
+	inclusion, err = validator.db.AttestationInclusion(context.Background(), validatorPubKey[:], att.Data.Slot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if inclusion == nil || inclusion.InclusionSlot != 0 {
+		t.Fatalf("Expected the attestation to be recorded as missed, received %v", inclusion)
+	}
+	if !inclusion.CorrectHead || !inclusion.CorrectTarget {
+		t.Errorf("Expected correct head and target votes, received %v", inclusion)
+	}
+	if len(validator.pendingAtts) != 0 {
+		t.Errorf("Expected the attestation to no longer be tracked, received %v", validator.pendingAtts)
+	}
+}
+
+func TestCheckAttestationInclusions_UnknownCanonicalHead(t *testing.T) {
+	hook := logTest.NewGlobal()
+	validator, _, finish := setup(t)
+	defer finish()
+	att, head, blocks := inclusionChain(params.BeaconConfig().SlotsPerEpoch + 2)
+	// The chain did not move past the block of the attestation slot.
+	headBlock := blocks[head]
+	delete(blocks, head)
+	defer mockChain(t, validator, bytesutil.ToBytes32(headBlock.ParentRoot), blocks)()
+
+	if err := validator.trackAttestation(validatorPubKey, att, 1); err != nil {
+		t.Fatal(err)
+	}
+	if err := validator.CheckAttestationInclusions(context.Background(), att.Data.Slot+params.BeaconConfig().SlotsPerEpoch+1); err != nil {
+		t.Fatal(err)
+	}
+	testutil.AssertLogsDoNotContain(t, hook, "Attestation was not included in the canonical chain")
+	inclusion, err := validator.db.AttestationInclusion(context.Background(), validatorPubKey[:], att.Data.Slot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if inclusion != nil {
+		t.Fatalf("Expected no inclusion while the canonical head is unknown, received %v", inclusion)
+	}
+	if len(validator.pendingAtts) != 1 {
+		t.Fatalf("Expected the attestation to still be tracked, received %v", validator.pendingAtts)
+	}
+}
@@ -0,0 +1,177 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/validator/db"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	"go.opencensus.io/trace"
+)
+
+var (
+	validatingKeysGauge = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: "validator",
+			Name:      "validating_keys",
+			Help:      "The number of keys the validator client validates with.",
+		},
+	)
+	validatingKeysAddedCounter = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "validator",
+			Name:      "validating_keys_added_total",
+			Help:      "The number of keys added to the key source while the validator client runs.",
+		},
+	)
+	validatingKeysRemovedCounter = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "validator",
+			Name:      "validating_keys_removed_total",
+			Help:      "The number of keys removed from the key source while the validator client runs.",
+		},
+	)
+)
+
+// disabledKeys are the keys whose duties are not performed, as set through the management
+// API of the validator client. A nil set disables no key.
+type disabledKeys struct {
+	lock sync.RWMutex
+	keys map[[48]byte]bool
+}
+
+func newDisabledKeys() *disabledKeys {
+	return &disabledKeys{keys: make(map[[48]byte]bool)}
+}
+
+func (d *disabledKeys) contains(pubKey [48]byte) bool {
+	if d == nil {
+		return false
+	}
+	d.lock.RLock()
+	defer d.lock.RUnlock()
+	return d.keys[pubKey]
+}
+
+func (d *disabledKeys) set(pubKey [48]byte, disabled bool) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	if disabled {
+		d.keys[pubKey] = true
+	} else {
+		delete(d.keys, pubKey)
+	}
+}
+
+// sync records the disabled keys in the database, and adds the keys disabled in the database.
+func (d *disabledKeys) sync(ctx context.Context, valDB *db.Store) error {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	for pubKey := range d.keys {
+		if err := valDB.SetKeyDisabled(ctx, pubKey[:], true); err != nil {
+			return err
+		}
+	}
+	keys, err := valDB.DisabledKeys(ctx)
+	if err != nil {
+		return err
+	}
+	for _, pubKey := range keys {
+		d.keys[pubKey] = true
+	}
+	return nil
+}
+
+// fetchValidatingKeys returns the keys of the key manager which are not disabled.
+func (v *validator) fetchValidatingKeys() ([][48]byte, error) {
+	keys, err := v.keyManager.FetchValidatingKeys()
+	if err != nil {
+		return nil, err
+	}
+	validatingKeys := make([][48]byte, 0, len(keys))
+	for _, pubKey := range keys {
+		if !v.disabledKeys.contains(pubKey) {
+			validatingKeys = append(validatingKeys, pubKey)
+		}
+	}
+	return validatingKeys, nil
+}
+
+// ReloadKeys reads the key source of the key manager again, if it may change while the
+// validator runs, and applies the keys enabled or disabled through the management API. The
+// slashing protection history of the added keys is initialized and the duties are refreshed
+// at the next update, so that the added keys start validating and the removed ones stop.
+// The activation of the added keys is awaited in the background.
+func (v *validator) ReloadKeys(ctx context.Context) error {
+	ctx, span := trace.StartSpan(ctx, "validator.ReloadKeys")
+	defer span.End()
+
+	if km, ok := v.keyManager.(keymanager.ReloadableKeyManager); ok {
+		if err := km.ReloadValidatingKeys(); err != nil {
+			return errors.Wrap(err, "could not reload validating keys")
+		}
+	}
+	validatingKeys, err := v.fetchValidatingKeys()
+	if err != nil {
+		return errors.Wrap(err, "could not fetch validating keys")
+	}
+	keySet := make(map[[48]byte]bool, len(validatingKeys))
+	var added [][48]byte
+	for _, pubKey := range validatingKeys {
+		keySet[pubKey] = true
+		if !v.validatingKeys[pubKey] {
+			added = append(added, pubKey)
+		}
+	}
+	var removed [][48]byte
+	for pubKey := range v.validatingKeys {
+		if !keySet[pubKey] {
+			removed = append(removed, pubKey)
+		}
+	}
+	if len(added) == 0 && len(removed) == 0 {
+		return nil
+	}
+
+	// The history must exist before any duty of the added keys is performed.
+	if v.db != nil {
+		if err := v.db.InitializeHistory(ctx, added); err != nil {
+			return errors.Wrap(err, "could not initialize slashing protection history")
+		}
+	}
+	for _, pubKey := range added {
+		log.WithField("pubKey", fmt.Sprintf("%#x", bytesutil.Trunc(pubKey[:]))).Info("Added validating key")
+	}
+	for _, pubKey := range removed {
+		log.WithField("pubKey", fmt.Sprintf("%#x", bytesutil.Trunc(pubKey[:]))).Info("Removed validating key")
+		delete(v.prevBalance, pubKey)
+		if v.emitAccountMetrics {
+			validatorStatusesGaugeVec.DeleteLabelValues(fmt.Sprintf("%#x", pubKey[:]))
+		}
+	}
+	validatingKeysGauge.Set(float64(len(validatingKeys)))
+	validatingKeysAddedCounter.Add(float64(len(added)))
+	validatingKeysRemovedCounter.Add(float64(len(removed)))
+	v.validatingKeys = keySet
+	// Clear the duties so that they are requested for the new set of keys.
+	v.duties = nil
+
+	if len(added) > 0 {
+		go func() {
+			activated, err := v.waitForActivation(ctx, added)
+			if err != nil {
+				log.WithError(err).Error("Could not wait for activation of added keys")
+				return
+			}
+			for _, pubKey := range activated {
+				log.WithField("pubKey", fmt.Sprintf("%#x", bytesutil.Trunc(pubKey[:]))).Info("Validator activated")
+			}
+		}()
+	}
+	return nil
+}
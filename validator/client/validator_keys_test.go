@@ -0,0 +1,197 @@
+package client
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/validator/db"
+	"github.com/prysmaticlabs/prysm/validator/internal"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+// reloadableKeyManager switches to the next key manager when it is reloaded.
+type reloadableKeyManager struct {
+	lock    sync.RWMutex
+	current keymanager.KeyManager
+	next    keymanager.KeyManager
+}
+
+func (km *reloadableKeyManager) FetchValidatingKeys() ([][48]byte, error) {
+	km.lock.RLock()
+	defer km.lock.RUnlock()
+	return km.current.FetchValidatingKeys()
+}
+
+func (km *reloadableKeyManager) Sign(pubKey [48]byte, root [32]byte, domain uint64) (*bls.Signature, error) {
+	km.lock.RLock()
+	defer km.lock.RUnlock()
+	return km.current.Sign(pubKey, root, domain)
+}
+
+func (km *reloadableKeyManager) ReloadValidatingKeys() error {
+	km.lock.Lock()
+	defer km.lock.Unlock()
+	if km.next != nil {
+		km.current, km.next = km.next, nil
+	}
+	return nil
+}
+
+func TestReloadKeys_AddsAndRemovesKeys(t *testing.T) {
+	hook := logTest.NewGlobal()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := internal.NewMockBeaconNodeValidatorClient(ctrl)
+
+	removedKey, addedKey := bls.RandKey(), bls.RandKey()
+	removedPubKey := bytesutil.ToBytes48(removedKey.PublicKey().Marshal())
+	addedPubKey := bytesutil.ToBytes48(addedKey.PublicKey().Marshal())
+	valDB := db.SetupDB(t, [][48]byte{removedPubKey})
+	defer db.TeardownDB(t, valDB)
+	v := validator{
+		db:              valDB,
+		validatorClient: client,
+		keyManager: &reloadableKeyManager{
+			current: keymanager.NewDirect([]*bls.SecretKey{removedKey}),
+			next:    keymanager.NewDirect([]*bls.SecretKey{addedKey}),
+		},
+		validatingKeys: map[[48]byte]bool{removedPubKey: true},
+		prevBalance:    map[[48]byte]uint64{removedPubKey: 32},
+		duties:         &ethpb.DutiesResponse{},
+	}
+
+	activated := make(chan struct{})
+	clientStream := internal.NewMockBeaconNodeValidator_WaitForActivationClient(ctrl)
+	client.EXPECT().WaitForActivation(
+		gomock.Any(),
+		&ethpb.ValidatorActivationRequest{
+			PublicKeys: [][]byte{addedPubKey[:]},
+		},
+	).Return(clientStream, nil)
+	clientStream.EXPECT().Recv().Do(func() {
+		close(activated)
+	}).Return(
+		&ethpb.ValidatorActivationResponse{
+			Statuses: []*ethpb.ValidatorActivationResponse_Status{
+				{
+					PublicKey: addedPubKey[:],
+					Status:    &ethpb.ValidatorStatusResponse{Status: ethpb.ValidatorStatus_ACTIVE},
+				},
+			},
+		},
+		nil,
+	)
+
+	if err := v.ReloadKeys(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if v.duties != nil {
+		t.Error("Expected duties to be cleared")
+	}
+	if len(v.validatingKeys) != 1 || !v.validatingKeys[addedPubKey] {
+		t.Errorf("Unexpected validating keys %v", v.validatingKeys)
+	}
+	if _, ok := v.prevBalance[removedPubKey]; ok {
+		t.Error("Expected balance of removed key to be forgotten")
+	}
+	history, err := valDB.ProposalHistory(context.Background(), addedPubKey[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if history == nil {
+		t.Error("Expected proposal history of added key to be initialized")
+	}
+	testutil.AssertLogsContain(t, hook, "Added validating key")
+	testutil.AssertLogsContain(t, hook, "Removed validating key")
+
+	select {
+	case <-activated:
+	case <-time.After(time.Second):
+		t.Fatal("Activation of added key was not awaited")
+	}
+}
+
+func TestReloadKeys_NoChanges(t *testing.T) {
+	hook := logTest.NewGlobal()
+	key := bls.RandKey()
+	pubKey := bytesutil.ToBytes48(key.PublicKey().Marshal())
+	duties := &ethpb.DutiesResponse{}
+	v := validator{
+		keyManager: &reloadableKeyManager{
+			current: keymanager.NewDirect([]*bls.SecretKey{key}),
+		},
+		validatingKeys: map[[48]byte]bool{pubKey: true},
+		duties:         duties,
+	}
+
+	if err := v.ReloadKeys(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if v.duties != duties {
+		t.Error("Expected duties to be kept")
+	}
+	testutil.AssertLogsDoNotContain(t, hook, "validating key")
+}
+
+func TestReloadKeys_DisabledKey(t *testing.T) {
+	hook := logTest.NewGlobal()
+	enabledKey, disabledKey := bls.RandKey(), bls.RandKey()
+	enabledPubKey := bytesutil.ToBytes48(enabledKey.PublicKey().Marshal())
+	disabledPubKey := bytesutil.ToBytes48(disabledKey.PublicKey().Marshal())
+	v := validator{
+		keyManager:     keymanager.NewDirect([]*bls.SecretKey{enabledKey, disabledKey}),
+		validatingKeys: map[[48]byte]bool{enabledPubKey: true, disabledPubKey: true},
+		disabledKeys:   newDisabledKeys(),
+		duties:         &ethpb.DutiesResponse{},
+	}
+	v.disabledKeys.set(disabledPubKey, true)
+
+	if err := v.ReloadKeys(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if v.duties != nil {
+		t.Error("Expected duties to be cleared")
+	}
+	keys, err := v.fetchValidatingKeys()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 1 || keys[0] != enabledPubKey {
+		t.Errorf("Expected only the enabled key to be validated with, received %v", keys)
+	}
+	testutil.AssertLogsContain(t, hook, "Removed validating key")
+}
+
+func TestDisabledKeys_Sync(t *testing.T) {
+	valDB := db.SetupDB(t, [][48]byte{})
+	defer db.TeardownDB(t, valDB)
+	ctx := context.Background()
+	disabledBefore, disabledInDB := [48]byte{1}, [48]byte{2}
+	if err := valDB.SetKeyDisabled(ctx, disabledInDB[:], true); err != nil {
+		t.Fatal(err)
+	}
+	keys := newDisabledKeys()
+	keys.set(disabledBefore, true)
+
+	if err := keys.sync(ctx, valDB); err != nil {
+		t.Fatal(err)
+	}
+	if !keys.contains(disabledBefore) || !keys.contains(disabledInDB) {
+		t.Error("Expected the keys disabled in memory and in the database to be disabled")
+	}
+	stored, err := valDB.DisabledKeys(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stored) != 2 {
+		t.Errorf("Expected 2 disabled keys in the database, received %d", len(stored))
+	}
+}
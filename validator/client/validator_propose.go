@@ -16,6 +16,8 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/slottiming"
+	"github.com/prysmaticlabs/prysm/validator/graffiti"
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
@@ -42,6 +44,18 @@ var (
 			"pubkey",
 		},
 	)
+	validatorProposalGraffitiVec = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "validator",
+			Name:      "proposal_graffiti",
+			Help:      "The number of proposed blocks by graffiti and the source the graffiti was selected from",
+		},
+		[]string{
+			// The source of the graffiti, as defined by the graffiti package.
+			"source",
+			"graffiti",
+		},
+	)
 )
 
 // ProposeBlock A new beacon block for a given slot. This method collects the
@@ -56,6 +70,7 @@ func (v *validator) ProposeBlock(ctx context.Context, slot uint64, pubKey [48]by
 	}
 	ctx, span := trace.StartSpan(ctx, "validator.ProposeBlock")
 	defer span.End()
+	ctx = v.startDutyTimer(ctx, slottiming.DutyProposal, slot)
 	fmtKey := fmt.Sprintf("%#x", pubKey[:])
 
 	span.AddAttributes(trace.StringAttribute("validator", fmt.Sprintf("%#x", pubKey)))
@@ -71,12 +86,14 @@ func (v *validator) ProposeBlock(ctx context.Context, slot uint64, pubKey [48]by
 		}
 		return
 	}
+	slottiming.Step(ctx, "randaoSigned")
 
 	// Request block from beacon node
+	graffiti, graffitiSource := v.graffitiFor(pubKey)
 	b, err := v.validatorClient.GetBlock(ctx, &ethpb.BlockRequest{
 		Slot:         slot,
 		RandaoReveal: randaoReveal,
-		Graffiti:     v.graffiti,
+		Graffiti:     graffiti,
 	})
 	if err != nil {
 		log.WithError(err).Error("Failed to request block from beacon node")
@@ -85,6 +102,7 @@ func (v *validator) ProposeBlock(ctx context.Context, slot uint64, pubKey [48]by
 		}
 		return
 	}
+	slottiming.Step(ctx, "blockReceived")
 
 	if featureconfig.Get().ProtectProposer {
 		history, err := v.db.ProposalHistory(ctx, pubKey[:])
@@ -114,6 +132,7 @@ func (v *validator) ProposeBlock(ctx context.Context, slot uint64, pubKey [48]by
 		}
 		return
 	}
+	slottiming.Step(ctx, "blockSigned")
 	blk := &ethpb.SignedBeaconBlock{
 		Block:     b,
 		Signature: sig,
@@ -128,6 +147,7 @@ func (v *validator) ProposeBlock(ctx context.Context, slot uint64, pubKey [48]by
 		}
 		return
 	}
+	slottiming.Step(ctx, "blockProposed")
 
 	if featureconfig.Get().ProtectProposer {
 		history, err := v.db.ProposalHistory(ctx, pubKey[:])
@@ -151,6 +171,7 @@ func (v *validator) ProposeBlock(ctx context.Context, slot uint64, pubKey [48]by
 	if v.emitAccountMetrics {
 		validatorProposeSuccessVec.WithLabelValues(fmtKey).Inc()
 	}
+	validatorProposalGraffitiVec.WithLabelValues(graffitiSource, string(graffiti)).Inc()
 
 	span.AddAttributes(
 		trace.StringAttribute("blockRoot", fmt.Sprintf("%#x", blkResp.BlockRoot)),
@@ -164,9 +185,20 @@ func (v *validator) ProposeBlock(ctx context.Context, slot uint64, pubKey [48]by
 		"blockRoot":       blkRoot,
 		"numAttestations": len(b.Body.Attestations),
 		"numDeposits":     len(b.Body.Deposits),
+		"graffiti":        string(graffiti),
+		"graffitiSource":  graffitiSource,
 	}).Info("Submitted new block")
 }
 
+// graffitiFor returns the graffiti of the next proposal of the validator, along with the
+// source it was selected from. The graffiti file takes precedence over the graffiti flag.
+func (v *validator) graffitiFor(pubKey [48]byte) ([]byte, string) {
+	if v.graffitiSource != nil {
+		return v.graffitiSource.Graffiti(pubKey)
+	}
+	return v.graffiti, graffiti.SourceFlag
+}
+
 // ProposeExit --
 func (v *validator) ProposeExit(ctx context.Context, exit *ethpb.VoluntaryExit) error {
 	return errors.New("unimplemented")
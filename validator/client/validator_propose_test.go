@@ -3,6 +3,10 @@ package client
 import (
 	"context"
 	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -13,6 +17,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/validator/db"
+	"github.com/prysmaticlabs/prysm/validator/graffiti"
 	"github.com/prysmaticlabs/prysm/validator/internal"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 )
@@ -33,6 +38,8 @@ func setup(t *testing.T) (*validator, *mocks, func()) {
 		keyManager:      testKeyManager,
 		graffiti:        []byte{},
 		attLogs:         make(map[[32]byte]*attSubmitted),
+		pendingAtts:     make(map[[32]byte][]*pendingAtt),
+		inclusionBlocks: make(map[[32]byte]*inclusionBlock),
 	}
 
 	return validator, m, ctrl.Finish
@@ -288,6 +295,47 @@ func TestProposeBlock_BroadcastsBlock_WithGraffiti(t *testing.T) {
 	}
 }
 
+func TestProposeBlock_RequestsBlockWithGraffitiFromFile(t *testing.T) {
+	validator, m, finish := setup(t)
+	defer finish()
+
+	dir, err := ioutil.TempDir("", "graffiti")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "graffiti.yaml")
+	content := fmt.Sprintf("default: \"default\"\nspecific:\n  \"%#x\": \"specific\"\n", validatorPubKey)
+	if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	validator.graffiti = []byte("flag")
+	validator.graffitiSource, err = graffiti.NewSource(path, validator.graffiti)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m.validatorClient.EXPECT().DomainData(
+		gomock.Any(), // ctx
+		gomock.Any(), //epoch
+	).Return(&ethpb.DomainResponse{}, nil /*err*/)
+
+	var blockRequest *ethpb.BlockRequest
+	m.validatorClient.EXPECT().GetBlock(
+		gomock.Any(), // ctx
+		gomock.Any(),
+	).DoAndReturn(func(ctx context.Context, req *ethpb.BlockRequest) (*ethpb.BeaconBlock, error) {
+		blockRequest = req
+		return nil, errors.New("uh oh")
+	})
+
+	validator.ProposeBlock(context.Background(), 1, validatorPubKey)
+
+	if string(blockRequest.Graffiti) != "specific" {
+		t.Errorf("Block was requested with the wrong graffiti, wanted %q, got %q", "specific", blockRequest.Graffiti)
+	}
+}
+
 func TestSetProposedForEpoch_SetsBit(t *testing.T) {
 	wsPeriod := params.BeaconConfig().WeakSubjectivityPeriod
 	proposals := &slashpb.ProposalHistory{
@@ -563,6 +563,64 @@ func TestUpdateDuties_OK(t *testing.T) {
 	}
 }
 
+func TestUpdateDuties_SubscribesToSubnets(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := internal.NewMockBeaconNodeValidatorClient(ctrl)
+	subnetsClient := internal.NewMockValidatorSubnetsClient(ctrl)
+
+	slot := params.BeaconConfig().SlotsPerEpoch
+	resp := &ethpb.DutiesResponse{
+		Duties: []*ethpb.DutiesResponse_Duty{
+			{
+				AttesterSlot:   slot + 2,
+				CommitteeIndex: 3,
+				Committee:      []uint64{0, 1, 2, 3},
+				PublicKey:      validatorPubKey[:],
+				Status:         ethpb.ValidatorStatus_ACTIVE,
+			},
+			{
+				AttesterSlot:   slot + 5,
+				CommitteeIndex: 1,
+				Committee:      []uint64{4, 5},
+				PublicKey:      validatorPubKey[:],
+				Status:         ethpb.ValidatorStatus_EXITING,
+			},
+			{
+				PublicKey: []byte("pendingPubKey"),
+				Status:    ethpb.ValidatorStatus_PENDING,
+			},
+		},
+	}
+	v := validator{
+		keyManager:      testKeyManager,
+		validatorClient: client,
+		subnetsClient:   subnetsClient,
+	}
+	client.EXPECT().GetDuties(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(resp, nil)
+	client.EXPECT().DomainData(
+		gomock.Any(), // ctx
+		gomock.Any(), // epoch
+	).Times(2).Return(&ethpb.DomainResponse{}, nil /*err*/)
+	// Every member of a committee smaller than the target number of aggregators aggregates.
+	// Exiting validators still attest, pending ones do not.
+	subnetsClient.EXPECT().SubscribeCommitteeSubnets(
+		gomock.Any(),
+		&pb.CommitteeSubnetsSubscribeRequest{
+			Slots:        []uint64{slot + 2, slot + 5},
+			CommitteeIds: []uint64{3, 1},
+			IsAggregator: []bool{true, true},
+		},
+	).Return(&ptypes.Empty{}, nil)
+
+	if err := v.UpdateDuties(context.Background(), slot); err != nil {
+		t.Fatalf("Could not update assignments: %v", err)
+	}
+}
+
 func TestRolesAt_OK(t *testing.T) {
 	v, m, finish := setup(t)
 	defer finish()
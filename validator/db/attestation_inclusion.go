@@ -0,0 +1,66 @@
+package db
+
+import (
+	"context"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+func unmarshalAttestationInclusion(enc []byte) (*slashpb.AttestationInclusion, error) {
+	inclusion := &slashpb.AttestationInclusion{}
+	err := proto.Unmarshal(enc, inclusion)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal encoding")
+	}
+	return inclusion, nil
+}
+
+// AttestationInclusion accepts a validator public key and a slot and returns the recorded inclusion
+// of the attestation submitted by the validator at the slot.
+// Returns nil if there is no inclusion recorded for the validator at the slot.
+func (db *Store) AttestationInclusion(ctx context.Context, publicKey []byte, slot uint64) (*slashpb.AttestationInclusion, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.AttestationInclusion")
+	defer span.End()
+
+	var err error
+	var attestationInclusion *slashpb.AttestationInclusion
+	err = db.view(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(attestationInclusionsBucket).Bucket(publicKey)
+		if bucket == nil {
+			return nil
+		}
+		enc := bucket.Get(bytesutil.Bytes8(slot))
+		if enc == nil {
+			return nil
+		}
+		attestationInclusion, err = unmarshalAttestationInclusion(enc)
+		return err
+	})
+	return attestationInclusion, err
+}
+
+// SaveAttestationInclusion saves the inclusion of an attestation submitted by the validator of the
+// given public key, indexed by the slot of the attestation.
+func (db *Store) SaveAttestationInclusion(ctx context.Context, pubKey []byte, attestationInclusion *slashpb.AttestationInclusion) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.SaveAttestationInclusion")
+	defer span.End()
+
+	enc, err := proto.Marshal(attestationInclusion)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode attestation inclusion")
+	}
+
+	err = db.update(func(tx *bolt.Tx) error {
+		bucket, err := tx.Bucket(attestationInclusionsBucket).CreateBucketIfNotExists(pubKey)
+		if err != nil {
+			return err
+		}
+		return bucket.Put(bytesutil.Bytes8(attestationInclusion.Slot), enc)
+	})
+	return err
+}
@@ -0,0 +1,66 @@
+package db
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
+)
+
+func TestAttestationInclusion_NilDB(t *testing.T) {
+	db := SetupDB(t, [][48]byte{})
+	defer TeardownDB(t, db)
+
+	attestationInclusion, err := db.AttestationInclusion(context.Background(), []byte{1, 2, 3}, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if attestationInclusion != nil {
+		t.Fatalf("Expected attestation inclusion to be nil, received: %v", attestationInclusion)
+	}
+}
+
+func TestSaveAttestationInclusion_OK(t *testing.T) {
+	db := SetupDB(t, [][48]byte{})
+	defer TeardownDB(t, db)
+
+	pubkey := []byte{3}
+	included := &slashpb.AttestationInclusion{
+		Slot:              10,
+		DataRoot:          []byte{'A'},
+		InclusionSlot:     11,
+		InclusionDistance: 1,
+		CorrectHead:       true,
+		CorrectTarget:     true,
+	}
+	missed := &slashpb.AttestationInclusion{
+		Slot:     12,
+		DataRoot: []byte{'B'},
+	}
+	for _, inclusion := range []*slashpb.AttestationInclusion{included, missed} {
+		if err := db.SaveAttestationInclusion(context.Background(), pubkey, inclusion); err != nil {
+			t.Fatalf("Saving attestation inclusion failed: %v", err)
+		}
+	}
+
+	for _, want := range []*slashpb.AttestationInclusion{included, missed} {
+		savedInclusion, err := db.AttestationInclusion(context.Background(), pubkey, want.Slot)
+		if err != nil {
+			t.Fatalf("Failed to get attestation inclusion: %v", err)
+		}
+		if !reflect.DeepEqual(savedInclusion, want) {
+			t.Fatalf("Expected DB to keep object the same, received: %v", savedInclusion)
+		}
+	}
+
+	// Other validators do not share the inclusions.
+	savedInclusion, err := db.AttestationInclusion(context.Background(), []byte{4}, included.Slot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if savedInclusion != nil {
+		t.Fatalf("Expected attestation inclusion to be nil, received: %v", savedInclusion)
+	}
+}
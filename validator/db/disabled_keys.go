@@ -0,0 +1,39 @@
+package db
+
+import (
+	"context"
+
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// DisabledKeys returns the public keys whose duties were disabled through the management API.
+func (db *Store) DisabledKeys(ctx context.Context) ([][48]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.DisabledKeys")
+	defer span.End()
+
+	var keys [][48]byte
+	err := db.view(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(disabledKeysBucket)
+		return bucket.ForEach(func(k, _ []byte) error {
+			keys = append(keys, bytesutil.ToBytes48(k))
+			return nil
+		})
+	})
+	return keys, err
+}
+
+// SetKeyDisabled records whether the duties of the validator public key are disabled.
+func (db *Store) SetKeyDisabled(ctx context.Context, pubKey []byte, disabled bool) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.SetKeyDisabled")
+	defer span.End()
+
+	return db.update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(disabledKeysBucket)
+		if !disabled {
+			return bucket.Delete(pubKey)
+		}
+		return bucket.Put(pubKey, []byte{1})
+	})
+}
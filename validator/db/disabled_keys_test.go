@@ -0,0 +1,42 @@
+package db
+
+import (
+	"context"
+	"testing"
+)
+
+func TestDisabledKeys_NilDB(t *testing.T) {
+	db := SetupDB(t, [][48]byte{})
+	defer TeardownDB(t, db)
+
+	keys, err := db.DisabledKeys(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 0 {
+		t.Fatalf("Expected no disabled keys, received: %v", keys)
+	}
+}
+
+func TestSetKeyDisabled_OK(t *testing.T) {
+	db := SetupDB(t, [][48]byte{})
+	defer TeardownDB(t, db)
+
+	first, second := [48]byte{1}, [48]byte{2}
+	for _, pubKey := range [][48]byte{first, second} {
+		if err := db.SetKeyDisabled(context.Background(), pubKey[:], true); err != nil {
+			t.Fatalf("Disabling key failed: %v", err)
+		}
+	}
+	if err := db.SetKeyDisabled(context.Background(), first[:], false); err != nil {
+		t.Fatalf("Enabling key failed: %v", err)
+	}
+
+	keys, err := db.DisabledKeys(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 1 || keys[0] != second {
+		t.Errorf("Expected only %#x to be disabled, received: %v", second, keys)
+	}
+}
@@ -13,6 +13,7 @@ type ValidatorDB interface {
 	io.Closer
 	DatabasePath() string
 	ClearDB() error
+	InitializeHistory(ctx context.Context, pubkeys [][48]byte) error
 	// Proposer protection related methods.
 	ProposalHistory(ctx context.Context, publicKey []byte) (*slashpb.ProposalHistory, error)
 	SaveProposalHistory(ctx context.Context, publicKey []byte, history *slashpb.ProposalHistory) error
@@ -21,4 +22,10 @@ type ValidatorDB interface {
 	AttestationHistory(ctx context.Context, publicKey []byte) (*slashpb.AttestationHistory, error)
 	SaveAttestationHistory(ctx context.Context, publicKey []byte, history *slashpb.AttestationHistory) error
 	DeleteAttestationHistory(ctx context.Context, publicKey []byte) error
+	// Attestation inclusion related methods.
+	AttestationInclusion(ctx context.Context, publicKey []byte, slot uint64) (*slashpb.AttestationInclusion, error)
+	SaveAttestationInclusion(ctx context.Context, publicKey []byte, inclusion *slashpb.AttestationInclusion) error
+	// Key management related methods.
+	DisabledKeys(ctx context.Context) ([][48]byte, error)
+	SetKeyDisabled(ctx context.Context, publicKey []byte, disabled bool) error
 }
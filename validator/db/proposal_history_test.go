@@ -30,6 +30,42 @@ func TestProposalHistory_InitializesNewPubKeys(t *testing.T) {
 	}
 }
 
+func TestInitializeHistory_KeepsExistingHistory(t *testing.T) {
+	existingKey, addedKey := [48]byte{30}, [48]byte{25}
+	db := SetupDB(t, [][48]byte{existingKey})
+	defer TeardownDB(t, db)
+	ctx := context.Background()
+
+	history := &slashpb.ProposalHistory{
+		EpochBits: bitfield.NewBitlist(params.BeaconConfig().WeakSubjectivityPeriod),
+	}
+	history.EpochBits.SetBitAt(1, true)
+	if err := db.SaveProposalHistory(ctx, existingKey[:], history); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := db.InitializeHistory(ctx, [][48]byte{existingKey, addedKey}); err != nil {
+		t.Fatal(err)
+	}
+	existing, err := db.ProposalHistory(ctx, existingKey[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !existing.EpochBits.BitAt(1) {
+		t.Error("Expected the existing proposal history to be kept")
+	}
+	added, err := db.ProposalHistory(ctx, addedKey[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	clean := &slashpb.ProposalHistory{
+		EpochBits: bitfield.NewBitlist(params.BeaconConfig().WeakSubjectivityPeriod),
+	}
+	if !reflect.DeepEqual(added, clean) {
+		t.Errorf("Expected proposal history of added key to be empty, received %v", added)
+	}
+}
+
 func TestProposalHistory_NilDB(t *testing.T) {
 	db := SetupDB(t, [][48]byte{})
 	defer TeardownDB(t, db)
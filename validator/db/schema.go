@@ -5,4 +5,8 @@ var (
 	historicProposalsBucket = []byte("proposal-history-bucket")
 	// Validator slashing protection from slashable attestations.
 	historicAttestationsBucket = []byte("attestation-history-bucket")
+	// Inclusion in the canonical chain of the attestations submitted by the validators.
+	attestationInclusionsBucket = []byte("attestation-inclusions-bucket")
+	// Keys whose duties were disabled through the management API.
+	disabledKeysBucket = []byte("disabled-keys-bucket")
 )
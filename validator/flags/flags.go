@@ -60,6 +60,13 @@ var (
 		Name:  "graffiti",
 		Usage: "String to include in proposed blocks",
 	}
+	// GraffitiFileFlag defines the path to a YAML file of graffiti included in proposed blocks.
+	GraffitiFileFlag = &cli.StringFlag{
+		Name: "graffiti-file",
+		Usage: "Path to a YAML file with a default graffiti, per public key graffiti and ordered or random " +
+			"graffiti lists to include in proposed blocks. The file is reloaded when it changes, and takes " +
+			"precedence over --graffiti",
+	}
 	// GrpcMaxCallRecvMsgSizeFlag defines the max call message size for GRPC
 	GrpcMaxCallRecvMsgSizeFlag = &cli.IntFlag{
 		Name:  "grpc-max-msg-size",
@@ -82,4 +89,28 @@ var (
 		Name:  "enable-account-metrics",
 		Usage: "Enable prometheus metrics for validator accounts",
 	}
+	// EnableRPCFlag enables the management API of the validator client.
+	EnableRPCFlag = &cli.BoolFlag{
+		Name: "enable-rpc",
+		Usage: "Serve the management API of the validator client over gRPC and JSON-HTTP. Requests " +
+			"must carry the token written to the auth-token file of the data directory",
+	}
+	// RPCHost defines the host on which the management API listens.
+	RPCHost = &cli.StringFlag{
+		Name:  "rpc-host",
+		Usage: "Host on which the management API of the validator client listens",
+		Value: "127.0.0.1",
+	}
+	// RPCPort defines the port on which the management API listens over gRPC.
+	RPCPort = &cli.IntFlag{
+		Name:  "rpc-port",
+		Usage: "Port on which the management API of the validator client listens over gRPC",
+		Value: 7000,
+	}
+	// GRPCGatewayPort enables the JSON-HTTP gateway of the management API.
+	GRPCGatewayPort = &cli.IntFlag{
+		Name:  "grpc-gateway-port",
+		Usage: "Port on which the management API of the validator client serves JSON-HTTP, 0 disables it",
+		Value: 7500,
+	}
 )
@@ -0,0 +1,168 @@
+// Package graffiti selects the graffiti of the blocks proposed by the validators of the
+// client from a graffiti file. The file is a YAML document such as:
+//
+//	default: "Prysm"
+//	ordered:
+//	  - "first"
+//	  - "second"
+//	random:
+//	  - "heads"
+//	  - "tails"
+//	specific:
+//	  "0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c": "my key"
+//
+// The graffiti of a proposal is the specific graffiti of the validator public key if there
+// is one. Otherwise, it is the next entry of the ordered list, which is rotated through
+// in order, or else a random entry of the random list, or else the default graffiti.
+// The file is read again whenever it changes on disk.
+package graffiti
+
+import (
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ghodss/yaml"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("prefix", "graffiti")
+
+// maxGraffitiLength is the size of the graffiti field of a beacon block body.
+const maxGraffitiLength = 32
+
+// Sources of the graffiti of a proposal, reported in the logs and metrics of the client.
+const (
+	SourceSpecific = "specific"
+	SourceOrdered  = "ordered"
+	SourceRandom   = "random"
+	SourceDefault  = "default"
+	SourceFlag     = "flag"
+)
+
+// Graffiti is the content of a graffiti file.
+type Graffiti struct {
+	Default  string            `json:"default"`
+	Ordered  []string          `json:"ordered"`
+	Random   []string          `json:"random"`
+	Specific map[string]string `json:"specific"`
+}
+
+// Source hands out the graffiti of the proposals of the validators from a graffiti file.
+// The fallback graffiti is used when the file has no graffiti for a proposal.
+type Source struct {
+	path         string
+	fallback     []byte
+	lock         sync.Mutex
+	graffiti     *Graffiti
+	modTime      time.Time
+	orderedIndex int
+}
+
+// NewSource reads the graffiti file at the given path. An error is returned if the file
+// cannot be read or is invalid.
+func NewSource(path string, fallback []byte) (*Source, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not stat graffiti file")
+	}
+	g, err := readFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return &Source{
+		path:     path,
+		fallback: fallback,
+		graffiti: g,
+		modTime:  info.ModTime(),
+	}, nil
+}
+
+// Graffiti returns the graffiti of the next proposal of the validator with the given public
+// key, along with the source it was selected from.
+func (s *Source) Graffiti(pubKey [48]byte) ([]byte, string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.reloadIfChanged()
+
+	if g, ok := s.graffiti.Specific[fmt.Sprintf("%#x", pubKey)]; ok {
+		return []byte(g), SourceSpecific
+	}
+	if len(s.graffiti.Ordered) > 0 {
+		g := s.graffiti.Ordered[s.orderedIndex%len(s.graffiti.Ordered)]
+		s.orderedIndex = (s.orderedIndex + 1) % len(s.graffiti.Ordered)
+		return []byte(g), SourceOrdered
+	}
+	if len(s.graffiti.Random) > 0 {
+		return []byte(s.graffiti.Random[rand.Intn(len(s.graffiti.Random))]), SourceRandom
+	}
+	if s.graffiti.Default != "" {
+		return []byte(s.graffiti.Default), SourceDefault
+	}
+	return s.fallback, SourceFlag
+}
+
+// reloadIfChanged reads the graffiti file again if it was modified since it was last read.
+// The previous graffiti are kept if the file cannot be read. The lock must be held.
+func (s *Source) reloadIfChanged() {
+	info, err := os.Stat(s.path)
+	if err != nil {
+		log.WithError(err).Error("Could not stat graffiti file, keeping previous graffiti")
+		return
+	}
+	if info.ModTime().Equal(s.modTime) {
+		return
+	}
+	g, err := readFile(s.path)
+	if err != nil {
+		log.WithError(err).Error("Could not reload graffiti file, keeping previous graffiti")
+		return
+	}
+	s.graffiti = g
+	s.modTime = info.ModTime()
+	s.orderedIndex = 0
+	log.WithField("path", s.path).Info("Reloaded graffiti file")
+}
+
+// readFile reads and validates a graffiti file.
+func readFile(path string) (*Graffiti, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read graffiti file")
+	}
+	g := &Graffiti{}
+	if err := yaml.Unmarshal(data, g); err != nil {
+		return nil, errors.Wrap(err, "could not parse graffiti file")
+	}
+	if err := g.validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid graffiti file")
+	}
+	return g, nil
+}
+
+func (g *Graffiti) validate() error {
+	all := append([]string{g.Default}, g.Ordered...)
+	all = append(all, g.Random...)
+	specific := make(map[string]string, len(g.Specific))
+	for key, value := range g.Specific {
+		// Public keys are looked up in lower case hex.
+		key = strings.ToLower(key)
+		if !strings.HasPrefix(key, "0x") || len(key) != 2+2*48 {
+			return fmt.Errorf("%q is not a 0x prefixed hex encoded public key", key)
+		}
+		specific[key] = value
+		all = append(all, value)
+	}
+	g.Specific = specific
+	for _, value := range all {
+		if len(value) > maxGraffitiLength {
+			return fmt.Errorf("graffiti %q is longer than %d bytes", value, maxGraffitiLength)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,166 @@
+package graffiti
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+var (
+	pubKey1 = [48]byte{1}
+	pubKey2 = [48]byte{2}
+)
+
+func writeGraffitiFile(t *testing.T, dir string, content string) string {
+	path := filepath.Join(dir, "graffiti.yaml")
+	if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func tempDir(t *testing.T) (string, func()) {
+	dir, err := ioutil.TempDir("", "graffiti")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return dir, func() {
+		if err := os.RemoveAll(dir); err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+func TestSource_Precedence(t *testing.T) {
+	dir, cleanup := tempDir(t)
+	defer cleanup()
+	path := writeGraffitiFile(t, dir, fmt.Sprintf(`
+default: "default"
+ordered:
+  - "first"
+  - "second"
+random:
+  - "random"
+specific:
+  "%#x": "specific"
+`, pubKey1))
+	s, err := NewSource(path, []byte("flag"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if g, source := s.Graffiti(pubKey1); string(g) != "specific" || source != SourceSpecific {
+		t.Errorf("Wanted specific graffiti, got %q from %s", g, source)
+	}
+	for _, want := range []string{"first", "second", "first"} {
+		if g, source := s.Graffiti(pubKey2); string(g) != want || source != SourceOrdered {
+			t.Errorf("Wanted ordered graffiti %q, got %q from %s", want, g, source)
+		}
+	}
+}
+
+func TestSource_RandomDefaultAndFallback(t *testing.T) {
+	dir, cleanup := tempDir(t)
+	defer cleanup()
+
+	path := writeGraffitiFile(t, dir, "random:\n  - \"heads\"\n  - \"tails\"\n")
+	s, err := NewSource(path, []byte("flag"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 10; i++ {
+		g, source := s.Graffiti(pubKey1)
+		if (string(g) != "heads" && string(g) != "tails") || source != SourceRandom {
+			t.Errorf("Wanted random graffiti, got %q from %s", g, source)
+		}
+	}
+
+	path = writeGraffitiFile(t, dir, "default: \"default\"\n")
+	s, err = NewSource(path, []byte("flag"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g, source := s.Graffiti(pubKey1); string(g) != "default" || source != SourceDefault {
+		t.Errorf("Wanted default graffiti, got %q from %s", g, source)
+	}
+
+	path = writeGraffitiFile(t, dir, "ordered: []\n")
+	s, err = NewSource(path, []byte("flag"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g, source := s.Graffiti(pubKey1); string(g) != "flag" || source != SourceFlag {
+		t.Errorf("Wanted flag graffiti, got %q from %s", g, source)
+	}
+}
+
+func TestSource_ReloadsOnChange(t *testing.T) {
+	dir, cleanup := tempDir(t)
+	defer cleanup()
+	path := writeGraffitiFile(t, dir, "default: \"before\"\n")
+	s, err := NewSource(path, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g, _ := s.Graffiti(pubKey1); string(g) != "before" {
+		t.Errorf("Wanted graffiti %q, got %q", "before", g)
+	}
+
+	writeGraffitiFile(t, dir, "default: \"after\"\n")
+	later := time.Now().Add(time.Minute)
+	if err := os.Chtimes(path, later, later); err != nil {
+		t.Fatal(err)
+	}
+	if g, _ := s.Graffiti(pubKey1); string(g) != "after" {
+		t.Errorf("Wanted reloaded graffiti %q, got %q", "after", g)
+	}
+
+	// An invalid file keeps the previous graffiti.
+	writeGraffitiFile(t, dir, "default: [")
+	later = later.Add(time.Minute)
+	if err := os.Chtimes(path, later, later); err != nil {
+		t.Fatal(err)
+	}
+	if g, _ := s.Graffiti(pubKey1); string(g) != "after" {
+		t.Errorf("Wanted previous graffiti %q, got %q", "after", g)
+	}
+}
+
+func TestNewSource_InvalidFile(t *testing.T) {
+	dir, cleanup := tempDir(t)
+	defer cleanup()
+
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{
+			name:    "too long",
+			content: fmt.Sprintf("default: %q\n", strings.Repeat("a", 33)),
+			wantErr: "longer than 32 bytes",
+		},
+		{
+			name:    "bad public key",
+			content: "specific:\n  \"0x1234\": \"short\"\n",
+			wantErr: "not a 0x prefixed hex encoded public key",
+		},
+		{
+			name:    "bad yaml",
+			content: "ordered: {",
+			wantErr: "could not parse graffiti file",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := writeGraffitiFile(t, dir, tt.content)
+			if _, err := NewSource(path, nil); err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Wanted error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
@@ -1,15 +1,22 @@
 package keymanager
 
 import (
+	"encoding/hex"
 	"encoding/json"
+	"io/ioutil"
 	"os"
 	"os/user"
 	"path/filepath"
 	"runtime"
 	"strings"
+	"sync"
+	"time"
 
+	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/keystore"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/validator/accounts"
 	"golang.org/x/crypto/ssh/terminal"
 )
@@ -17,6 +24,19 @@ import (
 // Keystore is a key manager that loads keys from a standard keystore.
 type Keystore struct {
 	*Direct
+	path        string
+	passphrase  string
+	reloadLock  sync.Mutex
+	fingerprint [32]byte
+	// Key to the map is the name of the file in the keystore.
+	files map[string]*keystoreFile
+}
+
+// keystoreFile is a file of the keystore as it was when its key was last decrypted.
+type keystoreFile struct {
+	size    int64
+	modTime time.Time
+	key     *keystore.Key
 }
 
 type keystoreOpts struct {
@@ -75,23 +95,71 @@ func NewKeystore(input string) (KeyManager, string, error) {
 		}
 	}
 
-	keyMap, err := accounts.DecryptKeysFromKeystore(opts.Path, opts.Passphrase)
-	if err != nil {
+	km := &Keystore{
+		Direct:     &Direct{},
+		path:       opts.Path,
+		passphrase: opts.Passphrase,
+	}
+	if err := km.ReloadValidatingKeys(); err != nil {
 		return nil, keystoreOptsHelp, err
 	}
+	return km, "", nil
+}
 
-	km := &Unencrypted{
-		Direct: &Direct{
-			publicKeys: make(map[[48]byte]*bls.PublicKey),
-			secretKeys: make(map[[48]byte]*bls.SecretKey),
-		},
+// ReloadValidatingKeys reads the keys of the keystore again if its files changed since
+// they were last read. Only the files which were added or changed since are decrypted, as
+// decrypting a key is deliberately expensive.
+func (km *Keystore) ReloadValidatingKeys() error {
+	km.reloadLock.Lock()
+	defer km.reloadLock.Unlock()
+	fingerprint, err := sourceFingerprint(km.path)
+	if err != nil {
+		return err
 	}
-	for _, key := range keyMap {
-		pubKey := bytesutil.ToBytes48(key.PublicKey.Marshal())
-		km.publicKeys[pubKey] = key.PublicKey
-		km.secretKeys[pubKey] = key.SecretKey
+	if fingerprint == km.fingerprint {
+		return nil
 	}
-	return km, "", nil
+	infos, err := ioutil.ReadDir(km.path)
+	if err != nil {
+		return err
+	}
+	prefix := strings.TrimPrefix(params.BeaconConfig().ValidatorPrivkeyFileName, "/")
+	files := make(map[string]*keystoreFile)
+	for _, info := range infos {
+		if !info.Mode().IsRegular() || !strings.Contains(info.Name(), prefix) {
+			continue
+		}
+		if f, ok := km.files[info.Name()]; ok && f.size == info.Size() && f.modTime.Equal(info.ModTime()) {
+			files[info.Name()] = f
+			continue
+		}
+		// #nosec G304
+		keyJSON, err := ioutil.ReadFile(filepath.Join(km.path, info.Name()))
+		if err != nil {
+			return err
+		}
+		f := &keystoreFile{size: info.Size(), modTime: info.ModTime()}
+		f.key, err = keystore.DecryptKey(keyJSON, km.passphrase)
+		if err != nil {
+			// The file is not decrypted again until it changes.
+			log.WithError(err).WithField("file", info.Name()).Warn("Failed to decrypt key")
+		}
+		files[info.Name()] = f
+	}
+	publicKeys := make(map[[48]byte]*bls.PublicKey)
+	secretKeys := make(map[[48]byte]*bls.SecretKey)
+	for _, f := range files {
+		if f.key == nil {
+			continue
+		}
+		pubKey := bytesutil.ToBytes48(f.key.PublicKey.Marshal())
+		publicKeys[pubKey] = f.key.PublicKey
+		secretKeys[pubKey] = f.key.SecretKey
+	}
+	km.setKeys(publicKeys, secretKeys)
+	km.files = files
+	km.fingerprint = fingerprint
+	return nil
 }
 
 func homeDir() string {
@@ -119,3 +187,57 @@ func defaultValidatorDir() string {
 	// As we cannot guess a stable location, return empty and handle later
 	return ""
 }
+
+// ImportKeystore decrypts the keystore with the password, and stores its key in the
+// keystore of the key manager, encrypted with the passphrase of the key manager.
+func (km *Keystore) ImportKeystore(keystoreJSON []byte, password string) ([48]byte, error) {
+	key, err := keystore.DecryptKey(keystoreJSON, password)
+	if err != nil {
+		return [48]byte{}, errors.Wrap(err, "could not decrypt keystore")
+	}
+	pubKey := bytesutil.ToBytes48(key.PublicKey.Marshal())
+	file := km.path + params.BeaconConfig().ValidatorPrivkeyFileName + hex.EncodeToString(pubKey[:])[:12]
+	if err := keystore.NewKeystore(km.path).StoreKey(file, key, km.passphrase); err != nil {
+		return [48]byte{}, errors.Wrap(err, "could not store key")
+	}
+	return pubKey, km.ReloadValidatingKeys()
+}
+
+// DeleteKey removes the files of the keystore which hold the key.
+func (km *Keystore) DeleteKey(pubKey [48]byte) error {
+	files, err := ioutil.ReadDir(km.path)
+	if err != nil {
+		return err
+	}
+	prefix := strings.TrimPrefix(params.BeaconConfig().ValidatorPrivkeyFileName, "/")
+	deleted := false
+	for _, f := range files {
+		if !f.Mode().IsRegular() || !strings.Contains(f.Name(), prefix) {
+			continue
+		}
+		file := filepath.Join(km.path, f.Name())
+		// #nosec G304
+		keyJSON, err := ioutil.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		// The public key of the keystore is stored in the clear.
+		header := &struct {
+			PublicKey string `json:"publickey"`
+		}{}
+		if err := json.Unmarshal(keyJSON, header); err != nil {
+			continue
+		}
+		if header.PublicKey != hex.EncodeToString(pubKey[:]) {
+			continue
+		}
+		if err := os.Remove(file); err != nil {
+			return err
+		}
+		deleted = true
+	}
+	if !deleted {
+		return ErrNoSuchKey
+	}
+	return km.ReloadValidatingKeys()
+}
@@ -0,0 +1,138 @@
+package keymanager_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/keystore"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
+)
+
+func TestKeystoreImportAndDeleteKey(t *testing.T) {
+	path, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(path)
+	// A keystore without keys, the key manager creates an account in an empty directory.
+	if err := ioutil.WriteFile(filepath.Join(path, "README"), []byte{}, 0600); err != nil {
+		t.Fatal(err)
+	}
+	km, _, err := keymanager.NewKeystore(fmt.Sprintf(`{"path":%q,"passphrase":"secret"}`, path))
+	if err != nil {
+		t.Fatal(err)
+	}
+	storing, ok := km.(keymanager.StoringKeyManager)
+	if !ok {
+		t.Fatal("Keystore key manager does not store keys")
+	}
+
+	key, err := keystore.NewKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	keystoreJSON, err := keystore.EncryptKey(key, "password", keystore.LightScryptN, keystore.LightScryptP)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := storing.ImportKeystore(keystoreJSON, "wrong"); err == nil {
+		t.Error("Expected import with the wrong password to fail")
+	}
+	pubKey, err := storing.ImportKeystore(keystoreJSON, "password")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pubKey != bytesutil.ToBytes48(key.PublicKey.Marshal()) {
+		t.Errorf("Imported public key %#x, expected %#x", pubKey, key.PublicKey.Marshal())
+	}
+	keys, err := km.FetchValidatingKeys()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 1 || keys[0] != pubKey {
+		t.Fatalf("Expected the imported key to be validated with, received %v", keys)
+	}
+
+	if err := storing.DeleteKey(pubKey); err != nil {
+		t.Fatal(err)
+	}
+	keys, err = km.FetchValidatingKeys()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 0 {
+		t.Errorf("Expected no keys after deletion, received %d", len(keys))
+	}
+	if err := storing.DeleteKey(pubKey); err != keymanager.ErrNoSuchKey {
+		t.Errorf("Expected %v when deleting a deleted key, received %v", keymanager.ErrNoSuchKey, err)
+	}
+}
+
+func TestKeystoreReloadDecryptsChangedFilesOnly(t *testing.T) {
+	path, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(path)
+	if err := ioutil.WriteFile(filepath.Join(path, "README"), []byte{}, 0600); err != nil {
+		t.Fatal(err)
+	}
+	km, _, err := keymanager.NewKeystore(fmt.Sprintf(`{"path":%q,"passphrase":"secret"}`, path))
+	if err != nil {
+		t.Fatal(err)
+	}
+	storing := km.(keymanager.StoringKeyManager)
+	importKey := func() [48]byte {
+		key, err := keystore.NewKey()
+		if err != nil {
+			t.Fatal(err)
+		}
+		keystoreJSON, err := keystore.EncryptKey(key, "password", keystore.LightScryptN, keystore.LightScryptP)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pubKey, err := storing.ImportKeystore(keystoreJSON, "password")
+		if err != nil {
+			t.Fatal(err)
+		}
+		return pubKey
+	}
+
+	first := importKey()
+	files, err := filepath.Glob(filepath.Join(path, "validatorprivatekey*"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 1 {
+		t.Fatalf("Expected 1 key file, found %d", len(files))
+	}
+	// Garble the file of the first key without changing its size or modification time, the
+	// key is only kept if the file is not decrypted again.
+	info, err := os.Stat(files[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(files[0], make([]byte, info.Size()), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(files[0], info.ModTime(), info.ModTime()); err != nil {
+		t.Fatal(err)
+	}
+
+	second := importKey()
+	keys, err := km.FetchValidatingKeys()
+	if err != nil {
+		t.Fatal(err)
+	}
+	found := make(map[[48]byte]bool)
+	for _, key := range keys {
+		found[key] = true
+	}
+	if len(keys) != 2 || !found[first] || !found[second] {
+		t.Errorf("Expected keys %#x and %#x after reload, received %v", first, second, keys)
+	}
+}
@@ -24,6 +24,26 @@ type KeyManager interface {
 	Sign(pubKey [48]byte, root [32]byte, domain uint64) (*bls.Signature, error)
 }
 
+// ReloadableKeyManager is a key manager whose key source may change while the validator runs.
+type ReloadableKeyManager interface {
+	KeyManager
+	// ReloadValidatingKeys reads the keys from the key source again if it changed since it was
+	// last read. Keys added to the source are validated with from then on, and keys removed
+	// from it are no longer available.
+	ReloadValidatingKeys() error
+}
+
+// StoringKeyManager is a key manager which stores keys that can be imported and deleted
+// while the validator runs.
+type StoringKeyManager interface {
+	KeyManager
+	// ImportKeystore decrypts the JSON encoded keystore with the password and stores its key,
+	// which is validated with from then on. It returns the public key of the keystore.
+	ImportKeystore(keystoreJSON []byte, password string) ([48]byte, error)
+	// DeleteKey deletes the key from the store, it is no longer validated with.
+	DeleteKey(pubKey [48]byte) error
+}
+
 // ProtectingKeyManager provides access to a keymanager that protects its clients from slashing events.
 type ProtectingKeyManager interface {
 	// SignProposal signs a block proposal for the validator to broadcast.
@@ -0,0 +1,28 @@
+package keymanager
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// sourceFingerprint returns a digest of the names, sizes and modification times of the files
+// under the path, which changes whenever a key is added to or removed from a key source
+// stored on disk. It is much cheaper than decrypting the keys of the source.
+func sourceFingerprint(path string) ([32]byte, error) {
+	h := sha256.New()
+	err := filepath.Walk(path, func(file string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(h, "%s %d %d\n", file, info.Size(), info.ModTime().UnixNano())
+		return err
+	})
+	if err != nil {
+		return [32]byte{}, err
+	}
+	var fingerprint [32]byte
+	copy(fingerprint[:], h.Sum(nil))
+	return fingerprint, nil
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+	"sync"
 
 	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
@@ -56,27 +57,69 @@ func NewWallet(input string) (KeyManager, string, error) {
 		return nil, walletOptsHelp, errors.New("at least one passphrase is required to decrypt accounts")
 	}
 
-	km := &Wallet{
-		accounts: make(map[[48]byte]e2wtypes.Account),
-	}
-
 	if strings.Contains(opts.Location, "$") || strings.Contains(opts.Location, "~") || strings.Contains(opts.Location, "%") {
 		log.WithField("path", opts.Location).Warn("Keystore path contains unexpanded shell expansion characters")
 	}
+	for _, path := range opts.Accounts {
+		if len(strings.Split(path, "/")[0]) == 0 {
+			return nil, walletOptsHelp, fmt.Errorf("did not understand account specifier %q", path)
+		}
+	}
+
+	km := &Wallet{
+		opts: opts,
+	}
+	if err := km.ReloadValidatingKeys(); err != nil {
+		return nil, walletOptsHelp, err
+	}
+	return km, walletOptsHelp, nil
+}
+
+// Wallet is a key manager that loads keys from a local Ethereum 2 wallet.
+type Wallet struct {
+	opts        *walletOpts
+	fingerprint [32]byte
+	lock        sync.RWMutex
+	accounts    map[[48]byte]e2wtypes.Account
+}
+
+// locatedStore is a wallet store which knows the directory it stores its wallets in.
+type locatedStore interface {
+	Location() string
+}
+
+// ReloadValidatingKeys opens the accounts of the wallets again if the wallets changed since
+// they were last opened.
+func (km *Wallet) ReloadValidatingKeys() error {
 	var store e2wtypes.Store
-	if opts.Location == "" {
+	if km.opts.Location == "" {
 		store = filesystem.New()
 	} else {
-		store = filesystem.New(filesystem.WithLocation(opts.Location))
+		store = filesystem.New(filesystem.WithLocation(km.opts.Location))
 	}
-	for _, path := range opts.Accounts {
-		parts := strings.Split(path, "/")
-		if len(parts[0]) == 0 {
-			return nil, walletOptsHelp, fmt.Errorf("did not understand account specifier %q", path)
+	// The default location of the store depends on the operating system, it is resolved by
+	// the store itself.
+	location := km.opts.Location
+	if s, ok := store.(locatedStore); ok {
+		location = s.Location()
+	}
+	var fingerprint [32]byte
+	if location != "" {
+		var err error
+		fingerprint, err = sourceFingerprint(location)
+		if err != nil {
+			return err
 		}
+	}
+	if km.accounts != nil && fingerprint == km.fingerprint {
+		return nil
+	}
+	accounts := make(map[[48]byte]e2wtypes.Account)
+	for _, path := range km.opts.Accounts {
+		parts := strings.Split(path, "/")
 		wallet, err := e2wallet.OpenWallet(parts[0], e2wallet.WithStore(store))
 		if err != nil {
-			return nil, walletOptsHelp, err
+			return err
 		}
 		accountSpecifier := "^.*$"
 		if len(parts) > 1 && len(parts[1]) > 0 {
@@ -88,11 +131,11 @@ func NewWallet(input string) (KeyManager, string, error) {
 			if re.Match([]byte(account.Name())) {
 				pubKey := bytesutil.ToBytes48(account.PublicKey().Marshal())
 				unlocked := false
-				for _, passphrase := range opts.Passphrases {
+				for _, passphrase := range km.opts.Passphrases {
 					if err := account.Unlock([]byte(passphrase)); err != nil {
 						log.WithError(err).Trace("Failed to unlock account with one of the supplied passphrases")
 					} else {
-						km.accounts[pubKey] = account
+						accounts[pubKey] = account
 						unlocked = true
 						break
 					}
@@ -104,16 +147,17 @@ func NewWallet(input string) (KeyManager, string, error) {
 		}
 	}
 
-	return km, walletOptsHelp, nil
-}
-
-// Wallet is a key manager that loads keys from a local Ethereum 2 wallet.
-type Wallet struct {
-	accounts map[[48]byte]e2wtypes.Account
+	km.lock.Lock()
+	defer km.lock.Unlock()
+	km.accounts = accounts
+	km.fingerprint = fingerprint
+	return nil
 }
 
 // FetchValidatingKeys fetches the list of public keys that should be used to validate with.
 func (km *Wallet) FetchValidatingKeys() ([][48]byte, error) {
+	km.lock.RLock()
+	defer km.lock.RUnlock()
 	res := make([][48]byte, 0, len(km.accounts))
 	for pubKey := range km.accounts {
 		res = append(res, pubKey)
@@ -123,7 +167,9 @@ func (km *Wallet) FetchValidatingKeys() ([][48]byte, error) {
 
 // Sign signs a message for the validator to broadcast.
 func (km *Wallet) Sign(pubKey [48]byte, root [32]byte, domain uint64) (*bls.Signature, error) {
+	km.lock.RLock()
 	account, exists := km.accounts[pubKey]
+	km.lock.RUnlock()
 	if !exists {
 		return nil, ErrNoSuchKey
 	}
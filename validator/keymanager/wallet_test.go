@@ -6,7 +6,9 @@ import (
 	"os"
 	"testing"
 
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	e2wallet "github.com/wealdtech/go-eth2-wallet"
 	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
 	nd "github.com/wealdtech/go-eth2-wallet-nd"
 	filesystem "github.com/wealdtech/go-eth2-wallet-store-filesystem"
@@ -91,3 +93,101 @@ func TestMultiplePassphrases(t *testing.T) {
 		})
 	}
 }
+
+func TestReloadValidatingKeys(t *testing.T) {
+	path := SetupWallet(t)
+	defer os.RemoveAll(path)
+	km := wallet(t, fmt.Sprintf(`{"location":%q,"accounts":["Wallet 1"],"passphrases":["foo","bar"]}`, path))
+	reloadable, ok := km.(keymanager.ReloadableKeyManager)
+	if !ok {
+		t.Fatal("Wallet key manager is not reloadable")
+	}
+
+	// Nothing changes when the wallet does not.
+	if err := reloadable.ReloadValidatingKeys(); err != nil {
+		t.Fatal(err)
+	}
+	keys, err := km.FetchValidatingKeys()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 2 {
+		t.Fatalf("Found %d keys; expected 2", len(keys))
+	}
+
+	w, err := e2wallet.OpenWallet("Wallet 1", e2wallet.WithStore(filesystem.New(filesystem.WithLocation(path))))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Unlock(nil); err != nil {
+		t.Fatal(err)
+	}
+	account, err := w.CreateAccount("Account 3", []byte("foo"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := reloadable.ReloadValidatingKeys(); err != nil {
+		t.Fatal(err)
+	}
+	keys, err = km.FetchValidatingKeys()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 3 {
+		t.Fatalf("Found %d keys after reload; expected 3", len(keys))
+	}
+	pubKey := bytesutil.ToBytes48(account.PublicKey().Marshal())
+	if _, err := km.Sign(pubKey, [32]byte{}, 0); err != nil {
+		t.Errorf("Could not sign with the added key: %v", err)
+	}
+}
+
+func TestReloadValidatingKeys_DefaultLocation(t *testing.T) {
+	home, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(home)
+	oldHome := os.Getenv("HOME")
+	if err := os.Setenv("HOME", home); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := os.Setenv("HOME", oldHome); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	w, err := nd.CreateWallet("Wallet 1", filesystem.New(), keystorev4.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Unlock(nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.CreateAccount("Account 1", []byte("foo")); err != nil {
+		t.Fatal(err)
+	}
+	km := wallet(t, `{"accounts":["Wallet 1"],"passphrases":["foo"]}`)
+	keys, err := km.FetchValidatingKeys()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 1 {
+		t.Fatalf("Found %d keys; expected 1", len(keys))
+	}
+
+	if _, err := w.CreateAccount("Account 2", []byte("foo")); err != nil {
+		t.Fatal(err)
+	}
+	if err := km.(keymanager.ReloadableKeyManager).ReloadValidatingKeys(); err != nil {
+		t.Fatal(err)
+	}
+	keys, err = km.FetchValidatingKeys()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 2 {
+		t.Errorf("Found %d keys after reload; expected 2", len(keys))
+	}
+}
@@ -39,6 +39,7 @@ var appFlags = []cli.Flag{
 	flags.BeaconRPCProviderFlag,
 	flags.CertFlag,
 	flags.GraffitiFlag,
+	flags.GraffitiFileFlag,
 	flags.KeystorePathFlag,
 	flags.PasswordFlag,
 	flags.DisablePenaltyRewardLogFlag,
@@ -51,6 +52,10 @@ var appFlags = []cli.Flag{
 	flags.KeyManager,
 	flags.KeyManagerOpts,
 	flags.AccountMetricsFlag,
+	flags.EnableRPCFlag,
+	flags.RPCHost,
+	flags.RPCPort,
+	flags.GRPCGatewayPort,
 	cmd.VerbosityFlag,
 	cmd.DataDirFlag,
 	cmd.ClearDB,
@@ -69,6 +74,8 @@ var appFlags = []cli.Flag{
 	debug.TraceFlag,
 	cmd.LogFileName,
 	cmd.ConfigFileFlag,
+	cmd.ChainConfigFileFlag,
+	cmd.SlotTimingFractionsFlag,
 }
 
 func init() {
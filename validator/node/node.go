@@ -18,12 +18,14 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/debug"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/prometheus"
+	"github.com/prysmaticlabs/prysm/shared/slottiming"
 	"github.com/prysmaticlabs/prysm/shared/tracing"
 	"github.com/prysmaticlabs/prysm/shared/version"
 	"github.com/prysmaticlabs/prysm/validator/client"
 	"github.com/prysmaticlabs/prysm/validator/db"
 	"github.com/prysmaticlabs/prysm/validator/flags"
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/validator/rpc"
 	"github.com/sirupsen/logrus"
 	"gopkg.in/urfave/cli.v2"
 )
@@ -67,6 +69,14 @@ func NewValidatorClient(ctx *cli.Context) (*ValidatorClient, error) {
 	}
 
 	featureconfig.ConfigureValidator(ctx)
+	if err := cmd.LoadChainConfigFile(ctx); err != nil {
+		return nil, err
+	}
+	slotTimingFractions, err := slottiming.ParseFractions(ctx.String(cmd.SlotTimingFractionsFlag.Name))
+	if err != nil {
+		return nil, err
+	}
+	slottiming.SetFractions(slotTimingFractions)
 
 	keyManager, err := selectKeyManager(ctx)
 	if err != nil {
@@ -111,6 +121,12 @@ func NewValidatorClient(ctx *cli.Context) (*ValidatorClient, error) {
 		return nil, err
 	}
 
+	if ctx.Bool(flags.EnableRPCFlag.Name) {
+		if err := ValidatorClient.registerRPCService(ctx); err != nil {
+			return nil, err
+		}
+	}
+
 	return ValidatorClient, nil
 }
 
@@ -122,7 +138,9 @@ func (s *ValidatorClient) Start() {
 		"version": version.GetVersion(),
 	}).Info("Starting validator node")
 
-	s.services.StartAll()
+	if err := s.services.StartAll(); err != nil {
+		log.WithError(err).Fatal("Could not start services")
+	}
 
 	stop := s.stop
 	s.lock.Unlock()
@@ -185,6 +203,7 @@ func (s *ValidatorClient) registerClientService(ctx *cli.Context, keyManager key
 		EmitAccountMetrics:         emitAccountMetrics,
 		CertFlag:                   cert,
 		GraffitiFlag:               graffiti,
+		GraffitiFileFlag:           ctx.String(flags.GraffitiFileFlag.Name),
 		GrpcMaxCallRecvMsgSizeFlag: maxCallRecvMsgSize,
 		GrpcRetriesFlag:            grpcRetries,
 		GrpcHeadersFlag:            ctx.String(flags.GrpcHeadersFlag.Name),
@@ -195,6 +214,25 @@ func (s *ValidatorClient) registerClientService(ctx *cli.Context, keyManager key
 	return s.services.RegisterService(v)
 }
 
+func (s *ValidatorClient) registerRPCService(ctx *cli.Context) error {
+	var vs *client.ValidatorService
+	if err := s.services.FetchService(&vs); err != nil {
+		return err
+	}
+	dataDir := ctx.String(cmd.DataDirFlag.Name)
+	if dataDir == "" {
+		dataDir = cmd.DefaultDataDir()
+	}
+	server := rpc.NewService(context.Background(), &rpc.Config{
+		Host:             ctx.String(flags.RPCHost.Name),
+		Port:             ctx.Int(flags.RPCPort.Name),
+		GatewayPort:      ctx.Int(flags.GRPCGatewayPort.Name),
+		DataDir:          dataDir,
+		ValidatorService: vs,
+	})
+	return s.services.RegisterService(server)
+}
+
 // selectKeyManager selects the key manager depending on the options provided by the user.
 func selectKeyManager(ctx *cli.Context) (keymanager.KeyManager, error) {
 	manager := strings.ToLower(ctx.String(flags.KeyManager.Name))
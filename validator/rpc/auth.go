@@ -0,0 +1,67 @@
+package rpc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// authTokenFileName is the file of the data directory which holds the token that
+// authenticates the requests to the management API.
+const authTokenFileName = "auth-token"
+
+// loadOrCreateAuthToken reads the authentication token from the data directory, and
+// creates a random one if there is none yet.
+func loadOrCreateAuthToken(dataDir string) (string, error) {
+	path := filepath.Join(dataDir, authTokenFileName)
+	// #nosec G304
+	token, err := ioutil.ReadFile(path)
+	if err == nil {
+		return strings.TrimSpace(string(token)), nil
+	}
+	if !os.IsNotExist(err) {
+		return "", err
+	}
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(dataDir, 0700); err != nil {
+		return "", err
+	}
+	if err := ioutil.WriteFile(path, []byte(hex.EncodeToString(b)), 0600); err != nil {
+		return "", err
+	}
+	log.WithField("path", path).Info("Generated authentication token of the management API")
+	return hex.EncodeToString(b), nil
+}
+
+// authInterceptor rejects the requests which do not carry the token as a bearer token in
+// their authorization header.
+func authInterceptor(token string) grpc.UnaryServerInterceptor {
+	want := []byte("Bearer " + token)
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		md, ok := metadata.FromIncomingContext(ctx)
+		if !ok {
+			return nil, status.Error(codes.Unauthenticated, "Missing authorization header")
+		}
+		values := md.Get("authorization")
+		if len(values) == 0 {
+			return nil, status.Error(codes.Unauthenticated, "Missing authorization header")
+		}
+		if subtle.ConstantTimeCompare([]byte(values[0]), want) != 1 {
+			return nil, status.Error(codes.Unauthenticated, "Invalid authentication token")
+		}
+		return handler(ctx, req)
+	}
+}
@@ -0,0 +1,88 @@
+package rpc
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestLoadOrCreateAuthToken(t *testing.T) {
+	dir, err := ioutil.TempDir("", "auth")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	token, err := loadOrCreateAuthToken(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(token) != 64 {
+		t.Errorf("Expected a 64 characters long token, received %q", token)
+	}
+	info, err := os.Stat(filepath.Join(dir, authTokenFileName))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != 0600 {
+		t.Errorf("Expected token file to be readable by its owner only, received %v", info.Mode())
+	}
+
+	loaded, err := loadOrCreateAuthToken(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loaded != token {
+		t.Errorf("Expected token %q to be loaded, received %q", token, loaded)
+	}
+}
+
+func TestAuthInterceptor(t *testing.T) {
+	interceptor := authInterceptor("secret")
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+	tests := []struct {
+		name string
+		md   metadata.MD
+		code codes.Code
+	}{
+		{
+			name: "missing header",
+			md:   metadata.MD{},
+			code: codes.Unauthenticated,
+		},
+		{
+			name: "wrong token",
+			md:   metadata.Pairs("authorization", "Bearer guess"),
+			code: codes.Unauthenticated,
+		},
+		{
+			name: "valid token",
+			md:   metadata.Pairs("authorization", "Bearer secret"),
+			code: codes.OK,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := metadata.NewIncomingContext(context.Background(), tt.md)
+			res, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{}, handler)
+			if status.Code(err) != tt.code {
+				t.Fatalf("Expected code %v, received %v", tt.code, err)
+			}
+			if tt.code == codes.OK && res != "ok" {
+				t.Errorf("Expected request to be handled, received %v", res)
+			}
+		})
+	}
+	if _, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{}, handler); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("Expected request without metadata to be rejected, received %v", err)
+	}
+}
@@ -0,0 +1,314 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+
+	ptypes "github.com/gogo/protobuf/types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/validator/client"
+	"github.com/prysmaticlabs/prysm/validator/db"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = pb.ValidatorManagementServer(&Server{})
+
+// KeyToggler enables and disables the duties of keys.
+type KeyToggler interface {
+	SetKeyEnabled(ctx context.Context, pubKey [48]byte, enabled bool) error
+	KeyEnabled(pubKey [48]byte) bool
+}
+
+// Backend provides the beacon node clients and the slashing protection database of the
+// validator client. The validator service only connects to the beacon node and opens its
+// database once it started, so they are read on every request.
+type Backend interface {
+	ValidatorClient() (ethpb.BeaconNodeValidatorClient, error)
+	BeaconClient() (ethpb.BeaconChainClient, error)
+	DB() (*db.Store, error)
+}
+
+// Server defines a server implementation of the gRPC validator management service,
+// providing RPC endpoints to manage the keys of the validator client.
+type Server struct {
+	KeyManager keymanager.KeyManager
+	Backend    Backend
+	Keys       KeyToggler
+}
+
+// ListKeys lists the keys of the key manager, with the status, balance and duties of their
+// validators in the epoch of the head of the beacon node.
+func (s *Server) ListKeys(ctx context.Context, _ *ptypes.Empty) (*pb.ListKeysResponse, error) {
+	keys, err := s.KeyManager.FetchValidatingKeys()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not fetch validating keys: %v", err)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return bytes.Compare(keys[i][:], keys[j][:]) < 0
+	})
+	res := &pb.ListKeysResponse{
+		Keys: make([]*pb.ListKeysResponse_Key, len(keys)),
+	}
+	byKey := make(map[[48]byte]*pb.ListKeysResponse_Key, len(keys))
+	for i, pubKey := range keys {
+		res.Keys[i] = &pb.ListKeysResponse_Key{
+			PublicKey: pubKey[:],
+			Enabled:   s.Keys.KeyEnabled(pubKey),
+			Status:    ethpb.ValidatorStatus_UNKNOWN_STATUS.String(),
+		}
+		byKey[pubKey] = res.Keys[i]
+	}
+	if len(keys) == 0 {
+		return res, nil
+	}
+	pubKeys := bytesutil.FromBytes48Array(keys)
+
+	validatorClient, err := s.Backend.ValidatorClient()
+	if err != nil {
+		return nil, err
+	}
+	beaconClient, err := s.Backend.BeaconClient()
+	if err != nil {
+		return nil, err
+	}
+	head, err := beaconClient.GetChainHead(ctx, &ptypes.Empty{})
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "Could not get chain head: %v", err)
+	}
+	duties, err := validatorClient.GetDuties(ctx, &ethpb.DutiesRequest{
+		Epoch:      head.HeadEpoch,
+		PublicKeys: pubKeys,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "Could not get duties: %v", err)
+	}
+	for _, duty := range duties.Duties {
+		key, ok := byKey[bytesutil.ToBytes48(duty.PublicKey)]
+		if !ok {
+			continue
+		}
+		key.Status = duty.Status.String()
+		key.Index = duty.ValidatorIndex
+		key.AttesterSlot = duty.AttesterSlot
+		key.CommitteeIndex = duty.CommitteeIndex
+		key.ProposerSlot = duty.ProposerSlot
+	}
+
+	req := &ethpb.ListValidatorBalancesRequest{
+		PublicKeys: pubKeys,
+	}
+	for {
+		balances, err := beaconClient.ListValidatorBalances(ctx, req)
+		if err != nil {
+			return nil, status.Errorf(codes.Unavailable, "Could not list validator balances: %v", err)
+		}
+		for _, balance := range balances.Balances {
+			if key, ok := byKey[bytesutil.ToBytes48(balance.PublicKey)]; ok {
+				key.Balance = balance.Balance
+			}
+		}
+		if balances.NextPageToken == "" {
+			break
+		}
+		req.PageToken = balances.NextPageToken
+	}
+	return res, nil
+}
+
+// ImportKeystore imports a keystore into the key manager, if it stores keys.
+func (s *Server) ImportKeystore(ctx context.Context, req *pb.ImportKeystoreRequest) (*pb.ImportKeystoreResponse, error) {
+	km, ok := s.KeyManager.(keymanager.StoringKeyManager)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "The key manager does not support importing keys")
+	}
+	pubKey, err := km.ImportKeystore(req.Keystore, req.Password)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Could not import keystore: %v", err)
+	}
+	log.WithField("pubKey", fmt.Sprintf("%#x", pubKey)).Info("Imported keystore")
+	return &pb.ImportKeystoreResponse{PublicKey: pubKey[:]}, nil
+}
+
+// DeleteKey deletes a key from the key manager, if it stores keys, and returns the slashing
+// protection history of the key.
+func (s *Server) DeleteKey(ctx context.Context, req *pb.DeleteKeyRequest) (*pb.SigningHistory, error) {
+	km, ok := s.KeyManager.(keymanager.StoringKeyManager)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "The key manager does not support deleting keys")
+	}
+	pubKey, err := publicKey(req.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	// The key is disabled and deleted before its history is read, so that it cannot sign
+	// anything which is missing from the exported history. It stays disabled, so it is not
+	// validated with again if it is imported back.
+	enabled := s.Keys.KeyEnabled(pubKey)
+	if err := s.Keys.SetKeyEnabled(ctx, pubKey, false); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not disable key: %v", err)
+	}
+	if err := km.DeleteKey(pubKey); err != nil {
+		if enabled {
+			if err := s.Keys.SetKeyEnabled(ctx, pubKey, true); err != nil {
+				log.WithError(err).Error("Could not enable key again")
+			}
+		}
+		if err == keymanager.ErrNoSuchKey {
+			return nil, status.Error(codes.NotFound, "No such key")
+		}
+		return nil, status.Errorf(codes.Internal, "Could not delete key: %v", err)
+	}
+	log.WithField("pubKey", fmt.Sprintf("%#x", pubKey)).Info("Deleted key")
+	// The history stays in the database, it can be read again if it cannot be exported now.
+	return s.signingHistory(ctx, pubKey)
+}
+
+// SetKeyEnabled enables or disables the duties of a key of the key manager.
+func (s *Server) SetKeyEnabled(ctx context.Context, req *pb.SetKeyEnabledRequest) (*ptypes.Empty, error) {
+	pubKey, err := s.knownPublicKey(req.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Keys.SetKeyEnabled(ctx, pubKey, req.Enabled); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not set key enabled: %v", err)
+	}
+	log.WithField("pubKey", fmt.Sprintf("%#x", pubKey)).WithField("enabled", req.Enabled).Info("Set key enabled")
+	return &ptypes.Empty{}, nil
+}
+
+// GetSigningHistory returns the recent block proposals and attestations of a key, from the
+// slashing protection database.
+func (s *Server) GetSigningHistory(ctx context.Context, req *pb.SigningHistoryRequest) (*pb.SigningHistory, error) {
+	pubKey, err := publicKey(req.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	return s.signingHistory(ctx, pubKey)
+}
+
+// VoluntaryExit signs and submits a voluntary exit of the validator of a key, at the epoch
+// of the head of the beacon node.
+func (s *Server) VoluntaryExit(ctx context.Context, req *pb.VoluntaryExitRequest) (*ptypes.Empty, error) {
+	pubKey, err := s.knownPublicKey(req.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	validatorClient, err := s.Backend.ValidatorClient()
+	if err != nil {
+		return nil, err
+	}
+	beaconClient, err := s.Backend.BeaconClient()
+	if err != nil {
+		return nil, err
+	}
+	index, err := validatorClient.ValidatorIndex(ctx, &ethpb.ValidatorIndexRequest{PublicKey: pubKey[:]})
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "Could not get validator index: %v", err)
+	}
+	head, err := beaconClient.GetChainHead(ctx, &ptypes.Empty{})
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "Could not get chain head: %v", err)
+	}
+	domain, err := validatorClient.DomainData(ctx, &ethpb.DomainRequest{
+		Epoch:  head.HeadEpoch,
+		Domain: params.BeaconConfig().DomainVoluntaryExit[:],
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "Could not get domain data: %v", err)
+	}
+	exit := &ethpb.VoluntaryExit{
+		Epoch:          head.HeadEpoch,
+		ValidatorIndex: index.Index,
+	}
+	root, err := ssz.HashTreeRoot(exit)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get signing root: %v", err)
+	}
+	sig, err := s.KeyManager.Sign(pubKey, root, domain.SignatureDomain)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not sign voluntary exit: %v", err)
+	}
+	if _, err := validatorClient.ProposeExit(ctx, &ethpb.SignedVoluntaryExit{
+		Exit:      exit,
+		Signature: sig.Marshal(),
+	}); err != nil {
+		return nil, status.Errorf(codes.Unavailable, "Could not propose voluntary exit: %v", err)
+	}
+	log.WithField("pubKey", fmt.Sprintf("%#x", pubKey)).WithField("epoch", exit.Epoch).Info("Submitted voluntary exit")
+	return &ptypes.Empty{}, nil
+}
+
+// signingHistory returns the proposals and attestations of the key recorded in the slashing
+// protection database.
+func (s *Server) signingHistory(ctx context.Context, pubKey [48]byte) (*pb.SigningHistory, error) {
+	valDB, err := s.Backend.DB()
+	if err != nil {
+		return nil, err
+	}
+	res := &pb.SigningHistory{
+		AttestedTargetToSource: make(map[uint64]uint64),
+	}
+	proposals, err := valDB.ProposalHistory(ctx, pubKey[:])
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get proposal history: %v", err)
+	}
+	if proposals != nil {
+		wsPeriod := params.BeaconConfig().WeakSubjectivityPeriod
+		start := uint64(0)
+		if proposals.LatestEpochWritten >= wsPeriod {
+			start = proposals.LatestEpochWritten - wsPeriod + 1
+		}
+		for epoch := start; epoch <= proposals.LatestEpochWritten; epoch++ {
+			if client.HasProposedForEpoch(proposals, epoch) {
+				res.ProposedEpochs = append(res.ProposedEpochs, epoch)
+			}
+		}
+	}
+	attestations, err := valDB.AttestationHistory(ctx, pubKey[:])
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get attestation history: %v", err)
+	}
+	if attestations != nil {
+		for target, source := range attestations.TargetToSource {
+			// A clean history holds a single entry with a far future source.
+			if source == params.BeaconConfig().FarFutureEpoch {
+				continue
+			}
+			res.AttestedTargetToSource[target] = source
+		}
+	}
+	return res, nil
+}
+
+// knownPublicKey parses the public key and checks that the key manager holds it.
+func (s *Server) knownPublicKey(b []byte) ([48]byte, error) {
+	pubKey, err := publicKey(b)
+	if err != nil {
+		return [48]byte{}, err
+	}
+	keys, err := s.KeyManager.FetchValidatingKeys()
+	if err != nil {
+		return [48]byte{}, status.Errorf(codes.Internal, "Could not fetch validating keys: %v", err)
+	}
+	for _, key := range keys {
+		if key == pubKey {
+			return pubKey, nil
+		}
+	}
+	return [48]byte{}, status.Error(codes.NotFound, "No such key")
+}
+
+func publicKey(b []byte) ([48]byte, error) {
+	if len(b) != params.BeaconConfig().BLSPubkeyLength {
+		return [48]byte{}, status.Errorf(codes.InvalidArgument, "Public key must be %d bytes long", params.BeaconConfig().BLSPubkeyLength)
+	}
+	return bytesutil.ToBytes48(b), nil
+}
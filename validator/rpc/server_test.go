@@ -0,0 +1,370 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/golang/mock/gomock"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/go-ssz"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/mock"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/validator/client"
+	"github.com/prysmaticlabs/prysm/validator/db"
+	"github.com/prysmaticlabs/prysm/validator/internal"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type keyToggler map[[48]byte]bool
+
+func (k keyToggler) SetKeyEnabled(_ context.Context, pubKey [48]byte, enabled bool) error {
+	k[pubKey] = !enabled
+	return nil
+}
+
+func (k keyToggler) KeyEnabled(pubKey [48]byte) bool {
+	return !k[pubKey]
+}
+
+// testBackend serves the given clients and database.
+type testBackend struct {
+	validatorClient ethpb.BeaconNodeValidatorClient
+	beaconClient    ethpb.BeaconChainClient
+	db              *db.Store
+}
+
+func (b *testBackend) ValidatorClient() (ethpb.BeaconNodeValidatorClient, error) {
+	return b.validatorClient, nil
+}
+
+func (b *testBackend) BeaconClient() (ethpb.BeaconChainClient, error) {
+	return b.beaconClient, nil
+}
+
+func (b *testBackend) DB() (*db.Store, error) {
+	return b.db, nil
+}
+
+func TestListKeys(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	validatorClient := internal.NewMockBeaconNodeValidatorClient(ctrl)
+	beaconClient := mock.NewMockBeaconChainClient(ctrl)
+
+	activeKey, pendingKey := bls.RandKey(), bls.RandKey()
+	activePubKey := bytesutil.ToBytes48(activeKey.PublicKey().Marshal())
+	pendingPubKey := bytesutil.ToBytes48(pendingKey.PublicKey().Marshal())
+	keys := keyToggler{pendingPubKey: true}
+	s := &Server{
+		KeyManager: keymanager.NewDirect([]*bls.SecretKey{activeKey, pendingKey}),
+		Backend:    &testBackend{validatorClient: validatorClient, beaconClient: beaconClient},
+		Keys:       keys,
+	}
+
+	beaconClient.EXPECT().GetChainHead(gomock.Any(), gomock.Any()).Return(&ethpb.ChainHead{HeadEpoch: 3}, nil)
+	validatorClient.EXPECT().GetDuties(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *ethpb.DutiesRequest) (*ethpb.DutiesResponse, error) {
+			if req.Epoch != 3 {
+				t.Errorf("Requested duties of epoch %d, expected 3", req.Epoch)
+			}
+			return &ethpb.DutiesResponse{
+				Duties: []*ethpb.DutiesResponse_Duty{
+					{
+						PublicKey:      activePubKey[:],
+						Status:         ethpb.ValidatorStatus_ACTIVE,
+						ValidatorIndex: 7,
+						AttesterSlot:   100,
+						CommitteeIndex: 2,
+						ProposerSlot:   101,
+					},
+					{
+						PublicKey: pendingPubKey[:],
+						Status:    ethpb.ValidatorStatus_DEPOSITED,
+					},
+				},
+			}, nil
+		})
+	beaconClient.EXPECT().ListValidatorBalances(gomock.Any(), gomock.Any()).Return(&ethpb.ValidatorBalances{
+		Balances: []*ethpb.ValidatorBalances_Balance{
+			{PublicKey: activePubKey[:], Index: 7, Balance: 32000000000},
+		},
+	}, nil)
+
+	res, err := s.ListKeys(context.Background(), &ptypes.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Keys) != 2 {
+		t.Fatalf("Expected 2 keys, received %d", len(res.Keys))
+	}
+	for _, key := range res.Keys {
+		switch bytesutil.ToBytes48(key.PublicKey) {
+		case activePubKey:
+			want := &pb.ListKeysResponse_Key{
+				PublicKey:      activePubKey[:],
+				Enabled:        true,
+				Status:         "ACTIVE",
+				Index:          7,
+				Balance:        32000000000,
+				AttesterSlot:   100,
+				CommitteeIndex: 2,
+				ProposerSlot:   101,
+			}
+			if !proto.Equal(want, key) {
+				t.Errorf("Wanted %v, received %v", want, key)
+			}
+		case pendingPubKey:
+			if key.Enabled {
+				t.Error("Expected disabled key to be listed as disabled")
+			}
+			if key.Status != "DEPOSITED" {
+				t.Errorf("Expected status DEPOSITED, received %s", key.Status)
+			}
+		default:
+			t.Errorf("Unexpected key %#x", key.PublicKey)
+		}
+	}
+}
+
+func TestSetKeyEnabled(t *testing.T) {
+	key := bls.RandKey()
+	pubKey := bytesutil.ToBytes48(key.PublicKey().Marshal())
+	keys := keyToggler{}
+	s := &Server{
+		KeyManager: keymanager.NewDirect([]*bls.SecretKey{key}),
+		Keys:       keys,
+	}
+
+	if _, err := s.SetKeyEnabled(context.Background(), &pb.SetKeyEnabledRequest{
+		PublicKey: pubKey[:],
+		Enabled:   false,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if keys.KeyEnabled(pubKey) {
+		t.Error("Expected key to be disabled")
+	}
+
+	unknown := bytesutil.ToBytes48(bls.RandKey().PublicKey().Marshal())
+	_, err := s.SetKeyEnabled(context.Background(), &pb.SetKeyEnabledRequest{PublicKey: unknown[:]})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("Expected NotFound for an unknown key, received %v", err)
+	}
+	_, err = s.SetKeyEnabled(context.Background(), &pb.SetKeyEnabledRequest{PublicKey: []byte{1}})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected InvalidArgument for a malformed key, received %v", err)
+	}
+}
+
+func TestGetSigningHistory(t *testing.T) {
+	pubKey := [48]byte{1}
+	valDB := db.SetupDB(t, [][48]byte{pubKey})
+	defer db.TeardownDB(t, valDB)
+	ctx := context.Background()
+	s := &Server{Backend: &testBackend{db: valDB}}
+
+	history, err := s.GetSigningHistory(ctx, &pb.SigningHistoryRequest{PublicKey: pubKey[:]})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(history.ProposedEpochs) != 0 || len(history.AttestedTargetToSource) != 0 {
+		t.Errorf("Expected an empty history, received %v", history)
+	}
+
+	proposals := &slashpb.ProposalHistory{
+		EpochBits: bitfield.NewBitlist(params.BeaconConfig().WeakSubjectivityPeriod),
+	}
+	proposals = client.SetProposedForEpoch(proposals, 4)
+	proposals = client.SetProposedForEpoch(proposals, 9)
+	if err := valDB.SaveProposalHistory(ctx, pubKey[:], proposals); err != nil {
+		t.Fatal(err)
+	}
+	attestations := &slashpb.AttestationHistory{
+		TargetToSource: map[uint64]uint64{
+			0: params.BeaconConfig().FarFutureEpoch,
+			5: 4,
+			6: 5,
+		},
+		LatestEpochWritten: 6,
+	}
+	if err := valDB.SaveAttestationHistory(ctx, pubKey[:], attestations); err != nil {
+		t.Fatal(err)
+	}
+
+	history, err = s.GetSigningHistory(ctx, &pb.SigningHistoryRequest{PublicKey: pubKey[:]})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &pb.SigningHistory{
+		ProposedEpochs:         []uint64{4, 9},
+		AttestedTargetToSource: map[uint64]uint64{5: 4, 6: 5},
+	}
+	if !proto.Equal(want, history) {
+		t.Errorf("Wanted %v, received %v", want, history)
+	}
+}
+
+// storingKeyManager deletes the keys it knows of, which must have been disabled before.
+type storingKeyManager struct {
+	keymanager.KeyManager
+	t      *testing.T
+	keys   keyToggler
+	stored map[[48]byte]bool
+}
+
+func (km *storingKeyManager) ImportKeystore([]byte, string) ([48]byte, error) {
+	return [48]byte{}, errors.New("not supported")
+}
+
+func (km *storingKeyManager) DeleteKey(pubKey [48]byte) error {
+	if km.keys.KeyEnabled(pubKey) {
+		km.t.Error("Expected key to be disabled before it is deleted")
+	}
+	if !km.stored[pubKey] {
+		return keymanager.ErrNoSuchKey
+	}
+	delete(km.stored, pubKey)
+	return nil
+}
+
+func TestDeleteKey(t *testing.T) {
+	key := bls.RandKey()
+	pubKey := bytesutil.ToBytes48(key.PublicKey().Marshal())
+	valDB := db.SetupDB(t, [][48]byte{pubKey})
+	defer db.TeardownDB(t, valDB)
+	ctx := context.Background()
+	proposals := &slashpb.ProposalHistory{
+		EpochBits: bitfield.NewBitlist(params.BeaconConfig().WeakSubjectivityPeriod),
+	}
+	proposals = client.SetProposedForEpoch(proposals, 4)
+	if err := valDB.SaveProposalHistory(ctx, pubKey[:], proposals); err != nil {
+		t.Fatal(err)
+	}
+	keys := keyToggler{}
+	s := &Server{
+		KeyManager: &storingKeyManager{
+			KeyManager: keymanager.NewDirect([]*bls.SecretKey{key}),
+			t:          t,
+			keys:       keys,
+			stored:     map[[48]byte]bool{pubKey: true},
+		},
+		Backend: &testBackend{db: valDB},
+		Keys:    keys,
+	}
+
+	history, err := s.DeleteKey(ctx, &pb.DeleteKeyRequest{PublicKey: pubKey[:]})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &pb.SigningHistory{
+		ProposedEpochs:         []uint64{4},
+		AttestedTargetToSource: map[uint64]uint64{},
+	}
+	if !proto.Equal(want, history) {
+		t.Errorf("Wanted %v, received %v", want, history)
+	}
+	if keys.KeyEnabled(pubKey) {
+		t.Error("Expected deleted key to stay disabled")
+	}
+
+	unknown := bytesutil.ToBytes48(bls.RandKey().PublicKey().Marshal())
+	_, err = s.DeleteKey(ctx, &pb.DeleteKeyRequest{PublicKey: unknown[:]})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("Expected NotFound for an unknown key, received %v", err)
+	}
+	if !keys.KeyEnabled(unknown) {
+		t.Error("Expected key to be enabled again when it could not be deleted")
+	}
+}
+
+func TestDeleteKey_UnsupportedKeyManager(t *testing.T) {
+	key := bls.RandKey()
+	pubKey := bytesutil.ToBytes48(key.PublicKey().Marshal())
+	s := &Server{
+		KeyManager: keymanager.NewDirect([]*bls.SecretKey{key}),
+	}
+	_, err := s.DeleteKey(context.Background(), &pb.DeleteKeyRequest{PublicKey: pubKey[:]})
+	if status.Code(err) != codes.Unimplemented {
+		t.Errorf("Expected Unimplemented, received %v", err)
+	}
+}
+
+func TestVoluntaryExit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	validatorClient := internal.NewMockBeaconNodeValidatorClient(ctrl)
+	beaconClient := mock.NewMockBeaconChainClient(ctrl)
+
+	key := bls.RandKey()
+	pubKey := bytesutil.ToBytes48(key.PublicKey().Marshal())
+	s := &Server{
+		KeyManager: keymanager.NewDirect([]*bls.SecretKey{key}),
+		Backend:    &testBackend{validatorClient: validatorClient, beaconClient: beaconClient},
+	}
+
+	validatorClient.EXPECT().ValidatorIndex(gomock.Any(), &ethpb.ValidatorIndexRequest{
+		PublicKey: pubKey[:],
+	}).Return(&ethpb.ValidatorIndexResponse{Index: 11}, nil)
+	beaconClient.EXPECT().GetChainHead(gomock.Any(), gomock.Any()).Return(&ethpb.ChainHead{HeadEpoch: 300}, nil)
+	validatorClient.EXPECT().DomainData(gomock.Any(), &ethpb.DomainRequest{
+		Epoch:  300,
+		Domain: params.BeaconConfig().DomainVoluntaryExit[:],
+	}).Return(&ethpb.DomainResponse{SignatureDomain: 5}, nil)
+	validatorClient.EXPECT().ProposeExit(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *ethpb.SignedVoluntaryExit) (*ptypes.Empty, error) {
+			if req.Exit.Epoch != 300 || req.Exit.ValidatorIndex != 11 {
+				t.Errorf("Unexpected exit %v", req.Exit)
+			}
+			sig, err := bls.SignatureFromBytes(req.Signature)
+			if err != nil {
+				t.Fatal(err)
+			}
+			root, err := ssz.HashTreeRoot(req.Exit)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !sig.Verify(root[:], key.PublicKey(), 5) {
+				t.Error("Invalid signature of voluntary exit")
+			}
+			return &ptypes.Empty{}, nil
+		})
+
+	if _, err := s.VoluntaryExit(context.Background(), &pb.VoluntaryExitRequest{PublicKey: pubKey[:]}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestServer_ValidatorServiceNotStarted(t *testing.T) {
+	validatorService, err := client.NewValidatorService(context.Background(), &client.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	key := bls.RandKey()
+	pubKey := bytesutil.ToBytes48(key.PublicKey().Marshal())
+	s := &Server{
+		KeyManager: keymanager.NewDirect([]*bls.SecretKey{key}),
+		Backend:    &validatorBackend{validatorService: validatorService},
+		Keys:       validatorService,
+	}
+
+	if _, err := s.ListKeys(context.Background(), &ptypes.Empty{}); status.Code(err) != codes.Unavailable {
+		t.Errorf("Expected Unavailable before the validator service started, received %v", err)
+	}
+	if _, err := s.GetSigningHistory(context.Background(), &pb.SigningHistoryRequest{PublicKey: pubKey[:]}); status.Code(err) != codes.Unavailable {
+		t.Errorf("Expected Unavailable before the validator service started, received %v", err)
+	}
+	// Keys are managed without the beacon node.
+	if _, err := s.SetKeyEnabled(context.Background(), &pb.SetKeyEnabledRequest{PublicKey: pubKey[:]}); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,202 @@
+// Package rpc serves the management API of the validator client, which lists, imports,
+// deletes, enables and disables the keys of the client over gRPC and JSON-HTTP.
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"reflect"
+
+	middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	recovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
+	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1_gateway"
+	"github.com/prysmaticlabs/prysm/shared"
+	"github.com/prysmaticlabs/prysm/shared/traceutil"
+	"github.com/prysmaticlabs/prysm/validator/client"
+	"github.com/prysmaticlabs/prysm/validator/db"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = shared.DependentService(&Service{})
+var _ = Backend(&validatorBackend{})
+
+// Service serves the management API of the validator client.
+type Service struct {
+	ctx              context.Context
+	cancel           context.CancelFunc
+	host             string
+	port             int
+	gatewayPort      int
+	dataDir          string
+	validatorService *client.ValidatorService
+	listener         net.Listener
+	grpcServer       *grpc.Server
+	gatewayServer    *http.Server
+	startFailure     error
+}
+
+// Config options for the management API service.
+type Config struct {
+	Host             string
+	Port             int
+	GatewayPort      int
+	DataDir          string
+	ValidatorService *client.ValidatorService
+}
+
+// NewService creates a new management API service for the validator service. The service
+// depends on the validator service, which connects to the beacon node and opens the database.
+func NewService(ctx context.Context, cfg *Config) *Service {
+	ctx, cancel := context.WithCancel(ctx)
+	return &Service{
+		ctx:              ctx,
+		cancel:           cancel,
+		host:             cfg.Host,
+		port:             cfg.Port,
+		gatewayPort:      cfg.GatewayPort,
+		dataDir:          cfg.DataDir,
+		validatorService: cfg.ValidatorService,
+	}
+}
+
+// Dependencies of the management API, which is started once the validator service is ready.
+func (s *Service) Dependencies() []reflect.Type {
+	return []reflect.Type{reflect.TypeOf(s.validatorService)}
+}
+
+// Start the gRPC server of the management API, and its JSON-HTTP gateway if it is enabled.
+// The requests which need the beacon node or the database fail until the validator service
+// started.
+func (s *Service) Start() {
+	token, err := loadOrCreateAuthToken(s.dataDir)
+	if err != nil {
+		s.startFailure = err
+		log.WithError(err).Error("Could not load authentication token of the management API")
+		return
+	}
+
+	address := fmt.Sprintf("%s:%d", s.host, s.port)
+	lis, err := net.Listen("tcp", address)
+	if err != nil {
+		s.startFailure = err
+		log.WithError(err).Errorf("Could not listen to port in Start() %s", address)
+		return
+	}
+	s.listener = lis
+	log.WithField("address", address).Info("Management API listening on port")
+
+	s.grpcServer = grpc.NewServer(
+		grpc.UnaryInterceptor(middleware.ChainUnaryServer(
+			recovery.UnaryServerInterceptor(
+				recovery.WithRecoveryHandlerContext(traceutil.RecoveryHandlerFunc),
+			),
+			grpc_prometheus.UnaryServerInterceptor,
+			authInterceptor(token),
+		)),
+	)
+	pb.RegisterValidatorManagementServer(s.grpcServer, &Server{
+		KeyManager: s.validatorService.KeyManager(),
+		Backend:    &validatorBackend{validatorService: s.validatorService},
+		Keys:       s.validatorService,
+	})
+	go func() {
+		if err := s.grpcServer.Serve(lis); err != nil {
+			log.WithError(err).Error("Could not serve management API")
+		}
+	}()
+
+	if s.gatewayPort > 0 {
+		s.startGateway(address)
+	}
+}
+
+// startGateway serves the management API over JSON-HTTP, by forwarding the requests to
+// the gRPC server. The authorization header of the requests is forwarded as is.
+func (s *Service) startGateway(grpcAddress string) {
+	conn, err := grpc.DialContext(s.ctx, grpcAddress, grpc.WithInsecure())
+	if err != nil {
+		s.startFailure = err
+		log.WithError(err).Error("Could not connect to management API")
+		return
+	}
+	gwmux := gwruntime.NewServeMux(gwruntime.WithMarshalerOption(gwruntime.MIMEWildcard, &gwruntime.JSONPb{OrigName: false, EmitDefaults: true}))
+	if err := pbrpc.RegisterValidatorManagementHandler(s.ctx, gwmux, conn); err != nil {
+		s.startFailure = err
+		log.WithError(err).Error("Could not start management API gateway")
+		return
+	}
+	address := fmt.Sprintf("%s:%d", s.host, s.gatewayPort)
+	s.gatewayServer = &http.Server{
+		Addr:    address,
+		Handler: gwmux,
+	}
+	log.WithField("address", address).Info("Management API gateway listening on port")
+	go func() {
+		if err := s.gatewayServer.ListenAndServe(); err != http.ErrServerClosed {
+			log.WithError(err).Error("Could not serve management API gateway")
+		}
+	}()
+}
+
+// Stop the servers of the management API.
+func (s *Service) Stop() error {
+	s.cancel()
+	if s.gatewayServer != nil {
+		if err := s.gatewayServer.Shutdown(context.Background()); err != nil {
+			log.WithError(err).Error("Could not shut down management API gateway")
+		}
+	}
+	if s.listener != nil {
+		s.grpcServer.GracefulStop()
+		log.Debug("Initiated graceful stop of management API")
+	}
+	return nil
+}
+
+// Status returns an error if the management API could not start.
+func (s *Service) Status() error {
+	return s.startFailure
+}
+
+// validatorBackend reads the beacon node connection and the database of the validator
+// service on every request.
+type validatorBackend struct {
+	validatorService *client.ValidatorService
+}
+
+// ValidatorClient returns a validator client of the beacon node the validator service is
+// connected to.
+func (b *validatorBackend) ValidatorClient() (ethpb.BeaconNodeValidatorClient, error) {
+	conn := b.validatorService.BeaconNodeConn()
+	if conn == nil {
+		return nil, status.Error(codes.Unavailable, "The validator client is not connected to a beacon node")
+	}
+	return ethpb.NewBeaconNodeValidatorClient(conn), nil
+}
+
+// BeaconClient returns a beacon chain client of the beacon node the validator service is
+// connected to.
+func (b *validatorBackend) BeaconClient() (ethpb.BeaconChainClient, error) {
+	conn := b.validatorService.BeaconNodeConn()
+	if conn == nil {
+		return nil, status.Error(codes.Unavailable, "The validator client is not connected to a beacon node")
+	}
+	return ethpb.NewBeaconChainClient(conn), nil
+}
+
+// DB returns the slashing protection database of the validator service.
+func (b *validatorBackend) DB() (*db.Store, error) {
+	valDB := b.validatorService.DB()
+	if valDB == nil {
+		return nil, status.Error(codes.Unavailable, "The validator client is not started")
+	}
+	return valDB, nil
+}
@@ -56,6 +56,8 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.LogFormat,
 			cmd.LogFileName,
 			cmd.ConfigFileFlag,
+			cmd.ChainConfigFileFlag,
+			cmd.SlotTimingFractionsFlag,
 		},
 	},
 	{
@@ -82,10 +84,15 @@ var appHelpFlagGroups = []flagGroup{
 			flags.DisablePenaltyRewardLogFlag,
 			flags.UnencryptedKeysFlag,
 			flags.GraffitiFlag,
+			flags.GraffitiFileFlag,
 			flags.GrpcMaxCallRecvMsgSizeFlag,
 			flags.GrpcRetriesFlag,
 			flags.GrpcHeadersFlag,
 			flags.AccountMetricsFlag,
+			flags.EnableRPCFlag,
+			flags.RPCHost,
+			flags.RPCPort,
+			flags.GRPCGatewayPort,
 		},
 	},
 	{